@@ -1,6 +1,11 @@
 package main
 
-import "REDACTED/team-11/backend/admin/internal/app"
+import (
+	// Time zones of buildings are validated in an image without zoneinfo.
+	_ "time/tzdata"
+
+	"REDACTED/team-11/backend/admin/internal/app"
+)
 
 // @securityDefinitions.apikey Bearer
 // @in header
@@ -22,7 +22,7 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ],
-                "description": "Get stats for booking creations. Avaliable only for ADMINs",
+                "description": "Get stats for booking creations. Requires stats.read permission",
                 "tags": [
                     "Booking"
                 ],
@@ -70,7 +70,7 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ],
-                "description": "Check status of user booking or invitation for nearest 12 hours. Avaliable only for ADMINs",
+                "description": "Check status of user booking or invitation for nearest 12 hours. Requires booking.verify permission",
                 "tags": [
                     "Booking"
                 ],
@@ -305,6 +305,526 @@ const docTemplate = `{
                 }
             }
         },
+        "/admin/internal/verification/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Returns user verification data. Requires booking.verify permission or verification.read scope of service",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Verification"
+                ],
+                "summary": "Check verification",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "User id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful response",
+                        "schema": {
+                            "$ref": "#/definitions/dto.VerificationData"
+                        }
+                    },
+                    "400": {
+                        "description": "Id must be uuid",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauth",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid role",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "500": {
+                        "description": "Something going wrong...",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/layout/amenities": {
+            "get": {
+                "description": "Get amenity catalog",
+                "tags": [
+                    "Amenity"
+                ],
+                "summary": "Get amenities",
+                "responses": {
+                    "200": {
+                        "description": "Successful get of amenities",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.Amenity"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Something going wrong...",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add amenity to catalog. Requires layout.edit permission",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Amenity"
+                ],
+                "summary": "Create amenity",
+                "parameters": [
+                    {
+                        "description": "Amenity data",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpsertAmenity"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Amenity"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauth",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid role",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "409": {
+                        "description": "Amenity already exists",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "500": {
+                        "description": "Something going wrong...",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/layout/amenities/{id}": {
+            "put": {
+                "description": "Rename amenity. Requires layout.edit permission",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Amenity"
+                ],
+                "summary": "Update amenity",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Amenity id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Amenity data",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpsertAmenity"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Amenity"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauth",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid role",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "404": {
+                        "description": "Amenity not found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "409": {
+                        "description": "Amenity already exists",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "500": {
+                        "description": "Something going wrong...",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete amenity from catalog and from all entities. Requires layout.edit permission",
+                "tags": [
+                    "Amenity"
+                ],
+                "summary": "Delete amenity",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Amenity id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful delete"
+                    },
+                    "400": {
+                        "description": "Id must be uuid",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauth",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid role",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "404": {
+                        "description": "Amenity not found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "500": {
+                        "description": "Something going wrong...",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/layout/buildings": {
+            "get": {
+                "description": "Get list of buildings",
+                "tags": [
+                    "Building"
+                ],
+                "summary": "Get buildings",
+                "responses": {
+                    "200": {
+                        "description": "Successful get of buildings",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.Building"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Something going wrong...",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create building. Requires layout.edit permission",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Building"
+                ],
+                "summary": "Create building",
+                "parameters": [
+                    {
+                        "description": "Building data",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpsertBuilding"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Building"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid time zone or opening hours",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauth",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid role",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "500": {
+                        "description": "Something going wrong...",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/layout/buildings/{id}": {
+            "get": {
+                "description": "Get building by id",
+                "tags": [
+                    "Building"
+                ],
+                "summary": "Get building by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Building id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ok",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Building"
+                        }
+                    },
+                    "400": {
+                        "description": "Id must be uuid",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "404": {
+                        "description": "Building not found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "500": {
+                        "description": "Something going wrong...",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update building. Requires layout.edit permission",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Building"
+                ],
+                "summary": "Update building",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Building id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Building data",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpsertBuilding"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Building"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid time zone or opening hours",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauth",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid role",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "404": {
+                        "description": "Building not found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "500": {
+                        "description": "Something going wrong...",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete building without floors. Requires layout.edit permission",
+                "tags": [
+                    "Building"
+                ],
+                "summary": "Delete building",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Building id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful delete"
+                    },
+                    "400": {
+                        "description": "Id must be uuid",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauth",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid role",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "404": {
+                        "description": "Building not found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "409": {
+                        "description": "Building has floors",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "500": {
+                        "description": "Something going wrong...",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    }
+                }
+            }
+        },
         "/admin/layout/entities/{id}": {
             "get": {
                 "description": "Get entity by id",
@@ -327,6 +847,12 @@ const docTemplate = `{
                         "description": "ok",
                         "schema": {
                             "$ref": "#/definitions/dto.BookingEntity"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of entity"
+                            }
                         }
                     },
                     "400": {
@@ -363,14 +889,35 @@ const docTemplate = `{
                     "Entity"
                 ],
                 "summary": "Get floors",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Building id",
+                        "name": "building_id",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "Succesful get of floors",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.FloorEntity"
-                            }
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.FloorEntity"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Id must be uuid",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "404": {
+                        "description": "Building not found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
                         }
                     },
                     "500": {
@@ -382,7 +929,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Save layout. Only for ADMINs",
+                "description": "Save layout. Requires layout.edit permission. With If-Match layout is saved only if it doesn` + "`" + `t overwrite entities changed since that floor version, conflicted entities are reported",
                 "consumes": [
                     "application/json"
                 ],
@@ -399,13 +946,25 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/dto.UpsertFloor"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of floor layout",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Report about relocated and cancelled bookings",
                         "schema": {
-                            "$ref": "#/definitions/resp.Message"
+                            "$ref": "#/definitions/dto.RelocationReport"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of saved floor layout"
+                            }
                         }
                     },
                     "400": {
@@ -426,6 +985,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.JsonError"
                         }
                     },
+                    "404": {
+                        "description": "Building not found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "412": {
+                        "description": "Floor was modified",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LayoutConflict"
+                        }
+                    },
                     "500": {
                         "description": "Something going wrong...",
                         "schema": {
@@ -450,6 +1021,16 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Amenity ids, entity must have all of them",
+                        "name": "amenity",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -460,6 +1041,12 @@ const docTemplate = `{
                             "items": {
                                 "$ref": "#/definitions/dto.BookingEntity"
                             }
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of floor layout"
+                            }
                         }
                     },
                     "400": {
@@ -477,7 +1064,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Delete floor. Only for ADMINs",
+                "description": "Delete floor. Requires layout.edit permission",
                 "tags": [
                     "Entity"
                 ],
@@ -490,11 +1077,80 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of floor layout",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "204": {
-                        "description": "Successful delete"
+                        "description": "Successful delete",
+                        "headers": {
+                            "X-Relocation-Report": {
+                                "type": "string",
+                                "description": "Id of relocation report, absent if no booking was affected"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Id must be uuid",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauth",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid role",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
+                    "412": {
+                        "description": "Floor was modified",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LayoutConflict"
+                        }
+                    },
+                    "500": {
+                        "description": "Something going wrong...",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/layout/relocations/{id}": {
+            "get": {
+                "description": "Get report about bookings relocated or cancelled while removing entities. Requires layout.edit permission",
+                "tags": [
+                    "Entity"
+                ],
+                "summary": "Get relocation report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Report id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ok",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RelocationReport"
+                        }
                     },
                     "400": {
                         "description": "Id must be uuid",
@@ -514,6 +1170,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.JsonError"
                         }
                     },
+                    "404": {
+                        "description": "Report not found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.JsonError"
+                        }
+                    },
                     "500": {
                         "description": "Something going wrong...",
                         "schema": {
@@ -530,7 +1192,7 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ],
-                "description": "Get orders with pagination and filters. Only for ADMINs.",
+                "description": "Get orders with pagination and filters. Requires order.read.any permission.",
                 "tags": [
                     "Orders"
                 ],
@@ -591,7 +1253,7 @@ const docTemplate = `{
         },
         "/admin/orders/stats": {
             "get": {
-                "description": "Get stats of order creations. Only for ADMINs.",
+                "description": "Get stats of order creations. Requires stats.read permission.",
                 "tags": [
                     "Orders"
                 ],
@@ -627,7 +1289,7 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ],
-                "description": "Set order. Only for ADMINs",
+                "description": "Set order. Requires order.fulfil permission",
                 "tags": [
                     "Orders"
                 ],
@@ -668,7 +1330,7 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ],
-                "description": "Returns user verification data. Only for ADMINs",
+                "description": "Returns user verification data. Requires booking.verify permission or verification.read scope of service",
                 "produces": [
                     "application/json"
                 ],
@@ -727,7 +1389,7 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ],
-                "description": "Set user verification data. Only for ADMINs",
+                "description": "Set user verification data. Requires booking.verify permission",
                 "consumes": [
                     "multipart/form-data"
                 ],
@@ -784,6 +1446,23 @@ const docTemplate = `{
         }
     },
     "definitions": {
+        "dto.Amenity": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
         "dto.BookingAccess": {
             "type": "object",
             "properties": {
@@ -798,6 +1477,15 @@ const docTemplate = `{
         "dto.BookingEntity": {
             "type": "object",
             "properties": {
+                "access": {
+                    "$ref": "#/definitions/dto.EntityAccess"
+                },
+                "amenities": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "capacity": {
                     "type": "integer"
                 },
@@ -810,6 +1498,9 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "is_premium": {
+                    "type": "boolean"
+                },
                 "title": {
                     "type": "string"
                 },
@@ -819,6 +1510,9 @@ const docTemplate = `{
                 "updated_at": {
                     "type": "string"
                 },
+                "version": {
+                    "type": "integer"
+                },
                 "width": {
                     "type": "integer"
                 },
@@ -830,9 +1524,47 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.Building": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "closes_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "opens_at": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
         "dto.Entity": {
             "type": "object",
             "properties": {
+                "access": {
+                    "$ref": "#/definitions/dto.EntityAccess"
+                },
+                "amenities": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "capacity": {
                     "type": "integer"
                 },
@@ -845,12 +1577,19 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "is_premium": {
+                    "type": "boolean"
+                },
                 "title": {
                     "type": "string"
                 },
                 "type": {
                     "$ref": "#/definitions/types.BookingType"
                 },
+                "version": {
+                    "description": "Version of entity editor has seen, zero for new entities.",
+                    "type": "integer"
+                },
                 "width": {
                     "type": "integer"
                 },
@@ -862,9 +1601,40 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.EntityAccess": {
+            "type": "object",
+            "properties": {
+                "teams": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.EntityConflict": {
+            "type": "object",
+            "properties": {
+                "entity_id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "$ref": "#/definitions/types.ConflictReason"
+                }
+            }
+        },
         "dto.FloorEntity": {
             "type": "object",
             "properties": {
+                "building_id": {
+                    "type": "string"
+                },
                 "created_at": {
                     "type": "string"
                 },
@@ -876,6 +1646,9 @@ const docTemplate = `{
                 },
                 "updated_at": {
                     "type": "string"
+                },
+                "version": {
+                    "type": "integer"
                 }
             }
         },
@@ -901,6 +1674,26 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.LayoutConflict": {
+            "type": "object",
+            "properties": {
+                "entities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.EntityConflict"
+                    }
+                },
+                "error": {
+                    "type": "string"
+                },
+                "floor_id": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
         "dto.Order": {
             "type": "object",
             "properties": {
@@ -941,6 +1734,55 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.Relocation": {
+            "type": "object",
+            "properties": {
+                "booking_id": {
+                    "type": "string"
+                },
+                "from_entity_id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/types.RelocationStatus"
+                },
+                "time_from": {
+                    "type": "string"
+                },
+                "time_to": {
+                    "type": "string"
+                },
+                "to_entity_id": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RelocationReport": {
+            "type": "object",
+            "properties": {
+                "cancelled": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "relocated": {
+                    "type": "integer"
+                },
+                "relocations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.Relocation"
+                    }
+                }
+            }
+        },
         "dto.Stats": {
             "type": "object",
             "properties": {
@@ -949,9 +1791,53 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.UpsertAmenity": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "projector"
+                }
+            }
+        },
+        "dto.UpsertBuilding": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "closes_at": {
+                    "type": "string",
+                    "example": "21:00"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "opens_at": {
+                    "type": "string",
+                    "example": "09:00"
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "Europe/Moscow"
+                }
+            }
+        },
         "dto.UpsertFloor": {
             "type": "object",
             "properties": {
+                "building_id": {
+                    "type": "string"
+                },
                 "entities": {
                     "type": "array",
                     "items": {
@@ -985,14 +1871,6 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.Message": {
-            "type": "object",
-            "properties": {
-                "message": {
-                    "type": "string"
-                }
-            }
-        },
         "types.BookingType": {
             "type": "string",
             "enum": [
@@ -1003,6 +1881,28 @@ const docTemplate = `{
                 "ROOM",
                 "OPENSPACE"
             ]
+        },
+        "types.ConflictReason": {
+            "type": "string",
+            "enum": [
+                "MODIFIED",
+                "DELETED"
+            ],
+            "x-enum-varnames": [
+                "MODIFIED",
+                "DELETED"
+            ]
+        },
+        "types.RelocationStatus": {
+            "type": "string",
+            "enum": [
+                "RELOCATED",
+                "CANCELLED"
+            ],
+            "x-enum-varnames": [
+                "RELOCATED",
+                "CANCELLED"
+            ]
         }
     },
     "securityDefinitions": {
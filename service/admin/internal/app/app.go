@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/nikitaSstepanov/tools"
@@ -11,6 +12,8 @@ import (
 	"REDACTED/team-11/backend/admin/internal/controller"
 	"REDACTED/team-11/backend/admin/internal/usecase"
 	"REDACTED/team-11/backend/admin/internal/usecase/storage"
+	"REDACTED/team-11/backend/admin/pkg/health"
+	"REDACTED/team-11/backend/admin/pkg/tracing"
 )
 
 type App struct {
@@ -18,7 +21,9 @@ type App struct {
 	usecase    *usecase.UseCase
 	storage    *storage.Storage
 	server     *httper.Server
+	health     *health.Checker
 	ctx        ctx.Context
+	tracing    func(context.Context) error
 }
 
 func New() *App {
@@ -39,11 +44,25 @@ func New() *App {
 
 	app.ctx = ctx
 
+	app.tracing, err = tracing.Init(context.Background(), &cfg.Tracing)
+	if err != nil {
+		log.Error("Can`t init tracing", sl.ErrAttr(err))
+		panic("App start error.")
+	}
+
 	app.storage = storage.New(ctx, &cfg.Storage)
 
-	app.usecase = usecase.New(app.storage, &cfg.UseCase)
+	app.usecase, err = usecase.New(app.storage, &cfg.UseCase)
+	if err != nil {
+		log.Error("Can`t init usecase", sl.ErrAttr(err))
+		panic("App start error.")
+	}
+
+	app.health = health.New(&cfg.Health)
+	app.health.Add("postgres", app.storage.PingPostgres)
+	app.health.Add("minio", app.storage.PingMinio)
 
-	app.controller = controller.New(app.usecase, &cfg.Controller)
+	app.controller = controller.New(app.usecase, app.health, &cfg.Controller)
 
 	handler := app.controller.InitRoutes(ctx)
 
@@ -68,11 +87,19 @@ func (a *App) Run() {
 func (a *App) shutdown() e.Error {
 	log := a.ctx.Logger()
 
+	a.health.Drain()
+
 	err := e.E(a.server.Shutdown(a.ctx))
 	if err != nil {
 		log.Error("Failed to stop http server", err.SlErr())
 		return err
 	}
 
+	err = e.E(a.tracing(a.ctx))
+	if err != nil {
+		log.Error("Failed to stop tracing", err.SlErr())
+		return err
+	}
+
 	return nil
 }
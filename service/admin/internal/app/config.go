@@ -5,12 +5,16 @@ import (
 	"REDACTED/team-11/backend/admin/internal/controller"
 	"REDACTED/team-11/backend/admin/internal/usecase"
 	"REDACTED/team-11/backend/admin/internal/usecase/storage"
+	"REDACTED/team-11/backend/admin/pkg/health"
+	"REDACTED/team-11/backend/admin/pkg/tracing"
 )
 
 type Config struct {
 	Controller controller.Config `yaml:"controller"`
 	Storage    storage.Config    `yaml:"storage"`
 	UseCase    usecase.Config    `yaml:"usecase"`
+	Tracing    tracing.Config    `yaml:"tracing"`
+	Health     health.Config     `yaml:"health"`
 }
 
 func getConfig() (*Config, error) {
@@ -7,25 +7,32 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/nikitaSstepanov/tools/ctx"
 	"github.com/nikitaSstepanov/tools/httper"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 	v1 "REDACTED/team-11/backend/admin/internal/controller/http/v1"
 	"REDACTED/team-11/backend/admin/internal/usecase"
+	"REDACTED/team-11/backend/admin/pkg/health"
+	"REDACTED/team-11/backend/admin/pkg/metrics"
 )
 
+const serviceName = "admin"
+
 type Config struct {
 	V1   v1.Config `yaml:"v1"`
 	Mode string    `yaml:"mode" env:"MODE" env-default:"DEBUG"`
 }
 
 type Controller struct {
-	v1  *v1.Router
-	cfg *Config
+	v1     *v1.Router
+	health *health.Checker
+	cfg    *Config
 }
 
-func New(uc *usecase.UseCase, cfg *Config) *Controller {
+func New(uc *usecase.UseCase, health *health.Checker, cfg *Config) *Controller {
 
 	return &Controller{
-		v1:  v1.New(uc, &cfg.V1),
-		cfg: cfg,
+		v1:     v1.New(uc, &cfg.V1),
+		health: health,
+		cfg:    cfg,
 	}
 }
 
@@ -43,6 +50,12 @@ func (c *Controller) InitRoutes(ctx ctx.Context) *gin.Engine {
 		MaxAge:           12 * time.Hour,
 	}))
 
+	router.GET("/metrics", gin.WrapH(metrics.Handler()))
+	router.GET("/healthz", gin.WrapF(c.health.Live))
+	router.GET("/readyz", gin.WrapF(c.health.Ready))
+
+	router.Use(otelgin.Middleware(serviceName), metrics.Gin())
+
 	router.GET("/ping", func(ctx *gin.Context) {
 		ctx.JSON(httper.StatusOK, "pong")
 	})
@@ -0,0 +1,15 @@
+package converter
+
+import (
+	"REDACTED/team-11/backend/admin/internal/controller/http/v1/dto"
+	"REDACTED/team-11/backend/admin/internal/entity"
+)
+
+func DtoAmenity(amenity *entity.Amenity) *dto.Amenity {
+	return &dto.Amenity{
+		Id:        amenity.Id,
+		Name:      amenity.Name,
+		CreatedAt: amenity.CreatedAt,
+		UpdatedAt: amenity.UpdatedAt,
+	}
+}
@@ -3,14 +3,17 @@ package converter
 import (
 	"REDACTED/team-11/backend/admin/internal/controller/http/v1/dto"
 	"REDACTED/team-11/backend/admin/internal/entity"
+	types "REDACTED/team-11/backend/admin/internal/entity/type"
 )
 
 func DtoFloor(floor *entity.FloorEntity) *dto.FloorEntity {
 	return &dto.FloorEntity{
-		Id:        floor.Id,
-		Name:      floor.Name,
-		CreatedAt: floor.CreatedAt,
-		UpdatedAt: floor.UpdatedAt,
+		Id:         floor.Id,
+		Name:       floor.Name,
+		BuildingId: floor.BuildingId,
+		CreatedAt:  floor.CreatedAt,
+		UpdatedAt:  floor.UpdatedAt,
+		Version:    floor.Version,
 	}
 }
 
@@ -24,7 +27,75 @@ func DtoEntity(entity *entity.BookingEntity) *dto.BookingEntity {
 		Width:     entity.Width,
 		Height:    entity.Height,
 		Capacity:  entity.Capacity,
+		IsPremium: entity.IsPremium,
+		Amenities: entity.Amenities,
+		Access:    DtoEntityAccess(entity.Access),
 		CreatedAt: entity.CreatedAt,
 		UpdatedAt: entity.UpdatedAt,
+		Version:   entity.Version,
+	}
+}
+
+func DtoEntityAccess(access *entity.EntityAccess) *dto.EntityAccess {
+	if access == nil {
+		return nil
+	}
+
+	return &dto.EntityAccess{
+		Teams: access.Teams,
+		Users: access.Users,
+	}
+}
+
+func EntityAccessFromDto(access *dto.EntityAccess) *entity.EntityAccess {
+	if access == nil {
+		return nil
+	}
+
+	return &entity.EntityAccess{
+		Teams: access.Teams,
+		Users: access.Users,
+	}
+}
+
+func DtoRelocationReport(report *entity.RelocationReport) *dto.RelocationReport {
+	relocations := make([]*dto.Relocation, 0, len(report.Relocations))
+
+	for _, relocation := range report.Relocations {
+		relocations = append(relocations, &dto.Relocation{
+			BookingId:    relocation.BookingId,
+			UserId:       relocation.UserId,
+			FromEntityId: relocation.FromEntityId,
+			ToEntityId:   relocation.ToEntityId,
+			TimeFrom:     relocation.TimeFrom,
+			TimeTo:       relocation.TimeTo,
+			Status:       relocation.Status,
+			Reason:       relocation.Reason,
+		})
+	}
+
+	return &dto.RelocationReport{
+		Id:          report.Id,
+		Relocated:   report.Count(types.RELOCATED),
+		Cancelled:   report.Count(types.CANCELLED),
+		Relocations: relocations,
+	}
+}
+
+func DtoLayoutConflict(msg string, conflict *entity.LayoutConflict) *dto.LayoutConflict {
+	entities := make([]*dto.EntityConflict, 0, len(conflict.Entities))
+
+	for _, ent := range conflict.Entities {
+		entities = append(entities, &dto.EntityConflict{
+			EntityId: ent.EntityId,
+			Reason:   ent.Reason,
+		})
+	}
+
+	return &dto.LayoutConflict{
+		Error:    msg,
+		FloorId:  conflict.FloorId,
+		Version:  conflict.Version,
+		Entities: entities,
 	}
 }
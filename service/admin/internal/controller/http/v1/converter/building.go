@@ -0,0 +1,29 @@
+package converter
+
+import (
+	"REDACTED/team-11/backend/admin/internal/controller/http/v1/dto"
+	"REDACTED/team-11/backend/admin/internal/entity"
+)
+
+func DtoBuilding(building *entity.Building) *dto.Building {
+	return &dto.Building{
+		Id:        building.Id,
+		Name:      building.Name,
+		Address:   building.Address,
+		Timezone:  building.Timezone,
+		OpensAt:   building.OpensAt,
+		ClosesAt:  building.ClosesAt,
+		CreatedAt: building.CreatedAt,
+		UpdatedAt: building.UpdatedAt,
+	}
+}
+
+func EntityBuilding(building *dto.UpsertBuilding) *entity.Building {
+	return &entity.Building{
+		Name:     building.Name,
+		Address:  building.Address,
+		Timezone: building.Timezone,
+		OpensAt:  building.OpensAt,
+		ClosesAt: building.ClosesAt,
+	}
+}
@@ -0,0 +1,14 @@
+package dto
+
+import "time"
+
+type Amenity struct {
+	Id        string    `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type UpsertAmenity struct {
+	Name string `json:"name" validate:"required,max=255" example:"projector"`
+}
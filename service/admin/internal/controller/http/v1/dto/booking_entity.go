@@ -7,10 +7,12 @@ import (
 )
 
 type FloorEntity struct {
-	Id        string    `json:"id"`
-	Name      string    `json:"name"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	Id         string    `json:"id"`
+	Name       string    `json:"name"`
+	BuildingId *string   `json:"building_id,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	Version    int       `json:"version"`
 }
 
 type BookingEntity struct {
@@ -22,24 +24,69 @@ type BookingEntity struct {
 	Width     int               `json:"width"`
 	Height    int               `json:"height"`
 	Capacity  int               `json:"capacity"`
+	IsPremium bool              `json:"is_premium"`
+	Amenities []string          `json:"amenities"`
+	Access    *EntityAccess     `json:"access"`
 	CreatedAt time.Time         `json:"created_at"`
 	UpdatedAt time.Time         `json:"updated_at"`
+	Version   int               `json:"version"`
 }
 
 type UpsertFloor struct {
-	Id       string   `json:"id" validate:"uuid"`
-	Name     string   `json:"name"`
-	Entities []Entity `json:"entities"`
+	Id         string   `json:"id"          validate:"uuid"`
+	Name       string   `json:"name"`
+	BuildingId *string  `json:"building_id" validate:"omitempty,uuid"`
+	Entities   []Entity `json:"entities"`
 }
 
 type Entity struct {
-	Id       string            `json:"id"       validate:"uuid"`
-	FloorId  string            `json:"floor_id" validate:"uuid"`
-	Type     types.BookingType `json:"type"     validate:"booking"`
-	Title    string            `json:"title"`
-	X        int               `json:"x"`
-	Y        int               `json:"y"`
-	Width    int               `json:"width"`
-	Height   int               `json:"height"`
-	Capacity int               `json:"capacity"`
+	Id        string            `json:"id"        validate:"uuid"`
+	FloorId   string            `json:"floor_id"  validate:"uuid"`
+	Type      types.BookingType `json:"type"      validate:"booking"`
+	Title     string            `json:"title"`
+	X         int               `json:"x"`
+	Y         int               `json:"y"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Capacity  int               `json:"capacity"`
+	IsPremium bool              `json:"is_premium"`
+	Amenities []string          `json:"amenities" validate:"dive,uuid"`
+	Access    *EntityAccess     `json:"access"`
+	// Version of entity editor has seen, zero for new entities.
+	Version int `json:"version"`
+}
+
+type EntityAccess struct {
+	Teams []string `json:"teams" validate:"dive,uuid"`
+	Users []string `json:"users" validate:"dive,uuid"`
+}
+
+type Relocation struct {
+	BookingId    string                 `json:"booking_id"`
+	UserId       string                 `json:"user_id"`
+	FromEntityId string                 `json:"from_entity_id"`
+	ToEntityId   *string                `json:"to_entity_id,omitempty"`
+	TimeFrom     time.Time              `json:"time_from"`
+	TimeTo       time.Time              `json:"time_to"`
+	Status       types.RelocationStatus `json:"status"`
+	Reason       string                 `json:"reason,omitempty"`
+}
+
+type RelocationReport struct {
+	Id          string        `json:"id,omitempty"`
+	Relocated   int           `json:"relocated"`
+	Cancelled   int           `json:"cancelled"`
+	Relocations []*Relocation `json:"relocations"`
+}
+
+type LayoutConflict struct {
+	Error    string            `json:"error"`
+	FloorId  string            `json:"floor_id"`
+	Version  int               `json:"version"`
+	Entities []*EntityConflict `json:"entities"`
+}
+
+type EntityConflict struct {
+	EntityId string               `json:"entity_id"`
+	Reason   types.ConflictReason `json:"reason"`
 }
@@ -0,0 +1,22 @@
+package dto
+
+import "time"
+
+type Building struct {
+	Id        string    `json:"id"`
+	Name      string    `json:"name"`
+	Address   string    `json:"address"`
+	Timezone  string    `json:"timezone"`
+	OpensAt   *string   `json:"opens_at,omitempty"`
+	ClosesAt  *string   `json:"closes_at,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type UpsertBuilding struct {
+	Name     string  `json:"name"      validate:"required,max=255"`
+	Address  string  `json:"address"   validate:"max=255"`
+	Timezone string  `json:"timezone"  example:"Europe/Moscow"`
+	OpensAt  *string `json:"opens_at"  example:"09:00"`
+	ClosesAt *string `json:"closes_at" example:"21:00"`
+}
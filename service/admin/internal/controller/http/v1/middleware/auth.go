@@ -1,58 +1,85 @@
 package middleware
 
 import (
-	"slices"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	e "github.com/nikitaSstepanov/tools/error"
 	resp "REDACTED/team-11/backend/admin/internal/controller/response"
 	types "REDACTED/team-11/backend/admin/internal/entity/type"
 )
 
-func (m *Middleware) CheckAccess(roles ...types.Role) gin.HandlerFunc {
+// CheckAccess authenticates request and requires token owner to have all of permissions.
+func (m *Middleware) CheckAccess(permissions ...types.Permission) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		header := ctx.GetHeader("Authorization")
-
-		if header == "" {
-			resp.AbortErrMsg(ctx, foundErr)
+		token, err := bearerToken(ctx)
+		if err != nil {
+			resp.AbortErrMsg(ctx, err)
 			return
 		}
 
-		parts := strings.Split(header, " ")
-		if len(parts) < 2 {
-			resp.AbortErrMsg(ctx, bearerErr)
+		claims, err := m.auth.ValidateToken(token, false)
+		if err != nil {
+			resp.AbortErrMsg(ctx, unauthErr.WithErr(err))
 			return
 		}
 
-		bearer := parts[0]
-		token := parts[1]
+		for _, permission := range permissions {
+			if !claims.Can(permission) {
+				resp.AbortErrMsg(ctx, forbiddenErr)
+				return
+			}
+		}
+
+		ctx.Set("userId", claims.Id)
+
+		ctx.Next()
+	}
+}
 
-		if bearer != bearerType {
-			resp.AbortErrMsg(ctx, bearerErr)
+// CheckService authenticates internal request of other service and requires its token to have all of scopes.
+func (m *Middleware) CheckService(scopes ...types.ServiceScope) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		token, err := bearerToken(ctx)
+		if err != nil {
+			resp.AbortErrMsg(ctx, err)
 			return
 		}
 
-		claims, err := m.auth.ValidateToken(token, false)
+		claims, err := m.auth.ValidateServiceToken(token)
 		if err != nil {
 			resp.AbortErrMsg(ctx, unauthErr.WithErr(err))
 			return
 		}
 
-		if len(roles) != 0 {
-			access := false
-
-			if slices.Contains(roles, claims.Role) {
-				access = true
-			}
-
-			if !access {
+		for _, scope := range scopes {
+			if !claims.Allows(scope) {
 				resp.AbortErrMsg(ctx, forbiddenErr)
 				return
 			}
 		}
 
-		ctx.Set("userId", claims.Id)
+		ctx.Set("clientId", claims.ClientId)
 
 		ctx.Next()
 	}
 }
+
+func bearerToken(ctx *gin.Context) (string, e.Error) {
+	header := ctx.GetHeader("Authorization")
+
+	if header == "" {
+		return "", foundErr
+	}
+
+	parts := strings.Split(header, " ")
+	if len(parts) < 2 {
+		return "", bearerErr
+	}
+
+	if parts[0] != bearerType {
+		return "", bearerErr
+	}
+
+	return parts[1], nil
+}
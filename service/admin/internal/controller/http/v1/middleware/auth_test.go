@@ -0,0 +1,124 @@
+package middleware
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/assert/v2"
+	"github.com/golang-jwt/jwt/v5"
+	types "REDACTED/team-11/backend/admin/internal/entity/type"
+	"REDACTED/team-11/backend/admin/internal/usecase/pkg/auth"
+)
+
+const (
+	testIssuer   = "coffee-id-backend"
+	testAudience = "coffee-admin"
+	testKid      = "2026-01"
+)
+
+func TestCheckAccess(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("Can`t generate key: %v", err)
+	}
+
+	jwks := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{{
+				"kty": "OKP",
+				"crv": "Ed25519",
+				"kid": testKid,
+				"use": "sig",
+				"x":   base64.RawURLEncoding.EncodeToString(public),
+			}},
+		})
+	}))
+	defer jwks.Close()
+
+	a, err := auth.New(&auth.JwtOptions{
+		Audience: testAudience,
+		Issuer:   testIssuer,
+		JwksUrl:  jwks.URL,
+		JwksTTL:  time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("Can`t init auth: %v", err)
+	}
+
+	m := New(a)
+
+	router := gin.New()
+	router.GET("/layout", m.CheckAccess(types.LAYOUT_EDIT), func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+	router.GET("/stats", m.CheckAccess(types.LAYOUT_EDIT, types.STATS_READ), func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	sign := func(audience string, permissions ...types.Permission) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, auth.Claims{
+			Id:          "id",
+			Role:        types.ADMIN,
+			Permissions: permissions,
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    testIssuer,
+				Audience:  jwt.ClaimStrings{audience},
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+			},
+		})
+		token.Header["kid"] = testKid
+
+		signed, err := token.SignedString(private)
+		if err != nil {
+			t.Fatalf("Can`t sign token: %v", err)
+		}
+
+		return signed
+	}
+
+	editor := sign(testAudience, types.LAYOUT_EDIT)
+
+	cases := []struct {
+		path   string
+		token  string
+		status int
+	}{
+		{"/layout", editor, http.StatusNoContent},
+		{"/stats", editor, http.StatusForbidden},
+		{"/stats", sign(testAudience, types.LAYOUT_EDIT, types.STATS_READ), http.StatusNoContent},
+		{"/layout", sign(testAudience), http.StatusForbidden},
+		{"/layout", sign("coffee-id-frontend", types.LAYOUT_EDIT), http.StatusUnauthorized},
+		{"/layout", "", http.StatusUnauthorized},
+	}
+
+	for _, tc := range cases {
+		req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+		if tc.token != "" {
+			req.Header.Set("Authorization", "Bearer "+tc.token)
+		}
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, w.Code, tc.status)
+	}
+}
+
+func TestAuthRequiresAudience(t *testing.T) {
+	_, err := auth.New(&auth.JwtOptions{
+		Issuer:  testIssuer,
+		JwksUrl: "http://localhost/jwks",
+	})
+	if err == nil {
+		t.Fatalf("Can`t reject config without audience")
+	}
+}
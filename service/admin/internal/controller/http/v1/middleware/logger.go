@@ -15,7 +15,7 @@ func (m *Middleware) InitLogger(c ctx.Context) gin.HandlerFunc {
 	log.Info("logger middleware enabled.")
 
 	return func(c *gin.Context) {
-		ctx := ctx.New(log)
+		ctx := ctx.NewWithCtx(c.Request.Context(), log)
 
 		c.Set(ct.CtxKey, ctx)
 		
@@ -0,0 +1,178 @@
+package amenity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/nikitaSstepanov/tools/httper"
+	conv "REDACTED/team-11/backend/admin/internal/controller/http/v1/converter"
+	"REDACTED/team-11/backend/admin/internal/controller/http/v1/dto"
+	"REDACTED/team-11/backend/admin/internal/controller/http/v1/validator"
+	resp "REDACTED/team-11/backend/admin/internal/controller/response"
+	"REDACTED/team-11/backend/admin/internal/entity"
+	ct "REDACTED/team-11/backend/admin/pkg/utils/controller"
+)
+
+type Amenity struct {
+	usecase AmenityUseCase
+}
+
+func New(uc AmenityUseCase) *Amenity {
+	return &Amenity{
+		usecase: uc,
+	}
+}
+
+// @Summary Get amenities
+// @Description Get amenity catalog
+// @Tags Amenity
+// @Success 200 {object} []dto.Amenity "Successful get of amenities"
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /admin/layout/amenities [get]
+func (a *Amenity) GetAll(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	amenities, err := a.usecase.GetAll(ctx)
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	result := make([]*dto.Amenity, 0)
+
+	for _, amenity := range amenities {
+		result = append(result, conv.DtoAmenity(amenity))
+	}
+
+	c.JSON(httper.StatusOK, result)
+}
+
+// @Summary Create amenity
+// @Description Add amenity to catalog. Requires layout.edit permission
+// @Tags Amenity
+// @Accept json
+// @Param body body dto.UpsertAmenity true "Amenity data"
+// @Success 201 {object} dto.Amenity "Created"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 403 {object} resp.JsonError "Invalid role"
+// @Failure 400 {object} resp.JsonError "Invalid input"
+// @Failure 409 {object} resp.JsonError "Amenity already exists"
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /admin/layout/amenities [post]
+func (a *Amenity) Create(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	var body dto.UpsertAmenity
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		resp.AbortErrMsg(c, e.BadInputErr.WithErr(err))
+		return
+	}
+
+	body.Name = strings.TrimSpace(body.Name)
+
+	if err := validator.Struct(body); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	curTime := time.Now().UTC()
+
+	amenity := &entity.Amenity{
+		Id:        uuid.NewString(),
+		Name:      body.Name,
+		CreatedAt: curTime,
+		UpdatedAt: curTime,
+	}
+
+	if err := a.usecase.Create(ctx, amenity); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(httper.StatusCreated, conv.DtoAmenity(amenity))
+}
+
+// @Summary Update amenity
+// @Description Rename amenity. Requires layout.edit permission
+// @Tags Amenity
+// @Accept json
+// @Param id path string true "Amenity id" Format(uuid)
+// @Param body body dto.UpsertAmenity true "Amenity data"
+// @Success 200 {object} dto.Amenity "Updated"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 403 {object} resp.JsonError "Invalid role"
+// @Failure 400 {object} resp.JsonError "Invalid input"
+// @Failure 404 {object} resp.JsonError "Amenity not found"
+// @Failure 409 {object} resp.JsonError "Amenity already exists"
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /admin/layout/amenities/{id} [put]
+func (a *Amenity) Update(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	id := c.Param("id")
+
+	if err := validator.UUID(id); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	var body dto.UpsertAmenity
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		resp.AbortErrMsg(c, e.BadInputErr.WithErr(err))
+		return
+	}
+
+	body.Name = strings.TrimSpace(body.Name)
+
+	if err := validator.Struct(body); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	amenity := &entity.Amenity{
+		Id:        id,
+		Name:      body.Name,
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	if err := a.usecase.Update(ctx, amenity); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(httper.StatusOK, conv.DtoAmenity(amenity))
+}
+
+// @Summary Delete amenity
+// @Description Delete amenity from catalog and from all entities. Requires layout.edit permission
+// @Tags Amenity
+// @Param id path string true "Amenity id" Format(uuid)
+// @Success 204 "Successful delete"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 403 {object} resp.JsonError "Invalid role"
+// @Failure 400 {object} resp.JsonError "Id must be uuid"
+// @Failure 404 {object} resp.JsonError "Amenity not found"
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /admin/layout/amenities/{id} [delete]
+func (a *Amenity) Delete(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	id := c.Param("id")
+
+	if err := validator.UUID(id); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	if err := a.usecase.Delete(ctx, id); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(httper.StatusNoContent, nil)
+}
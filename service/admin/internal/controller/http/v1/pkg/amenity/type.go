@@ -0,0 +1,14 @@
+package amenity
+
+import (
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"REDACTED/team-11/backend/admin/internal/entity"
+)
+
+type AmenityUseCase interface {
+	GetAll(c ctx.Context) ([]*entity.Amenity, e.Error)
+	Create(c ctx.Context, amenity *entity.Amenity) e.Error
+	Update(c ctx.Context, amenity *entity.Amenity) e.Error
+	Delete(c ctx.Context, id string) e.Error
+}
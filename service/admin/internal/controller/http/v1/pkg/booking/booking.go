@@ -24,7 +24,7 @@ func New(uc BookingUseCase) *Booking {
 }
 
 // @Summary Check user access
-// @Description Check status of user booking or invitation for nearest 12 hours. Avaliable only for ADMINs
+// @Description Check status of user booking or invitation for nearest 12 hours. Requires booking.verify permission
 // @Tags Booking
 // @Security Bearer
 // @Param id path string true  "User id"  Format(uuid)
@@ -70,7 +70,7 @@ func (b *Booking) CheckAccess(c *gin.Context) {
 }
 
 // @Summary Get stats
-// @Description Get stats for booking creations. Avaliable only for ADMINs
+// @Description Get stats for booking creations. Requires stats.read permission
 // @Tags Booking
 // @Security Bearer
 // @Param filter query string false "Parametr for stats specify. Must be 'day', 'week' or 'month'"
@@ -27,13 +27,25 @@ func New(uc EntityuseCase) *BookingEntity {
 // @Summary Get floors
 // @Description Get list of floor
 // @Tags Entity
+// @Param building_id query string false "Building id" Format(uuid)
 // @Success 200 {object} []dto.FloorEntity "Succesful get of floors"
+// @Failure 400 {object} resp.JsonError "Id must be uuid"
+// @Failure 404 {object} resp.JsonError "Building not found"
 // @Failure 500 {object} resp.JsonError "Something going wrong..."
 // @Router /admin/layout/floors [get]
 func (b *BookingEntity) GetFloors(c *gin.Context) {
 	ctx := ct.GetCtx(c)
 
-	floors, err := b.usecase.GetFloors(ctx)
+	buildingId := c.Query("building_id")
+
+	if buildingId != "" {
+		if err := validator.UUID(buildingId); err != nil {
+			resp.AbortErrMsg(c, err)
+			return
+		}
+	}
+
+	floors, err := b.usecase.GetFloors(ctx, buildingId)
 	if err != nil {
 		resp.AbortErrMsg(c, err)
 		return
@@ -52,7 +64,9 @@ func (b *BookingEntity) GetFloors(c *gin.Context) {
 // @Description Get entities for floor
 // @Tags Entity
 // @Param id path string true "Floor id" Format(uuid)
+// @Param amenity query []string false "Amenity ids, entity must have all of them" collectionFormat(multi)
 // @Success 200 {object} []dto.BookingEntity "Successful get entities"
+// @Header 200 {string} ETag "Version of floor layout"
 // @Failure 400 {object} resp.JsonError "Id must be uuid"
 // @Failure 500 {object} resp.JsonError "Something going wrong..."
 // @Router /admin/layout/floors/{id} [get]
@@ -66,7 +80,16 @@ func (b *BookingEntity) GetEntities(c *gin.Context) {
 		return
 	}
 
-	bookings, err := b.usecase.GetEntities(ctx, id)
+	amenities := c.QueryArray("amenity")
+
+	for _, amenity := range amenities {
+		if err := validator.UUID(amenity); err != nil {
+			resp.AbortErrMsg(c, err)
+			return
+		}
+	}
+
+	floor, bookings, err := b.usecase.GetEntities(ctx, id, amenities)
 	if err != nil {
 		resp.AbortErrMsg(c, err)
 		return
@@ -78,18 +101,23 @@ func (b *BookingEntity) GetEntities(c *gin.Context) {
 		result = append(result, conv.DtoEntity(booking))
 	}
 
+	ct.SetETag(c, floor.Version)
 	c.JSON(httper.StatusOK, result)
 }
 
 // @Summary Save layout
-// @Description Save layout. Only for ADMINs
+// @Description Save layout. Requires layout.edit permission. With If-Match layout is saved only if it doesn`t overwrite entities changed since that floor version, conflicted entities are reported
 // @Tags Entity
 // @Accept json
 // @Param upsert body dto.UpsertFloor true	"Upsert data"
-// @Success 200 {object} resp.Message "OK"
+// @Param If-Match header string false "ETag of floor layout"
+// @Success 200 {object} dto.RelocationReport "Report about relocated and cancelled bookings"
+// @Header 200 {string} ETag "Version of saved floor layout"
 // @Failure 401 {object} resp.JsonError "Unauth"
 // @Failure 403 {object} resp.JsonError "Invalid role"
 // @Failure 400 {object} resp.JsonError "Id must be uuid"
+// @Failure 404 {object} resp.JsonError "Building not found"
+// @Failure 412 {object} dto.LayoutConflict "Floor was modified"
 // @Failure 500 {object} resp.JsonError "Something going wrong..."
 // @Router /admin/layout/floors [post]
 func (b *BookingEntity) Save(c *gin.Context) {
@@ -128,37 +156,46 @@ func (b *BookingEntity) Save(c *gin.Context) {
 			Width:     booking.Width,
 			Height:    booking.Height,
 			Capacity:  booking.Capacity,
+			IsPremium: booking.IsPremium,
+			Amenities: booking.Amenities,
+			Access:    conv.EntityAccessFromDto(booking.Access),
 			CreatedAt: curTime,
 			UpdatedAt: curTime,
+			Version:   booking.Version,
 		}
 
 		bookings = append(bookings, toAdd)
 	}
 
 	floor := &entity.FloorEntity{
-		Id:        body.Id,
-		Name:      body.Name,
-		CreatedAt: curTime,
-		UpdatedAt: curTime,
+		Id:         body.Id,
+		Name:       body.Name,
+		BuildingId: body.BuildingId,
+		CreatedAt:  curTime,
+		UpdatedAt:  curTime,
 	}
 
-	err := b.usecase.Save(ctx, bookings, floor)
+	report, err := b.usecase.Save(ctx, bookings, floor, ct.IfMatch(c))
 	if err != nil {
-		resp.AbortErrMsg(c, err)
+		abortErr(c, err)
 		return
 	}
 
-	c.JSON(httper.StatusOK, resp.NewMessage("OK"))
+	ct.SetETag(c, floor.Version)
+	c.JSON(httper.StatusOK, conv.DtoRelocationReport(report))
 }
 
 // @Summary Delete floor
-// @Description Delete floor. Only for ADMINs
+// @Description Delete floor. Requires layout.edit permission
 // @Tags Entity
 // @Param id path string true "Floor id" Format(uuid)
+// @Param If-Match header string false "ETag of floor layout"
 // @Success 204 "Successful delete"
+// @Header 204 {string} X-Relocation-Report "Id of relocation report, absent if no booking was affected"
 // @Failure 401 {object} resp.JsonError "Unauth"
 // @Failure 403 {object} resp.JsonError "Invalid role"
 // @Failure 400 {object} resp.JsonError "Id must be uuid"
+// @Failure 412 {object} dto.LayoutConflict "Floor was modified"
 // @Failure 500 {object} resp.JsonError "Something going wrong..."
 // @Router /admin/layout/floors/{id} [delete]
 func (b *BookingEntity) DeleteFloor(c *gin.Context) {
@@ -171,11 +208,16 @@ func (b *BookingEntity) DeleteFloor(c *gin.Context) {
 		return
 	}
 
-	if err := b.usecase.DeleteFloor(ctx, id); err != nil {
-		resp.AbortErrMsg(c, err)
+	report, err := b.usecase.DeleteFloor(ctx, id, ct.IfMatch(c))
+	if err != nil {
+		abortErr(c, err)
 		return
 	}
 
+	if report.Id != "" {
+		c.Header(relocationHeader, report.Id)
+	}
+
 	c.JSON(httper.StatusNoContent, nil)
 }
 
@@ -184,6 +226,7 @@ func (b *BookingEntity) DeleteFloor(c *gin.Context) {
 // @Tags Entity
 // @Param id path string true "Entity id"  Format(uuid)
 // @Success 200 {object} dto.BookingEntity "ok"
+// @Header 200 {string} ETag "Version of entity"
 // @Failure 401 {object} resp.JsonError "Unauth"
 // @Failure 403 {object} resp.JsonError "Invalid role"
 // @Failure 400 {object} resp.JsonError "Id must be uuid"
@@ -207,5 +250,53 @@ func (b *BookingEntity) EntityById(c *gin.Context) {
 
 	result := conv.DtoEntity(booking)
 
+	ct.SetETag(c, booking.Version)
 	c.JSON(httper.StatusOK, result)
 }
+
+// @Summary Get relocation report
+// @Description Get report about bookings relocated or cancelled while removing entities. Requires layout.edit permission
+// @Tags Entity
+// @Param id path string true "Report id"  Format(uuid)
+// @Success 200 {object} dto.RelocationReport "ok"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 403 {object} resp.JsonError "Invalid role"
+// @Failure 400 {object} resp.JsonError "Id must be uuid"
+// @Failure 404 {object} resp.JsonError "Report not found"
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /admin/layout/relocations/{id} [get]
+func (b *BookingEntity) RelocationReport(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	id := c.Param("id")
+
+	if err := validator.UUID(id); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	report, err := b.usecase.GetRelocationReport(ctx, id)
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(httper.StatusOK, conv.DtoRelocationReport(report))
+}
+
+// abortErr responds with report of conflicted entities, if layout was
+// modified since the version in If-Match.
+func abortErr(c *gin.Context, err e.Error) {
+	conflict, ok := err.GetTag(entity.LayoutConflictTag).(*entity.LayoutConflict)
+	if !ok {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	ct.GetL(c).Info("Layout conflict")
+
+	c.AbortWithStatusJSON(
+		httper.StatusPreconditionFailed,
+		conv.DtoLayoutConflict(err.GetMessage(), conflict),
+	)
+}
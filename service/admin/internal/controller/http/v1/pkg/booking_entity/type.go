@@ -7,9 +7,10 @@ import (
 )
 
 type EntityuseCase interface {
-	Save(c ctx.Context, bookings []*entity.BookingEntity, floorEntity *entity.FloorEntity) e.Error
-	GetEntities(c ctx.Context, id string) ([]*entity.BookingEntity, e.Error)
-	GetFloors(c ctx.Context) ([]*entity.FloorEntity, e.Error)
-	DeleteFloor(c ctx.Context, id string) e.Error
+	Save(c ctx.Context, bookings []*entity.BookingEntity, floorEntity *entity.FloorEntity, version *int) (*entity.RelocationReport, e.Error)
+	GetEntities(c ctx.Context, id string, amenities []string) (*entity.FloorEntity, []*entity.BookingEntity, e.Error)
+	GetFloors(c ctx.Context, buildingId string) ([]*entity.FloorEntity, e.Error)
+	DeleteFloor(c ctx.Context, id string, version *int) (*entity.RelocationReport, e.Error)
 	GetEntity(c ctx.Context, id string) (*entity.BookingEntity, e.Error)
+	GetRelocationReport(c ctx.Context, id string) (*entity.RelocationReport, e.Error)
 }
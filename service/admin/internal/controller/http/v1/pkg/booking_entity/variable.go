@@ -0,0 +1,5 @@
+package booking_entity
+
+const (
+	relocationHeader = "X-Relocation-Report"
+)
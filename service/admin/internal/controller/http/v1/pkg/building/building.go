@@ -0,0 +1,195 @@
+package building
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/nikitaSstepanov/tools/httper"
+	conv "REDACTED/team-11/backend/admin/internal/controller/http/v1/converter"
+	"REDACTED/team-11/backend/admin/internal/controller/http/v1/dto"
+	"REDACTED/team-11/backend/admin/internal/controller/http/v1/validator"
+	resp "REDACTED/team-11/backend/admin/internal/controller/response"
+	ct "REDACTED/team-11/backend/admin/pkg/utils/controller"
+)
+
+type Building struct {
+	usecase BuildingUseCase
+}
+
+func New(uc BuildingUseCase) *Building {
+	return &Building{
+		usecase: uc,
+	}
+}
+
+// @Summary Get buildings
+// @Description Get list of buildings
+// @Tags Building
+// @Success 200 {object} []dto.Building "Successful get of buildings"
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /admin/layout/buildings [get]
+func (b *Building) GetAll(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	buildings, err := b.usecase.GetAll(ctx)
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	result := make([]*dto.Building, 0)
+
+	for _, building := range buildings {
+		result = append(result, conv.DtoBuilding(building))
+	}
+
+	c.JSON(httper.StatusOK, result)
+}
+
+// @Summary Get building by id
+// @Description Get building by id
+// @Tags Building
+// @Param id path string true "Building id" Format(uuid)
+// @Success 200 {object} dto.Building "ok"
+// @Failure 400 {object} resp.JsonError "Id must be uuid"
+// @Failure 404 {object} resp.JsonError "Building not found"
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /admin/layout/buildings/{id} [get]
+func (b *Building) Get(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	id := c.Param("id")
+
+	if err := validator.UUID(id); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	building, err := b.usecase.Get(ctx, id)
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(httper.StatusOK, conv.DtoBuilding(building))
+}
+
+// @Summary Create building
+// @Description Create building. Requires layout.edit permission
+// @Tags Building
+// @Accept json
+// @Param body body dto.UpsertBuilding true "Building data"
+// @Success 201 {object} dto.Building "Created"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 403 {object} resp.JsonError "Invalid role"
+// @Failure 400 {object} resp.JsonError "Invalid time zone or opening hours"
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /admin/layout/buildings [post]
+func (b *Building) Create(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	var body dto.UpsertBuilding
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		resp.AbortErrMsg(c, e.BadInputErr.WithErr(err))
+		return
+	}
+
+	if err := validator.Struct(body); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	curTime := time.Now().UTC()
+
+	building := conv.EntityBuilding(&body)
+	building.Id = uuid.NewString()
+	building.CreatedAt = curTime
+	building.UpdatedAt = curTime
+
+	if err := b.usecase.Create(ctx, building); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(httper.StatusCreated, conv.DtoBuilding(building))
+}
+
+// @Summary Update building
+// @Description Update building. Requires layout.edit permission
+// @Tags Building
+// @Accept json
+// @Param id path string true "Building id" Format(uuid)
+// @Param body body dto.UpsertBuilding true "Building data"
+// @Success 200 {object} dto.Building "Updated"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 403 {object} resp.JsonError "Invalid role"
+// @Failure 400 {object} resp.JsonError "Invalid time zone or opening hours"
+// @Failure 404 {object} resp.JsonError "Building not found"
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /admin/layout/buildings/{id} [put]
+func (b *Building) Update(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	id := c.Param("id")
+
+	if err := validator.UUID(id); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	var body dto.UpsertBuilding
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		resp.AbortErrMsg(c, e.BadInputErr.WithErr(err))
+		return
+	}
+
+	if err := validator.Struct(body); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	building := conv.EntityBuilding(&body)
+	building.Id = id
+	building.UpdatedAt = time.Now().UTC()
+
+	if err := b.usecase.Update(ctx, building); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(httper.StatusOK, conv.DtoBuilding(building))
+}
+
+// @Summary Delete building
+// @Description Delete building without floors. Requires layout.edit permission
+// @Tags Building
+// @Param id path string true "Building id" Format(uuid)
+// @Success 204 "Successful delete"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 403 {object} resp.JsonError "Invalid role"
+// @Failure 400 {object} resp.JsonError "Id must be uuid"
+// @Failure 404 {object} resp.JsonError "Building not found"
+// @Failure 409 {object} resp.JsonError "Building has floors"
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /admin/layout/buildings/{id} [delete]
+func (b *Building) Delete(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	id := c.Param("id")
+
+	if err := validator.UUID(id); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	if err := b.usecase.Delete(ctx, id); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(httper.StatusNoContent, nil)
+}
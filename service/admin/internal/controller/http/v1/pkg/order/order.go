@@ -24,7 +24,7 @@ func New(uc OrderUseCase) *Order {
 }
 
 // @Summary Set order completed
-// @Description Set order. Only for ADMINs
+// @Description Set order. Requires order.fulfil permission
 // @Tags Orders
 // @Security Bearer
 // @Param id    path     string  true  "user id"  Format(uuid)
@@ -52,7 +52,7 @@ func (o *Order) SetStatus(c *gin.Context) {
 }
 
 // @Summary Get orders
-// @Description Get orders with pagination and filters. Only for ADMINs.
+// @Description Get orders with pagination and filters. Requires order.read.any permission.
 // @Tags Orders
 // @Security Bearer
 // @Param page      query int  false  "Page"
@@ -101,7 +101,7 @@ func (o *Order) Get(c *gin.Context) {
 }
 
 // @Summary Get stats
-// @Description Get stats of order creations. Only for ADMINs.
+// @Description Get stats of order creations. Requires stats.read permission.
 // @Tags Orders
 // @Param filter query string  false  "Filter"
 // @Success 200 {object} dto.Stats
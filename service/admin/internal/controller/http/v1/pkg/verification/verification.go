@@ -24,7 +24,7 @@ func New(uc VerificationUseCase) *Verification {
 }
 
 // @Summary Check verification
-// @Description Returns user verification data. Only for ADMINs
+// @Description Returns user verification data. Requires booking.verify permission or verification.read scope of service
 // @Tags Verification
 // @Produce json
 // @Security Bearer
@@ -35,6 +35,7 @@ func New(uc VerificationUseCase) *Verification {
 // @Failure 403 {object} resp.JsonError "Invalid role"
 // @Failure 500 {object} resp.JsonError "Something going wrong..."
 // @Router /admin/verification/{id}/check [get]
+// @Router /admin/internal/verification/{id} [get]
 func (v *Verification) CheckVerify(c *gin.Context) {
 	ctx := ct.GetCtx(c)
 
@@ -66,7 +67,7 @@ func (v *Verification) CheckVerify(c *gin.Context) {
 }
 
 // @Summary Set verification
-// @Description Set user verification data. Only for ADMINs
+// @Description Set user verification data. Requires booking.verify permission
 // @Tags Verification
 // @Security Bearer
 // @Accept multipart/form-data
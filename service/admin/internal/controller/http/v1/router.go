@@ -7,11 +7,14 @@ import (
 	swaggerfiles "github.com/swaggo/files"     // swagger embed files
 	ginSwagger "github.com/swaggo/gin-swagger" // gin-swagger middleware
 	"REDACTED/team-11/backend/admin/internal/controller/http/v1/middleware"
+	"REDACTED/team-11/backend/admin/internal/controller/http/v1/pkg/amenity"
 	"REDACTED/team-11/backend/admin/internal/controller/http/v1/pkg/booking"
 	"REDACTED/team-11/backend/admin/internal/controller/http/v1/pkg/booking_entity"
+	"REDACTED/team-11/backend/admin/internal/controller/http/v1/pkg/building"
 	"REDACTED/team-11/backend/admin/internal/controller/http/v1/pkg/guest"
 	"REDACTED/team-11/backend/admin/internal/controller/http/v1/pkg/order"
 	"REDACTED/team-11/backend/admin/internal/controller/http/v1/pkg/verification"
+	types "REDACTED/team-11/backend/admin/internal/entity/type"
 	"REDACTED/team-11/backend/admin/internal/usecase"
 	"REDACTED/team-11/backend/admin/pkg/swagger"
 )
@@ -26,6 +29,8 @@ type Config struct {
 type Router struct {
 	booking      BookingHandler
 	entity       EntityHandler
+	building     BuildingHandler
+	amenity      AmenityHandler
 	verification VerificationHandler
 	guest        GuestHandler
 	order        OrderHandler
@@ -38,6 +43,8 @@ func New(uc *usecase.UseCase, cfg *Config) *Router {
 	return &Router{
 		booking:      booking.New(uc.Booking),
 		entity:       booking_entity.New(uc.BookingEntity),
+		building:     building.New(uc.Building),
+		amenity:      amenity.New(uc.Amenity),
 		guest:        guest.New(uc.Guest),
 		mid:          middleware.New(uc.Auth),
 		order:        order.New(uc.Order),
@@ -53,6 +60,7 @@ func (r *Router) InitRoutes(c ctx.Context, h *gin.RouterGroup) *gin.RouterGroup
 		r.initEntityRoutes(router)
 		r.initVerificationRoutes(router)
 		r.initOrderRoutes(router)
+		r.initInternalRoutes(router)
 		booking := r.initBookingRoutes(router)
 		r.initGuestsRouets(booking)
 		r.initSwaggerRoute(router)
@@ -64,8 +72,8 @@ func (r *Router) InitRoutes(c ctx.Context, h *gin.RouterGroup) *gin.RouterGroup
 func (r *Router) initBookingRoutes(h *gin.RouterGroup) *gin.RouterGroup {
 	router := h.Group("/booking")
 	{
-		router.GET("/:id/access", r.mid.CheckAccess("ADMIN"), r.booking.CheckAccess)
-		router.GET("/stats", r.mid.CheckAccess("ADMIN"), r.booking.Stats)
+		router.GET("/:id/access", r.mid.CheckAccess(types.BOOKING_VERIFY), r.booking.CheckAccess)
+		router.GET("/stats", r.mid.CheckAccess(types.STATS_READ), r.booking.Stats)
 	}
 
 	return router
@@ -84,9 +92,19 @@ func (r *Router) initEntityRoutes(h *gin.RouterGroup) *gin.RouterGroup {
 	{
 		router.GET("/floors", r.entity.GetFloors)
 		router.GET("/floors/:id", r.entity.GetEntities)
-		router.POST("/floors", r.mid.CheckAccess("ADMIN"), r.entity.Save)
-		router.DELETE("/floors/:id", r.mid.CheckAccess("ADMIN"), r.entity.DeleteFloor)
+		router.POST("/floors", r.mid.CheckAccess(types.LAYOUT_EDIT), r.entity.Save)
+		router.DELETE("/floors/:id", r.mid.CheckAccess(types.LAYOUT_EDIT), r.entity.DeleteFloor)
 		router.GET("/entities/:id", r.entity.EntityById)
+		router.GET("/relocations/:id", r.mid.CheckAccess(types.LAYOUT_EDIT), r.entity.RelocationReport)
+		router.GET("/buildings", r.building.GetAll)
+		router.GET("/buildings/:id", r.building.Get)
+		router.POST("/buildings", r.mid.CheckAccess(types.LAYOUT_EDIT), r.building.Create)
+		router.PUT("/buildings/:id", r.mid.CheckAccess(types.LAYOUT_EDIT), r.building.Update)
+		router.DELETE("/buildings/:id", r.mid.CheckAccess(types.LAYOUT_EDIT), r.building.Delete)
+		router.GET("/amenities", r.amenity.GetAll)
+		router.POST("/amenities", r.mid.CheckAccess(types.LAYOUT_EDIT), r.amenity.Create)
+		router.PUT("/amenities/:id", r.mid.CheckAccess(types.LAYOUT_EDIT), r.amenity.Update)
+		router.DELETE("/amenities/:id", r.mid.CheckAccess(types.LAYOUT_EDIT), r.amenity.Delete)
 	}
 
 	return router
@@ -95,20 +113,29 @@ func (r *Router) initEntityRoutes(h *gin.RouterGroup) *gin.RouterGroup {
 func (r *Router) initVerificationRoutes(h *gin.RouterGroup) *gin.RouterGroup {
 	router := h.Group("/verification")
 	{
-		router.GET("/:id/check", r.mid.CheckAccess("ADMIN"), r.verification.CheckVerify)
-		router.POST("/:id/set", r.mid.CheckAccess("ADMIN"), r.verification.Verify)
+		router.GET("/:id/check", r.mid.CheckAccess(types.BOOKING_VERIFY), r.verification.CheckVerify)
+		router.POST("/:id/set", r.mid.CheckAccess(types.BOOKING_VERIFY), r.verification.Verify)
 	}
 
 	return router
 }
 
 func (r *Router) initOrderRoutes(h *gin.RouterGroup) *gin.RouterGroup {
-	h.GET("/orders", r.mid.CheckAccess("ADMIN"), r.order.Get)
+	h.GET("/orders", r.mid.CheckAccess(types.ORDER_READ_ANY), r.order.Get)
 
 	router := h.Group("/orders")
 	{
-		router.POST("/:id", r.mid.CheckAccess("ADMIN"), r.order.SetStatus)
-		router.GET("/stats", r.mid.CheckAccess("ADMIN"), r.order.Stats)
+		router.POST("/:id", r.mid.CheckAccess(types.ORDER_FULFIL), r.order.SetStatus)
+		router.GET("/stats", r.mid.CheckAccess(types.STATS_READ), r.order.Stats)
+	}
+
+	return router
+}
+
+func (r *Router) initInternalRoutes(h *gin.RouterGroup) *gin.RouterGroup {
+	router := h.Group("/internal")
+	{
+		router.GET("/verification/:id", r.mid.CheckService(types.VERIFICATION_READ), r.verification.CheckVerify)
 	}
 
 	return router
@@ -12,6 +12,22 @@ type EntityHandler interface {
 	GetFloors(c *gin.Context)
 	DeleteFloor(c *gin.Context)
 	EntityById(c *gin.Context)
+	RelocationReport(c *gin.Context)
+}
+
+type AmenityHandler interface {
+	GetAll(c *gin.Context)
+	Create(c *gin.Context)
+	Update(c *gin.Context)
+	Delete(c *gin.Context)
+}
+
+type BuildingHandler interface {
+	GetAll(c *gin.Context)
+	Get(c *gin.Context)
+	Create(c *gin.Context)
+	Update(c *gin.Context)
+	Delete(c *gin.Context)
 }
 
 type GuestHandler interface {
@@ -37,6 +53,7 @@ type VerificationHandler interface {
 }
 
 type Middleware interface {
-	CheckAccess(roles ...types.Role) gin.HandlerFunc
+	CheckAccess(permissions ...types.Permission) gin.HandlerFunc
+	CheckService(scopes ...types.ServiceScope) gin.HandlerFunc
 	InitLogger(c ctx.Context) gin.HandlerFunc
 }
@@ -0,0 +1,29 @@
+package entity
+
+import "slices"
+
+// EntityAccess restricts booking of an entity to the listed teams
+// and users. An entity without rules is bookable by everyone.
+type EntityAccess struct {
+	Teams []string
+	Users []string
+}
+
+func (a *EntityAccess) IsEmpty() bool {
+	return a == nil || (len(a.Teams) == 0 && len(a.Users) == 0)
+}
+
+// Allows reports whether user, which is a member of teams, may book entity.
+func (a *EntityAccess) Allows(userId string, teams []*Team) bool {
+	if a.IsEmpty() || slices.Contains(a.Users, userId) {
+		return true
+	}
+
+	for _, team := range teams {
+		if slices.Contains(a.Teams, team.Id) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,23 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/nikitaSstepanov/tools/client/pg"
+)
+
+type Amenity struct {
+	Id        string
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (a *Amenity) Scan(r pg.Row) error {
+	return r.Scan(
+		&a.Id,
+		&a.Name,
+		&a.CreatedAt,
+		&a.UpdatedAt,
+	)
+}
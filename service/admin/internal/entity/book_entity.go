@@ -8,10 +8,12 @@ import (
 )
 
 type FloorEntity struct {
-	Id        string
-	Name      string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	Id         string
+	Name       string
+	BuildingId *string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	Version    int
 }
 
 type BookingEntity struct {
@@ -24,8 +26,12 @@ type BookingEntity struct {
 	Width     int
 	Height    int
 	Capacity  int
+	IsPremium bool
+	Amenities []string
+	Access    *EntityAccess
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	Version   int
 }
 
 func (b *BookingEntity) Scan(r pg.Row) error {
@@ -41,6 +47,8 @@ func (b *BookingEntity) Scan(r pg.Row) error {
 		&b.Capacity,
 		&b.CreatedAt,
 		&b.UpdatedAt,
+		&b.IsPremium,
+		&b.Version,
 	)
 }
 
@@ -50,5 +58,7 @@ func (f *FloorEntity) Scan(r pg.Row) error {
 		&f.Name,
 		&f.CreatedAt,
 		&f.UpdatedAt,
+		&f.BuildingId,
+		&f.Version,
 	)
 }
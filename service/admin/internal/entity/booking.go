@@ -14,6 +14,9 @@ type Booking struct {
 	TimeTo    time.Time
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	BookedBy  string
+	GroupId   *string
+	Version   int
 }
 
 type Guest struct {
@@ -39,5 +42,8 @@ func (b *Booking) Scan(r pg.Row) error {
 		&b.TimeTo,
 		&b.CreatedAt,
 		&b.UpdatedAt,
+		&b.BookedBy,
+		&b.GroupId,
+		&b.Version,
 	)
 }
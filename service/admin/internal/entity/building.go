@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/nikitaSstepanov/tools/client/pg"
+)
+
+type Building struct {
+	Id        string
+	Name      string
+	Address   string
+	Timezone  string
+	OpensAt   *string
+	ClosesAt  *string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (b *Building) Scan(r pg.Row) error {
+	return r.Scan(
+		&b.Id,
+		&b.Name,
+		&b.Address,
+		&b.Timezone,
+		&b.OpensAt,
+		&b.ClosesAt,
+		&b.CreatedAt,
+		&b.UpdatedAt,
+	)
+}
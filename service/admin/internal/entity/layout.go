@@ -0,0 +1,22 @@
+package entity
+
+import (
+	types "REDACTED/team-11/backend/admin/internal/entity/type"
+)
+
+// LayoutConflictTag is the error tag, which holds *LayoutConflict of
+// rejected floor save or delete.
+const LayoutConflictTag = "layout_conflict"
+
+// LayoutConflict describes entities, which were changed by another save
+// since the floor version editor has seen.
+type LayoutConflict struct {
+	FloorId  string
+	Version  int
+	Entities []*EntityConflict
+}
+
+type EntityConflict struct {
+	EntityId string
+	Reason   types.ConflictReason
+}
@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/nikitaSstepanov/tools/client/pg"
+	types "REDACTED/team-11/backend/admin/internal/entity/type"
+)
+
+type Relocation struct {
+	Id           string
+	ReportId     string
+	BookingId    string
+	UserId       string
+	FromEntityId string
+	ToEntityId   *string
+	TimeFrom     time.Time
+	TimeTo       time.Time
+	Status       types.RelocationStatus
+	Reason       string
+	CreatedAt    time.Time
+}
+
+type RelocationReport struct {
+	Id          string
+	Relocations []*Relocation
+}
+
+func (r *RelocationReport) Count(status types.RelocationStatus) int {
+	count := 0
+
+	for _, relocation := range r.Relocations {
+		if relocation.Status == status {
+			count += 1
+		}
+	}
+
+	return count
+}
+
+func (r *Relocation) Scan(row pg.Row) error {
+	return row.Scan(
+		&r.Id,
+		&r.ReportId,
+		&r.BookingId,
+		&r.UserId,
+		&r.FromEntityId,
+		&r.ToEntityId,
+		&r.TimeFrom,
+		&r.TimeTo,
+		&r.Status,
+		&r.Reason,
+		&r.CreatedAt,
+	)
+}
@@ -0,0 +1,8 @@
+package types
+
+type ConflictReason string
+
+const (
+	MODIFIED ConflictReason = "MODIFIED"
+	DELETED  ConflictReason = "DELETED"
+)
@@ -0,0 +1,13 @@
+package types
+
+// Permission is read from access tokens of Coffee ID, only permissions
+// used by admin routes are listed.
+type Permission string
+
+const (
+	BOOKING_VERIFY Permission = "booking.verify"
+	ORDER_READ_ANY Permission = "order.read.any"
+	ORDER_FULFIL   Permission = "order.fulfil"
+	LAYOUT_EDIT    Permission = "layout.edit"
+	STATS_READ     Permission = "stats.read"
+)
@@ -0,0 +1,8 @@
+package types
+
+type RelocationStatus string
+
+const (
+	RELOCATED RelocationStatus = "RELOCATED"
+	CANCELLED RelocationStatus = "CANCELLED"
+)
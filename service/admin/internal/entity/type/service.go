@@ -0,0 +1,9 @@
+package types
+
+// ServiceScope is what internal service may do with token issued by Coffee ID
+// with client credentials grant.
+type ServiceScope string
+
+const (
+	VERIFICATION_READ ServiceScope = "verification.read"
+)
@@ -5,3 +5,8 @@ type User struct {
 	Name  string `json:"name"`
 	Email string `json:"email"`
 }
+
+type Team struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
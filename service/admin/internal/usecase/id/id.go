@@ -5,15 +5,20 @@ import (
 	e "github.com/nikitaSstepanov/tools/error"
 	"github.com/nikitaSstepanov/tools/httper"
 	"REDACTED/team-11/backend/admin/internal/entity"
+	"REDACTED/team-11/backend/admin/pkg/tracing"
 )
 
 type Id struct {
 	client *httper.Client
+	tokens *tokenSource
 }
 
-func New(cfg *httper.ClientCfg) *Id {
+func New(cfg *httper.ClientCfg, creds *Credentials) *Id {
+	client := httper.NewClient(cfg)
+
 	return &Id{
-		client: httper.NewClient(cfg),
+		client: client,
+		tokens: newTokenSource(client, creds),
 	}
 }
 
@@ -22,7 +27,7 @@ func (i *Id) GetUser(c ctx.Context, email string) (*entity.User, e.Error) {
 
 	req, err := httper.NewReq(&httper.Params{
 		Method:        httper.GetMethod,
-		Url:           "/account/email/" + email,
+		Url:           "/internal/users/email/" + email,
 		Unmarshal:     true,
 		UnmarshalTo:   &user,
 		UnmarshalType: httper.JsonType,
@@ -31,7 +36,11 @@ func (i *Id) GetUser(c ctx.Context, email string) (*entity.User, e.Error) {
 		return nil, e.InternalErr.WithErr(err)
 	}
 
-	response, err := i.client.Do(req)
+	if err := i.authorize(req); err != nil {
+		return nil, err
+	}
+
+	response, err := i.do(c, req)
 	if err != nil {
 		return nil, e.InternalErr.WithErr(err)
 	}
@@ -50,7 +59,7 @@ func (i *Id) GetUserById(c ctx.Context, id string) (*entity.User, e.Error) {
 
 	req, err := httper.NewReq(&httper.Params{
 		Method:        httper.GetMethod,
-		Url:           "/account/" + id,
+		Url:           "/internal/users/" + id,
 		Unmarshal:     true,
 		UnmarshalTo:   &user,
 		UnmarshalType: httper.JsonType,
@@ -59,7 +68,11 @@ func (i *Id) GetUserById(c ctx.Context, id string) (*entity.User, e.Error) {
 		return nil, e.InternalErr.WithErr(err)
 	}
 
-	response, err := i.client.Do(req)
+	if err := i.authorize(req); err != nil {
+		return nil, err
+	}
+
+	response, err := i.do(c, req)
 	if err != nil {
 		return nil, e.InternalErr.WithErr(err)
 	}
@@ -72,3 +85,61 @@ func (i *Id) GetUserById(c ctx.Context, id string) (*entity.User, e.Error) {
 
 	return &user, nil
 }
+
+func (i *Id) GetUserTeams(c ctx.Context, id string) ([]*entity.Team, e.Error) {
+	teams := make([]*entity.Team, 0)
+
+	req, err := httper.NewReq(&httper.Params{
+		Method:        httper.GetMethod,
+		Url:           "/internal/users/" + id + "/teams",
+		Unmarshal:     true,
+		UnmarshalTo:   &teams,
+		UnmarshalType: httper.JsonType,
+	})
+	if err != nil {
+		return nil, e.InternalErr.WithErr(err)
+	}
+
+	if err := i.authorize(req); err != nil {
+		return nil, err
+	}
+
+	response, err := i.do(c, req)
+	if err != nil {
+		return nil, e.InternalErr.WithErr(err)
+	}
+
+	if response.StatusCode == 404 {
+		return nil, e.New("User wasn`t found", e.NotFound).WithTag("coffee", true)
+	} else if response.StatusCode != 200 {
+		return nil, e.InternalErr
+	}
+
+	return teams, nil
+}
+
+// do sends request to Coffee ID in a client span of the current trace.
+func (i *Id) do(c ctx.Context, req *httper.Req) (*httper.Resp, error) {
+	span := tracing.Outgoing(c, req.Request)
+
+	response, err := i.client.Do(req)
+	if err != nil {
+		tracing.End(span, 0, err)
+		return nil, err
+	}
+
+	tracing.End(span, response.StatusCode, nil)
+
+	return response, nil
+}
+
+func (i *Id) authorize(req *httper.Req) e.Error {
+	token, err := i.tokens.Token()
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package id
+
+import (
+	"net/url"
+	"sync"
+	"time"
+
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/nikitaSstepanov/tools/httper"
+)
+
+// Credentials identify admin service in Coffee ID. Token is got with client
+// credentials grant and reused until it is about to expire.
+type Credentials struct {
+	ClientId string `yaml:"client_id"     env:"SERVICE_CLIENT_ID" env-default:"admin"`
+	Secret   string `yaml:"client_secret" env:"SERVICE_CLIENT_SECRET"`
+	Scope    string `yaml:"scope"         env:"SERVICE_SCOPE"     env-default:"users.read"`
+}
+
+const (
+	tokenUrl = "/oauth/token"
+	// Token is refreshed a bit earlier than it expires, so it doesn`t expire on the way.
+	expiryLeeway = 30 * time.Second
+)
+
+type tokenSource struct {
+	client  *httper.Client
+	creds   *Credentials
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+func newTokenSource(client *httper.Client, creds *Credentials) *tokenSource {
+	return &tokenSource{
+		client: client,
+		creds:  creds,
+	}
+}
+
+func (t *tokenSource) Token() (string, e.Error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.token != "" && time.Now().Before(t.expires) {
+		return t.token, nil
+	}
+
+	var token tokenResponse
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("scope", t.creds.Scope)
+
+	req, err := httper.NewReq(&httper.Params{
+		Method:        httper.PostMethod,
+		Url:           tokenUrl,
+		ByteBody:      []byte(form.Encode()),
+		Unmarshal:     true,
+		UnmarshalTo:   &token,
+		UnmarshalType: httper.JsonType,
+	})
+	if err != nil {
+		return "", e.InternalErr.WithErr(err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(t.creds.ClientId), url.QueryEscape(t.creds.Secret))
+
+	response, err := t.client.Do(req)
+	if err != nil {
+		return "", e.InternalErr.WithErr(err)
+	}
+
+	if response.StatusCode != 200 || token.AccessToken == "" {
+		return "", e.InternalErr
+	}
+
+	t.token = token.AccessToken
+	t.expires = time.Now().Add(time.Duration(token.ExpiresIn)*time.Second - expiryLeeway)
+
+	return t.token, nil
+}
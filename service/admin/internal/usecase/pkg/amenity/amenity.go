@@ -0,0 +1,69 @@
+package amenity
+
+import (
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"REDACTED/team-11/backend/admin/internal/entity"
+)
+
+var (
+	nameConflictErr = e.New("Amenity with this name already exists.", e.Conflict)
+)
+
+type Amenity struct {
+	amenity AmenityStorage
+}
+
+func New(amenity AmenityStorage) *Amenity {
+	return &Amenity{
+		amenity: amenity,
+	}
+}
+
+func (a *Amenity) GetAll(c ctx.Context) ([]*entity.Amenity, e.Error) {
+	return a.amenity.GetAll(c)
+}
+
+func (a *Amenity) Create(c ctx.Context, amenity *entity.Amenity) e.Error {
+	if err := a.checkName(c, amenity); err != nil {
+		return err
+	}
+
+	return a.amenity.Create(c, amenity)
+}
+
+func (a *Amenity) Update(c ctx.Context, amenity *entity.Amenity) e.Error {
+	old, err := a.amenity.Get(c, amenity.Id)
+	if err != nil {
+		return err
+	}
+
+	if err := a.checkName(c, amenity); err != nil {
+		return err
+	}
+
+	amenity.CreatedAt = old.CreatedAt
+
+	return a.amenity.Update(c, amenity)
+}
+
+func (a *Amenity) Delete(c ctx.Context, id string) e.Error {
+	if _, err := a.amenity.Get(c, id); err != nil {
+		return err
+	}
+
+	return a.amenity.Delete(c, id)
+}
+
+func (a *Amenity) checkName(c ctx.Context, amenity *entity.Amenity) e.Error {
+	same, err := a.amenity.GetByName(c, amenity.Name)
+	if err != nil && err.GetCode() != e.NotFound {
+		return err
+	}
+
+	if err == nil && same.Id != amenity.Id {
+		return nameConflictErr.WithCtx(c)
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package amenity
+
+import (
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"REDACTED/team-11/backend/admin/internal/entity"
+)
+
+type AmenityStorage interface {
+	Get(c ctx.Context, id string) (*entity.Amenity, e.Error)
+	GetByName(c ctx.Context, name string) (*entity.Amenity, e.Error)
+	GetAll(c ctx.Context) ([]*entity.Amenity, e.Error)
+	Create(c ctx.Context, amenity *entity.Amenity) e.Error
+	Update(c ctx.Context, amenity *entity.Amenity) e.Error
+	Delete(c ctx.Context, id string) e.Error
+}
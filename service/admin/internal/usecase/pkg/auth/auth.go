@@ -6,12 +6,21 @@ type Auth struct {
 	jwt *Jwt
 }
 
-func New(opts *JwtOptions) *Auth {
-	return &Auth{
-		jwt: NewJwt(opts),
+func New(opts *JwtOptions) (*Auth, error) {
+	jwt, err := NewJwt(opts)
+	if err != nil {
+		return nil, err
 	}
+
+	return &Auth{
+		jwt: jwt,
+	}, nil
 }
 
 func (a *Auth) ValidateToken(token string, isRefresh bool) (*Claims, e.Error) {
 	return a.jwt.ValidateToken(token, isRefresh)
 }
+
+func (a *Auth) ValidateServiceToken(token string) (*ServiceClaims, e.Error) {
+	return a.jwt.ValidateServiceToken(token)
+}
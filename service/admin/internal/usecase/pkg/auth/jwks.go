@@ -0,0 +1,217 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	minRefetchInterval = time.Minute
+	fetchTimeout       = 5 * time.Second
+)
+
+var (
+	errUnknownKid    = errors.New("unknown kid")
+	errUnexpectedAlg = errors.New("unexpected signing algorithm")
+
+	// methods are signing algorithms issued by Coffee ID.
+	methods = []string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}
+)
+
+type publicKey struct {
+	alg string
+	key crypto.PublicKey
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+}
+
+// Jwks fetches JSON Web Key Set of Coffee ID and caches keys by kid.
+type Jwks struct {
+	url    string
+	ttl    time.Duration
+	client *http.Client
+
+	fetchMu     sync.Mutex
+	mu          sync.RWMutex
+	keys        map[string]publicKey
+	fetchedAt   time.Time
+	attemptedAt time.Time
+}
+
+func NewJwks(url string, ttl time.Duration) *Jwks {
+	return &Jwks{
+		url:    url,
+		ttl:    ttl,
+		client: &http.Client{Timeout: fetchTimeout},
+		keys:   make(map[string]publicKey),
+	}
+}
+
+// keyFunc resolves key by kid header and rejects tokens signed with algorithm other than key's.
+// Keys are refetched when cache expires or kid is unknown, but not more often than once a minute.
+// Stale keys are used while Coffee ID is unavailable.
+func (j *Jwks) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	j.mu.RLock()
+	key, ok := j.keys[kid]
+	fresh := time.Since(j.fetchedAt) < j.ttl
+	j.mu.RUnlock()
+
+	if !ok || !fresh {
+		if err := j.refetch(); err != nil && !ok {
+			return nil, err
+		}
+
+		j.mu.RLock()
+		key, ok = j.keys[kid]
+		j.mu.RUnlock()
+
+		if !ok {
+			return nil, errUnknownKid
+		}
+	}
+
+	if token.Method.Alg() != key.alg {
+		return nil, errUnexpectedAlg
+	}
+
+	return key.key, nil
+}
+
+func (j *Jwks) refetch() error {
+	j.fetchMu.Lock()
+	defer j.fetchMu.Unlock()
+
+	j.mu.RLock()
+	attemptedAt := j.attemptedAt
+	j.mu.RUnlock()
+
+	if time.Since(attemptedAt) < minRefetchInterval {
+		return nil
+	}
+
+	keys, err := j.fetch()
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.attemptedAt = time.Now()
+
+	if err != nil {
+		return err
+	}
+
+	j.keys = keys
+	j.fetchedAt = j.attemptedAt
+
+	return nil
+}
+
+func (j *Jwks) fetch() (map[string]publicKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, j.url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := j.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("jwks: unexpected status %d", resp.StatusCode)
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]publicKey, len(set.Keys))
+
+	for _, raw := range set.Keys {
+		if raw.Use != "" && raw.Use != "sig" {
+			continue
+		}
+
+		key, err := parseJwk(raw)
+		if err != nil {
+			return nil, fmt.Errorf("jwks: key %q: %w", raw.Kid, err)
+		}
+
+		keys[raw.Kid] = key
+	}
+
+	return keys, nil
+}
+
+func parseJwk(raw jwk) (publicKey, error) {
+	switch raw.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(raw.N)
+		if err != nil {
+			return publicKey{}, err
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(raw.E)
+		if err != nil {
+			return publicKey{}, err
+		}
+
+		return publicKey{
+			alg: jwt.SigningMethodRS256.Alg(),
+			key: &rsa.PublicKey{
+				N: new(big.Int).SetBytes(n),
+				E: int(new(big.Int).SetBytes(e).Int64()),
+			},
+		}, nil
+	case "OKP":
+		if raw.Crv != "Ed25519" {
+			return publicKey{}, fmt.Errorf("unsupported curve %s", raw.Crv)
+		}
+
+		x, err := base64.RawURLEncoding.DecodeString(raw.X)
+		if err != nil {
+			return publicKey{}, err
+		}
+
+		if len(x) != ed25519.PublicKeySize {
+			return publicKey{}, errors.New("invalid ed25519 key size")
+		}
+
+		return publicKey{
+			alg: jwt.SigningMethodEdDSA.Alg(),
+			key: ed25519.PublicKey(x),
+		}, nil
+	default:
+		return publicKey{}, fmt.Errorf("unsupported key type %s", raw.Kty)
+	}
+}
@@ -1,30 +1,49 @@
 package auth
 
 import (
+	"errors"
+	"slices"
+	"strings"
+
 	"github.com/golang-jwt/jwt/v5"
 	e "github.com/nikitaSstepanov/tools/error"
+	types "REDACTED/team-11/backend/admin/internal/entity/type"
 )
 
+var errNoAudience = errors.New("jwt audience is not set")
+
 type Jwt struct {
-	Audience  []string
-	issuer    string
-	accessKey string
+	audience        string
+	serviceAudience string
+	issuer          string
+	keys            *Jwks
 }
 
-func NewJwt(options *JwtOptions) *Jwt {
-	return &Jwt{
-		Audience:  options.Audience,
-		issuer:    options.Issuer,
-		accessKey: options.AccessKey,
+// NewJwt fails without audience, otherwise tokens issued by Coffee ID for
+// any other client would be accepted.
+func NewJwt(options *JwtOptions) (*Jwt, error) {
+	if options.Audience == "" {
+		return nil, errNoAudience
 	}
+
+	return &Jwt{
+		audience:        options.Audience,
+		serviceAudience: options.ServiceAudience,
+		issuer:          options.Issuer,
+		keys:            NewJwks(options.JwksUrl, options.JwksTTL),
+	}, nil
 }
 
+// ValidateToken checks signature, algorithm, issuer, audience and expiration of access token.
 func (j *Jwt) ValidateToken(jwtString string, isRefresh bool) (*Claims, e.Error) {
-	keyFunc := func(token *jwt.Token) (interface{}, error) {
-		return []byte(j.accessKey), nil
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(methods),
+		jwt.WithIssuer(j.issuer),
+		jwt.WithAudience(j.audience),
+		jwt.WithExpirationRequired(),
 	}
 
-	token, err := jwt.ParseWithClaims(jwtString, &Claims{}, keyFunc)
+	token, err := jwt.ParseWithClaims(jwtString, &Claims{}, j.keys.keyFunc, opts...)
 	if err != nil {
 		return nil, unauthErr.WithErr(err)
 	}
@@ -35,3 +54,41 @@ func (j *Jwt) ValidateToken(jwtString string, isRefresh bool) (*Claims, e.Error)
 
 	return token.Claims.(*Claims), nil
 }
+
+// ValidateServiceToken checks token of internal service. Tokens of users have other
+// audience, so they are rejected.
+func (j *Jwt) ValidateServiceToken(jwtString string) (*ServiceClaims, e.Error) {
+	if j.serviceAudience == "" {
+		return nil, unauthErr
+	}
+
+	token, err := jwt.ParseWithClaims(
+		jwtString, &ServiceClaims{}, j.keys.keyFunc,
+		jwt.WithValidMethods(methods),
+		jwt.WithIssuer(j.issuer),
+		jwt.WithAudience(j.serviceAudience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil || !token.Valid {
+		return nil, unauthErr.WithErr(err)
+	}
+
+	claims := token.Claims.(*ServiceClaims)
+
+	if claims.ClientId == "" {
+		return nil, unauthErr
+	}
+
+	return claims, nil
+}
+
+// Allows reports whether service was granted scope.
+func (c *ServiceClaims) Allows(scope types.ServiceScope) bool {
+	return slices.Contains(strings.Fields(c.Scope), string(scope))
+}
+
+// Can reports whether Coffee ID put permission into the token, CheckAccess
+// rejects requests to admin routes without it.
+func (c *Claims) Can(permission types.Permission) bool {
+	return slices.Contains(c.Permissions, permission)
+}
@@ -1,18 +1,31 @@
 package auth
 
 import (
+	"time"
+
 	"github.com/golang-jwt/jwt/v5"
 	types "REDACTED/team-11/backend/admin/internal/entity/type"
 )
 
 type JwtOptions struct {
-	Audience  []string `yaml:"audience" env:"JWT_AUDIENCE"`
-	Issuer    string   `yaml:"issuer"   env:"JWT_ISSUER"`
-	AccessKey string   `env:"JWT_SECRET"`
+	Audience        string        `yaml:"audience"         env:"JWT_AUDIENCE"`
+	ServiceAudience string        `yaml:"service_audience" env:"JWT_SERVICE_AUDIENCE"`
+	Issuer          string        `yaml:"issuer"           env:"JWT_ISSUER"`
+	JwksUrl         string        `yaml:"jwks_url"         env:"JWKS_URL"`
+	JwksTTL         time.Duration `yaml:"jwks_ttl"         env:"JWKS_CACHE_TTL" env-default:"10m"`
 }
 
 type Claims struct {
-	Id   string     `json:"id"`
-	Role types.Role `json:"role"`
+	Id          string             `json:"id"`
+	Role        types.Role         `json:"role"`
+	Permissions []types.Permission `json:"permissions"`
+	jwt.RegisteredClaims
+}
+
+// ServiceClaims are claims of token issued to internal service by Coffee ID with
+// client credentials grant.
+type ServiceClaims struct {
+	ClientId string `json:"client_id"`
+	Scope    string `json:"scope"`
 	jwt.RegisteredClaims
 }
@@ -3,124 +3,417 @@ package booking_entity
 import (
 	"slices"
 
+	"github.com/nikitaSstepanov/tools/client/pg"
 	"github.com/nikitaSstepanov/tools/ctx"
 	e "github.com/nikitaSstepanov/tools/error"
 	"REDACTED/team-11/backend/admin/internal/entity"
 )
 
 type BookingEntity struct {
-	booking EntityStorage
+	booking     EntityStorage
+	bookings    BookingStorage
+	guests      GuestStorage
+	relocations RelocationStorage
+	buildings   BuildingStorage
+	amenities   AmenityStorage
+	access      AccessStorage
+	users       UserClient
 }
 
-func New(booking EntityStorage) *BookingEntity {
+func New(
+	booking EntityStorage,
+	bookings BookingStorage,
+	guests GuestStorage,
+	relocations RelocationStorage,
+	buildings BuildingStorage,
+	amenities AmenityStorage,
+	access AccessStorage,
+	users UserClient,
+) *BookingEntity {
 	return &BookingEntity{
-		booking: booking,
+		booking:     booking,
+		buildings:   buildings,
+		amenities:   amenities,
+		access:      access,
+		users:       users,
+		bookings:    bookings,
+		guests:      guests,
+		relocations: relocations,
 	}
 }
 
+var (
+	unknownAmenityErr = e.New("Unknown amenity.", e.BadInput)
+	unknownUserErr    = e.New("Unknown user in entity access.", e.BadInput)
+)
+
 func (b *BookingEntity) GetEntity(c ctx.Context, id string) (*entity.BookingEntity, e.Error) {
-	return b.booking.GetEntity(c, id)
+	ent, err := b.booking.GetEntity(c, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.fillAmenities(c, []*entity.BookingEntity{ent}); err != nil {
+		return nil, err
+	}
+
+	if err := b.fillAccess(c, []*entity.BookingEntity{ent}); err != nil {
+		return nil, err
+	}
+
+	return ent, nil
 }
 
-func (b *BookingEntity) GetFloors(c ctx.Context) ([]*entity.FloorEntity, e.Error) {
-	return b.booking.GetFloors(c)
+func (b *BookingEntity) GetFloors(c ctx.Context, buildingId string) ([]*entity.FloorEntity, e.Error) {
+	if buildingId != "" {
+		if _, err := b.buildings.Get(c, buildingId); err != nil {
+			return nil, err
+		}
+	}
+
+	return b.booking.GetFloors(c, buildingId)
 }
 
-func (b *BookingEntity) GetEntities(c ctx.Context, id string) ([]*entity.BookingEntity, e.Error) {
-	_, err := b.booking.GetFloor(c, id)
+// GetEntities returns floor and its entities, which have all of amenities.
+func (b *BookingEntity) GetEntities(c ctx.Context, id string, amenities []string) (*entity.FloorEntity, []*entity.BookingEntity, e.Error) {
+	floor, err := b.booking.GetFloor(c, id)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return b.booking.GetEntities(c, id)
+	entities, err := b.booking.GetEntities(c, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := b.fillAmenities(c, entities); err != nil {
+		return nil, nil, err
+	}
+
+	if err := b.fillAccess(c, entities); err != nil {
+		return nil, nil, err
+	}
+
+	result := make([]*entity.BookingEntity, 0, len(entities))
+
+	for _, ent := range entities {
+		if hasAll(ent.Amenities, amenities) {
+			result = append(result, ent)
+		}
+	}
+
+	return floor, result, nil
 }
 
-func (b *BookingEntity) Save(c ctx.Context, bookings []*entity.BookingEntity, floorEntity *entity.FloorEntity) e.Error {
+// Save saves layout of floor. If version is set, layout is saved only if
+// entities changed since that version of floor are not overwritten. On
+// success floorEntity.Version is set to the version of saved layout.
+func (b *BookingEntity) Save(c ctx.Context, bookings []*entity.BookingEntity, floorEntity *entity.FloorEntity, version *int) (*entity.RelocationReport, e.Error) {
+	if floorEntity.BuildingId != nil {
+		if _, err := b.buildings.Get(c, *floorEntity.BuildingId); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := b.checkAmenities(c, bookings); err != nil {
+		return nil, err
+	}
+
+	if err := b.checkAccess(c, bookings); err != nil {
+		return nil, err
+	}
+
 	floor, err := b.booking.GetFloor(c, floorEntity.Id)
 	if err != nil && err.GetCode() != e.NotFound {
-		return err
+		return nil, err
 	}
 
+	entities := make([]*entity.BookingEntity, 0)
+
 	if err != nil {
-		floor := &entity.FloorEntity{
-			Id:        floorEntity.Id,
-			Name:      floorEntity.Name,
-			CreatedAt: floorEntity.CreatedAt,
-			UpdatedAt: floorEntity.UpdatedAt,
+		if version != nil {
+			return nil, layoutConflictErr(c, &entity.LayoutConflict{
+				FloorId:  floorEntity.Id,
+				Entities: layoutConflicts(*version, bookings, entities),
+			})
 		}
 
-		err := b.booking.CreateFloor(c, floor)
+		floorEntity.Version = 1
+
+		err := b.booking.CreateFloor(c, floorEntity)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	} else {
+		entities, err = b.layout(c, floor.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		if version != nil && *version != floor.Version {
+			conflicts := layoutConflicts(*version, bookings, entities)
+
+			if len(conflicts) != 0 {
+				return nil, layoutConflictErr(c, &entity.LayoutConflict{
+					FloorId:  floor.Id,
+					Version:  floor.Version,
+					Entities: conflicts,
+				})
+			}
+		}
+
 		floor.UpdatedAt = floorEntity.UpdatedAt
 		floor.Name = floorEntity.Name
+		floor.BuildingId = floorEntity.BuildingId
 
-		err := b.booking.UpdateFloor(c, floor)
-		if err != nil {
-			return err
+		if err := b.booking.UpdateFloor(c, floor); err != nil {
+			if err.GetCode() == e.Conflict {
+				return nil, b.floorConflictErr(c, floor.Id)
+			}
+
+			return nil, err
 		}
-	}
 
-	entities, err := b.booking.GetEntities(c, floorEntity.Id)
-	if err != nil && err.GetCode() != e.NotFound {
-		return err
+		floorEntity.Version = floor.Version
 	}
 
-	id1 := make([]string, 0)
+	saved := make(map[string]*entity.BookingEntity, len(entities))
 
-	for _, entit := range entities {
-		id1 = append(id1, entit.Id)
+	for _, ent := range entities {
+		saved[ent.Id] = ent
 	}
 
-	id2 := make([]string, 0)
+	ids := make([]string, 0)
 
 	for _, booking := range bookings {
-		id2 = append(id2, booking.Id)
+		ids = append(ids, booking.Id)
 	}
 
-	toDel := make([]string, 0)
+	toDel := make([]*entity.BookingEntity, 0)
 
-	for _, i := range id1 {
-		if !slices.Contains(id2, i) {
-			toDel = append(toDel, i)
+	for _, entit := range entities {
+		if !slices.Contains(ids, entit.Id) {
+			toDel = append(toDel, entit)
 		}
 	}
 
-	for _, to := range toDel {
-		if err := b.booking.DeleteEntity(c, to); err != nil {
-			return err
+	for _, u := range bookings {
+		if old, ok := saved[u.Id]; ok && !changed(old, u) {
+			continue
 		}
-	}
 
-	for _, u := range bookings {
+		u.Version = floorEntity.Version
+
 		_, err := b.booking.GetEntity(c, u.Id)
 		if err != nil && err.GetCode() != e.NotFound {
-			return err
+			return nil, err
 		}
 
 		if err != nil {
 			err := b.booking.CreateEntity(c, u)
 			if err != nil {
-				return err
+				return nil, err
 			}
 		} else {
 			err := b.booking.UpdateEntity(c, u)
 			if err != nil {
+				return nil, err
+			}
+		}
+
+		if err := b.amenities.SetForEntity(c, u.Id, u.Amenities); err != nil {
+			return nil, err
+		}
+
+		if err := b.access.SetForEntity(c, u.Id, u.Access); err != nil {
+			return nil, err
+		}
+	}
+
+	return b.remove(c, toDel, func(tx pg.Tx) e.Error {
+		for _, ent := range toDel {
+			if err := b.booking.DeleteEntity(c, tx, ent.Id); err != nil {
 				return err
 			}
 		}
+
+		return nil
+	})
+}
+
+// DeleteFloor deletes floor and relocates bookings of its entities. If
+// version is set, floor is deleted only if it still has this version.
+func (b *BookingEntity) DeleteFloor(c ctx.Context, id string, version *int) (*entity.RelocationReport, e.Error) {
+	floor, err := b.booking.GetFloor(c, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if version != nil && *version != floor.Version {
+		return nil, layoutConflictErr(c, &entity.LayoutConflict{
+			FloorId:  floor.Id,
+			Version:  floor.Version,
+			Entities: make([]*entity.EntityConflict, 0),
+		})
+	}
+
+	entities, err := b.booking.GetEntities(c, id)
+	if err != nil && err.GetCode() != e.NotFound {
+		return nil, err
+	}
+
+	return b.remove(c, entities, func(tx pg.Tx) e.Error {
+		return b.booking.DeleteFloor(c, tx, id)
+	})
+}
+
+// remove relocates bookings of entities and deletes entities by del in one
+// transaction, so no booking is left on deleted entity and report is
+// complete, or nothing is changed at all.
+func (b *BookingEntity) remove(c ctx.Context, entities []*entity.BookingEntity, del func(tx pg.Tx) e.Error) (*entity.RelocationReport, e.Error) {
+	tx, err := b.booking.Begin(c)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(c)
+
+	report, err := b.relocate(c, tx, entities)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := del(tx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(c); err != nil {
+		return nil, e.InternalErr.
+			WithErr(err).
+			WithCtx(c)
+	}
+
+	return report, nil
+}
+
+func (b *BookingEntity) fillAmenities(c ctx.Context, entities []*entity.BookingEntity) e.Error {
+	if len(entities) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(entities))
+
+	for _, ent := range entities {
+		ids = append(ids, ent.Id)
+	}
+
+	amenities, err := b.amenities.GetForEntities(c, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, ent := range entities {
+		ent.Amenities = amenities[ent.Id]
+
+		if ent.Amenities == nil {
+			ent.Amenities = make([]string, 0)
+		}
+	}
+
+	return nil
+}
+
+func (b *BookingEntity) checkAmenities(c ctx.Context, entities []*entity.BookingEntity) e.Error {
+	ids := make([]string, 0)
+
+	for _, ent := range entities {
+		for _, id := range ent.Amenities {
+			if !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	count, err := b.amenities.Count(c, ids)
+	if err != nil {
+		return err
+	}
+
+	if count != len(ids) {
+		return unknownAmenityErr.WithCtx(c)
 	}
 
 	return nil
 }
 
-func (b *BookingEntity) DeleteFloor(c ctx.Context, id string) e.Error {
-	_, err := b.booking.GetFloor(c, id)
+func (b *BookingEntity) fillAccess(c ctx.Context, entities []*entity.BookingEntity) e.Error {
+	if len(entities) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(entities))
+
+	for _, ent := range entities {
+		ids = append(ids, ent.Id)
+	}
+
+	access, err := b.access.GetForEntities(c, ids)
 	if err != nil {
 		return err
 	}
 
-	return b.booking.DeleteFloor(c, id)
+	for _, ent := range entities {
+		ent.Access = access[ent.Id]
+
+		if ent.Access == nil {
+			ent.Access = &entity.EntityAccess{
+				Teams: make([]string, 0),
+				Users: make([]string, 0),
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkAccess verifies that users, which desks are assigned to, exist.
+func (b *BookingEntity) checkAccess(c ctx.Context, entities []*entity.BookingEntity) e.Error {
+	checked := make([]string, 0)
+
+	for _, ent := range entities {
+		if ent.Access.IsEmpty() {
+			continue
+		}
+
+		for _, id := range ent.Access.Users {
+			if slices.Contains(checked, id) {
+				continue
+			}
+
+			if _, err := b.users.GetUserById(c, id); err != nil {
+				if err.GetCode() == e.NotFound {
+					return unknownUserErr.WithCtx(c)
+				}
+
+				return err
+			}
+
+			checked = append(checked, id)
+		}
+	}
+
+	return nil
+}
+
+func hasAll(have []string, want []string) bool {
+	for _, id := range want {
+		if !slices.Contains(have, id) {
+			return false
+		}
+	}
+
+	return true
 }
@@ -0,0 +1,121 @@
+package booking_entity
+
+import (
+	"slices"
+
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"REDACTED/team-11/backend/admin/internal/entity"
+	types "REDACTED/team-11/backend/admin/internal/entity/type"
+)
+
+// layout returns saved entities of floor with their amenities and access.
+func (b *BookingEntity) layout(c ctx.Context, floorId string) ([]*entity.BookingEntity, e.Error) {
+	entities, err := b.booking.GetEntities(c, floorId)
+	if err != nil && err.GetCode() != e.NotFound {
+		return nil, err
+	}
+
+	if err := b.fillAmenities(c, entities); err != nil {
+		return nil, err
+	}
+
+	if err := b.fillAccess(c, entities); err != nil {
+		return nil, err
+	}
+
+	return entities, nil
+}
+
+// floorConflictErr reports floor, which was saved by someone else
+// between reading and updating it.
+func (b *BookingEntity) floorConflictErr(c ctx.Context, floorId string) e.Error {
+	conflict := &entity.LayoutConflict{
+		FloorId:  floorId,
+		Entities: make([]*entity.EntityConflict, 0),
+	}
+
+	if floor, err := b.booking.GetFloor(c, floorId); err == nil {
+		conflict.Version = floor.Version
+	}
+
+	return layoutConflictErr(c, conflict)
+}
+
+func layoutConflictErr(c ctx.Context, conflict *entity.LayoutConflict) e.Error {
+	return e.New("Floor was modified.", e.Conflict).
+		WithTag(entity.LayoutConflictTag, conflict).
+		WithCtx(c)
+}
+
+// layoutConflicts compares layout, which editor has based on the floor of
+// version seen, with the saved one. Entity conflicts if saving the layout
+// would overwrite, remove or restore changes made after that version.
+func layoutConflicts(seen int, layout []*entity.BookingEntity, saved []*entity.BookingEntity) []*entity.EntityConflict {
+	conflicts := make([]*entity.EntityConflict, 0)
+
+	byId := make(map[string]*entity.BookingEntity, len(saved))
+
+	for _, ent := range saved {
+		byId[ent.Id] = ent
+	}
+
+	kept := make(map[string]bool, len(layout))
+
+	for _, ent := range layout {
+		kept[ent.Id] = true
+
+		old, ok := byId[ent.Id]
+
+		switch {
+		case !ok && ent.Version > 0:
+			conflicts = append(conflicts, &entity.EntityConflict{
+				EntityId: ent.Id,
+				Reason:   types.DELETED,
+			})
+		case ok && old.Version > seen && changed(old, ent):
+			conflicts = append(conflicts, &entity.EntityConflict{
+				EntityId: ent.Id,
+				Reason:   types.MODIFIED,
+			})
+		}
+	}
+
+	for _, ent := range saved {
+		if !kept[ent.Id] && ent.Version > seen {
+			conflicts = append(conflicts, &entity.EntityConflict{
+				EntityId: ent.Id,
+				Reason:   types.MODIFIED,
+			})
+		}
+	}
+
+	return conflicts
+}
+
+// changed reports whether saving ent would change stored old entity.
+func changed(old, ent *entity.BookingEntity) bool {
+	return old.Title != ent.Title ||
+		old.X != ent.X || old.Y != ent.Y ||
+		old.Width != ent.Width || old.Height != ent.Height ||
+		old.IsPremium != ent.IsPremium ||
+		!sameIds(old.Amenities, ent.Amenities) ||
+		!sameAccess(old.Access, ent.Access)
+}
+
+func sameAccess(a, b *entity.EntityAccess) bool {
+	if a.IsEmpty() || b.IsEmpty() {
+		return a.IsEmpty() == b.IsEmpty()
+	}
+
+	return sameIds(a.Teams, b.Teams) && sameIds(a.Users, b.Users)
+}
+
+func sameIds(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+
+	slices.Sort(a)
+	slices.Sort(b)
+
+	return slices.Equal(a, b)
+}
@@ -0,0 +1,274 @@
+package booking_entity
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nikitaSstepanov/tools/client/pg"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"REDACTED/team-11/backend/admin/internal/entity"
+	types "REDACTED/team-11/backend/admin/internal/entity/type"
+)
+
+const (
+	slotDuration = 15 * time.Minute
+
+	noCompatibleReason = "There is no compatible entity."
+	allBusyReason      = "All compatible entities are busy."
+)
+
+func (b *BookingEntity) GetRelocationReport(c ctx.Context, id string) (*entity.RelocationReport, e.Error) {
+	relocations, err := b.relocations.GetByReport(c, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.RelocationReport{
+		Id:          id,
+		Relocations: relocations,
+	}, nil
+}
+
+// relocate moves future bookings of removed entities to compatible free
+// entities of the same building, which user of booking may book. Bookings
+// which can`t be moved are cancelled. Every decision
+// is stored in the report. Everything is done in tx, so removed entities
+// have to be deleted in the same tx. Report gets id only if some booking
+// was affected, empty report isn`t stored.
+func (b *BookingEntity) relocate(c ctx.Context, tx pg.Tx, removed []*entity.BookingEntity) (*entity.RelocationReport, e.Error) {
+	reportId := uuid.NewString()
+
+	report := &entity.RelocationReport{
+		Relocations: make([]*entity.Relocation, 0),
+	}
+
+	removedIds := make([]string, 0, len(removed))
+	teams := make(map[string][]*entity.Team)
+
+	for _, ent := range removed {
+		removedIds = append(removedIds, ent.Id)
+	}
+
+	for _, ent := range removed {
+		bookings, err := b.bookings.GetFuture(c, tx, ent.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(bookings) == 0 {
+			continue
+		}
+
+		candidates, err := b.getCandidates(c, ent, removedIds)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, booking := range bookings {
+			relocation, err := b.relocateBooking(c, tx, booking, candidates, teams)
+			if err != nil {
+				return nil, err
+			}
+
+			relocation.ReportId = reportId
+
+			if err := b.relocations.Create(c, tx, relocation); err != nil {
+				return nil, err
+			}
+
+			report.Relocations = append(report.Relocations, relocation)
+		}
+	}
+
+	if len(report.Relocations) != 0 {
+		report.Id = reportId
+	}
+
+	return report, nil
+}
+
+func (b *BookingEntity) relocateBooking(c ctx.Context, tx pg.Tx, booking *entity.Booking, candidates []*entity.BookingEntity, teams map[string][]*entity.Team) (*entity.Relocation, e.Error) {
+	relocation := &entity.Relocation{
+		Id:           uuid.NewString(),
+		BookingId:    booking.Id,
+		UserId:       booking.UserId,
+		FromEntityId: booking.EntityId,
+		TimeFrom:     booking.TimeFrom,
+		TimeTo:       booking.TimeTo,
+		CreatedAt:    time.Now().UTC(),
+	}
+
+	guests, err := b.guests.Get(c, booking.Id)
+	if err != nil && err.GetCode() != e.NotFound {
+		return nil, err
+	}
+
+	people := len(guests) + 1
+	compatible := 0
+
+	for _, candidate := range candidates {
+		if candidate.Type == types.ROOM && candidate.Capacity < people {
+			continue
+		}
+
+		allowed, err := b.canBook(c, candidate, booking.UserId, teams)
+		if err != nil {
+			return nil, err
+		}
+
+		if !allowed {
+			continue
+		}
+
+		compatible += 1
+
+		free, err := b.isFree(c, tx, candidate, booking.TimeFrom, booking.TimeTo)
+		if err != nil {
+			return nil, err
+		}
+
+		if !free {
+			continue
+		}
+
+		if err := b.bookings.ChangeEntity(c, tx, booking.Id, candidate.Id); err != nil {
+			return nil, err
+		}
+
+		relocation.ToEntityId = &candidate.Id
+		relocation.Status = types.RELOCATED
+
+		return relocation, nil
+	}
+
+	if err := b.bookings.Delete(c, tx, booking.Id); err != nil {
+		return nil, err
+	}
+
+	relocation.Status = types.CANCELLED
+
+	if compatible == 0 {
+		relocation.Reason = noCompatibleReason
+	} else {
+		relocation.Reason = allBusyReason
+	}
+
+	return relocation, nil
+}
+
+// getCandidates returns entities of the same type in the building of
+// removed entity, entities of its floor go first. Floor without building
+// is searched alone.
+func (b *BookingEntity) getCandidates(c ctx.Context, from *entity.BookingEntity, removed []string) ([]*entity.BookingEntity, e.Error) {
+	floor, err := b.booking.GetFloor(c, from.FloorId)
+	if err != nil {
+		return nil, err
+	}
+
+	floors := []*entity.FloorEntity{floor}
+
+	if floor.BuildingId != nil {
+		floors, err = b.booking.GetFloors(c, *floor.BuildingId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	floorIds := make([]string, 0, len(floors))
+
+	for _, floor := range floors {
+		floorIds = append(floorIds, floor.Id)
+	}
+
+	entities, err := b.booking.GetEntitiesByType(c, from.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]*entity.BookingEntity, 0)
+
+	for _, ent := range entities {
+		if !slices.Contains(floorIds, ent.FloorId) || slices.Contains(removed, ent.Id) || ent.Capacity < 1 {
+			continue
+		}
+
+		candidates = append(candidates, ent)
+	}
+
+	if err := b.fillAccess(c, candidates); err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(candidates, func(a, b *entity.BookingEntity) int {
+		aFloor, bFloor := a.FloorId == from.FloorId, b.FloorId == from.FloorId
+
+		if aFloor != bFloor {
+			if aFloor {
+				return -1
+			}
+
+			return 1
+		}
+
+		return a.Capacity - b.Capacity
+	})
+
+	return candidates, nil
+}
+
+// canBook checks access rules of entity for user. Teams of user are
+// requested only for restricted entities and only once per relocation.
+func (b *BookingEntity) canBook(c ctx.Context, ent *entity.BookingEntity, userId string, teams map[string][]*entity.Team) (bool, e.Error) {
+	if ent.Access.IsEmpty() {
+		return true, nil
+	}
+
+	userTeams, ok := teams[userId]
+	if !ok {
+		var err e.Error
+
+		userTeams, err = b.users.GetUserTeams(c, userId)
+		if err != nil && err.GetCode() != e.NotFound {
+			return false, err
+		}
+
+		teams[userId] = userTeams
+	}
+
+	return ent.Access.Allows(userId, userTeams), nil
+}
+
+// isFree checks the workload of entity in every slot of interval.
+// Room can be used only by one booking at the same time, open space
+// can be used while there are free seats.
+func (b *BookingEntity) isFree(c ctx.Context, tx pg.Tx, ent *entity.BookingEntity, from, to time.Time) (bool, e.Error) {
+	bookings, err := b.bookings.GetIntersected(c, tx, ent.Id, from, to)
+	if err != nil {
+		return false, err
+	}
+
+	limit := ent.Capacity
+
+	if ent.Type == types.ROOM {
+		limit = 1
+	}
+
+	for slot := from; slot.Before(to); slot = slot.Add(slotDuration) {
+		slotEnd := slot.Add(slotDuration)
+		busy := 0
+
+		for _, booking := range bookings {
+			if booking.TimeFrom.Before(slotEnd) && booking.TimeTo.After(slot) {
+				busy += 1
+			}
+		}
+
+		if busy >= limit {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,567 @@
+package booking_entity
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-playground/assert/v2"
+	"github.com/nikitaSstepanov/tools/client/pg"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/nikitaSstepanov/tools/sl"
+	"REDACTED/team-11/backend/admin/internal/entity"
+	types "REDACTED/team-11/backend/admin/internal/entity/type"
+)
+
+var day = time.Date(2030, time.March, 4, 0, 0, 0, 0, time.UTC)
+
+func TestGetCandidates(t *testing.T) {
+	c := ctx.New(sl.Default())
+
+	building, other := "building", "other"
+
+	storage := &db{
+		floors: []*entity.FloorEntity{
+			{Id: "first", BuildingId: &building},
+			{Id: "second", BuildingId: &building},
+			{Id: "other", BuildingId: &other},
+			{Id: "alone"},
+		},
+		entities: []*entity.BookingEntity{
+			room("second-small", "second", 2),
+			room("first-big", "first", 6),
+			room("first-small", "first", 2),
+			room("removed", "first", 4),
+			room("other-small", "other", 2),
+			room("alone-big", "alone", 6),
+			room("alone-removed", "alone", 4),
+			{Id: "first-space", Type: types.OPENSPACE, FloorId: "first", Capacity: 10},
+		},
+	}
+
+	usecase := storage.usecase()
+
+	tests := []struct {
+		TestName string
+		From     string
+		Want     []string
+	}{
+		{
+			TestName: "Same floor first",
+			From:     "removed",
+			Want:     []string{"first-small", "first-big", "second-small"},
+		},
+		{
+			TestName: "Floor without building",
+			From:     "alone-removed",
+			Want:     []string{"alone-big"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.TestName, func(t *testing.T) {
+			from := storage.entity(tc.From)
+
+			candidates, err := usecase.getCandidates(c, from, []string{from.Id})
+			if err != nil {
+				t.Fatalf("Can`t get candidates: %v", err)
+			}
+
+			ids := make([]string, 0, len(candidates))
+
+			for _, candidate := range candidates {
+				ids = append(ids, candidate.Id)
+			}
+
+			assert.Equal(t, ids, tc.Want)
+		})
+	}
+}
+
+func TestIsFree(t *testing.T) {
+	c := ctx.New(sl.Default())
+
+	tests := []struct {
+		TestName string
+		Entity   *entity.BookingEntity
+		Bookings [][2]time.Duration
+		IsFree   bool
+	}{
+		{
+			TestName: "Empty room",
+			Entity:   room("room", "floor", 4),
+			IsFree:   true,
+		},
+		{
+			TestName: "Room booked in one slot",
+			Entity:   room("room", "floor", 4),
+			Bookings: [][2]time.Duration{{630 * time.Minute, 645 * time.Minute}},
+			IsFree:   false,
+		},
+		{
+			TestName: "Room booked right before",
+			Entity:   room("room", "floor", 4),
+			Bookings: [][2]time.Duration{{540 * time.Minute, 600 * time.Minute}},
+			IsFree:   true,
+		},
+		{
+			TestName: "Open space with free seat",
+			Entity:   space("space", "floor", 2),
+			Bookings: [][2]time.Duration{{600 * time.Minute, 660 * time.Minute}},
+			IsFree:   true,
+		},
+		{
+			TestName: "Open space full in other slots",
+			Entity:   space("space", "floor", 2),
+			Bookings: [][2]time.Duration{
+				{600 * time.Minute, 615 * time.Minute},
+				{630 * time.Minute, 645 * time.Minute},
+				{645 * time.Minute, 660 * time.Minute},
+			},
+			IsFree: true,
+		},
+		{
+			TestName: "Open space full in one slot",
+			Entity:   space("space", "floor", 2),
+			Bookings: [][2]time.Duration{
+				{600 * time.Minute, 660 * time.Minute},
+				{630 * time.Minute, 645 * time.Minute},
+			},
+			IsFree: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.TestName, func(t *testing.T) {
+			storage := &db{
+				entities: []*entity.BookingEntity{tc.Entity},
+			}
+
+			for i, interval := range tc.Bookings {
+				storage.bookings = append(storage.bookings, booking(string(rune('a'+i)), tc.Entity.Id, interval[0], interval[1]))
+			}
+
+			free, err := storage.usecase().isFree(c, storage.begin(), tc.Entity, day.Add(10*time.Hour), day.Add(11*time.Hour))
+			if err != nil {
+				t.Fatalf("Can`t check entity: %v", err)
+			}
+
+			assert.Equal(t, free, tc.IsFree)
+		})
+	}
+}
+
+func TestRelocationReport(t *testing.T) {
+	c := ctx.New(sl.Default())
+
+	building, other := "building", "other"
+
+	tests := []struct {
+		TestName string
+		Entities []*entity.BookingEntity
+		Access   map[string]*entity.EntityAccess
+		Guests   int
+		Busy     bool
+		Status   types.RelocationStatus
+		ToEntity string
+		Reason   string
+	}{
+		{
+			TestName: "Relocated in the building",
+			Entities: []*entity.BookingEntity{room("target", "second", 2)},
+			Status:   types.RELOCATED,
+			ToEntity: "target",
+		},
+		{
+			TestName: "Free room only in other building",
+			Entities: []*entity.BookingEntity{room("target", "other", 2)},
+			Status:   types.CANCELLED,
+			Reason:   noCompatibleReason,
+		},
+		{
+			TestName: "Room is too small",
+			Entities: []*entity.BookingEntity{room("target", "second", 2)},
+			Guests:   2,
+			Status:   types.CANCELLED,
+			Reason:   noCompatibleReason,
+		},
+		{
+			TestName: "Room is restricted",
+			Entities: []*entity.BookingEntity{room("target", "second", 2)},
+			Access: map[string]*entity.EntityAccess{
+				"target": {Teams: []string{"managers"}, Users: []string{"someone"}},
+			},
+			Status: types.CANCELLED,
+			Reason: noCompatibleReason,
+		},
+		{
+			TestName: "Room is allowed for team",
+			Entities: []*entity.BookingEntity{room("target", "second", 2)},
+			Access: map[string]*entity.EntityAccess{
+				"target": {Teams: []string{"developers"}, Users: make([]string, 0)},
+			},
+			Status:   types.RELOCATED,
+			ToEntity: "target",
+		},
+		{
+			TestName: "Room is busy",
+			Entities: []*entity.BookingEntity{room("target", "second", 2)},
+			Busy:     true,
+			Status:   types.CANCELLED,
+			Reason:   allBusyReason,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.TestName, func(t *testing.T) {
+			storage := &db{
+				floors: []*entity.FloorEntity{
+					{Id: "first", BuildingId: &building},
+					{Id: "second", BuildingId: &building},
+					{Id: "other", BuildingId: &other},
+				},
+				entities: append([]*entity.BookingEntity{room("removed", "first", 4)}, tc.Entities...),
+				bookings: []*entity.Booking{booking("booking", "removed", 10*time.Hour, 11*time.Hour)},
+				access:   tc.Access,
+				teams: map[string][]*entity.Team{
+					"user": {{Id: "developers"}},
+				},
+				guests: make(map[string][]*entity.Guest),
+			}
+
+			for i := range tc.Guests {
+				storage.guests["booking"] = append(storage.guests["booking"], &entity.Guest{UserId: string(rune('a' + i))})
+			}
+
+			if tc.Busy {
+				storage.bookings = append(storage.bookings, booking("busy", "target", 10*time.Hour, 11*time.Hour))
+			}
+
+			report, err := storage.usecase().DeleteFloor(c, "first", nil)
+			if err != nil {
+				t.Fatalf("Can`t delete floor: %v", err)
+			}
+
+			if report.Id == "" || len(report.Relocations) != 1 {
+				t.Fatalf("Can`t find booking in report")
+			}
+
+			relocation := report.Relocations[0]
+
+			assert.Equal(t, relocation.BookingId, "booking")
+			assert.Equal(t, relocation.ReportId, report.Id)
+			assert.Equal(t, relocation.Status, tc.Status)
+			assert.Equal(t, relocation.Reason, tc.Reason)
+			assert.Equal(t, storage.relocations, report.Relocations)
+
+			stored := storage.booking("booking")
+
+			if tc.Status == types.RELOCATED {
+				assert.Equal(t, *relocation.ToEntityId, tc.ToEntity)
+				assert.Equal(t, stored.EntityId, tc.ToEntity)
+			} else {
+				assert.Equal(t, relocation.ToEntityId, nil)
+				assert.Equal(t, stored, nil)
+			}
+		})
+	}
+}
+
+func TestRelocationRollback(t *testing.T) {
+	c := ctx.New(sl.Default())
+
+	building := "building"
+
+	storage := &db{
+		floors: []*entity.FloorEntity{
+			{Id: "first", BuildingId: &building},
+			{Id: "second", BuildingId: &building},
+		},
+		entities: []*entity.BookingEntity{
+			room("removed", "first", 4),
+			room("target", "second", 4),
+		},
+		bookings: []*entity.Booking{
+			booking("relocated", "removed", 10*time.Hour, 11*time.Hour),
+			booking("cancelled", "removed", 10*time.Hour, 11*time.Hour),
+		},
+		deleteErr: e.InternalErr,
+	}
+
+	_, err := storage.usecase().DeleteFloor(c, "first", nil)
+	if err == nil {
+		t.Fatalf("Can`t fail on delete")
+	}
+
+	assert.Equal(t, storage.booking("relocated").EntityId, "removed")
+	assert.Equal(t, storage.booking("cancelled").EntityId, "removed")
+	assert.Equal(t, len(storage.relocations), 0)
+}
+
+func room(id string, floorId string, capacity int) *entity.BookingEntity {
+	return &entity.BookingEntity{Id: id, Type: types.ROOM, FloorId: floorId, Capacity: capacity}
+}
+
+func space(id string, floorId string, capacity int) *entity.BookingEntity {
+	return &entity.BookingEntity{Id: id, Type: types.OPENSPACE, FloorId: floorId, Capacity: capacity}
+}
+
+func booking(id string, entityId string, from, to time.Duration) *entity.Booking {
+	return &entity.Booking{Id: id, EntityId: entityId, UserId: "user", TimeFrom: day.Add(from), TimeTo: day.Add(to)}
+}
+
+// db keeps layout and bookings in memory. Bookings and relocations are
+// changed in tx and stored only on commit.
+type db struct {
+	floors      []*entity.FloorEntity
+	entities    []*entity.BookingEntity
+	access      map[string]*entity.EntityAccess
+	teams       map[string][]*entity.Team
+	guests      map[string][]*entity.Guest
+	bookings    []*entity.Booking
+	relocations []*entity.Relocation
+	deleteErr   e.Error
+}
+
+func (d *db) usecase() *BookingEntity {
+	return New(
+		&entityStorage{d}, &bookingStorage{d}, &guestStorage{d},
+		&relocationStorage{d}, nil, nil, &accessStorage{d}, &userClient{d},
+	)
+}
+
+func (d *db) begin() *tx {
+	bookings := make([]*entity.Booking, 0, len(d.bookings))
+
+	for _, booking := range d.bookings {
+		copied := *booking
+		bookings = append(bookings, &copied)
+	}
+
+	return &tx{db: d, bookings: bookings, relocations: slices.Clone(d.relocations)}
+}
+
+func (d *db) entity(id string) *entity.BookingEntity {
+	for _, ent := range d.entities {
+		if ent.Id == id {
+			return ent
+		}
+	}
+
+	return nil
+}
+
+func (d *db) booking(id string) *entity.Booking {
+	for _, booking := range d.bookings {
+		if booking.Id == id {
+			return booking
+		}
+	}
+
+	return nil
+}
+
+type tx struct {
+	pg.Tx
+	db          *db
+	bookings    []*entity.Booking
+	relocations []*entity.Relocation
+}
+
+func (t *tx) Commit(c context.Context) error {
+	t.db.bookings = t.bookings
+	t.db.relocations = t.relocations
+
+	return nil
+}
+
+func (t *tx) Rollback(c context.Context) error {
+	return nil
+}
+
+type entityStorage struct {
+	*db
+}
+
+func (s *entityStorage) UpdateEntity(c ctx.Context, ent *entity.BookingEntity) e.Error {
+	return nil
+}
+
+func (s *entityStorage) GetFloor(c ctx.Context, id string) (*entity.FloorEntity, e.Error) {
+	for _, floor := range s.floors {
+		if floor.Id == id {
+			return floor, nil
+		}
+	}
+
+	return nil, e.New("Floor wasn`t found.", e.NotFound)
+}
+
+func (s *entityStorage) GetEntities(c ctx.Context, id string) ([]*entity.BookingEntity, e.Error) {
+	entities := make([]*entity.BookingEntity, 0)
+
+	for _, ent := range s.entities {
+		if ent.FloorId == id {
+			entities = append(entities, ent)
+		}
+	}
+
+	return entities, nil
+}
+
+func (s *entityStorage) GetFloors(c ctx.Context, buildingId string) ([]*entity.FloorEntity, e.Error) {
+	floors := make([]*entity.FloorEntity, 0)
+
+	for _, floor := range s.floors {
+		if floor.BuildingId != nil && *floor.BuildingId == buildingId {
+			floors = append(floors, floor)
+		}
+	}
+
+	return floors, nil
+}
+
+func (s *entityStorage) CreateEntity(c ctx.Context, entity *entity.BookingEntity) e.Error {
+	return nil
+}
+
+func (s *entityStorage) DeleteEntity(c ctx.Context, tx pg.Tx, id string) e.Error {
+	return s.deleteErr
+}
+
+func (s *entityStorage) CreateFloor(c ctx.Context, floor *entity.FloorEntity) e.Error {
+	return nil
+}
+
+func (s *entityStorage) UpdateFloor(c ctx.Context, floor *entity.FloorEntity) e.Error {
+	return nil
+}
+
+func (s *entityStorage) DeleteFloor(c ctx.Context, tx pg.Tx, id string) e.Error {
+	return s.deleteErr
+}
+
+func (s *entityStorage) GetEntity(c ctx.Context, id string) (*entity.BookingEntity, e.Error) {
+	if ent := s.entity(id); ent != nil {
+		return ent, nil
+	}
+
+	return nil, e.New("Entity wasn`t found.", e.NotFound)
+}
+
+func (s *entityStorage) GetEntitiesByType(c ctx.Context, typ types.BookingType) ([]*entity.BookingEntity, e.Error) {
+	entities := make([]*entity.BookingEntity, 0)
+
+	for _, ent := range s.entities {
+		if ent.Type == typ {
+			entities = append(entities, ent)
+		}
+	}
+
+	return entities, nil
+}
+
+func (s *entityStorage) Begin(c ctx.Context) (pg.Tx, e.Error) {
+	return s.begin(), nil
+}
+
+type bookingStorage struct {
+	*db
+}
+
+func (s *bookingStorage) GetFuture(c ctx.Context, tx pg.Tx, entityId string) ([]*entity.Booking, e.Error) {
+	bookings := make([]*entity.Booking, 0)
+
+	for _, booking := range s.inTx(tx) {
+		if booking.EntityId == entityId {
+			bookings = append(bookings, booking)
+		}
+	}
+
+	return bookings, nil
+}
+
+func (s *bookingStorage) GetIntersected(c ctx.Context, tx pg.Tx, entityId string, from, to time.Time) ([]*entity.Booking, e.Error) {
+	bookings := make([]*entity.Booking, 0)
+
+	for _, booking := range s.inTx(tx) {
+		if booking.EntityId == entityId && booking.TimeFrom.Before(to) && booking.TimeTo.After(from) {
+			bookings = append(bookings, booking)
+		}
+	}
+
+	return bookings, nil
+}
+
+func (s *bookingStorage) ChangeEntity(c ctx.Context, tx pg.Tx, id string, entityId string) e.Error {
+	for _, booking := range s.inTx(tx) {
+		if booking.Id == id {
+			booking.EntityId = entityId
+		}
+	}
+
+	return nil
+}
+
+func (s *bookingStorage) Delete(c ctx.Context, t pg.Tx, id string) e.Error {
+	tx := t.(*tx)
+
+	tx.bookings = slices.DeleteFunc(tx.bookings, func(booking *entity.Booking) bool {
+		return booking.Id == id
+	})
+
+	return nil
+}
+
+func (s *bookingStorage) inTx(t pg.Tx) []*entity.Booking {
+	return t.(*tx).bookings
+}
+
+type guestStorage struct {
+	*db
+}
+
+func (s *guestStorage) Get(c ctx.Context, id string) ([]*entity.Guest, e.Error) {
+	return s.guests[id], nil
+}
+
+type relocationStorage struct {
+	*db
+}
+
+func (s *relocationStorage) Create(c ctx.Context, t pg.Tx, relocation *entity.Relocation) e.Error {
+	tx := t.(*tx)
+	tx.relocations = append(tx.relocations, relocation)
+
+	return nil
+}
+
+func (s *relocationStorage) GetByReport(c ctx.Context, id string) ([]*entity.Relocation, e.Error) {
+	return s.relocations, nil
+}
+
+type accessStorage struct {
+	*db
+}
+
+func (s *accessStorage) GetForEntities(c ctx.Context, ids []string) (map[string]*entity.EntityAccess, e.Error) {
+	return s.access, nil
+}
+
+func (s *accessStorage) SetForEntity(c ctx.Context, entityId string, access *entity.EntityAccess) e.Error {
+	return nil
+}
+
+type userClient struct {
+	*db
+}
+
+func (u *userClient) GetUserById(c ctx.Context, id string) (*entity.User, e.Error) {
+	return &entity.User{Id: id}, nil
+}
+
+func (u *userClient) GetUserTeams(c ctx.Context, id string) ([]*entity.Team, e.Error) {
+	return u.teams[id], nil
+}
@@ -1,20 +1,62 @@
 package booking_entity
 
 import (
+	"time"
+
+	"github.com/nikitaSstepanov/tools/client/pg"
 	"github.com/nikitaSstepanov/tools/ctx"
 	e "github.com/nikitaSstepanov/tools/error"
 	"REDACTED/team-11/backend/admin/internal/entity"
+	types "REDACTED/team-11/backend/admin/internal/entity/type"
 )
 
 type EntityStorage interface {
 	UpdateEntity(c ctx.Context, ent *entity.BookingEntity) e.Error
 	GetFloor(c ctx.Context, id string) (*entity.FloorEntity, e.Error)
 	GetEntities(c ctx.Context, id string) ([]*entity.BookingEntity, e.Error)
-	GetFloors(c ctx.Context) ([]*entity.FloorEntity, e.Error)
+	GetFloors(c ctx.Context, buildingId string) ([]*entity.FloorEntity, e.Error)
 	CreateEntity(c ctx.Context, entity *entity.BookingEntity) e.Error
-	DeleteEntity(c ctx.Context, id string) e.Error
+	DeleteEntity(c ctx.Context, tx pg.Tx, id string) e.Error
 	CreateFloor(c ctx.Context, floor *entity.FloorEntity) e.Error
 	UpdateFloor(c ctx.Context, floor *entity.FloorEntity) e.Error
-	DeleteFloor(c ctx.Context, id string) e.Error
+	DeleteFloor(c ctx.Context, tx pg.Tx, id string) e.Error
 	GetEntity(c ctx.Context, id string) (*entity.BookingEntity, e.Error)
+	GetEntitiesByType(c ctx.Context, typ types.BookingType) ([]*entity.BookingEntity, e.Error)
+	Begin(c ctx.Context) (pg.Tx, e.Error)
+}
+
+type BookingStorage interface {
+	GetFuture(c ctx.Context, tx pg.Tx, entityId string) ([]*entity.Booking, e.Error)
+	GetIntersected(c ctx.Context, tx pg.Tx, entityId string, from, to time.Time) ([]*entity.Booking, e.Error)
+	ChangeEntity(c ctx.Context, tx pg.Tx, id string, entityId string) e.Error
+	Delete(c ctx.Context, tx pg.Tx, id string) e.Error
+}
+
+type GuestStorage interface {
+	Get(c ctx.Context, id string) ([]*entity.Guest, e.Error)
+}
+
+type RelocationStorage interface {
+	Create(c ctx.Context, tx pg.Tx, relocation *entity.Relocation) e.Error
+	GetByReport(c ctx.Context, id string) ([]*entity.Relocation, e.Error)
+}
+
+type BuildingStorage interface {
+	Get(c ctx.Context, id string) (*entity.Building, e.Error)
+}
+
+type AmenityStorage interface {
+	Count(c ctx.Context, ids []string) (int, e.Error)
+	GetForEntities(c ctx.Context, ids []string) (map[string][]string, e.Error)
+	SetForEntity(c ctx.Context, entityId string, ids []string) e.Error
+}
+
+type AccessStorage interface {
+	GetForEntities(c ctx.Context, ids []string) (map[string]*entity.EntityAccess, e.Error)
+	SetForEntity(c ctx.Context, entityId string, access *entity.EntityAccess) e.Error
+}
+
+type UserClient interface {
+	GetUserById(c ctx.Context, id string) (*entity.User, e.Error)
+	GetUserTeams(c ctx.Context, id string) ([]*entity.Team, e.Error)
 }
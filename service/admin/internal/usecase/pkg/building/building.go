@@ -0,0 +1,116 @@
+package building
+
+import (
+	"time"
+
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"REDACTED/team-11/backend/admin/internal/entity"
+)
+
+var (
+	timezoneErr = e.New("Unknown time zone.", e.BadInput)
+	hoursErr    = e.New("Opening hours must be in HH:MM format and opens_at must be before closes_at.", e.BadInput)
+	hasFloors   = e.New("Building has floors.", e.Conflict)
+)
+
+type Building struct {
+	building BuildingStorage
+}
+
+func New(building BuildingStorage) *Building {
+	return &Building{
+		building: building,
+	}
+}
+
+func (b *Building) Get(c ctx.Context, id string) (*entity.Building, e.Error) {
+	return b.building.Get(c, id)
+}
+
+func (b *Building) GetAll(c ctx.Context) ([]*entity.Building, e.Error) {
+	return b.building.GetAll(c)
+}
+
+func (b *Building) Create(c ctx.Context, building *entity.Building) e.Error {
+	if err := validate(building); err != nil {
+		return err
+	}
+
+	return b.building.Create(c, building)
+}
+
+func (b *Building) Update(c ctx.Context, building *entity.Building) e.Error {
+	old, err := b.building.Get(c, building.Id)
+	if err != nil {
+		return err
+	}
+
+	building.CreatedAt = old.CreatedAt
+
+	if err := validate(building); err != nil {
+		return err
+	}
+
+	return b.building.Update(c, building)
+}
+
+func (b *Building) Delete(c ctx.Context, id string) e.Error {
+	if _, err := b.building.Get(c, id); err != nil {
+		return err
+	}
+
+	count, err := b.building.CountFloors(c, id)
+	if err != nil {
+		return err
+	}
+
+	if count != 0 {
+		return hasFloors.WithCtx(c)
+	}
+
+	return b.building.Delete(c, id)
+}
+
+func validate(building *entity.Building) e.Error {
+	if building.Timezone == "" {
+		building.Timezone = time.UTC.String()
+	}
+
+	if _, err := time.LoadLocation(building.Timezone); err != nil {
+		return timezoneErr.WithErr(err)
+	}
+
+	if building.OpensAt == nil && building.ClosesAt == nil {
+		return nil
+	}
+
+	if building.OpensAt == nil || building.ClosesAt == nil {
+		return hoursErr
+	}
+
+	opens, ok := parseClock(*building.OpensAt)
+	if !ok {
+		return hoursErr
+	}
+
+	closes, ok := parseClock(*building.ClosesAt)
+	if !ok || opens >= closes {
+		return hoursErr
+	}
+
+	return nil
+}
+
+func parseClock(clock string) (time.Duration, bool) {
+	if clock == "24:00" {
+		return 24 * time.Hour, true
+	}
+
+	t, err := time.Parse("15:04", clock)
+	if err != nil || len(clock) != len("15:04") {
+		return 0, false
+	}
+
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, true
+}
@@ -0,0 +1,116 @@
+package building
+
+import (
+	"testing"
+
+	"github.com/go-playground/assert/v2"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/nikitaSstepanov/tools/sl"
+	"REDACTED/team-11/backend/admin/internal/entity"
+)
+
+func TestValidateHours(t *testing.T) {
+	c := ctx.New(sl.Default())
+
+	usecase := New(&buildingStorage{
+		stored: &entity.Building{Id: "building"},
+	})
+
+	tests := []struct {
+		TestName string
+		OpensAt  *string
+		ClosesAt *string
+		IsError  bool
+	}{
+		{
+			TestName: "Around the clock",
+			IsError:  false,
+		},
+		{
+			TestName: "Success",
+			OpensAt:  clock("09:00"),
+			ClosesAt: clock("24:00"),
+			IsError:  false,
+		},
+		{
+			TestName: "Single digit hour",
+			OpensAt:  clock("9:00"),
+			ClosesAt: clock("18:00"),
+			IsError:  true,
+		},
+		{
+			TestName: "Not a time",
+			OpensAt:  clock("09:00"),
+			ClosesAt: clock("evening"),
+			IsError:  true,
+		},
+		{
+			TestName: "Only opens_at",
+			OpensAt:  clock("09:00"),
+			IsError:  true,
+		},
+		{
+			TestName: "Closes before opens",
+			OpensAt:  clock("18:00"),
+			ClosesAt: clock("09:00"),
+			IsError:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.TestName, func(t *testing.T) {
+			for name, save := range map[string]func(ctx.Context, *entity.Building) e.Error{
+				"create": usecase.Create,
+				"update": usecase.Update,
+			} {
+				err := save(c, &entity.Building{
+					Id:       "building",
+					OpensAt:  tc.OpensAt,
+					ClosesAt: tc.ClosesAt,
+				})
+
+				if tc.IsError {
+					if err == nil {
+						t.Fatalf("Can`t reject hours on %s", name)
+					}
+					assert.Equal(t, err.GetCode(), e.BadInput)
+				} else if err != nil {
+					t.Fatalf("Can`t %s building: %v", name, err)
+				}
+			}
+		})
+	}
+}
+
+func clock(value string) *string {
+	return &value
+}
+
+type buildingStorage struct {
+	stored *entity.Building
+}
+
+func (b *buildingStorage) Get(c ctx.Context, id string) (*entity.Building, e.Error) {
+	return b.stored, nil
+}
+
+func (b *buildingStorage) GetAll(c ctx.Context) ([]*entity.Building, e.Error) {
+	return []*entity.Building{b.stored}, nil
+}
+
+func (b *buildingStorage) CountFloors(c ctx.Context, id string) (int, e.Error) {
+	return 0, nil
+}
+
+func (b *buildingStorage) Create(c ctx.Context, building *entity.Building) e.Error {
+	return nil
+}
+
+func (b *buildingStorage) Update(c ctx.Context, building *entity.Building) e.Error {
+	return nil
+}
+
+func (b *buildingStorage) Delete(c ctx.Context, id string) e.Error {
+	return nil
+}
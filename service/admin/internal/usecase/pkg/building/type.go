@@ -0,0 +1,16 @@
+package building
+
+import (
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"REDACTED/team-11/backend/admin/internal/entity"
+)
+
+type BuildingStorage interface {
+	Get(c ctx.Context, id string) (*entity.Building, e.Error)
+	GetAll(c ctx.Context) ([]*entity.Building, e.Error)
+	CountFloors(c ctx.Context, id string) (int, e.Error)
+	Create(c ctx.Context, building *entity.Building) e.Error
+	Update(c ctx.Context, building *entity.Building) e.Error
+	Delete(c ctx.Context, id string) e.Error
+}
@@ -0,0 +1,113 @@
+package access
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/nikitaSstepanov/tools/client/pg"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"REDACTED/team-11/backend/admin/internal/entity"
+)
+
+type Access struct {
+	postgres pg.Client
+}
+
+func New(postgres pg.Client) *Access {
+	return &Access{
+		postgres: postgres,
+	}
+}
+
+func (a *Access) GetForEntities(c ctx.Context, ids []string) (map[string]*entity.EntityAccess, e.Error) {
+	query, args, _ := sq.Select("entity_id", "team_id", "user_id").From(accessTable).
+		Where(sq.Eq{"entity_id": ids}).PlaceholderFormat(sq.Dollar).ToSql()
+
+	rows, err := a.postgres.Query(c, query, args...)
+	if err != nil {
+		return nil, e.InternalErr.
+			WithErr(err).
+			WithCtx(c)
+	}
+	defer rows.Close()
+
+	result := make(map[string]*entity.EntityAccess)
+
+	for rows.Next() {
+		var (
+			entityId       string
+			teamId, userId *string
+		)
+
+		if err := rows.Scan(&entityId, &teamId, &userId); err != nil {
+			return nil, e.InternalErr.
+				WithErr(err).
+				WithCtx(c)
+		}
+
+		access, ok := result[entityId]
+		if !ok {
+			access = &entity.EntityAccess{
+				Teams: make([]string, 0),
+				Users: make([]string, 0),
+			}
+			result[entityId] = access
+		}
+
+		if teamId != nil {
+			access.Teams = append(access.Teams, *teamId)
+		}
+
+		if userId != nil {
+			access.Users = append(access.Users, *userId)
+		}
+	}
+
+	return result, nil
+}
+
+func (a *Access) SetForEntity(c ctx.Context, entityId string, access *entity.EntityAccess) e.Error {
+	del, delArgs, _ := sq.Delete(accessTable).
+		Where(sq.Eq{"entity_id": entityId}).PlaceholderFormat(sq.Dollar).ToSql()
+
+	tx, err := a.postgres.Begin(c)
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(c)
+	}
+	defer tx.Rollback(c)
+
+	if _, err := tx.Exec(c, del, delArgs...); err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(c)
+	}
+
+	if !access.IsEmpty() {
+		builder := sq.Insert(accessTable).Columns("entity_id", "team_id", "user_id")
+
+		for _, id := range access.Teams {
+			builder = builder.Values(entityId, id, nil)
+		}
+
+		for _, id := range access.Users {
+			builder = builder.Values(entityId, nil, id)
+		}
+
+		query, args, _ := builder.PlaceholderFormat(sq.Dollar).ToSql()
+
+		if _, err := tx.Exec(c, query, args...); err != nil {
+			return e.InternalErr.
+				WithErr(err).
+				WithCtx(c)
+		}
+	}
+
+	if err := tx.Commit(c); err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(c)
+	}
+
+	return nil
+}
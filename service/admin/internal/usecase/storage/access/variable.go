@@ -0,0 +1,5 @@
+package access
+
+const (
+	accessTable = "booking_entity_access"
+)
@@ -0,0 +1,217 @@
+package amenity
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/nikitaSstepanov/tools/client/pg"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"REDACTED/team-11/backend/admin/internal/entity"
+)
+
+type Amenity struct {
+	postgres pg.Client
+}
+
+func New(postgres pg.Client) *Amenity {
+	return &Amenity{
+		postgres: postgres,
+	}
+}
+
+func (a *Amenity) Get(c ctx.Context, id string) (*entity.Amenity, e.Error) {
+	query, args, _ := sq.Select("*").From(amenityTable).
+		Where(sq.Eq{"id": id}).PlaceholderFormat(sq.Dollar).ToSql()
+
+	return a.getOne(c, query, args...)
+}
+
+func (a *Amenity) GetByName(c ctx.Context, name string) (*entity.Amenity, e.Error) {
+	query, args, _ := sq.Select("*").From(amenityTable).
+		Where(sq.Eq{"name": name}).PlaceholderFormat(sq.Dollar).ToSql()
+
+	return a.getOne(c, query, args...)
+}
+
+func (a *Amenity) GetAll(c ctx.Context) ([]*entity.Amenity, e.Error) {
+	query, args, _ := sq.Select("*").From(amenityTable).
+		OrderBy("name").PlaceholderFormat(sq.Dollar).ToSql()
+
+	rows, err := a.postgres.Query(c, query, args...)
+	if err != nil {
+		return nil, e.InternalErr.
+			WithErr(err).
+			WithCtx(c)
+	}
+
+	amenities := make([]*entity.Amenity, 0)
+
+	for rows.Next() {
+		var amenity entity.Amenity
+
+		if err := amenity.Scan(rows); err != nil {
+			return nil, e.InternalErr.
+				WithErr(err).
+				WithCtx(c)
+		}
+
+		amenities = append(amenities, &amenity)
+	}
+
+	return amenities, nil
+}
+
+func (a *Amenity) Count(c ctx.Context, ids []string) (int, e.Error) {
+	query, args, _ := sq.Select("COUNT(*)").From(amenityTable).
+		Where(sq.Eq{"id": ids}).PlaceholderFormat(sq.Dollar).ToSql()
+
+	var count int
+
+	if err := a.postgres.QueryRow(c, query, args...).Scan(&count); err != nil {
+		return 0, e.InternalErr.
+			WithErr(err).
+			WithCtx(c)
+	}
+
+	return count, nil
+}
+
+// GetForEntities returns amenity ids of every entity.
+func (a *Amenity) GetForEntities(c ctx.Context, ids []string) (map[string][]string, e.Error) {
+	query, args, _ := sq.Select("entity_id", "amenity_id").From(entityAmenityTable).
+		Where(sq.Eq{"entity_id": ids}).PlaceholderFormat(sq.Dollar).ToSql()
+
+	rows, err := a.postgres.Query(c, query, args...)
+	if err != nil {
+		return nil, e.InternalErr.
+			WithErr(err).
+			WithCtx(c)
+	}
+
+	result := make(map[string][]string)
+
+	for rows.Next() {
+		var entityId, amenityId string
+
+		if err := rows.Scan(&entityId, &amenityId); err != nil {
+			return nil, e.InternalErr.
+				WithErr(err).
+				WithCtx(c)
+		}
+
+		result[entityId] = append(result[entityId], amenityId)
+	}
+
+	return result, nil
+}
+
+func (a *Amenity) SetForEntity(c ctx.Context, entityId string, ids []string) e.Error {
+	del, delArgs, _ := sq.Delete(entityAmenityTable).
+		Where(sq.Eq{"entity_id": entityId}).PlaceholderFormat(sq.Dollar).ToSql()
+
+	tx, err := a.postgres.Begin(c)
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(c)
+	}
+	defer tx.Rollback(c)
+
+	if _, err := tx.Exec(c, del, delArgs...); err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(c)
+	}
+
+	if len(ids) != 0 {
+		builder := sq.Insert(entityAmenityTable).Columns("entity_id", "amenity_id")
+
+		for _, id := range ids {
+			builder = builder.Values(entityId, id)
+		}
+
+		query, args, _ := builder.Suffix("ON CONFLICT DO NOTHING").
+			PlaceholderFormat(sq.Dollar).ToSql()
+
+		if _, err := tx.Exec(c, query, args...); err != nil {
+			return e.InternalErr.
+				WithErr(err).
+				WithCtx(c)
+		}
+	}
+
+	if err := tx.Commit(c); err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(c)
+	}
+
+	return nil
+}
+
+func (a *Amenity) Create(c ctx.Context, amenity *entity.Amenity) e.Error {
+	query, args, _ := sq.Insert(amenityTable).
+		Columns("id", "name", "created_at", "updated_at").
+		Values(amenity.Id, amenity.Name, amenity.CreatedAt, amenity.UpdatedAt).
+		PlaceholderFormat(sq.Dollar).ToSql()
+
+	return a.exec(c, query, args...)
+}
+
+func (a *Amenity) Update(c ctx.Context, amenity *entity.Amenity) e.Error {
+	query, args, _ := sq.Update(amenityTable).
+		Set("name", amenity.Name).Set("updated_at", amenity.UpdatedAt).
+		Where(sq.Eq{"id": amenity.Id}).PlaceholderFormat(sq.Dollar).ToSql()
+
+	return a.exec(c, query, args...)
+}
+
+func (a *Amenity) Delete(c ctx.Context, id string) e.Error {
+	query, args, _ := sq.Delete(amenityTable).
+		Where(sq.Eq{"id": id}).PlaceholderFormat(sq.Dollar).ToSql()
+
+	return a.exec(c, query, args...)
+}
+
+func (a *Amenity) getOne(c ctx.Context, query string, args ...interface{}) (*entity.Amenity, e.Error) {
+	row := a.postgres.QueryRow(c, query, args...)
+
+	var amenity entity.Amenity
+
+	if err := amenity.Scan(row); err != nil {
+		if err == pg.ErrNoRows {
+			return nil, e.New("Amenity not found.", e.NotFound).
+				WithErr(err).
+				WithCtx(c)
+		} else {
+			return nil, e.InternalErr.
+				WithErr(err).
+				WithCtx(c)
+		}
+	}
+
+	return &amenity, nil
+}
+
+func (a *Amenity) exec(c ctx.Context, query string, args ...interface{}) e.Error {
+	tx, err := a.postgres.Begin(c)
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(c)
+	}
+	defer tx.Rollback(c)
+
+	if _, err := tx.Exec(c, query, args...); err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(c)
+	}
+
+	if err := tx.Commit(c); err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(c)
+	}
+
+	return nil
+}
@@ -0,0 +1,6 @@
+package amenity
+
+const (
+	amenityTable       = "amenity"
+	entityAmenityTable = "booking_entity_amenity"
+)
@@ -170,6 +170,9 @@ func (b *Booking) GetNearestGuest(c ctx.Context, id string) (*entity.Booking, e.
 			&booking.TimeTo,
 			&booking.CreatedAt,
 			&booking.UpdatedAt,
+			&booking.BookedBy,
+			&booking.GroupId,
+			&booking.Version,
 			&guest.UserId,
 			&guest.BookingId,
 			&guest.CreatedAt,
@@ -254,3 +257,82 @@ func (b *Booking) GetStats(c ctx.Context, filter string) (int, e.Error) {
 
 	return count, nil
 }
+
+// GetFuture returns bookings of entity, which are not finished yet, and
+// locks them until tx ends, so they can`t be changed while relocated.
+func (b *Booking) GetFuture(c ctx.Context, tx pg.Tx, entityId string) ([]*entity.Booking, e.Error) {
+	query, args, _ := sq.Select("*").From(bookingTable).
+		Where(sq.And{
+			sq.Eq{"entity_id": entityId},
+			sq.Gt{"time_to": time.Now().UTC()},
+		}).
+		OrderBy("time_from").Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar).ToSql()
+
+	return b.getMany(c, tx, query, args...)
+}
+
+func (b *Booking) GetIntersected(c ctx.Context, tx pg.Tx, entityId string, from, to time.Time) ([]*entity.Booking, e.Error) {
+	query, args, _ := sq.Select("*").From(bookingTable).
+		Where(sq.And{
+			sq.Eq{"entity_id": entityId},
+			sq.Lt{"time_from": to},
+			sq.Gt{"time_to": from},
+		}).
+		PlaceholderFormat(sq.Dollar).ToSql()
+
+	return b.getMany(c, tx, query, args...)
+}
+
+func (b *Booking) ChangeEntity(c ctx.Context, tx pg.Tx, id string, entityId string) e.Error {
+	query, args, _ := sq.Update(bookingTable).
+		Set("entity_id", entityId).
+		Where(sq.Eq{"id": id}).PlaceholderFormat(sq.Dollar).ToSql()
+
+	if _, err := tx.Exec(c, query, args...); err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(c)
+	}
+
+	return nil
+}
+
+func (b *Booking) Delete(c ctx.Context, tx pg.Tx, id string) e.Error {
+	query, args, _ := sq.Delete(bookingTable).
+		Where(sq.Eq{"id": id}).PlaceholderFormat(sq.Dollar).ToSql()
+
+	if _, err := tx.Exec(c, query, args...); err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(c)
+	}
+
+	return nil
+}
+
+func (b *Booking) getMany(c ctx.Context, tx pg.Tx, query string, args ...interface{}) ([]*entity.Booking, e.Error) {
+	rows, err := tx.Query(c, query, args...)
+	if err != nil {
+		return nil, e.InternalErr.
+			WithErr(err).
+			WithCtx(c)
+	}
+	defer rows.Close()
+
+	bookings := make([]*entity.Booking, 0)
+
+	for rows.Next() {
+		var booking entity.Booking
+
+		if err := booking.Scan(rows); err != nil {
+			return nil, e.InternalErr.
+				WithErr(err).
+				WithCtx(c)
+		}
+
+		bookings = append(bookings, &booking)
+	}
+
+	return bookings, nil
+}
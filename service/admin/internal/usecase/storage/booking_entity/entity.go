@@ -6,6 +6,7 @@ import (
 	"github.com/nikitaSstepanov/tools/ctx"
 	e "github.com/nikitaSstepanov/tools/error"
 	"REDACTED/team-11/backend/admin/internal/entity"
+	types "REDACTED/team-11/backend/admin/internal/entity/type"
 )
 
 type BookingEntity struct {
@@ -41,8 +42,14 @@ func (b *BookingEntity) GetEntity(c ctx.Context, id string) (*entity.BookingEnti
 	return &booking, nil
 }
 
-func (b *BookingEntity) GetFloors(c ctx.Context) ([]*entity.FloorEntity, e.Error) {
-	query, args, _ := sq.Select("*").From(floorTable).ToSql()
+func (b *BookingEntity) GetFloors(c ctx.Context, buildingId string) ([]*entity.FloorEntity, e.Error) {
+	builder := sq.Select("*").From(floorTable)
+
+	if buildingId != "" {
+		builder = builder.Where(sq.Eq{"building_id": buildingId})
+	}
+
+	query, args, _ := builder.PlaceholderFormat(sq.Dollar).ToSql()
 
 	rows, err := b.postgres.Query(c, query, args...)
 	if err != nil {
@@ -100,10 +107,10 @@ func (b *BookingEntity) GetFloor(c ctx.Context, id string) (*entity.FloorEntity,
 func (b *BookingEntity) CreateFloor(c ctx.Context, floor *entity.FloorEntity) e.Error {
 	query, args, _ := sq.Insert(floorTable).
 		Columns(
-			"id", "name", "created_at", "updated_at",
+			"id", "name", "building_id", "created_at", "updated_at", "version",
 		).
 		Values(
-			floor.Id, floor.Name, floor.CreatedAt, floor.UpdatedAt,
+			floor.Id, floor.Name, floor.BuildingId, floor.CreatedAt, floor.UpdatedAt, floor.Version,
 		).PlaceholderFormat(sq.Dollar).ToSql()
 
 	tx, err := b.postgres.Begin(c)
@@ -129,10 +136,14 @@ func (b *BookingEntity) CreateFloor(c ctx.Context, floor *entity.FloorEntity) e.
 	return nil
 }
 
+// UpdateFloor updates floor only if it still has floor.Version, on
+// success floor.Version is set to the new version.
 func (b *BookingEntity) UpdateFloor(c ctx.Context, floor *entity.FloorEntity) e.Error {
 	query, args, _ := sq.Update(floorTable).
-		Set("name", floor.Name).Set("updated_at", floor.UpdatedAt).
-		Where(sq.Eq{"id": floor.Id}).PlaceholderFormat(sq.Dollar).ToSql()
+		Set("name", floor.Name).Set("building_id", floor.BuildingId).
+		Set("updated_at", floor.UpdatedAt).Set("version", floor.Version+1).
+		Where(sq.Eq{"id": floor.Id, "version": floor.Version}).
+		PlaceholderFormat(sq.Dollar).ToSql()
 
 	tx, err := b.postgres.Begin(c)
 	if err != nil {
@@ -142,18 +153,26 @@ func (b *BookingEntity) UpdateFloor(c ctx.Context, floor *entity.FloorEntity) e.
 	}
 	defer tx.Rollback(c)
 
-	if _, err := tx.Exec(c, query, args...); err != nil {
+	tag, err := tx.Exec(c, query, args...)
+	if err != nil {
 		return e.InternalErr.
 			WithErr(err).
 			WithCtx(c)
 	}
 
+	if tag.RowsAffected() == 0 {
+		return e.New("Floor was modified.", e.Conflict).
+			WithCtx(c)
+	}
+
 	if err := tx.Commit(c); err != nil {
 		return e.InternalErr.
 			WithErr(err).
 			WithCtx(c)
 	}
 
+	floor.Version++
+
 	return nil
 }
 
@@ -161,6 +180,7 @@ func (b *BookingEntity) UpdateEntity(c ctx.Context, ent *entity.BookingEntity) e
 	query, args, _ := sq.Update(bookingTable).
 		Set("title", ent.Title).Set("x", ent.X).
 		Set("y", ent.Y).Set("width", ent.Width).Set("height", ent.Height).
+		Set("is_premium", ent.IsPremium).Set("version", ent.Version).
 		Where(sq.Eq{"id": ent.Id}).PlaceholderFormat(sq.Dollar).ToSql()
 
 	tx, err := b.postgres.Begin(c)
@@ -225,11 +245,13 @@ func (b *BookingEntity) CreateEntity(c ctx.Context, entity *entity.BookingEntity
 		Columns(
 			"id", "type", "title", "x", "y", "floor_id",
 			"width", "height", "capacity", "created_at", "updated_at",
+			"is_premium", "version",
 		).
 		Values(
 			entity.Id, entity.Type, entity.Title, entity.X,
 			entity.Y, entity.FloorId, entity.Width,
 			entity.Height, entity.Capacity, entity.CreatedAt, entity.UpdatedAt,
+			entity.IsPremium, entity.Version,
 		).PlaceholderFormat(sq.Dollar).ToSql()
 
 	tx, err := b.postgres.Begin(c)
@@ -255,17 +277,22 @@ func (b *BookingEntity) CreateEntity(c ctx.Context, entity *entity.BookingEntity
 	return nil
 }
 
-func (b *BookingEntity) DeleteEntity(c ctx.Context, id string) e.Error {
-	query, args, _ := sq.Delete(bookingTable).Where(sq.Eq{"id": id}).
-		PlaceholderFormat(sq.Dollar).ToSql()
-
+// Begin starts transaction, in which entities are removed together with
+// relocation of their bookings.
+func (b *BookingEntity) Begin(c ctx.Context) (pg.Tx, e.Error) {
 	tx, err := b.postgres.Begin(c)
 	if err != nil {
-		return e.InternalErr.
+		return nil, e.InternalErr.
 			WithErr(err).
 			WithCtx(c)
 	}
-	defer tx.Rollback(c)
+
+	return tx, nil
+}
+
+func (b *BookingEntity) DeleteEntity(c ctx.Context, tx pg.Tx, id string) e.Error {
+	query, args, _ := sq.Delete(bookingTable).Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).ToSql()
 
 	if _, err := tx.Exec(c, query, args...); err != nil {
 		return e.InternalErr.
@@ -273,38 +300,46 @@ func (b *BookingEntity) DeleteEntity(c ctx.Context, id string) e.Error {
 			WithCtx(c)
 	}
 
-	if err := tx.Commit(c); err != nil {
-		e.InternalErr.
-			WithErr(err).
-			WithCtx(c)
-	}
-
 	return nil
 }
 
-func (b *BookingEntity) DeleteFloor(c ctx.Context, id string) e.Error {
+func (b *BookingEntity) DeleteFloor(c ctx.Context, tx pg.Tx, id string) e.Error {
 	query, args, _ := sq.Delete(floorTable).
 		Where(sq.Eq{"id": id}).PlaceholderFormat(sq.Dollar).ToSql()
 
-	tx, err := b.postgres.Begin(c)
-	if err != nil {
+	if _, err := tx.Exec(c, query, args...); err != nil {
 		return e.InternalErr.
 			WithErr(err).
 			WithCtx(c)
 	}
-	defer tx.Rollback(c)
 
-	if _, err := tx.Exec(c, query, args...); err != nil {
-		return e.InternalErr.
+	return nil
+}
+
+func (b *BookingEntity) GetEntitiesByType(c ctx.Context, typ types.BookingType) ([]*entity.BookingEntity, e.Error) {
+	query, args, _ := sq.Select("*").From(bookingTable).
+		Where(sq.Eq{"type": typ}).PlaceholderFormat(sq.Dollar).ToSql()
+
+	rows, err := b.postgres.Query(c, query, args...)
+	if err != nil {
+		return nil, e.InternalErr.
 			WithErr(err).
 			WithCtx(c)
 	}
 
-	if err := tx.Commit(c); err != nil {
-		e.InternalErr.
-			WithErr(err).
-			WithCtx(c)
+	entities := make([]*entity.BookingEntity, 0)
+
+	for rows.Next() {
+		var entity entity.BookingEntity
+
+		if err := entity.Scan(rows); err != nil {
+			return nil, e.InternalErr.
+				WithErr(err).
+				WithCtx(c)
+		}
+
+		entities = append(entities, &entity)
 	}
 
-	return nil
+	return entities, nil
 }
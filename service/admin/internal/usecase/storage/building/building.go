@@ -0,0 +1,140 @@
+package building
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/nikitaSstepanov/tools/client/pg"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"REDACTED/team-11/backend/admin/internal/entity"
+)
+
+type Building struct {
+	postgres pg.Client
+}
+
+func New(postgres pg.Client) *Building {
+	return &Building{
+		postgres: postgres,
+	}
+}
+
+func (b *Building) Get(c ctx.Context, id string) (*entity.Building, e.Error) {
+	query, args, _ := sq.Select("*").From(buildingTable).
+		Where(sq.Eq{"id": id}).PlaceholderFormat(sq.Dollar).ToSql()
+
+	row := b.postgres.QueryRow(c, query, args...)
+
+	var building entity.Building
+
+	if err := building.Scan(row); err != nil {
+		if err == pg.ErrNoRows {
+			return nil, e.New("Building not found.", e.NotFound).
+				WithErr(err).
+				WithCtx(c)
+		} else {
+			return nil, e.InternalErr.
+				WithErr(err).
+				WithCtx(c)
+		}
+	}
+
+	return &building, nil
+}
+
+func (b *Building) GetAll(c ctx.Context) ([]*entity.Building, e.Error) {
+	query, args, _ := sq.Select("*").From(buildingTable).
+		OrderBy("name").PlaceholderFormat(sq.Dollar).ToSql()
+
+	rows, err := b.postgres.Query(c, query, args...)
+	if err != nil {
+		return nil, e.InternalErr.
+			WithErr(err).
+			WithCtx(c)
+	}
+
+	buildings := make([]*entity.Building, 0)
+
+	for rows.Next() {
+		var building entity.Building
+
+		if err := building.Scan(rows); err != nil {
+			return nil, e.InternalErr.
+				WithErr(err).
+				WithCtx(c)
+		}
+
+		buildings = append(buildings, &building)
+	}
+
+	return buildings, nil
+}
+
+func (b *Building) CountFloors(c ctx.Context, id string) (int, e.Error) {
+	query, args, _ := sq.Select("COUNT(*)").From(floorTable).
+		Where(sq.Eq{"building_id": id}).PlaceholderFormat(sq.Dollar).ToSql()
+
+	var count int
+
+	if err := b.postgres.QueryRow(c, query, args...).Scan(&count); err != nil {
+		return 0, e.InternalErr.
+			WithErr(err).
+			WithCtx(c)
+	}
+
+	return count, nil
+}
+
+func (b *Building) Create(c ctx.Context, building *entity.Building) e.Error {
+	query, args, _ := sq.Insert(buildingTable).
+		Columns(
+			"id", "name", "address", "timezone", "opens_at",
+			"closes_at", "created_at", "updated_at",
+		).
+		Values(
+			building.Id, building.Name, building.Address, building.Timezone,
+			building.OpensAt, building.ClosesAt, building.CreatedAt, building.UpdatedAt,
+		).PlaceholderFormat(sq.Dollar).ToSql()
+
+	return b.exec(c, query, args...)
+}
+
+func (b *Building) Update(c ctx.Context, building *entity.Building) e.Error {
+	query, args, _ := sq.Update(buildingTable).
+		Set("name", building.Name).Set("address", building.Address).
+		Set("timezone", building.Timezone).Set("opens_at", building.OpensAt).
+		Set("closes_at", building.ClosesAt).Set("updated_at", building.UpdatedAt).
+		Where(sq.Eq{"id": building.Id}).PlaceholderFormat(sq.Dollar).ToSql()
+
+	return b.exec(c, query, args...)
+}
+
+func (b *Building) Delete(c ctx.Context, id string) e.Error {
+	query, args, _ := sq.Delete(buildingTable).
+		Where(sq.Eq{"id": id}).PlaceholderFormat(sq.Dollar).ToSql()
+
+	return b.exec(c, query, args...)
+}
+
+func (b *Building) exec(c ctx.Context, query string, args ...interface{}) e.Error {
+	tx, err := b.postgres.Begin(c)
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(c)
+	}
+	defer tx.Rollback(c)
+
+	if _, err := tx.Exec(c, query, args...); err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(c)
+	}
+
+	if err := tx.Commit(c); err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(c)
+	}
+
+	return nil
+}
@@ -0,0 +1,6 @@
+package building
+
+const (
+	buildingTable = "building"
+	floorTable    = "entity_floor"
+)
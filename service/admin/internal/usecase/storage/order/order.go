@@ -90,6 +90,9 @@ func (o *Order) Get(c ctx.Context) ([]*entity.OrderBooking, e.Error) {
 			&booking.TimeTo,
 			&booking.CreatedAt,
 			&booking.UpdatedAt,
+			&booking.BookedBy,
+			&booking.GroupId,
+			&booking.Version,
 			&bentity.Id,
 			&bentity.Type,
 			&bentity.Title,
@@ -101,6 +104,8 @@ func (o *Order) Get(c ctx.Context) ([]*entity.OrderBooking, e.Error) {
 			&bentity.Capacity,
 			&bentity.CreatedAt,
 			&bentity.UpdatedAt,
+			&bentity.IsPremium,
+			&bentity.Version,
 		)
 
 		if err != nil {
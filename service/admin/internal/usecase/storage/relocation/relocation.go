@@ -0,0 +1,74 @@
+package relocation
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/nikitaSstepanov/tools/client/pg"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"REDACTED/team-11/backend/admin/internal/entity"
+)
+
+type Relocation struct {
+	postgres pg.Client
+}
+
+func New(postgres pg.Client) *Relocation {
+	return &Relocation{
+		postgres: postgres,
+	}
+}
+
+// Create stores decision about booking in tx, which moved or cancelled it.
+func (r *Relocation) Create(c ctx.Context, tx pg.Tx, relocation *entity.Relocation) e.Error {
+	query, args, _ := sq.Insert(relocationTable).
+		Columns(
+			"id", "report_id", "booking_id", "user_id", "from_entity_id",
+			"to_entity_id", "time_from", "time_to", "status", "reason", "created_at",
+		).
+		Values(
+			relocation.Id, relocation.ReportId, relocation.BookingId, relocation.UserId,
+			relocation.FromEntityId, relocation.ToEntityId, relocation.TimeFrom,
+			relocation.TimeTo, relocation.Status, relocation.Reason, relocation.CreatedAt,
+		).
+		PlaceholderFormat(sq.Dollar).ToSql()
+
+	if _, err := tx.Exec(c, query, args...); err != nil {
+		return e.InternalErr.WithErr(err).WithCtx(c)
+	}
+
+	return nil
+}
+
+func (r *Relocation) GetByReport(c ctx.Context, id string) ([]*entity.Relocation, e.Error) {
+	query, args, _ := sq.Select("*").From(relocationTable).
+		Where(sq.Eq{"report_id": id}).OrderBy("time_from").
+		PlaceholderFormat(sq.Dollar).ToSql()
+
+	rows, err := r.postgres.Query(c, query, args...)
+	if err != nil {
+		return nil, e.InternalErr.
+			WithErr(err).
+			WithCtx(c)
+	}
+
+	relocations := make([]*entity.Relocation, 0)
+
+	for rows.Next() {
+		var relocation entity.Relocation
+
+		if err := relocation.Scan(rows); err != nil {
+			return nil, e.InternalErr.
+				WithErr(err).
+				WithCtx(c)
+		}
+
+		relocations = append(relocations, &relocation)
+	}
+
+	if len(relocations) == 0 {
+		return nil, e.New("Relocation report not found.", e.NotFound).
+			WithCtx(c)
+	}
+
+	return relocations, nil
+}
@@ -0,0 +1,5 @@
+package relocation
+
+const (
+	relocationTable = "booking_relocation"
+)
@@ -1,26 +1,39 @@
 package storage
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/nikitaSstepanov/tools"
 	"github.com/nikitaSstepanov/tools/client/pg"
 	"github.com/nikitaSstepanov/tools/ctx"
 	"github.com/nikitaSstepanov/tools/sl"
+	"REDACTED/team-11/backend/admin/internal/usecase/storage/access"
+	"REDACTED/team-11/backend/admin/internal/usecase/storage/amenity"
 	"REDACTED/team-11/backend/admin/internal/usecase/storage/booking"
 	"REDACTED/team-11/backend/admin/internal/usecase/storage/booking_entity"
+	"REDACTED/team-11/backend/admin/internal/usecase/storage/building"
 	"REDACTED/team-11/backend/admin/internal/usecase/storage/guest"
 	"REDACTED/team-11/backend/admin/internal/usecase/storage/order"
+	"REDACTED/team-11/backend/admin/internal/usecase/storage/relocation"
 	"REDACTED/team-11/backend/admin/internal/usecase/storage/verification"
 	"REDACTED/team-11/backend/admin/pkg/client/minio"
+	"REDACTED/team-11/backend/admin/pkg/metrics"
 )
 
 type Storage struct {
 	BookingEntity *booking_entity.BookingEntity
+	Amenity       *amenity.Amenity
+	Access        *access.Access
+	Building      *building.Building
 	Verification  *verification.Verification
 	Booking       *booking.Booking
 	Guest         *guest.Guest
 	Order         *order.Order
+	Relocation    *relocation.Relocation
 	pg            pg.Client
 	mn            minio.Client
+	bucket        string
 }
 
 type Config struct {
@@ -31,14 +44,21 @@ func New(c ctx.Context, cfg *Config) *Storage {
 	pg := connectPg(c)
 	minio := connectMinio(c, &cfg.Minio)
 
+	metrics.RegisterPgx(pg.ToPgx())
+
 	return &Storage{
 		BookingEntity: booking_entity.New(pg),
+		Amenity:       amenity.New(pg),
+		Access:        access.New(pg),
+		Building:      building.New(pg),
 		Verification:  verification.New(pg, minio, cfg.Minio.Bucket),
 		Booking:       booking.New(pg),
 		Order:         order.New(pg),
 		Guest:         guest.New(pg),
+		Relocation:    relocation.New(pg),
 		pg:            pg,
 		mn:            minio,
+		bucket:        cfg.Minio.Bucket,
 	}
 }
 
@@ -46,6 +66,23 @@ func (s *Storage) Close() {
 	s.pg.Close()
 }
 
+func (s *Storage) PingPostgres(ctx context.Context) error {
+	return s.pg.ToPgx().Ping(ctx)
+}
+
+func (s *Storage) PingMinio(ctx context.Context) error {
+	exists, err := s.mn.BucketExists(ctx, s.bucket)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return fmt.Errorf("bucket %s does not exist", s.bucket)
+	}
+
+	return nil
+}
+
 func connectPg(c ctx.Context) pg.Client {
 	log := c.Logger()
 
@@ -3,5 +3,6 @@ package storage
 var (
 	pgTypes = []string{
 		"booking_type", "_booking_type",
+		"relocation_status", "_relocation_status",
 	}
 )
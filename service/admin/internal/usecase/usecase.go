@@ -3,9 +3,11 @@ package usecase
 import (
 	"github.com/nikitaSstepanov/tools/httper"
 	"REDACTED/team-11/backend/admin/internal/usecase/id"
+	"REDACTED/team-11/backend/admin/internal/usecase/pkg/amenity"
 	"REDACTED/team-11/backend/admin/internal/usecase/pkg/auth"
 	"REDACTED/team-11/backend/admin/internal/usecase/pkg/booking"
 	"REDACTED/team-11/backend/admin/internal/usecase/pkg/booking_entity"
+	"REDACTED/team-11/backend/admin/internal/usecase/pkg/building"
 	"REDACTED/team-11/backend/admin/internal/usecase/pkg/guest"
 	"REDACTED/team-11/backend/admin/internal/usecase/pkg/order"
 	"REDACTED/team-11/backend/admin/internal/usecase/pkg/verification"
@@ -14,6 +16,8 @@ import (
 
 type UseCase struct {
 	BookingEntity *booking_entity.BookingEntity
+	Amenity       *amenity.Amenity
+	Building      *building.Building
 	Verification  *verification.Verification
 	Booking       *booking.Booking
 	Guest         *guest.Guest
@@ -22,19 +26,27 @@ type UseCase struct {
 }
 
 type Config struct {
-	Jwt      auth.JwtOptions  `yaml:"jwt"`
-	CoffeeId httper.ClientCfg `yaml:"coffee_id"`
+	Jwt         auth.JwtOptions  `yaml:"jwt"`
+	CoffeeId    httper.ClientCfg `yaml:"coffee_id"`
+	Credentials id.Credentials   `yaml:"credentials"`
 }
 
-func New(store *storage.Storage, cfg *Config) *UseCase {
-	coffeeId := id.New(&cfg.CoffeeId)
+func New(store *storage.Storage, cfg *Config) (*UseCase, error) {
+	jwtAuth, err := auth.New(&cfg.Jwt)
+	if err != nil {
+		return nil, err
+	}
+
+	coffeeId := id.New(&cfg.CoffeeId, &cfg.Credentials)
 
 	return &UseCase{
 		Booking:       booking.New(store.Booking),
-		BookingEntity: booking_entity.New(store.BookingEntity),
+		BookingEntity: booking_entity.New(store.BookingEntity, store.Booking, store.Guest, store.Relocation, store.Building, store.Amenity, store.Access, coffeeId),
+		Amenity:       amenity.New(store.Amenity),
+		Building:      building.New(store.Building),
 		Verification:  verification.New(store.Verification, coffeeId),
 		Order:         order.New(store.Order),
-		Auth:          auth.New(&cfg.Jwt),
+		Auth:          jwtAuth,
 		Guest:         guest.New(store.Guest, store.BookingEntity, store.Booking, coffeeId),
-	}
+	}, nil
 }
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS booking_entity_access (
+    id UUID PRIMARY KEY DEFAULT (gen_random_uuid()),
+    entity_id UUID NOT NULL,
+    team_id UUID,
+    user_id UUID,
+    created_at TIMESTAMP NOT NULL DEFAULT (now()),
+    FOREIGN KEY (entity_id) REFERENCES booking_entity (id) ON DELETE CASCADE,
+    CHECK ((team_id IS NULL) <> (user_id IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS booking_entity_access_entity_id_idx ON booking_entity_access (entity_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS booking_entity_access;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE booking_entity ADD COLUMN IF NOT EXISTS is_premium BOOLEAN NOT NULL DEFAULT (false);
+
+CREATE TABLE IF NOT EXISTS booking_quota (
+    id UUID PRIMARY KEY DEFAULT (gen_random_uuid()),
+    user_id UUID UNIQUE,
+    role VARCHAR(32) UNIQUE,
+    hours_per_week INT CHECK (hours_per_week >= 0),
+    active_bookings INT CHECK (active_bookings >= 0),
+    premium_hours_per_week INT CHECK (premium_hours_per_week >= 0),
+    created_at TIMESTAMP NOT NULL DEFAULT (now()),
+    updated_at TIMESTAMP NOT NULL DEFAULT (now()),
+    CHECK ((user_id IS NULL) <> (role IS NULL))
+);
+
+CREATE TRIGGER update_booking_quota_updated_at
+BEFORE UPDATE ON booking_quota
+FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS booking_quota;
+
+ALTER TABLE booking_entity DROP COLUMN IF EXISTS is_premium;
+-- +goose StatementEnd
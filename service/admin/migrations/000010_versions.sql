@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE entity_floor ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT (1);
+ALTER TABLE booking_entity ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT (1);
+ALTER TABLE booking ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT (1);
+
+CREATE OR REPLACE FUNCTION increment_version()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.version = OLD.version + 1;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER increment_booking_version
+BEFORE UPDATE ON booking
+FOR EACH ROW
+EXECUTE FUNCTION increment_version();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS increment_booking_version ON booking;
+DROP FUNCTION IF EXISTS increment_version();
+
+ALTER TABLE booking DROP COLUMN IF EXISTS version;
+ALTER TABLE booking_entity DROP COLUMN IF EXISTS version;
+ALTER TABLE entity_floor DROP COLUMN IF EXISTS version;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS booking_history (
+    id UUID PRIMARY KEY DEFAULT (gen_random_uuid()),
+    booking_id UUID NOT NULL,
+    action VARCHAR(32) NOT NULL,
+    actor_id UUID NOT NULL,
+    prev_time_to TIMESTAMP NOT NULL,
+    time_to TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT (now()),
+    FOREIGN KEY (booking_id) REFERENCES booking (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS booking_history_booking_id_idx ON booking_history (booking_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS booking_history;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE booking_quota ADD COLUMN IF NOT EXISTS team_id UUID UNIQUE;
+
+ALTER TABLE booking_quota DROP CONSTRAINT IF EXISTS booking_quota_check;
+ALTER TABLE booking_quota ADD CONSTRAINT booking_quota_check CHECK (num_nonnulls(user_id, role, team_id) = 1);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM booking_quota WHERE team_id IS NOT NULL;
+
+ALTER TABLE booking_quota DROP CONSTRAINT IF EXISTS booking_quota_check;
+ALTER TABLE booking_quota ADD CONSTRAINT booking_quota_check CHECK ((user_id IS NULL) <> (role IS NULL));
+
+ALTER TABLE booking_quota DROP COLUMN IF EXISTS team_id;
+-- +goose StatementEnd
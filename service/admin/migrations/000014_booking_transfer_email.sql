@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE booking_transfer ADD COLUMN IF NOT EXISTS to_email TEXT NOT NULL DEFAULT '';
+ALTER TABLE booking_transfer ALTER COLUMN to_email DROP DEFAULT;
+ALTER TABLE booking_transfer ALTER COLUMN to_user_id DROP NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM booking_transfer WHERE to_user_id IS NULL;
+
+ALTER TABLE booking_transfer ALTER COLUMN to_user_id SET NOT NULL;
+ALTER TABLE booking_transfer DROP COLUMN IF EXISTS to_email;
+-- +goose StatementEnd
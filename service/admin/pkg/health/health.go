@@ -0,0 +1,182 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	StatusOk       = "ok"
+	StatusDegraded = "degraded"
+	StatusFail     = "fail"
+	StatusDraining = "draining"
+)
+
+// Check pings a dependency within ctx deadline.
+type Check func(ctx context.Context) error
+
+type check struct {
+	name     string
+	check    Check
+	critical bool
+}
+
+type CheckResult struct {
+	Status   string `json:"status"`
+	Error    string `json:"error,omitempty"`
+	Duration string `json:"duration"`
+}
+
+type Response struct {
+	Status string                 `json:"status"`
+	Build  BuildInfo              `json:"build"`
+	Checks map[string]CheckResult `json:"checks,omitempty"`
+}
+
+// Checker serves /healthz and /readyz of admin. Postgres and minio are
+// critical, coffee-id is only reported as degraded.
+type Checker struct {
+	timeout  time.Duration
+	build    BuildInfo
+	checks   []check
+	draining atomic.Bool
+}
+
+func New(cfg *Config) *Checker {
+	c := &Checker{
+		timeout: cfg.Timeout,
+		build:   Build(),
+	}
+
+	client := &http.Client{}
+	for _, service := range cfg.Downstream {
+		c.AddOptional(service.Name, HTTP(client, service.Url))
+	}
+
+	return c
+}
+
+// Add registers a critical check.
+func (c *Checker) Add(name string, fn Check) {
+	c.checks = append(c.checks, check{name: name, check: fn, critical: true})
+}
+
+// AddOptional registers a check which can only degrade readiness.
+func (c *Checker) AddOptional(name string, fn Check) {
+	c.checks = append(c.checks, check{name: name, check: fn})
+}
+
+// Drain fails readiness from the start of shutdown.
+func (c *Checker) Drain() {
+	c.draining.Store(true)
+}
+
+func (c *Checker) Live(w http.ResponseWriter, r *http.Request) {
+	writeResponse(w, http.StatusOK, Response{Status: StatusOk, Build: c.build})
+}
+
+func (c *Checker) Ready(w http.ResponseWriter, r *http.Request) {
+	if c.draining.Load() {
+		writeResponse(w, http.StatusServiceUnavailable, Response{Status: StatusDraining, Build: c.build})
+		return
+	}
+
+	res := c.Run(r.Context())
+
+	code := http.StatusOK
+	if res.Status == StatusFail {
+		code = http.StatusServiceUnavailable
+	}
+
+	writeResponse(w, code, res)
+}
+
+// Run runs checks in parallel with the configured timeout.
+func (c *Checker) Run(ctx context.Context) Response {
+	results := make([]CheckResult, len(c.checks))
+
+	var wg sync.WaitGroup
+	for i, ch := range c.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = c.run(ctx, ch.check)
+		}()
+	}
+	wg.Wait()
+
+	res := Response{
+		Status: StatusOk,
+		Build:  c.build,
+		Checks: make(map[string]CheckResult, len(c.checks)),
+	}
+
+	for i, ch := range c.checks {
+		res.Checks[ch.name] = results[i]
+		if results[i].Status == StatusOk {
+			continue
+		}
+
+		if ch.critical {
+			res.Status = StatusFail
+		} else if res.Status == StatusOk {
+			res.Status = StatusDegraded
+		}
+	}
+
+	return res
+}
+
+func (c *Checker) run(ctx context.Context, fn Check) CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := fn(ctx)
+	res := CheckResult{Status: StatusOk, Duration: time.Since(start).String()}
+
+	if err == nil && ctx.Err() != nil {
+		err = ctx.Err()
+	}
+
+	if err != nil {
+		res.Status = StatusFail
+		res.Error = err.Error()
+	}
+
+	return res
+}
+
+// HTTP expects 2xx from GET url.
+func HTTP(client *http.Client, url string) Check {
+	return func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return fmt.Errorf("unexpected status %d", resp.StatusCode)
+		}
+
+		return nil
+	}
+}
+
+func writeResponse(w http.ResponseWriter, code int, res Response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(res)
+}
@@ -0,0 +1,96 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-playground/assert/v2"
+)
+
+func TestReady(t *testing.T) {
+	ok := func(ctx context.Context) error { return nil }
+	fail := func(ctx context.Context) error { return errors.New("connection refused") }
+
+	tests := []struct {
+		TestName string
+		Critical Check
+		Optional Check
+		Status   string
+		Code     int
+	}{
+		{
+			TestName: "All ok",
+			Critical: ok,
+			Optional: ok,
+			Status:   StatusOk,
+			Code:     http.StatusOK,
+		},
+		{
+			TestName: "Optional fails",
+			Critical: ok,
+			Optional: fail,
+			Status:   StatusDegraded,
+			Code:     http.StatusOK,
+		},
+		{
+			TestName: "Critical fails",
+			Critical: fail,
+			Optional: ok,
+			Status:   StatusFail,
+			Code:     http.StatusServiceUnavailable,
+		},
+		{
+			TestName: "Both fail",
+			Critical: fail,
+			Optional: fail,
+			Status:   StatusFail,
+			Code:     http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.TestName, func(t *testing.T) {
+			c := New(&Config{Timeout: time.Second})
+			c.Add("postgres", tc.Critical)
+			c.AddOptional("coffee-id", tc.Optional)
+
+			assert.Equal(t, c.Run(context.Background()).Status, tc.Status)
+
+			w := httptest.NewRecorder()
+			c.Ready(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+			assert.Equal(t, w.Code, tc.Code)
+		})
+	}
+}
+
+func TestReadyTimeout(t *testing.T) {
+	c := New(&Config{Timeout: 10 * time.Millisecond})
+	c.Add("minio", func(ctx context.Context) error {
+		<-ctx.Done()
+		return nil
+	})
+
+	res := c.Run(context.Background())
+
+	assert.Equal(t, res.Status, StatusFail)
+	assert.Equal(t, res.Checks["minio"].Error, context.DeadlineExceeded.Error())
+}
+
+func TestDrain(t *testing.T) {
+	c := New(&Config{Timeout: time.Second})
+	c.Add("postgres", func(ctx context.Context) error { return nil })
+
+	c.Drain()
+
+	w := httptest.NewRecorder()
+	c.Ready(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, w.Code, http.StatusServiceUnavailable)
+
+	w = httptest.NewRecorder()
+	c.Live(w, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.Equal(t, w.Code, http.StatusOK)
+}
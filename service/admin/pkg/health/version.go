@@ -0,0 +1,36 @@
+package health
+
+import "runtime/debug"
+
+// Version and Commit are set by the dockerfile with -ldflags -X.
+var (
+	Version = "dev"
+	Commit  = ""
+)
+
+type BuildInfo struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit,omitempty"`
+	GoVersion string `json:"go_version"`
+}
+
+// Build falls back to the VCS revision when Commit is empty.
+func Build() BuildInfo {
+	info := BuildInfo{Version: Version, Commit: Commit}
+
+	build, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+
+	info.GoVersion = build.GoVersion
+	if info.Commit == "" {
+		for _, setting := range build.Settings {
+			if setting.Key == "vcs.revision" {
+				info.Commit = setting.Value
+			}
+		}
+	}
+
+	return info
+}
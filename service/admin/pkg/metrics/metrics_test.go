@@ -0,0 +1,28 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/assert/v2"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestGin(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(Gin())
+	router.GET("/buildings/:id", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	for _, path := range []string{"/buildings/1", "/buildings/2", "/unknown"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	assert.Equal(t, testutil.ToFloat64(requestsTotal.WithLabelValues("/buildings/:id", http.MethodGet, "200")), float64(2))
+	assert.Equal(t, testutil.ToFloat64(requestsTotal.WithLabelValues("unmatched", http.MethodGet, "404")), float64(1))
+}
@@ -0,0 +1,33 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-playground/assert/v2"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestOutgoing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	for _, id := range []string{"1", "2"} {
+		req, err := http.NewRequest(http.MethodGet, "http://coffee-id:80/api/v1/accounts/"+id, nil)
+		if err != nil {
+			t.Fatalf("Can`t create request: %v", err)
+		}
+
+		End(Outgoing(context.Background(), req), http.StatusOK, nil)
+	}
+
+	spans := recorder.Ended()
+
+	assert.Equal(t, len(spans), 2)
+	for _, span := range spans {
+		assert.Equal(t, span.Name(), "GET coffee-id:80")
+	}
+}
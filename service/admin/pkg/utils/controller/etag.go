@@ -0,0 +1,36 @@
+package ct
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	ETagHeader    = "ETag"
+	IfMatchHeader = "If-Match"
+)
+
+// SetETag sets version of resource as strong entity tag of response.
+func SetETag(c *gin.Context, version int) {
+	c.Header(ETagHeader, strconv.Quote(strconv.Itoa(version)))
+}
+
+// IfMatch reads If-Match of request for storage updates. It is nil without
+// precondition, and 0 for tags not made by SetETag, which no row has.
+func IfMatch(c *gin.Context) *int {
+	tag := strings.TrimSpace(c.GetHeader(IfMatchHeader))
+	if tag == "" || tag == "*" {
+		return nil
+	}
+
+	tag = strings.TrimPrefix(tag, "W/")
+
+	version, err := strconv.Atoi(strings.Trim(tag, `"`))
+	if err != nil || version < 1 {
+		version = 0
+	}
+
+	return &version
+}
@@ -8,16 +8,26 @@ import (
 	"os/signal"
 	"syscall"
 	"time"
+	// Runner image has no zoneinfo, building time zones are embedded.
+	_ "time/tzdata"
 
 	"REDACTED/team-11/backend/booking/internal/config"
+	"REDACTED/team-11/backend/booking/internal/models"
 	coffeeid "REDACTED/team-11/backend/booking/internal/repo/coffee-id"
 	"REDACTED/team-11/backend/booking/internal/repo/postgres"
 	"REDACTED/team-11/backend/booking/internal/service"
 	"REDACTED/team-11/backend/booking/internal/transport/http/v1"
 	"REDACTED/team-11/backend/booking/internal/transport/http/v1/handlers"
 	"REDACTED/team-11/backend/booking/internal/transport/http/v1/security"
+	"REDACTED/team-11/backend/booking/pkg/credentials"
+	"REDACTED/team-11/backend/booking/pkg/health"
+	"REDACTED/team-11/backend/booking/pkg/idempotency"
+	"REDACTED/team-11/backend/booking/pkg/jwks"
 	"REDACTED/team-11/backend/booking/pkg/logger"
+	"REDACTED/team-11/backend/booking/pkg/metrics"
 	pg_helper "REDACTED/team-11/backend/booking/pkg/postgres"
+	redis_helper "REDACTED/team-11/backend/booking/pkg/redis"
+	"REDACTED/team-11/backend/booking/pkg/tracing"
 	"go.uber.org/zap"
 )
 
@@ -38,33 +48,82 @@ func main() {
 		log.Fatal("get logger:", err)
 	}
 
+	shutdownTracing, err := tracing.Init(ctx, cfg.TracingConfig)
+	if err != nil {
+		l.Fatal("init tracing", zap.Error(err))
+	}
+
 	db, err := pg_helper.Connect(ctx, cfg.PostgresConfig)
 	if err != nil {
 		l.Fatal("connect to postgres", zap.Error(err))
 	}
 
+	metrics.RegisterDB(db.DB, "booking")
+
+	rdb, err := redis_helper.Connect(ctx, cfg.RedisConfig)
+	if err != nil {
+		l.Fatal("connect to redis", zap.Error(err))
+	}
+
 	floorsRepo := postgres.NewFloorsRepo(db)
-	usersRepo := coffeeid.NewUserRepo(cfg.CoffeeIdBaseUrl)
+	usersRepo := coffeeid.NewUserRepo(cfg.CoffeeIdBaseUrl, credentials.New(cfg.CredentialsConfig), cfg.CoffeeIdConfig)
 	bookingEntitiesRepo := postgres.NewBookingEntitiesRepo(db)
 	bookingsRepo := postgres.NewBookingsRepo(db)
 	ordersRepo := postgres.NewOrdersRepo(db)
-
-	workloadsService := service.NewWorkloadService(bookingEntitiesRepo, bookingsRepo, floorsRepo)
+	buildingsRepo := postgres.NewBuildingsRepo(db)
+	amenitiesRepo := postgres.NewAmenitiesRepo(db)
+	accessRulesRepo := postgres.NewAccessRulesRepo(db)
+	delegationsRepo := postgres.NewDelegationsRepo(db)
+	bookingGroupsRepo := postgres.NewBookingGroupsRepo(db)
+	quotasRepo := postgres.NewQuotasRepo(db)
+	transfersRepo := postgres.NewTransfersRepo(db)
+
+	buildingsService := service.NewBuildingsService(buildingsRepo)
+	accessService := service.NewAccessService(accessRulesRepo, usersRepo)
+	delegationsService := service.NewDelegationsService(delegationsRepo, usersRepo)
+	quotasService := service.NewQuotasService(quotasRepo, bookingEntitiesRepo, usersRepo, buildingsService, models.QuotaLimits{
+		HoursPerWeek:        cfg.QuotaConfig.HoursPerWeek,
+		ActiveBookings:      cfg.QuotaConfig.ActiveBookings,
+		PremiumHoursPerWeek: cfg.QuotaConfig.PremiumHoursPerWeek,
+	})
+	workloadsService := service.NewWorkloadService(bookingEntitiesRepo, bookingsRepo, floorsRepo, buildingsRepo, buildingsService, amenitiesRepo, accessService)
 	ordersService := service.NewOrdersService(ordersRepo, bookingsRepo)
-	bookingsService := service.NewBookingsService(bookingsRepo, bookingEntitiesRepo, ordersRepo, workloadsService, usersRepo)
+	bookingsService := service.NewBookingsService(bookingsRepo, bookingEntitiesRepo, ordersRepo, workloadsService, usersRepo, buildingsService, accessService, delegationsService, quotasService)
+	bookingGroupsService := service.NewBookingGroupsService(bookingGroupsRepo, bookingsRepo, bookingsService, workloadsService, buildingsService, accessService, quotasService)
+	allocationsService := service.NewAllocationsService(workloadsService, bookingGroupsService)
+	transfersService := service.NewTransfersService(transfersRepo, bookingsRepo, usersRepo, accessService, quotasService)
 
 	bookingsHandler := handlers.NewBookingsHandler(bookingsService)
 	ordersHandler := handlers.NewOrdersHandler(ordersService)
 	workloadsHandler := handlers.NewWorkloadsHandler(workloadsService)
+	delegationsHandler := handlers.NewDelegationsHandler(delegationsService)
+	bookingGroupsHandler := handlers.NewBookingGroupsHandler(bookingGroupsService)
+	allocationsHandler := handlers.NewAllocationsHandler(allocationsService)
+	quotasHandler := handlers.NewQuotasHandler(quotasService)
+	transfersHandler := handlers.NewTransfersHandler(transfersService)
 
-	securityHandler := security.NewSecurityHandler(cfg.JWTSecret)
+	securityHandler := security.NewSecurityHandler(jwks.New(cfg.JWKSConfig), cfg.JWTIssuer, cfg.JWTAudience)
 	handler := http.NewHandler(
 		bookingsHandler,
 		ordersHandler,
 		workloadsHandler,
+		delegationsHandler,
+		bookingGroupsHandler,
+		allocationsHandler,
+		quotasHandler,
+		transfersHandler,
 	)
 
-	server, err := http.NewServer(handler, securityHandler, l)
+	checker := health.New(cfg.HealthConfig.Timeout)
+	checker.Add("postgres", db.PingContext)
+	checker.Add("redis", func(ctx context.Context) error {
+		return rdb.Ping(ctx).Err()
+	})
+	checker.AddOptional("coffee-id", health.HTTP(&stdhttp.Client{}, cfg.HealthConfig.CoffeeIdUrl))
+
+	idempotencyMiddleware := http.Idempotency(idempotency.NewRedisStore(rdb), cfg.IdempotencyConfig)
+
+	server, err := http.NewServer(handler, securityHandler, checker, idempotencyMiddleware, l)
 	if err != nil {
 		l.Fatal("get server", zap.Error(err))
 	}
@@ -73,7 +132,7 @@ func main() {
 	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
 
 	go func() {
-		l.Info("starting http server", zap.Int("port", cfg.ServerPort))
+		l.Info("starting http server", zap.Int("port", cfg.ServerPort), zap.String("version", health.Version))
 		if err := server.Run(ctx, cfg.ServerPort); err != nil && err != stdhttp.ErrServerClosed {
 			l.Fatal("start http server", zap.Error(err))
 		}
@@ -81,6 +140,12 @@ func main() {
 
 	<-sigCh
 
+	// Report unready first and give load balancers time to notice before
+	// the listener is closed.
+	l.Info("draining http server", zap.Duration("delay", cfg.HealthConfig.DrainDelay))
+	checker.Drain()
+	time.Sleep(cfg.HealthConfig.DrainDelay)
+
 	ctx, cancel := context.WithTimeout(ctx, shutdownTimeout)
 	defer cancel()
 
@@ -89,5 +154,13 @@ func main() {
 		l.Error("shutdown http server", zap.Error(err))
 	}
 
+	if err := rdb.Close(); err != nil {
+		l.Error("close redis", zap.Error(err))
+	}
+
+	if err := shutdownTracing(ctx); err != nil {
+		l.Error("shutdown tracing", zap.Error(err))
+	}
+
 	l.Info("server stopped")
 }
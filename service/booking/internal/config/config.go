@@ -1,18 +1,39 @@
 package config
 
 import (
-	"github.com/ilyakaznacheev/cleanenv"
+	"REDACTED/team-11/backend/booking/pkg/credentials"
+	"REDACTED/team-11/backend/booking/pkg/health"
+	"REDACTED/team-11/backend/booking/pkg/idempotency"
+	"REDACTED/team-11/backend/booking/pkg/jwks"
 	"REDACTED/team-11/backend/booking/pkg/postgres"
 	"REDACTED/team-11/backend/booking/pkg/redis"
+	"REDACTED/team-11/backend/booking/pkg/tracing"
+	"github.com/ilyakaznacheev/cleanenv"
+	coffeeid "REDACTED/team-11/backend/booking/internal/repo/coffee-id"
 )
 
 type Config struct {
-	ServerPort      int    `env:"SERVER_PORT" env-default:"8080"`
-	LogLevel        string `env:"LOG_LEVEL" env-default:"info"`
-	JWTSecret       string `env:"JWT_SECRET" env-default:"root"`
-	CoffeeIdBaseUrl string `env:"COFFEE_ID_BASE_URL" env-default:"http://localhost:8090"`
-	PostgresConfig  postgres.Config
-	RedisConfig     redis.Config
+	ServerPort        int    `env:"SERVER_PORT" env-default:"8080"`
+	LogLevel          string `env:"LOG_LEVEL" env-default:"info"`
+	JWTIssuer         string `env:"JWT_ISSUER" env-default:"coffee-id-backend"`
+	JWTAudience       string `env:"JWT_AUDIENCE" env-default:"coffee-id-frontend"`
+	CoffeeIdBaseUrl   string `env:"COFFEE_ID_BASE_URL" env-default:"http://localhost:8090"`
+	PostgresConfig    postgres.Config
+	RedisConfig       redis.Config
+	JWKSConfig        jwks.Config
+	CredentialsConfig credentials.Config
+	CoffeeIdConfig    coffeeid.Config
+	QuotaConfig       QuotaConfig
+	TracingConfig     tracing.Config
+	HealthConfig      health.Config
+	IdempotencyConfig idempotency.Config
+}
+
+// QuotaConfig holds default booking limits, 0 disables limit.
+type QuotaConfig struct {
+	HoursPerWeek        int `env:"QUOTA_HOURS_PER_WEEK" env-default:"40"`
+	ActiveBookings      int `env:"QUOTA_ACTIVE_BOOKINGS" env-default:"10"`
+	PremiumHoursPerWeek int `env:"QUOTA_PREMIUM_HOURS_PER_WEEK" env-default:"8"`
 }
 
 func Get() (Config, error) {
@@ -0,0 +1,23 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"REDACTED/team-11/backend/booking/internal/models"
+)
+
+type AllocationDto struct {
+	FloorId  uuid.UUID
+	TimeFrom time.Time
+	TimeTo   time.Time
+	Seats    int
+	Filter   BookingEntityFilter
+
+	// Book makes group booking for allocated seats.
+	Book bool
+	// Attendees receive allocated seats in order, seats are booked for Requester if empty.
+	Attendees []uuid.UUID
+
+	Requester models.Token
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"REDACTED/team-11/backend/booking/internal/models"
 )
 
 type BookingCreateDto struct {
@@ -11,4 +12,8 @@ type BookingCreateDto struct {
 	UserId   uuid.UUID
 	TimeFrom time.Time
 	TimeTo   time.Time
+	GroupId  *uuid.UUID
+
+	// Requester is token of user, who makes the booking.
+	Requester models.Token
 }
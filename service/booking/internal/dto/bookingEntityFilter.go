@@ -0,0 +1,8 @@
+package dto
+
+import "github.com/google/uuid"
+
+// BookingEntityFilter selects entities which have all of amenities.
+type BookingEntityFilter struct {
+	Amenities []uuid.UUID
+}
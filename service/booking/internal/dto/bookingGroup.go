@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"REDACTED/team-11/backend/booking/internal/models"
+)
+
+type BookingGroupItem struct {
+	EntityId uuid.UUID
+	UserId   uuid.UUID
+}
+
+type BookingGroupCreateDto struct {
+	TimeFrom time.Time
+	TimeTo   time.Time
+	Items    []BookingGroupItem
+
+	// Requester is token of user, who makes the group booking.
+	Requester models.Token
+}
+
+type BookingGroupUpdateDto struct {
+	GroupId  uuid.UUID
+	TimeFrom *time.Time
+	TimeTo   *time.Time
+}
@@ -0,0 +1,100 @@
+package dto
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"REDACTED/team-11/backend/booking/internal/models"
+)
+
+const (
+	DefaultBookingListLimit = 20
+	MaxBookingListLimit     = 100
+)
+
+type BookingSort string
+
+var (
+	BookingSortCreatedAt     BookingSort = "created_at"
+	BookingSortCreatedAtDesc BookingSort = "-created_at"
+	BookingSortTimeFrom      BookingSort = "time_from"
+	BookingSortTimeFromDesc  BookingSort = "-time_from"
+)
+
+// Column returns the booking column the list is ordered by.
+func (s BookingSort) Column() string {
+	switch s {
+	case BookingSortTimeFrom, BookingSortTimeFromDesc:
+		return "time_from"
+	default:
+		return "created_at"
+	}
+}
+
+func (s BookingSort) Desc() bool {
+	return s == "" || s[0] == '-'
+}
+
+// Value returns the value of the sort column for the booking.
+func (s BookingSort) Value(booking models.Booking) time.Time {
+	if s.Column() == "time_from" {
+		return booking.TimeFrom
+	}
+
+	return booking.CreatedAt
+}
+
+type BookingListFilter struct {
+	BuildingId *uuid.UUID
+	FloorId    *uuid.UUID
+	EntityId   *uuid.UUID
+	UserId     *uuid.UUID
+	TimeFrom   *time.Time
+	TimeTo     *time.Time
+	Status     *models.BookingStatus
+	Sort       BookingSort
+	Limit      int
+	Cursor     string
+}
+
+// BookingCursor points at the last booking of a page. It is only valid
+// for the sort it was issued for.
+type BookingCursor struct {
+	Sort  BookingSort `json:"s"`
+	Value time.Time   `json:"v"`
+	Id    uuid.UUID   `json:"id"`
+}
+
+func NewBookingCursor(sort BookingSort, booking models.Booking) BookingCursor {
+	return BookingCursor{
+		Sort:  sort,
+		Value: sort.Value(booking),
+		Id:    booking.Id,
+	}
+}
+
+func (c BookingCursor) Encode() string {
+	raw, _ := json.Marshal(c)
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func DecodeBookingCursor(cursor string) (BookingCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return BookingCursor{}, models.ErrInvalidCursor
+	}
+
+	var res BookingCursor
+	if err := json.Unmarshal(raw, &res); err != nil {
+		return BookingCursor{}, models.ErrInvalidCursor
+	}
+
+	if res.Id == uuid.Nil {
+		return BookingCursor{}, models.ErrInvalidCursor
+	}
+
+	return res, nil
+}
@@ -0,0 +1,42 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"REDACTED/team-11/backend/booking/internal/models"
+)
+
+func TestBookingCursor(t *testing.T) {
+	booking := models.Booking{
+		Id:        uuid.New(),
+		TimeFrom:  time.Date(2025, 3, 3, 10, 0, 0, 0, time.UTC),
+		CreatedAt: time.Date(2025, 3, 1, 8, 30, 15, 123456000, time.UTC),
+	}
+
+	cursor := NewBookingCursor(BookingSortCreatedAtDesc, booking)
+
+	decoded, err := DecodeBookingCursor(cursor.Encode())
+	require.NoError(t, err)
+	assert.Equal(t, booking.Id, decoded.Id)
+	assert.Equal(t, BookingSortCreatedAtDesc, decoded.Sort)
+	assert.True(t, booking.CreatedAt.Equal(decoded.Value))
+
+	assert.True(t, NewBookingCursor(BookingSortTimeFrom, booking).Value.Equal(booking.TimeFrom))
+
+	for _, invalid := range []string{"invalid!", "bm90IGpzb24", "e30"} {
+		_, err := DecodeBookingCursor(invalid)
+		assert.ErrorIs(t, err, models.ErrInvalidCursor, invalid)
+	}
+}
+
+func TestBookingSort(t *testing.T) {
+	assert.Equal(t, "created_at", BookingSortCreatedAt.Column())
+	assert.Equal(t, "time_from", BookingSortTimeFromDesc.Column())
+
+	assert.True(t, BookingSortCreatedAtDesc.Desc())
+	assert.False(t, BookingSortTimeFrom.Desc())
+}
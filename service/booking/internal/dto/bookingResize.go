@@ -0,0 +1,21 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"REDACTED/team-11/backend/booking/internal/models"
+)
+
+// BookingResizeDto moves end of active booking from PrevTimeTo to TimeTo.
+type BookingResizeDto struct {
+	BookingId  uuid.UUID
+	Action     models.BookingAction
+	ActorId    uuid.UUID
+	PrevTimeTo time.Time
+	TimeTo     time.Time
+
+	// Version makes resize conditional: it is applied only if booking
+	// still has this version.
+	Version *int
+}
@@ -0,0 +1,16 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"REDACTED/team-11/backend/booking/internal/models"
+)
+
+// BookingTransferCreateDto proposes to hand booking over to user with Email.
+type BookingTransferCreateDto struct {
+	BookingId uuid.UUID
+	Email     string
+	Requester models.Token
+
+	// SwapBookingId is booking of recipient, which is given in exchange.
+	SwapBookingId *uuid.UUID
+}
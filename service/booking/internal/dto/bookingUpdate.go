@@ -10,4 +10,13 @@ type BookingUpdateDto struct {
 	BookingId uuid.UUID
 	TimeFrom  *time.Time
 	TimeTo    *time.Time
+
+	// UserId reassigns booking to another user, BookedBy is set
+	// to user, who made reassignment.
+	UserId   *uuid.UUID
+	BookedBy *uuid.UUID
+
+	// Version makes update conditional: it is applied only if booking
+	// still has this version.
+	Version *int
 }
@@ -0,0 +1,23 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"REDACTED/team-11/backend/booking/internal/models"
+)
+
+type QuotaCheckDto struct {
+	UserId uuid.UUID
+	// Requester is used for role of user, if user books by themselves.
+	Requester models.Token
+
+	// Bookings are new bookings of user, only EntityId, TimeFrom and TimeTo are used.
+	Bookings []models.BookedInterval
+	// Exclude are existing bookings, which are replaced by Bookings.
+	Exclude []uuid.UUID
+}
+
+type QuotaOverrideDto struct {
+	HoursPerWeek        *int
+	ActiveBookings      *int
+	PremiumHoursPerWeek *int
+}
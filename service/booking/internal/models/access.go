@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EntityAccessRule grants booking of an entity to a team or to a single user.
+// Entity without rules is bookable by everyone.
+type EntityAccessRule struct {
+	Id        uuid.UUID  `db:"id"`
+	EntityId  uuid.UUID  `db:"entity_id"`
+	TeamId    *uuid.UUID `db:"team_id"`
+	UserId    *uuid.UUID `db:"user_id"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+type Team struct {
+	Id   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
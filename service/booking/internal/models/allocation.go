@@ -0,0 +1,11 @@
+package models
+
+type AllocatedSeats struct {
+	Entity BookingEntity
+	Places int
+}
+
+type Allocation struct {
+	Seats []AllocatedSeats
+	Group *BookingGroup
+}
@@ -7,13 +7,63 @@ import (
 )
 
 type Booking struct {
-	Id        uuid.UUID `db:"id"`
-	EntityId  uuid.UUID `db:"entity_id"`
-	UserId    uuid.UUID `db:"user_id"`
-	TimeFrom  time.Time `db:"time_from"`
-	TimeTo    time.Time `db:"time_to"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	Id        uuid.UUID  `db:"id"`
+	EntityId  uuid.UUID  `db:"entity_id"`
+	UserId    uuid.UUID  `db:"user_id"`
+	TimeFrom  time.Time  `db:"time_from"`
+	TimeTo    time.Time  `db:"time_to"`
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	BookedBy  uuid.UUID  `db:"booked_by"`
+	GroupId   *uuid.UUID `db:"group_id"`
+	Version   int        `db:"version"`
+}
+
+type BookingStatus string
+
+var (
+	BookingStatusUpcoming BookingStatus = "upcoming"
+	BookingStatusActive   BookingStatus = "active"
+	BookingStatusFinished BookingStatus = "finished"
+)
+
+// Status derives the booking status from its time range relative to now.
+func (b Booking) Status(now time.Time) BookingStatus {
+	switch {
+	case now.Before(b.TimeFrom):
+		return BookingStatusUpcoming
+	case now.Before(b.TimeTo):
+		return BookingStatusActive
+	default:
+		return BookingStatusFinished
+	}
+}
+
+// MaxTaken returns the most of bookings, which overlap each other at some
+// moment of interval [timeFrom, timeTo).
+func MaxTaken(bookings []Booking, timeFrom, timeTo time.Time) int {
+	res := 0
+
+	for _, start := range bookings {
+		moment := start.TimeFrom
+		if moment.Before(timeFrom) {
+			moment = timeFrom
+		}
+		if !moment.Before(timeTo) {
+			continue
+		}
+
+		taken := 0
+		for _, booking := range bookings {
+			if !booking.TimeFrom.After(moment) && booking.TimeTo.After(moment) {
+				taken++
+			}
+		}
+
+		res = max(res, taken)
+	}
+
+	return res
 }
 
 type BookingInfo struct {
@@ -21,4 +71,10 @@ type BookingInfo struct {
 	User   User
 	Orders []Order
 	Entity BookingEntity
+	Floor  Floor
+}
+
+type BookingPage struct {
+	Items      []BookingInfo
+	NextCursor string
 }
@@ -25,4 +25,17 @@ type BookingEntity struct {
 	Capacity  int               `db:"capacity"`
 	CreatedAt time.Time         `db:"created_at"`
 	UpdatedAt time.Time         `db:"updated_at"`
+	IsPremium bool              `db:"is_premium"`
+	Version   int               `db:"version"`
+	Amenities []Amenity         `db:"-"`
+}
+
+// Places returns how many bookings entity can hold at the same time.
+// Room is booked as a whole, whatever its capacity is.
+func (be BookingEntity) Places() int {
+	if be.Type == BookingEntityTypeRoom {
+		return 1
+	}
+
+	return be.Capacity
 }
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type BookingGroup struct {
+	Id        uuid.UUID `db:"id"`
+	OwnerId   uuid.UUID `db:"owner_id"`
+	TimeFrom  time.Time `db:"time_from"`
+	TimeTo    time.Time `db:"time_to"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+	Bookings  []Booking `db:"-"`
+}
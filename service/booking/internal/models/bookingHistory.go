@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type BookingAction string
+
+var (
+	BookingActionExtend  BookingAction = "extend"
+	BookingActionRelease BookingAction = "release"
+)
+
+// BookingHistoryItem records change of active booking end time.
+type BookingHistoryItem struct {
+	Id         uuid.UUID     `db:"id"`
+	BookingId  uuid.UUID     `db:"booking_id"`
+	Action     BookingAction `db:"action"`
+	ActorId    uuid.UUID     `db:"actor_id"`
+	PrevTimeTo time.Time     `db:"prev_time_to"`
+	TimeTo     time.Time     `db:"time_to"`
+	CreatedAt  time.Time     `db:"created_at"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BookingTransfer is a pending proposal to hand booking over to another user.
+// If SwapBookingId is set, recipient gives their booking for the same time in
+// exchange, and the bookings swap their entities.
+//
+// ToUserId is nil when no user has ToEmail. Such transfer looks the same to
+// sender, so emails of colleagues can't be probed, but nobody can accept it.
+type BookingTransfer struct {
+	Id            uuid.UUID  `db:"id"`
+	BookingId     uuid.UUID  `db:"booking_id"`
+	FromUserId    uuid.UUID  `db:"from_user_id"`
+	ToUserId      *uuid.UUID `db:"to_user_id"`
+	ToEmail       string     `db:"to_email"`
+	SwapBookingId *uuid.UUID `db:"swap_booking_id"`
+	CreatedAt     time.Time  `db:"created_at"`
+}
+
+// IsTo reports whether user is the recipient of transfer.
+func (t BookingTransfer) IsTo(userId uuid.UUID) bool {
+	return t.ToUserId != nil && *t.ToUserId == userId
+}
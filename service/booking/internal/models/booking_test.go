@@ -0,0 +1,32 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaxTaken(t *testing.T) {
+	at := func(hour int) time.Time {
+		return time.Date(2025, 3, 3, hour, 0, 0, 0, time.UTC)
+	}
+
+	bookings := []Booking{
+		{TimeFrom: at(8), TimeTo: at(10)},
+		{TimeFrom: at(9), TimeTo: at(11)},
+		{TimeFrom: at(10), TimeTo: at(12)},
+		{TimeFrom: at(13), TimeTo: at(14)},
+	}
+
+	assert.Equal(t, 2, MaxTaken(bookings, at(8), at(12)))
+	assert.Equal(t, 2, MaxTaken(bookings, at(10), at(11)))
+	assert.Equal(t, 1, MaxTaken(bookings, at(11), at(14)))
+	assert.Equal(t, 0, MaxTaken(bookings, at(12), at(13)))
+	assert.Equal(t, 0, MaxTaken(nil, at(8), at(12)))
+}
+
+func TestPlaces(t *testing.T) {
+	assert.Equal(t, 1, BookingEntity{Type: BookingEntityTypeRoom, Capacity: 8}.Places())
+	assert.Equal(t, 8, BookingEntity{Type: BookingEntityTypeOpenSpace, Capacity: 8}.Places())
+}
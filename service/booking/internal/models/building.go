@@ -0,0 +1,78 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Building struct {
+	Id        uuid.UUID `db:"id"`
+	Name      string    `db:"name"`
+	Address   string    `db:"address"`
+	Timezone  string    `db:"timezone"`
+	OpensAt   *string   `db:"opens_at"`
+	ClosesAt  *string   `db:"closes_at"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// Location returns building time zone. Floors without building get
+// building in UTC, an unknown zone is an error, not a silent UTC.
+func (b Building) Location() (*time.Location, error) {
+	loc, err := time.LoadLocation(b.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("building %s: %w: %w", b.Id, ErrInvalidTimezone, err)
+	}
+
+	return loc, nil
+}
+
+// IsOpen reports whether [timeFrom, timeTo) fits in building opening hours.
+// Interval must be inside one local day. Building without opening hours
+// is open around the clock, hours which can't be parsed are an error
+// rather than a reason to let booking through.
+func (b Building) IsOpen(timeFrom, timeTo time.Time) (bool, error) {
+	if b.OpensAt == nil || b.ClosesAt == nil {
+		return true, nil
+	}
+
+	opens, err := parseClock(*b.OpensAt)
+	if err != nil {
+		return false, fmt.Errorf("building %s: opens_at: %w", b.Id, err)
+	}
+
+	closes, err := parseClock(*b.ClosesAt)
+	if err != nil {
+		return false, fmt.Errorf("building %s: closes_at: %w", b.Id, err)
+	}
+
+	loc, err := b.Location()
+	if err != nil {
+		return false, err
+	}
+
+	from := timeFrom.In(loc)
+	to := timeTo.In(loc)
+
+	// Bounds are wall clock of the day, so they hold on days of DST change.
+	clock := func(d time.Duration) time.Time {
+		return time.Date(from.Year(), from.Month(), from.Day(), int(d/time.Hour), int(d%time.Hour/time.Minute), 0, 0, loc)
+	}
+
+	return !from.Before(clock(opens)) && !to.After(clock(closes)), nil
+}
+
+func parseClock(clock string) (time.Duration, error) {
+	if clock == "24:00" {
+		return 24 * time.Hour, nil
+	}
+
+	t, err := time.Parse("15:04", clock)
+	if err != nil || len(clock) != len("15:04") {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidOpeningHours, clock)
+	}
+
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
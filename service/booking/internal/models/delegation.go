@@ -0,0 +1,15 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Delegation allows delegate to book on behalf of user.
+type Delegation struct {
+	Id         uuid.UUID `db:"id"`
+	UserId     uuid.UUID `db:"user_id"`
+	DelegateId uuid.UUID `db:"delegate_id"`
+	CreatedAt  time.Time `db:"created_at"`
+}
@@ -10,16 +10,46 @@ var (
 
 	ErrFloorNotFound = errors.New("floor not found")
 
+	ErrBuildingNotFound    = errors.New("building not found")
+	ErrBuildingClosed      = errors.New("building closed")
+	ErrInvalidOpeningHours = errors.New("invalid opening hours")
+	ErrInvalidTimezone     = errors.New("invalid time zone")
+
 	ErrBookingEntityNotFound = errors.New("booking entity not found")
+	ErrEntityRestricted      = errors.New("booking entity restricted")
 
 	ErrBookingNotFound    = errors.New("booking not found")
 	ErrAlreadyHaveBooking = errors.New("already have booking")
 	ErrNoFreePlaces       = errors.New("no free places")
+	ErrNotEnoughSeats     = errors.New("not enough seats")
 	ErrNoAccessToBooking  = errors.New("no access to booking")
 	ErrInvalidBookingTime = errors.New("invalid booking time")
+	ErrInvalidBookingSlot = errors.New("invalid booking slot")
+	ErrBookingInGroup     = errors.New("booking in group")
+	ErrInvalidCursor      = errors.New("invalid cursor")
+	ErrBookingModified    = errors.New("booking was modified")
+	ErrBookingNotActive   = errors.New("booking is not active")
+
+	ErrBookingGroupNotFound       = errors.New("booking group not found")
+	ErrBookingGroupMemberNotFound = errors.New("booking group member not found")
+	ErrNoAccessToBookingGroup     = errors.New("no access to booking group")
 
 	ErrNoRights = errors.New("no rights")
 
+	ErrQuotaExceeded         = errors.New("quota exceeded")
+	ErrQuotaOverrideNotFound = errors.New("quota override not found")
+
+	ErrDelegationNotFound      = errors.New("delegation not found")
+	ErrDelegationAlreadyExists = errors.New("delegation already exists")
+	ErrSelfDelegation          = errors.New("self delegation")
+	ErrNoDelegation            = errors.New("no delegation")
+
+	ErrTransferNotFound      = errors.New("transfer not found")
+	ErrTransferAlreadyExists = errors.New("transfer already exists")
+	ErrSelfTransfer          = errors.New("self transfer")
+	ErrBookingFinished       = errors.New("booking is finished")
+	ErrSwapMismatch          = errors.New("bookings can't be swapped")
+
 	ErrOrderNotFound = errors.New("order not found")
 
 	ErrGuestNotFounc       = errors.New("guest not found")
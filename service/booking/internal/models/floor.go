@@ -7,8 +7,10 @@ import (
 )
 
 type Floor struct {
-	Id        uuid.UUID `db:"id"`
-	Name      string    `db:"name"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	Id         uuid.UUID  `db:"id"`
+	Name       string     `db:"name"`
+	CreatedAt  time.Time  `db:"created_at"`
+	UpdatedAt  time.Time  `db:"updated_at"`
+	BuildingId *uuid.UUID `db:"building_id"`
+	Version    int        `db:"version"`
 }
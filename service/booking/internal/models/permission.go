@@ -0,0 +1,13 @@
+package models
+
+// Permission comes from permissions claim of access token. Constants are
+// named as in coffee-id, which grants them to roles.
+type Permission string
+
+const (
+	BOOKING_READ_ANY  Permission = "booking.read.any"
+	BOOKING_WRITE_ANY Permission = "booking.write.any"
+	ORDER_READ_ANY    Permission = "order.read.any"
+	ORDER_WRITE_ANY   Permission = "order.write.any"
+	QUOTA_MANAGE      Permission = "quota.manage"
+)
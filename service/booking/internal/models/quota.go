@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// QuotaLimits are booking limits of user, zero value of limit means no limit.
+type QuotaLimits struct {
+	HoursPerWeek        int
+	ActiveBookings      int
+	PremiumHoursPerWeek int
+}
+
+// QuotaOverride replaces default limits for user, team or role, nil limits are inherited.
+type QuotaOverride struct {
+	Id                  uuid.UUID  `db:"id"`
+	UserId              *uuid.UUID `db:"user_id"`
+	TeamId              *uuid.UUID `db:"team_id"`
+	Role                *Role      `db:"role"`
+	HoursPerWeek        *int       `db:"hours_per_week"`
+	ActiveBookings      *int       `db:"active_bookings"`
+	PremiumHoursPerWeek *int       `db:"premium_hours_per_week"`
+	CreatedAt           time.Time  `db:"created_at"`
+	UpdatedAt           time.Time  `db:"updated_at"`
+}
+
+func (qo QuotaOverride) Apply(limits QuotaLimits) QuotaLimits {
+	if qo.HoursPerWeek != nil {
+		limits.HoursPerWeek = *qo.HoursPerWeek
+	}
+	if qo.ActiveBookings != nil {
+		limits.ActiveBookings = *qo.ActiveBookings
+	}
+	if qo.PremiumHoursPerWeek != nil {
+		limits.PremiumHoursPerWeek = *qo.PremiumHoursPerWeek
+	}
+
+	return limits
+}
+
+type QuotaUsage struct {
+	Week           time.Duration
+	ActiveBookings int
+	PremiumWeek    time.Duration
+}
+
+type QuotaStatus struct {
+	Limits QuotaLimits
+	Used   QuotaUsage
+}
+
+// BookedInterval is a booking as seen by quotas.
+type BookedInterval struct {
+	BookingId uuid.UUID `db:"id"`
+	EntityId  uuid.UUID `db:"entity_id"`
+	TimeFrom  time.Time `db:"time_from"`
+	TimeTo    time.Time `db:"time_to"`
+	IsPremium bool      `db:"is_premium"`
+
+	// Location is time zone of building of entity, quota weeks of new
+	// bookings start in it. Nil means UTC.
+	Location *time.Location `db:"-"`
+}
@@ -1,10 +1,21 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"slices"
+
+	"github.com/google/uuid"
+)
 
 type Token struct {
-	UserId uuid.UUID
-	Role   Role
+	UserId      uuid.UUID
+	Role        Role
+	Permissions []Permission
+}
+
+// Can reports whether permissions claim of token has permission.
+// Services check permissions of token, never its role.
+func (t Token) Can(permission Permission) bool {
+	return slices.Contains(t.Permissions, permission)
 }
 
 func TokenFromCliams(claims map[string]any) (Token, error) {
@@ -28,8 +39,22 @@ func TokenFromCliams(claims map[string]any) (Token, error) {
 		return Token{}, ErrInvalidToken
 	}
 
+	// Tokens issued before permissions were introduced have no such claim.
+	rawPermissions, _ := claims["permissions"].([]any)
+
+	permissions := make([]Permission, 0, len(rawPermissions))
+	for _, raw := range rawPermissions {
+		permission, ok := raw.(string)
+		if !ok {
+			return Token{}, ErrInvalidToken
+		}
+
+		permissions = append(permissions, Permission(permission))
+	}
+
 	return Token{
-		UserId: userId,
-		Role:   role,
+		UserId:      userId,
+		Role:        role,
+		Permissions: permissions,
 	}, nil
 }
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenFromClaims(t *testing.T) {
+	userId := uuid.New()
+
+	token, err := TokenFromCliams(map[string]any{
+		"id":          userId.String(),
+		"role":        "SUPPORT",
+		"permissions": []any{"booking.read.any", "order.read.any"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, userId, token.UserId)
+	assert.Equal(t, RoleSupport, token.Role)
+	assert.True(t, token.Can(BOOKING_READ_ANY))
+	assert.True(t, token.Can(ORDER_READ_ANY))
+	assert.False(t, token.Can(BOOKING_WRITE_ANY))
+	assert.False(t, token.Can(QUOTA_MANAGE))
+
+	// Admin role without permissions in token grants nothing.
+	token, err = TokenFromCliams(map[string]any{
+		"id":   userId.String(),
+		"role": "ADMIN",
+	})
+	require.NoError(t, err)
+	assert.False(t, token.Can(BOOKING_WRITE_ANY))
+
+	_, err = TokenFromCliams(map[string]any{
+		"id":          userId.String(),
+		"role":        "ADMIN",
+		"permissions": []any{42},
+	})
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	_, err = TokenFromCliams(map[string]any{
+		"id":   userId.String(),
+		"role": "OWNER",
+	})
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
@@ -6,4 +6,15 @@ type User struct {
 	Id    uuid.UUID `json:"id"`
 	Email string    `json:"email"`
 	Name  string    `json:"name"`
+	Role  Role      `json:"role"`
+}
+
+// UnknownUserName is shown instead of name of user, who can't be read from coffee-id.
+const UnknownUserName = "Unknown user"
+
+func UnknownUser(id uuid.UUID) User {
+	return User{
+		Id:   id,
+		Name: UnknownUserName,
+	}
 }
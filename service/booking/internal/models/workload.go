@@ -5,15 +5,18 @@ import (
 )
 
 type WorkloadItem struct {
-	Time   time.Time
-	IsFree bool
+	Time       time.Time
+	IsFree     bool
+	FreePlaces int
 }
 
 type Workload []WorkloadItem
 
 type FloorWorkloadItem struct {
-	Entity BookingEntity
-	IsFree bool
+	Entity       BookingEntity
+	IsFree       bool
+	IsRestricted bool
+	FreePlaces   int
 }
 
 type FloorWorkload []FloorWorkloadItem
@@ -0,0 +1,12 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"REDACTED/team-11/backend/booking/internal/models"
+)
+
+type AccessRulesRepo interface {
+	ListForEntities(ctx context.Context, entityIds []uuid.UUID) (map[uuid.UUID][]models.EntityAccessRule, error)
+}
@@ -0,0 +1,20 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"REDACTED/team-11/backend/booking/internal/dto"
+	"REDACTED/team-11/backend/booking/internal/models"
+)
+
+type BookingGroupsRepo interface {
+	Create(ctx context.Context, input dto.BookingGroupCreateDto) (models.BookingGroup, error)
+	GetById(ctx context.Context, id uuid.UUID) (models.BookingGroup, error)
+	UpdateTime(ctx context.Context, id uuid.UUID, timeFrom, timeTo time.Time) (models.BookingGroup, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	AddMember(ctx context.Context, id uuid.UUID, item dto.BookingGroupItem, bookedBy uuid.UUID) (models.Booking, error)
+	DeleteMember(ctx context.Context, id uuid.UUID, userId uuid.UUID) error
+}
@@ -12,11 +12,13 @@ import (
 type BookingsRepo interface {
 	Create(ctx context.Context, input dto.BookingCreateDto) (models.Booking, error)
 	GetById(ctx context.Context, id uuid.UUID) (models.Booking, error)
-	ListForUser(ctx context.Context, userId uuid.UUID) ([]models.Booking, error)
 	Update(ctx context.Context, input dto.BookingUpdateDto) (models.Booking, error)
-	Delete(ctx context.Context, id uuid.UUID) error
+	Delete(ctx context.Context, id uuid.UUID, version *int) error
 
-	ListAll(ctx context.Context) ([]models.Booking, error)
+	Resize(ctx context.Context, input dto.BookingResizeDto) (models.Booking, error)
+	ListHistory(ctx context.Context, bookingId uuid.UUID) ([]models.BookingHistoryItem, error)
+
+	ListInfo(ctx context.Context, filter dto.BookingListFilter, after *dto.BookingCursor) ([]models.BookingInfo, error)
 
 	ListIntersectedForUser(ctx context.Context, userId uuid.UUID, timeFrom, timeTo time.Time) ([]models.Booking, error)
 	ListIntersectedForEntity(ctx context.Context, entityId uuid.UUID, timeFrom, timeTo time.Time) ([]models.Booking, error)
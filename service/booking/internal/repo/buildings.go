@@ -0,0 +1,13 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"REDACTED/team-11/backend/booking/internal/models"
+)
+
+type BuildingsRepo interface {
+	GetById(ctx context.Context, id uuid.UUID) (models.Building, error)
+	GetForEntity(ctx context.Context, entityId uuid.UUID) (models.Building, error)
+}
@@ -0,0 +1,13 @@
+package coffeeid
+
+import "time"
+
+type Config struct {
+	Timeout          time.Duration `env:"COFFEE_ID_TIMEOUT" env-default:"2s"`
+	Retries          int           `env:"COFFEE_ID_RETRIES" env-default:"2"`
+	RetryBackoff     time.Duration `env:"COFFEE_ID_RETRY_BACKOFF" env-default:"100ms"`
+	CacheSize        int           `env:"COFFEE_ID_CACHE_SIZE" env-default:"1000"`
+	CacheTTL         time.Duration `env:"COFFEE_ID_CACHE_TTL" env-default:"5m"`
+	BreakerThreshold int           `env:"COFFEE_ID_BREAKER_THRESHOLD" env-default:"5"`
+	BreakerCooldown  time.Duration `env:"COFFEE_ID_BREAKER_COOLDOWN" env-default:"30s"`
+}
@@ -1,51 +1,282 @@
 package coffeeid
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	neturl "net/url"
+	"time"
 
 	"github.com/google/uuid"
 	"REDACTED/team-11/backend/booking/internal/models"
+	"REDACTED/team-11/backend/booking/pkg/breaker"
+	"REDACTED/team-11/backend/booking/pkg/lru"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
+// batchSize is the most ids coffee-id accepts in one batch request.
+const batchSize = 100
+
+var errUnavailable = errors.New("coffee-id is unavailable")
+
+// Authorizer sets service token of booking to request, internal endpoints of coffee-id
+// don't allow anonymous access.
+type Authorizer interface {
+	Authorize(req *http.Request) error
+}
+
+// UsersRepo reads users from coffee-id. Users are cached, requests are retried with
+// backoff and stopped by circuit breaker while coffee-id is failing.
 type UsersRepo struct {
 	coffeeIdBaseUrl string
+	credentials     Authorizer
+	cfg             Config
+	httpClient      *http.Client
+	cache           *lru.Cache[uuid.UUID, models.User]
+	breaker         *breaker.Breaker
 }
 
 func NewUserRepo(
 	coffeeIdBaseUrl string,
+	credentials Authorizer,
+	cfg Config,
 ) *UsersRepo {
 	return &UsersRepo{
 		coffeeIdBaseUrl: coffeeIdBaseUrl,
+		credentials:     credentials,
+		cfg:             cfg,
+		httpClient:      &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)},
+		cache:           lru.New[uuid.UUID, models.User](cfg.CacheSize, cfg.CacheTTL),
+		breaker:         breaker.New(cfg.BreakerThreshold, cfg.BreakerCooldown),
 	}
 }
 
 func (ur *UsersRepo) GetById(ctx context.Context, id uuid.UUID) (models.User, error) {
 	op := "coffee-id.UserRepo.GetById"
 
-	url := fmt.Sprintf("%s/account/%s", ur.coffeeIdBaseUrl, id.String())
+	if user, ok := ur.cache.Get(id); ok {
+		return user, nil
+	}
+
+	url := fmt.Sprintf("%s/internal/users/%s", ur.coffeeIdBaseUrl, id.String())
 
-	resp, err := http.Get(url)
+	code, body, err := ur.do(ctx, http.MethodGet, url, nil)
 	if err != nil {
-		return models.User{}, fmt.Errorf("%s: make request: %w", op, err)
+		return models.User{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		if resp.StatusCode == http.StatusNotFound {
+	if code != http.StatusOK {
+		if code == http.StatusNotFound {
 			return models.User{}, models.ErrUserNotFound
 		}
 
-		return models.User{}, fmt.Errorf("%s: get user by id: unexpected code %d", op, resp.StatusCode)
+		return models.User{}, fmt.Errorf("%s: get user by id: unexpected code %d", op, code)
 	}
 
 	var user models.User
-	defer resp.Body.Close()
-	err = json.NewDecoder(resp.Body).Decode(&user)
+	if err := json.Unmarshal(body, &user); err != nil {
+		return models.User{}, fmt.Errorf("%s: json.Unmarshal: %w", op, err)
+	}
+
+	ur.cache.Set(id, user)
+
+	return user, nil
+}
+
+// GetByEmail finds user by email. Found user is cached by id, but emails
+// themselves are always looked up in coffee-id.
+func (ur *UsersRepo) GetByEmail(ctx context.Context, email string) (models.User, error) {
+	op := "coffee-id.UserRepo.GetByEmail"
+
+	url := fmt.Sprintf("%s/internal/users/email/%s", ur.coffeeIdBaseUrl, neturl.PathEscape(email))
+
+	code, body, err := ur.do(ctx, http.MethodGet, url, nil)
 	if err != nil {
-		return models.User{}, fmt.Errorf("%s: json.Decode: %w", op, err)
+		return models.User{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if code != http.StatusOK {
+		if code == http.StatusNotFound {
+			return models.User{}, models.ErrUserNotFound
+		}
+
+		return models.User{}, fmt.Errorf("%s: get user by email: unexpected code %d", op, code)
+	}
+
+	var user models.User
+	if err := json.Unmarshal(body, &user); err != nil {
+		return models.User{}, fmt.Errorf("%s: json.Unmarshal: %w", op, err)
 	}
 
+	ur.cache.Set(user.Id, user)
+
 	return user, nil
 }
+
+// GetByIds returns users by ids with as few requests as possible. Unknown users are
+// missing in result.
+func (ur *UsersRepo) GetByIds(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]models.User, error) {
+	op := "coffee-id.UserRepo.GetByIds"
+
+	users := make(map[uuid.UUID]models.User, len(ids))
+	missing := make([]uuid.UUID, 0, len(ids))
+	seen := make(map[uuid.UUID]struct{}, len(ids))
+
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+
+		seen[id] = struct{}{}
+
+		if user, ok := ur.cache.Get(id); ok {
+			users[id] = user
+			continue
+		}
+
+		missing = append(missing, id)
+	}
+
+	for start := 0; start < len(missing); start += batchSize {
+		batch := missing[start:min(start+batchSize, len(missing))]
+
+		found, err := ur.getBatch(ctx, batch)
+		if err != nil {
+			return users, fmt.Errorf("%s: %w", op, err)
+		}
+
+		for _, user := range found {
+			ur.cache.Set(user.Id, user)
+			users[user.Id] = user
+		}
+	}
+
+	return users, nil
+}
+
+func (ur *UsersRepo) getBatch(ctx context.Context, ids []uuid.UUID) ([]models.User, error) {
+	payload, err := json.Marshal(struct {
+		Ids []uuid.UUID `json:"ids"`
+	}{ids})
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/internal/users/batch", ur.coffeeIdBaseUrl)
+
+	code, body, err := ur.do(ctx, http.MethodPost, url, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if code != http.StatusOK {
+		return nil, fmt.Errorf("get users batch: unexpected code %d", code)
+	}
+
+	var users []models.User
+	if err := json.Unmarshal(body, &users); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	return users, nil
+}
+
+func (ur *UsersRepo) ListTeams(ctx context.Context, id uuid.UUID) ([]models.Team, error) {
+	op := "coffee-id.UserRepo.ListTeams"
+
+	url := fmt.Sprintf("%s/internal/users/%s/teams", ur.coffeeIdBaseUrl, id.String())
+
+	code, body, err := ur.do(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if code != http.StatusOK {
+		if code == http.StatusNotFound {
+			return nil, models.ErrUserNotFound
+		}
+
+		return nil, fmt.Errorf("%s: list teams: unexpected code %d", op, code)
+	}
+
+	var teams []models.Team
+	if err := json.Unmarshal(body, &teams); err != nil {
+		return nil, fmt.Errorf("%s: json.Unmarshal: %w", op, err)
+	}
+
+	return teams, nil
+}
+
+// do makes request with timeout and retries it with exponential backoff on network
+// errors and 5xx. Answers of coffee-id other than 5xx are returned to caller.
+func (ur *UsersRepo) do(ctx context.Context, method, url string, payload []byte) (int, []byte, error) {
+	if err := ur.breaker.Allow(); err != nil {
+		return 0, nil, fmt.Errorf("%w: %w", errUnavailable, err)
+	}
+
+	var lastErr error
+
+	for attempt := 0; attempt <= ur.cfg.Retries; attempt++ {
+		if attempt > 0 {
+			backoff := ur.cfg.RetryBackoff << (attempt - 1)
+
+			select {
+			case <-ctx.Done():
+				ur.breaker.Failure()
+				return 0, nil, ctx.Err()
+			case <-time.After(backoff):
+			}
+		}
+
+		code, body, err := ur.attempt(ctx, method, url, payload)
+		if err == nil && code < http.StatusInternalServerError {
+			ur.breaker.Success()
+			return code, body, nil
+		}
+
+		if err != nil {
+			lastErr = err
+		} else {
+			lastErr = fmt.Errorf("unexpected code %d", code)
+		}
+	}
+
+	ur.breaker.Failure()
+
+	return 0, nil, fmt.Errorf("%w: %w", errUnavailable, lastErr)
+}
+
+func (ur *UsersRepo) attempt(ctx context.Context, method, url string, payload []byte) (int, []byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, ur.cfg.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(payload))
+	if err != nil {
+		return 0, nil, fmt.Errorf("new request: %w", err)
+	}
+
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if err := ur.credentials.Authorize(req); err != nil {
+		return 0, nil, fmt.Errorf("authorize: %w", err)
+	}
+
+	resp, err := ur.httpClient.Do(req)
+	if err != nil {
+		return 0, nil, fmt.Errorf("make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, fmt.Errorf("read body: %w", err)
+	}
+
+	return resp.StatusCode, body, nil
+}
@@ -0,0 +1,147 @@
+package coffeeid
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"REDACTED/team-11/backend/booking/internal/models"
+	"REDACTED/team-11/backend/booking/pkg/breaker"
+)
+
+type noAuth struct{}
+
+func (noAuth) Authorize(req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer token")
+	return nil
+}
+
+var testConfig = Config{
+	Timeout:          time.Second,
+	Retries:          2,
+	RetryBackoff:     time.Millisecond,
+	CacheSize:        10,
+	CacheTTL:         time.Minute,
+	BreakerThreshold: 2,
+	BreakerCooldown:  time.Minute,
+}
+
+func TestGetByIds(t *testing.T) {
+	known := models.User{Id: uuid.New(), Email: "user@coffee.id", Name: "User", Role: models.RoleAdmin}
+
+	var batches atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/internal/users/batch", r.URL.Path)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+		// The first call fails, so it is retried.
+		if batches.Add(1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		var body struct {
+			Ids []uuid.UUID `json:"ids"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		users := make([]models.User, 0)
+		for _, id := range body.Ids {
+			if id == known.Id {
+				users = append(users, known)
+			}
+		}
+
+		json.NewEncoder(w).Encode(users)
+	}))
+	defer server.Close()
+
+	repo := NewUserRepo(server.URL, noAuth{}, testConfig)
+	unknown := uuid.New()
+
+	users, err := repo.GetByIds(context.Background(), []uuid.UUID{known.Id, unknown, known.Id})
+	require.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]models.User{known.Id: known}, users)
+	assert.Equal(t, int32(2), batches.Load())
+
+	// Known user is cached now.
+	user, err := repo.GetById(context.Background(), known.Id)
+	require.NoError(t, err)
+	assert.Equal(t, known, user)
+	assert.Equal(t, int32(2), batches.Load())
+}
+
+func TestGetByIdNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	repo := NewUserRepo(server.URL, noAuth{}, testConfig)
+
+	_, err := repo.GetById(context.Background(), uuid.New())
+	assert.ErrorIs(t, err, models.ErrUserNotFound)
+}
+
+func TestGetByEmail(t *testing.T) {
+	known := models.User{Id: uuid.New(), Email: "user+desk@coffee.id", Name: "User"}
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+
+		if r.URL.Path != "/internal/users/email/"+known.Email {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		json.NewEncoder(w).Encode(known)
+	}))
+	defer server.Close()
+
+	repo := NewUserRepo(server.URL, noAuth{}, testConfig)
+
+	user, err := repo.GetByEmail(context.Background(), known.Email)
+	require.NoError(t, err)
+	assert.Equal(t, known, user)
+
+	_, err = repo.GetByEmail(context.Background(), "unknown@coffee.id")
+	assert.ErrorIs(t, err, models.ErrUserNotFound)
+
+	// Found user is cached by id.
+	user, err = repo.GetById(context.Background(), known.Id)
+	require.NoError(t, err)
+	assert.Equal(t, known, user)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestBreaker(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	repo := NewUserRepo(server.URL, noAuth{}, testConfig)
+
+	for range testConfig.BreakerThreshold {
+		_, err := repo.GetById(context.Background(), uuid.New())
+		assert.ErrorIs(t, err, errUnavailable)
+	}
+
+	assert.Equal(t, int32(testConfig.BreakerThreshold*(testConfig.Retries+1)), calls.Load())
+
+	// Breaker is open, so coffee-id isn't called.
+	_, err := repo.GetById(context.Background(), uuid.New())
+	assert.True(t, errors.Is(err, breaker.ErrOpen))
+	assert.Equal(t, int32(testConfig.BreakerThreshold*(testConfig.Retries+1)), calls.Load())
+}
@@ -0,0 +1,16 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"REDACTED/team-11/backend/booking/internal/models"
+)
+
+type DelegationsRepo interface {
+	Create(ctx context.Context, userId, delegateId uuid.UUID) (models.Delegation, error)
+	Get(ctx context.Context, userId, delegateId uuid.UUID) (models.Delegation, error)
+	ListForUser(ctx context.Context, userId uuid.UUID) ([]models.Delegation, error)
+	ListForDelegate(ctx context.Context, delegateId uuid.UUID) ([]models.Delegation, error)
+	Delete(ctx context.Context, userId, delegateId uuid.UUID) error
+}
@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"REDACTED/team-11/backend/booking/internal/models"
+)
+
+var (
+	accessRulesTable = "booking_entity_access"
+)
+
+type AccessRulesRepo struct {
+	db *sqlx.DB
+	sq sq.StatementBuilderType
+}
+
+func NewAccessRulesRepo(db *sqlx.DB) *AccessRulesRepo {
+	return &AccessRulesRepo{
+		db: db,
+		sq: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (ar *AccessRulesRepo) ListForEntities(ctx context.Context, entityIds []uuid.UUID) (map[uuid.UUID][]models.EntityAccessRule, error) {
+	op := "postgres.AccessRulesRepo.ListForEntities"
+
+	res := make(map[uuid.UUID][]models.EntityAccessRule, len(entityIds))
+	if len(entityIds) == 0 {
+		return res, nil
+	}
+
+	query, args, err := ar.sq.
+		Select("*").
+		From(accessRulesTable).
+		Where(sq.Eq{"entity_id": entityIds}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	var rules []models.EntityAccessRule
+	if err := ar.db.SelectContext(ctx, &rules, query, args...); err != nil {
+		return nil, fmt.Errorf("%s: db.SelectContext: %w", op, err)
+	}
+
+	for _, rule := range rules {
+		res[rule.EntityId] = append(res[rule.EntityId], rule)
+	}
+
+	return res, nil
+}
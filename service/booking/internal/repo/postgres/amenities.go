@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"REDACTED/team-11/backend/booking/internal/models"
+)
+
+var (
+	amenitiesTable = "amenity"
+)
+
+type AmenitiesRepo struct {
+	db *sqlx.DB
+	sq sq.StatementBuilderType
+}
+
+func NewAmenitiesRepo(db *sqlx.DB) *AmenitiesRepo {
+	return &AmenitiesRepo{
+		db: db,
+		sq: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (ar *AmenitiesRepo) ListForEntities(ctx context.Context, entityIds []uuid.UUID) (map[uuid.UUID][]models.Amenity, error) {
+	op := "postgres.AmenitiesRepo.ListForEntities"
+
+	res := make(map[uuid.UUID][]models.Amenity, len(entityIds))
+	if len(entityIds) == 0 {
+		return res, nil
+	}
+
+	query, args, err := ar.sq.
+		Select("ea.entity_id", "a.*").
+		From(fmt.Sprintf("%s AS a", amenitiesTable)).
+		Join(fmt.Sprintf("%s AS ea ON ea.amenity_id = a.id", entityAmenitiesTable)).
+		Where(sq.Eq{"ea.entity_id": entityIds}).
+		OrderBy("a.name").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	var rows []models.EntityAmenity
+	if err := ar.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("%s: db.SelectContext: %w", op, err)
+	}
+
+	for _, row := range rows {
+		res[row.EntityId] = append(res[row.EntityId], row.Amenity)
+	}
+
+	return res, nil
+}
@@ -9,11 +9,13 @@ import (
 	sq "github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"REDACTED/team-11/backend/booking/internal/dto"
 	"REDACTED/team-11/backend/booking/internal/models"
 )
 
 var (
 	bookingEntitiesTable = "booking_entity"
+	entityAmenitiesTable = "booking_entity_amenity"
 )
 
 type BookingEntitiesRepo struct {
@@ -52,13 +54,15 @@ func (ber *BookingEntitiesRepo) GetById(ctx context.Context, id uuid.UUID) (mode
 	return res, nil
 }
 
-func (ber *BookingEntitiesRepo) GetForFloor(ctx context.Context, floorId uuid.UUID) ([]models.BookingEntity, error) {
+func (ber *BookingEntitiesRepo) GetForFloor(ctx context.Context, floorId uuid.UUID, filter dto.BookingEntityFilter) ([]models.BookingEntity, error) {
 	op := "postgres.BookingEntitiesRepo.GetForFloor"
 
-	query, args, err := ber.sq.
+	qb := ber.sq.
 		Select("*").
 		From(bookingEntitiesTable).
-		Where(sq.Eq{"floor_id": floorId}).
+		Where(sq.Eq{"floor_id": floorId})
+
+	query, args, err := applyBookingEntityFilter(qb, "id", filter).
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("%s: build query: %w", op, err)
@@ -71,3 +75,49 @@ func (ber *BookingEntitiesRepo) GetForFloor(ctx context.Context, floorId uuid.UU
 
 	return res, nil
 }
+
+func (ber *BookingEntitiesRepo) GetForBuilding(ctx context.Context, buildingId uuid.UUID, filter dto.BookingEntityFilter) ([]models.BookingEntity, error) {
+	op := "postgres.BookingEntitiesRepo.GetForBuilding"
+
+	qb := ber.sq.
+		Select("e.*").
+		From(fmt.Sprintf("%s AS e", bookingEntitiesTable)).
+		Join(fmt.Sprintf("%s AS f ON f.id = e.floor_id", floorsTable)).
+		Where(sq.Eq{"f.building_id": buildingId})
+
+	query, args, err := applyBookingEntityFilter(qb, "e.id", filter).
+		OrderBy("f.name", "e.title").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	var res []models.BookingEntity
+	if err := ber.db.SelectContext(ctx, &res, query, args...); err != nil {
+		return nil, fmt.Errorf("%s: db.SelectContext: %w", op, err)
+	}
+
+	return res, nil
+}
+
+func applyBookingEntityFilter(qb sq.SelectBuilder, idColumn string, filter dto.BookingEntityFilter) sq.SelectBuilder {
+	if len(filter.Amenities) == 0 {
+		return qb
+	}
+
+	// Amenity repeated in query must not raise the count entity has to reach.
+	amenities := make(map[uuid.UUID]struct{}, len(filter.Amenities))
+	for _, id := range filter.Amenities {
+		amenities[id] = struct{}{}
+	}
+
+	subquery, args, _ := sq.
+		Select("entity_id").
+		From(entityAmenitiesTable).
+		Where(sq.Eq{"amenity_id": sortedIds(amenities)}).
+		GroupBy("entity_id").
+		Having("COUNT(DISTINCT amenity_id) = ?", len(amenities)).
+		ToSql()
+
+	return qb.Where(fmt.Sprintf("%s IN (%s)", idColumn, subquery), args...)
+}
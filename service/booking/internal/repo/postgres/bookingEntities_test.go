@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"testing"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"REDACTED/team-11/backend/booking/internal/dto"
+)
+
+func TestApplyBookingEntityFilter(t *testing.T) {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	floorId := uuid.New()
+	qb := builder.Select("*").From(bookingEntitiesTable).Where(sq.Eq{"floor_id": floorId})
+
+	query, args, err := applyBookingEntityFilter(qb, "id", dto.BookingEntityFilter{}).ToSql()
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT * FROM booking_entity WHERE floor_id = $1", query)
+	assert.Equal(t, []any{floorId.String()}, args)
+
+	projector := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	whiteboard := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+
+	query, args, err = applyBookingEntityFilter(qb, "id", dto.BookingEntityFilter{
+		Amenities: []uuid.UUID{whiteboard, projector, whiteboard},
+	}).ToSql()
+	require.NoError(t, err)
+
+	// Entity must have every amenity, duplicates are counted once.
+	assert.Equal(t, "SELECT * FROM booking_entity WHERE floor_id = $1 AND id IN ("+
+		"SELECT entity_id FROM booking_entity_amenity WHERE amenity_id IN ($2,$3) "+
+		"GROUP BY entity_id HAVING COUNT(DISTINCT amenity_id) = $4)", query)
+	assert.Equal(t, []any{floorId.String(), projector, whiteboard, 2}, args)
+}
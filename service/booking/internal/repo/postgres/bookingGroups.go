@@ -0,0 +1,538 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"REDACTED/team-11/backend/booking/internal/dto"
+	"REDACTED/team-11/backend/booking/internal/models"
+)
+
+var (
+	bookingGroupsTable = "booking_group"
+)
+
+type BookingGroupsRepo struct {
+	db *sqlx.DB
+	sq sq.StatementBuilderType
+}
+
+func NewBookingGroupsRepo(db *sqlx.DB) *BookingGroupsRepo {
+	return &BookingGroupsRepo{
+		db: db,
+		sq: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+// Create inserts group and all its bookings in one transaction. Members and
+// entities are checked again inside the transaction, see lockAndCheck.
+func (bgr *BookingGroupsRepo) Create(ctx context.Context, input dto.BookingGroupCreateDto) (models.BookingGroup, error) {
+	op := "postgres.BookingGroupsRepo.Create"
+
+	tx, err := bgr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return models.BookingGroup{}, fmt.Errorf("%s: db.BeginTxx: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if err := bgr.lockAndCheck(ctx, tx, input.Items, input.TimeFrom, input.TimeTo, nil, nil); err != nil {
+		if isGroupConflict(err) {
+			return models.BookingGroup{}, err
+		}
+
+		return models.BookingGroup{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query, args, err := bgr.sq.
+		Insert(bookingGroupsTable).
+		Columns("owner_id", "time_from", "time_to").
+		Values(input.Requester.UserId, input.TimeFrom, input.TimeTo).
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		return models.BookingGroup{}, fmt.Errorf("%s: build group query: %w", op, err)
+	}
+
+	var group models.BookingGroup
+	if err := tx.GetContext(ctx, &group, query, args...); err != nil {
+		return models.BookingGroup{}, fmt.Errorf("%s: tx.GetContext: %w", op, err)
+	}
+
+	qb := bgr.sq.
+		Insert(bookingsTable).
+		Columns("entity_id", "user_id", "time_from", "time_to", "booked_by", "group_id")
+	for _, item := range input.Items {
+		qb = qb.Values(item.EntityId, item.UserId, input.TimeFrom, input.TimeTo, input.Requester.UserId, group.Id)
+	}
+
+	query, args, err = qb.
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		return models.BookingGroup{}, fmt.Errorf("%s: build bookings query: %w", op, err)
+	}
+
+	if err := tx.SelectContext(ctx, &group.Bookings, query, args...); err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			case "23503":
+				return models.BookingGroup{}, models.ErrBookingEntityNotFound
+			}
+		}
+
+		return models.BookingGroup{}, fmt.Errorf("%s: tx.SelectContext: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.BookingGroup{}, fmt.Errorf("%s: tx.Commit: %w", op, err)
+	}
+
+	return group, nil
+}
+
+func (bgr *BookingGroupsRepo) GetById(ctx context.Context, id uuid.UUID) (models.BookingGroup, error) {
+	op := "postgres.BookingGroupsRepo.GetById"
+
+	query, args, err := bgr.sq.
+		Select("*").
+		From(bookingGroupsTable).
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return models.BookingGroup{}, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	var group models.BookingGroup
+	if err := bgr.db.GetContext(ctx, &group, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.BookingGroup{}, models.ErrBookingGroupNotFound
+		}
+
+		return models.BookingGroup{}, fmt.Errorf("%s: db.GetContext: %w", op, err)
+	}
+
+	group.Bookings, err = bgr.listBookings(ctx, bgr.db, id)
+	if err != nil {
+		return models.BookingGroup{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return group, nil
+}
+
+// UpdateTime moves group and all its bookings to the new interval in one transaction.
+// Members and entities are checked again for the new interval inside the transaction.
+func (bgr *BookingGroupsRepo) UpdateTime(ctx context.Context, id uuid.UUID, timeFrom, timeTo time.Time) (models.BookingGroup, error) {
+	op := "postgres.BookingGroupsRepo.UpdateTime"
+
+	tx, err := bgr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return models.BookingGroup{}, fmt.Errorf("%s: db.BeginTxx: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if _, err := bgr.lockGroup(ctx, tx, id); err != nil {
+		if errors.Is(err, models.ErrBookingGroupNotFound) {
+			return models.BookingGroup{}, models.ErrBookingGroupNotFound
+		}
+
+		return models.BookingGroup{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	bookings, err := bgr.listBookings(ctx, tx, id)
+	if err != nil {
+		return models.BookingGroup{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	items := make([]dto.BookingGroupItem, 0, len(bookings))
+	for _, booking := range bookings {
+		items = append(items, dto.BookingGroupItem{
+			EntityId: booking.EntityId,
+			UserId:   booking.UserId,
+		})
+	}
+
+	if err := bgr.lockAndCheck(ctx, tx, items, timeFrom, timeTo, &id, &id); err != nil {
+		if isGroupConflict(err) {
+			return models.BookingGroup{}, err
+		}
+
+		return models.BookingGroup{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query, args, err := bgr.sq.
+		Update(bookingGroupsTable).
+		Set("time_from", timeFrom).
+		Set("time_to", timeTo).
+		Where(sq.Eq{"id": id}).
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		return models.BookingGroup{}, fmt.Errorf("%s: build group query: %w", op, err)
+	}
+
+	var group models.BookingGroup
+	if err := tx.GetContext(ctx, &group, query, args...); err != nil {
+		return models.BookingGroup{}, fmt.Errorf("%s: tx.GetContext: %w", op, err)
+	}
+
+	query, args, err = bgr.sq.
+		Update(bookingsTable).
+		Set("time_from", timeFrom).
+		Set("time_to", timeTo).
+		Where(sq.Eq{"group_id": id}).
+		ToSql()
+	if err != nil {
+		return models.BookingGroup{}, fmt.Errorf("%s: build bookings query: %w", op, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return models.BookingGroup{}, fmt.Errorf("%s: tx.ExecContext: %w", op, err)
+	}
+
+	group.Bookings, err = bgr.listBookings(ctx, tx, id)
+	if err != nil {
+		return models.BookingGroup{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.BookingGroup{}, fmt.Errorf("%s: tx.Commit: %w", op, err)
+	}
+
+	return group, nil
+}
+
+// AddMember books item for the whole interval of group. Member and entity
+// are checked inside the transaction, while the group is locked.
+func (bgr *BookingGroupsRepo) AddMember(ctx context.Context, id uuid.UUID, item dto.BookingGroupItem, bookedBy uuid.UUID) (models.Booking, error) {
+	op := "postgres.BookingGroupsRepo.AddMember"
+
+	tx, err := bgr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return models.Booking{}, fmt.Errorf("%s: db.BeginTxx: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	group, err := bgr.lockGroup(ctx, tx, id)
+	if err != nil {
+		if errors.Is(err, models.ErrBookingGroupNotFound) {
+			return models.Booking{}, models.ErrBookingGroupNotFound
+		}
+
+		return models.Booking{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	items := []dto.BookingGroupItem{item}
+
+	if err := bgr.lockAndCheck(ctx, tx, items, group.TimeFrom, group.TimeTo, &id, nil); err != nil {
+		if isGroupConflict(err) {
+			return models.Booking{}, err
+		}
+
+		return models.Booking{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query, args, err := bgr.sq.
+		Insert(bookingsTable).
+		Columns("entity_id", "user_id", "time_from", "time_to", "booked_by", "group_id").
+		Values(item.EntityId, item.UserId, group.TimeFrom, group.TimeTo, bookedBy, id).
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		return models.Booking{}, fmt.Errorf("%s: build booking query: %w", op, err)
+	}
+
+	var res models.Booking
+	if err := tx.GetContext(ctx, &res, query, args...); err != nil {
+		return models.Booking{}, fmt.Errorf("%s: tx.GetContext: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.Booking{}, fmt.Errorf("%s: tx.Commit: %w", op, err)
+	}
+
+	return res, nil
+}
+
+// Delete removes group, its bookings are removed by cascade.
+func (bgr *BookingGroupsRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	op := "postgres.BookingGroupsRepo.Delete"
+
+	query, args, err := bgr.sq.
+		Delete(bookingGroupsTable).
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	res, err := bgr.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%s: db.ExecContext: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: res.RowsAffected: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		return models.ErrBookingGroupNotFound
+	}
+
+	return nil
+}
+
+// DeleteMember removes all bookings of user in group and
+// removes the group itself if no bookings are left.
+func (bgr *BookingGroupsRepo) DeleteMember(ctx context.Context, id uuid.UUID, userId uuid.UUID) error {
+	op := "postgres.BookingGroupsRepo.DeleteMember"
+
+	tx, err := bgr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: db.BeginTxx: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	query, args, err := bgr.sq.
+		Delete(bookingsTable).
+		Where(sq.Eq{
+			"group_id": id,
+			"user_id":  userId,
+		}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: build bookings query: %w", op, err)
+	}
+
+	res, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%s: tx.ExecContext: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: res.RowsAffected: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		return models.ErrBookingGroupMemberNotFound
+	}
+
+	query, args, err = bgr.sq.
+		Delete(bookingGroupsTable).
+		Where(sq.And{
+			sq.Eq{"id": id},
+			sq.Expr(fmt.Sprintf("NOT EXISTS (SELECT 1 FROM %s WHERE group_id = ?)", bookingsTable), id),
+		}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: build group query: %w", op, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("%s: tx.ExecContext: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: tx.Commit: %w", op, err)
+	}
+
+	return nil
+}
+
+func (bgr *BookingGroupsRepo) listBookings(ctx context.Context, q sqlx.QueryerContext, id uuid.UUID) ([]models.Booking, error) {
+	query, args, err := bgr.sq.
+		Select("*").
+		From(bookingsTable).
+		Where(sq.Eq{"group_id": id}).
+		OrderBy("created_at", "id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build bookings query: %w", err)
+	}
+
+	res := []models.Booking{}
+	if err := sqlx.SelectContext(ctx, q, &res, query, args...); err != nil {
+		return nil, fmt.Errorf("sqlx.SelectContext: %w", err)
+	}
+
+	return res, nil
+}
+
+// lockGroup reads group and locks it until tx ends, so concurrent changes
+// of the same group are applied one by one.
+func (bgr *BookingGroupsRepo) lockGroup(ctx context.Context, tx *sqlx.Tx, id uuid.UUID) (models.BookingGroup, error) {
+	query, args, err := bgr.sq.
+		Select("*").
+		From(bookingGroupsTable).
+		Where(sq.Eq{"id": id}).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return models.BookingGroup{}, fmt.Errorf("build group query: %w", err)
+	}
+
+	var group models.BookingGroup
+	if err := tx.GetContext(ctx, &group, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.BookingGroup{}, models.ErrBookingGroupNotFound
+		}
+
+		return models.BookingGroup{}, fmt.Errorf("tx.GetContext: %w", err)
+	}
+
+	return group, nil
+}
+
+// lockAndCheck locks entities and members of items until tx ends and checks,
+// that items still fit into interval: members have no other bookings and
+// entities have enough free places. Concurrent group requests for the same
+// entities or members wait for each other, so they can`t overbook.
+// Bookings of ownGroup don`t intersect with members, bookings of movedGroup
+// don`t take places, since tx moves them.
+func (bgr *BookingGroupsRepo) lockAndCheck(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	items []dto.BookingGroupItem,
+	timeFrom, timeTo time.Time,
+	ownGroup, movedGroup *uuid.UUID,
+) error {
+	required := make(map[uuid.UUID]int)
+	members := make(map[uuid.UUID]struct{})
+	for _, item := range items {
+		required[item.EntityId]++
+		members[item.UserId] = struct{}{}
+	}
+
+	entityIds := sortedIds(required)
+	userIds := sortedIds(members)
+
+	query, args, err := bgr.sq.
+		Select("*").
+		From(bookingEntitiesTable).
+		Where(sq.Eq{"id": entityIds}).
+		OrderBy("id").
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build entities query: %w", err)
+	}
+
+	entities := []models.BookingEntity{}
+	if err := tx.SelectContext(ctx, &entities, query, args...); err != nil {
+		return fmt.Errorf("tx.SelectContext: %w", err)
+	}
+
+	if len(entities) != len(entityIds) {
+		return models.ErrBookingEntityNotFound
+	}
+
+	// Users are not stored in booking database, so they are locked by
+	// advisory locks in the same order by every transaction.
+	for _, userId := range userIds {
+		query, args, err := bgr.sq.
+			Select().
+			Column(sq.Expr("pg_advisory_xact_lock(hashtextextended(?, 0))", userId.String())).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("build lock query: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("tx.ExecContext: %w", err)
+		}
+	}
+
+	intersected, err := bgr.listIntersected(ctx, tx, sq.Eq{"user_id": userIds}, timeFrom, timeTo, ownGroup)
+	if err != nil {
+		return err
+	}
+
+	if len(intersected) != 0 {
+		return models.ErrAlreadyHaveBooking
+	}
+
+	taken, err := bgr.listIntersected(ctx, tx, sq.Eq{"entity_id": entityIds}, timeFrom, timeTo, movedGroup)
+	if err != nil {
+		return err
+	}
+
+	for _, entity := range entities {
+		bookings := make([]models.Booking, 0)
+		for _, booking := range taken {
+			if booking.EntityId == entity.Id {
+				bookings = append(bookings, booking)
+			}
+		}
+
+		if models.MaxTaken(bookings, timeFrom, timeTo)+required[entity.Id] > entity.Places() {
+			return models.ErrNoFreePlaces
+		}
+	}
+
+	return nil
+}
+
+// listIntersected returns bookings matching where, which intersect with
+// interval and are not a part of group exclude.
+func (bgr *BookingGroupsRepo) listIntersected(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	where sq.Sqlizer,
+	timeFrom, timeTo time.Time,
+	exclude *uuid.UUID,
+) ([]models.Booking, error) {
+	cond := sq.And{
+		where,
+		sq.Lt{"time_from": timeTo},
+		sq.Gt{"time_to": timeFrom},
+	}
+	if exclude != nil {
+		cond = append(cond, sq.Expr("group_id IS DISTINCT FROM ?", *exclude))
+	}
+
+	query, args, err := bgr.sq.
+		Select("*").
+		From(bookingsTable).
+		Where(cond).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build intersected query: %w", err)
+	}
+
+	res := []models.Booking{}
+	if err := tx.SelectContext(ctx, &res, query, args...); err != nil {
+		return nil, fmt.Errorf("tx.SelectContext: %w", err)
+	}
+
+	return res, nil
+}
+
+// isGroupConflict reports whether err means that group doesn`t fit anymore.
+func isGroupConflict(err error) bool {
+	return errors.Is(err, models.ErrBookingEntityNotFound) ||
+		errors.Is(err, models.ErrAlreadyHaveBooking) ||
+		errors.Is(err, models.ErrNoFreePlaces)
+}
+
+func sortedIds[V any](set map[uuid.UUID]V) []uuid.UUID {
+	res := make([]uuid.UUID, 0, len(set))
+	for id := range set {
+		res = append(res, id)
+	}
+
+	slices.SortFunc(res, func(a, b uuid.UUID) int {
+		return bytes.Compare(a[:], b[:])
+	})
+
+	return res
+}
@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"REDACTED/team-11/backend/booking/internal/dto"
+	"REDACTED/team-11/backend/booking/internal/models"
+)
+
+var (
+	bookingHistoryTable = "booking_history"
+)
+
+// Resize changes end of booking and records it in history in one
+// transaction. Booking is changed only if it still ends at PrevTimeTo.
+func (br *BookingsRepo) Resize(ctx context.Context, input dto.BookingResizeDto) (models.Booking, error) {
+	op := "postgres.BookingsRepo.Resize"
+
+	tx, err := br.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return models.Booking{}, fmt.Errorf("%s: db.BeginTxx: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	where := sq.Eq{
+		"id":      input.BookingId,
+		"time_to": input.PrevTimeTo,
+	}
+	if input.Version != nil {
+		where["version"] = *input.Version
+	}
+
+	query, args, err := br.sq.
+		Update(bookingsTable).
+		Set("time_to", input.TimeTo).
+		Where(where).
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		return models.Booking{}, fmt.Errorf("%s: build booking query: %w", op, err)
+	}
+
+	var res models.Booking
+	if err := tx.GetContext(ctx, &res, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Booking{}, models.ErrBookingModified
+		}
+
+		return models.Booking{}, fmt.Errorf("%s: tx.GetContext: %w", op, err)
+	}
+
+	query, args, err = br.sq.
+		Insert(bookingHistoryTable).
+		Columns("booking_id", "action", "actor_id", "prev_time_to", "time_to").
+		Values(input.BookingId, input.Action, input.ActorId, input.PrevTimeTo, input.TimeTo).
+		ToSql()
+	if err != nil {
+		return models.Booking{}, fmt.Errorf("%s: build history query: %w", op, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return models.Booking{}, fmt.Errorf("%s: tx.ExecContext: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.Booking{}, fmt.Errorf("%s: tx.Commit: %w", op, err)
+	}
+
+	return res, nil
+}
+
+func (br *BookingsRepo) ListHistory(ctx context.Context, bookingId uuid.UUID) ([]models.BookingHistoryItem, error) {
+	op := "postgres.BookingsRepo.ListHistory"
+
+	query, args, err := br.sq.
+		Select("*").
+		From(bookingHistoryTable).
+		Where(sq.Eq{"booking_id": bookingId}).
+		OrderBy("created_at", "id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	res := []models.BookingHistoryItem{}
+	if err := br.db.SelectContext(ctx, &res, query, args...); err != nil {
+		return nil, fmt.Errorf("%s: db.SelectContext: %w", op, err)
+	}
+
+	return res, nil
+}
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -37,8 +38,8 @@ func (br *BookingsRepo) Create(ctx context.Context, input dto.BookingCreateDto)
 
 	query, args, err := br.sq.
 		Insert(bookingsTable).
-		Columns("entity_id", "user_id", "time_from", "time_to").
-		Values(input.EntityId, input.UserId, input.TimeFrom, input.TimeTo).
+		Columns("entity_id", "user_id", "time_from", "time_to", "booked_by", "group_id").
+		Values(input.EntityId, input.UserId, input.TimeFrom, input.TimeTo, input.Requester.UserId, input.GroupId).
 		Suffix("RETURNING *").
 		ToSql()
 	if err != nil {
@@ -84,46 +85,84 @@ func (br *BookingsRepo) GetById(ctx context.Context, id uuid.UUID) (models.Booki
 	return res, nil
 }
 
-func (br *BookingsRepo) ListForUser(ctx context.Context, userId uuid.UUID) ([]models.Booking, error) {
-	op := "postgres.BookingsRepo.ListForUser"
-
-	query, args, err := br.sq.
-		Select("*").
-		From(bookingsTable).
-		Where(sq.Eq{
-			"user_id": userId,
-		}).
-		OrderBy("created_at DESC").
-		ToSql()
-	if err != nil {
-		return nil, fmt.Errorf("%s: build query: %w", op, err)
+// ListInfo returns a page of bookings joined with their entity, floor and
+// orders in a single query. Bookings after the cursor are returned in the
+// filter sort order, at most filter.Limit of them.
+func (br *BookingsRepo) ListInfo(ctx context.Context, filter dto.BookingListFilter, after *dto.BookingCursor) ([]models.BookingInfo, error) {
+	op := "postgres.BookingsRepo.ListInfo"
+
+	qb := br.sq.
+		Select(
+			"b.*",
+			`e.id AS "entity.id"`,
+			`e.type AS "entity.type"`,
+			`e.title AS "entity.title"`,
+			`e.x AS "entity.x"`,
+			`e.y AS "entity.y"`,
+			`e.floor_id AS "entity.floor_id"`,
+			`e.width AS "entity.width"`,
+			`e.height AS "entity.height"`,
+			`e.capacity AS "entity.capacity"`,
+			`e.created_at AS "entity.created_at"`,
+			`e.updated_at AS "entity.updated_at"`,
+			`e.is_premium AS "entity.is_premium"`,
+			`f.id AS "floor.id"`,
+			`f.name AS "floor.name"`,
+			`f.created_at AS "floor.created_at"`,
+			`f.updated_at AS "floor.updated_at"`,
+			`f.building_id AS "floor.building_id"`,
+			fmt.Sprintf(`(
+				SELECT COALESCE(json_agg(json_build_object(
+					'Id', o.id,
+					'BookingId', o.booking_id,
+					'Completed', o.completed,
+					'Thing', o.thing,
+					'CreatedAt', to_char(o.created_at, '%[2]s'),
+					'UpdatedAt', to_char(o.updated_at, '%[2]s')
+				) ORDER BY o.created_at), '[]')
+				FROM %[1]s AS o WHERE o.booking_id = b.id
+			) AS orders`, ordersTable, jsonTimeFormat),
+		).
+		From(bookingsTable + " AS b").
+		Join(bookingEntitiesTable + " AS e ON e.id = b.entity_id").
+		Join(floorsTable + " AS f ON f.id = e.floor_id")
+
+	dir := "ASC"
+	cmp := ">"
+	if filter.Sort.Desc() {
+		dir = "DESC"
+		cmp = "<"
 	}
 
-	var res []models.Booking
-	if err := br.db.SelectContext(ctx, &res, query, args...); err != nil {
-		return nil, fmt.Errorf("%s: db.SelectContext: %w", op, err)
-	}
-
-	return res, nil
-}
+	column := "b." + filter.Sort.Column()
 
-func (br *BookingsRepo) ListAll(ctx context.Context) ([]models.Booking, error) {
-	op := "postgres.BookingsRepo.ListAll"
+	if after != nil {
+		qb = qb.Where(sq.Expr(fmt.Sprintf("(%s, b.id) %s (?, ?)", column, cmp), after.Value, after.Id))
+	}
 
-	query, args, err := br.sq.
-		Select("*").
-		From(bookingsTable).
-		OrderBy("created_at DESC").
+	query, args, err := applyBookingListFilter(qb, filter).
+		OrderBy(column+" "+dir, "b.id "+dir).
+		Limit(uint64(filter.Limit)).
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("%s: build query: %w", op, err)
 	}
 
-	var res []models.Booking
-	if err := br.db.SelectContext(ctx, &res, query, args...); err != nil {
+	var rows []bookingInfoRow
+	if err := br.db.SelectContext(ctx, &rows, query, args...); err != nil {
 		return nil, fmt.Errorf("%s: db.SelectContext: %w", op, err)
 	}
 
+	res := make([]models.BookingInfo, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, models.BookingInfo{
+			Booking: row.Booking,
+			Entity:  row.Entity,
+			Floor:   row.Floor,
+			Orders:  row.Orders,
+		})
+	}
+
 	return res, nil
 }
 
@@ -142,13 +181,25 @@ func (br *BookingsRepo) Update(ctx context.Context, input dto.BookingUpdateDto)
 		fieldsUpdates++
 		qb = qb.Set("time_to", *input.TimeTo)
 	}
+	if input.UserId != nil {
+		fieldsUpdates++
+		qb = qb.Set("user_id", *input.UserId)
+	}
+	if input.BookedBy != nil {
+		fieldsUpdates++
+		qb = qb.Set("booked_by", *input.BookedBy)
+	}
 
 	if fieldsUpdates == 0 {
 		qb = qb.Set("updated_at", time.Now().UTC())
 	}
 
+	qb = qb.Where(sq.Eq{"id": input.BookingId})
+	if input.Version != nil {
+		qb = qb.Where(sq.Eq{"version": *input.Version})
+	}
+
 	query, args, err := qb.
-		Where(sq.Eq{"id": input.BookingId}).
 		Suffix("RETURNING *").
 		ToSql()
 	if err != nil {
@@ -158,6 +209,10 @@ func (br *BookingsRepo) Update(ctx context.Context, input dto.BookingUpdateDto)
 	var res models.Booking
 	if err := br.db.GetContext(ctx, &res, query, args...); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
+			if input.Version != nil {
+				return models.Booking{}, models.ErrBookingModified
+			}
+
 			return models.Booking{}, models.ErrBookingNotFound
 		}
 
@@ -167,13 +222,17 @@ func (br *BookingsRepo) Update(ctx context.Context, input dto.BookingUpdateDto)
 	return res, nil
 }
 
-func (br *BookingsRepo) Delete(ctx context.Context, id uuid.UUID) error {
+func (br *BookingsRepo) Delete(ctx context.Context, id uuid.UUID, version *int) error {
 	op := "postgres.BookingsRepo.Delete"
 
-	query, args, err := br.sq.
+	qb := br.sq.
 		Delete(bookingsTable).
-		Where(sq.Eq{"id": id}).
-		ToSql()
+		Where(sq.Eq{"id": id})
+	if version != nil {
+		qb = qb.Where(sq.Eq{"version": *version})
+	}
+
+	query, args, err := qb.ToSql()
 	if err != nil {
 		return fmt.Errorf("%s: build query: %w", op, err)
 	}
@@ -189,12 +248,18 @@ func (br *BookingsRepo) Delete(ctx context.Context, id uuid.UUID) error {
 	}
 
 	if rowsAffected == 0 {
+		if version != nil {
+			return models.ErrBookingModified
+		}
+
 		return models.ErrBookingNotFound
 	}
 
 	return nil
 }
 
+// ListIntersectedForUser returns bookings of user overlapping the interval.
+// Bookings which only touch it are not included.
 func (br *BookingsRepo) ListIntersectedForUser(ctx context.Context, userId uuid.UUID, timeFrom, timeTo time.Time) ([]models.Booking, error) {
 	op := "postgres.BookingsRepo.ListInterSectedForUser"
 
@@ -203,24 +268,8 @@ func (br *BookingsRepo) ListIntersectedForUser(ctx context.Context, userId uuid.
 		From(bookingsTable).
 		Where(sq.And{
 			sq.Eq{"user_id": userId},
-			sq.Or{
-				sq.And{
-					sq.Gt{"time_from": timeFrom},
-					sq.Lt{"time_from": timeTo},
-				},
-				sq.And{
-					sq.Gt{"time_to": timeFrom},
-					sq.Lt{"time_to": timeTo},
-				},
-				sq.Eq{
-					"time_to":   timeTo,
-					"time_from": timeFrom,
-				},
-				sq.And{
-					sq.Lt{"time_from": timeFrom},
-					sq.Gt{"time_to": timeTo},
-				},
-			},
+			sq.Lt{"time_from": timeTo},
+			sq.Gt{"time_to": timeFrom},
 		}).
 		ToSql()
 	if err != nil {
@@ -237,6 +286,8 @@ func (br *BookingsRepo) ListIntersectedForUser(ctx context.Context, userId uuid.
 	return res, nil
 }
 
+// ListIntersectedForEntity returns bookings of entity overlapping the
+// interval. Bookings which only touch it are not included.
 func (br *BookingsRepo) ListIntersectedForEntity(ctx context.Context, entityId uuid.UUID, timeFrom, timeTo time.Time) ([]models.Booking, error) {
 	op := "postgres.BookingsRepo.ListInterSected"
 
@@ -245,24 +296,8 @@ func (br *BookingsRepo) ListIntersectedForEntity(ctx context.Context, entityId u
 		From(bookingsTable).
 		Where(sq.And{
 			sq.Eq{"entity_id": entityId},
-			sq.Or{
-				sq.And{
-					sq.Gt{"time_from": timeFrom},
-					sq.Lt{"time_from": timeTo},
-				},
-				sq.And{
-					sq.Gt{"time_to": timeFrom},
-					sq.Lt{"time_to": timeTo},
-				},
-				sq.Eq{
-					"time_to":   timeTo,
-					"time_from": timeFrom,
-				},
-				sq.And{
-					sq.Lt{"time_from": timeFrom},
-					sq.Gt{"time_to": timeTo},
-				},
-			},
+			sq.Lt{"time_from": timeTo},
+			sq.Gt{"time_to": timeFrom},
 		}).
 		ToSql()
 	if err != nil {
@@ -276,3 +311,71 @@ func (br *BookingsRepo) ListIntersectedForEntity(ctx context.Context, entityId u
 
 	return res, nil
 }
+
+func applyBookingListFilter(qb sq.SelectBuilder, filter dto.BookingListFilter) sq.SelectBuilder {
+	if filter.BuildingId != nil {
+		qb = qb.Where(sq.Eq{"f.building_id": *filter.BuildingId})
+	}
+	if filter.FloorId != nil {
+		qb = qb.Where(sq.Eq{"e.floor_id": *filter.FloorId})
+	}
+	if filter.EntityId != nil {
+		qb = qb.Where(sq.Eq{"b.entity_id": *filter.EntityId})
+	}
+	if filter.UserId != nil {
+		qb = qb.Where(sq.Eq{"b.user_id": *filter.UserId})
+	}
+	if filter.TimeFrom != nil {
+		qb = qb.Where(sq.Gt{"b.time_to": *filter.TimeFrom})
+	}
+	if filter.TimeTo != nil {
+		qb = qb.Where(sq.Lt{"b.time_from": *filter.TimeTo})
+	}
+
+	if filter.Status != nil {
+		now := time.Now().UTC()
+
+		switch *filter.Status {
+		case models.BookingStatusUpcoming:
+			qb = qb.Where(sq.Gt{"b.time_from": now})
+		case models.BookingStatusActive:
+			qb = qb.Where(sq.And{
+				sq.LtOrEq{"b.time_from": now},
+				sq.Gt{"b.time_to": now},
+			})
+		case models.BookingStatusFinished:
+			qb = qb.Where(sq.LtOrEq{"b.time_to": now})
+		}
+	}
+
+	return qb
+}
+
+// bookingInfoRow is a booking joined with its entity, floor and orders.
+type bookingInfoRow struct {
+	models.Booking
+	Entity models.BookingEntity `db:"entity"`
+	Floor  models.Floor         `db:"floor"`
+	Orders bookingOrders        `db:"orders"`
+}
+
+// jsonTimeFormat formats timestamps in json_build_object so that they are
+// decoded as UTC time.Time.
+const jsonTimeFormat = `YYYY-MM-DD"T"HH24:MI:SS.US"Z"`
+
+type bookingOrders []models.Order
+
+func (o *bookingOrders) Scan(src any) error {
+	var raw []byte
+
+	switch v := src.(type) {
+	case []byte:
+		raw = v
+	case string:
+		raw = []byte(v)
+	default:
+		return fmt.Errorf("unsupported orders type %T", src)
+	}
+
+	return json.Unmarshal(raw, (*[]models.Order)(o))
+}
@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"REDACTED/team-11/backend/booking/internal/models"
+)
+
+var (
+	buildingsTable = "building"
+)
+
+type BuildingsRepo struct {
+	db *sqlx.DB
+	sq sq.StatementBuilderType
+}
+
+func NewBuildingsRepo(db *sqlx.DB) *BuildingsRepo {
+	return &BuildingsRepo{
+		db: db,
+		sq: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (br *BuildingsRepo) GetById(ctx context.Context, id uuid.UUID) (models.Building, error) {
+	op := "postgres.BuildingsRepo.GetById"
+
+	query, args, err := br.sq.
+		Select("*").
+		From(buildingsTable).
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return models.Building{}, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	var res models.Building
+	if err := br.db.GetContext(ctx, &res, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Building{}, models.ErrBuildingNotFound
+		}
+
+		return models.Building{}, fmt.Errorf("%s: db.GetContext: %w", op, err)
+	}
+
+	return res, nil
+}
+
+func (br *BuildingsRepo) GetForEntity(ctx context.Context, entityId uuid.UUID) (models.Building, error) {
+	op := "postgres.BuildingsRepo.GetForEntity"
+
+	query, args, err := br.sq.
+		Select("b.*").
+		From(fmt.Sprintf("%s AS b", buildingsTable)).
+		Join(fmt.Sprintf("%s AS f ON f.building_id = b.id", floorsTable)).
+		Join(fmt.Sprintf("%s AS e ON e.floor_id = f.id", bookingEntitiesTable)).
+		Where(sq.Eq{"e.id": entityId}).
+		ToSql()
+	if err != nil {
+		return models.Building{}, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	var res models.Building
+	if err := br.db.GetContext(ctx, &res, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Building{}, models.ErrBuildingNotFound
+		}
+
+		return models.Building{}, fmt.Errorf("%s: db.GetContext: %w", op, err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"REDACTED/team-11/backend/booking/internal/models"
+)
+
+var (
+	delegationsTable = "booking_delegation"
+)
+
+type DelegationsRepo struct {
+	db *sqlx.DB
+	sq sq.StatementBuilderType
+}
+
+func NewDelegationsRepo(db *sqlx.DB) *DelegationsRepo {
+	return &DelegationsRepo{
+		db: db,
+		sq: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (dr *DelegationsRepo) Create(ctx context.Context, userId, delegateId uuid.UUID) (models.Delegation, error) {
+	op := "postgres.DelegationsRepo.Create"
+
+	query, args, err := dr.sq.
+		Insert(delegationsTable).
+		Columns("user_id", "delegate_id").
+		Values(userId, delegateId).
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		return models.Delegation{}, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	var res models.Delegation
+	if err := dr.db.GetContext(ctx, &res, query, args...); err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			case "23505":
+				return models.Delegation{}, models.ErrDelegationAlreadyExists
+			case "23514":
+				return models.Delegation{}, models.ErrSelfDelegation
+			}
+		}
+
+		return models.Delegation{}, fmt.Errorf("%s: db.GetContext: %w", op, err)
+	}
+
+	return res, nil
+}
+
+func (dr *DelegationsRepo) Get(ctx context.Context, userId, delegateId uuid.UUID) (models.Delegation, error) {
+	op := "postgres.DelegationsRepo.Get"
+
+	query, args, err := dr.sq.
+		Select("*").
+		From(delegationsTable).
+		Where(sq.Eq{"user_id": userId, "delegate_id": delegateId}).
+		ToSql()
+	if err != nil {
+		return models.Delegation{}, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	var res models.Delegation
+	if err := dr.db.GetContext(ctx, &res, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Delegation{}, models.ErrDelegationNotFound
+		}
+
+		return models.Delegation{}, fmt.Errorf("%s: db.GetContext: %w", op, err)
+	}
+
+	return res, nil
+}
+
+func (dr *DelegationsRepo) ListForUser(ctx context.Context, userId uuid.UUID) ([]models.Delegation, error) {
+	op := "postgres.DelegationsRepo.ListForUser"
+
+	res, err := dr.list(ctx, sq.Eq{"user_id": userId})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, nil
+}
+
+func (dr *DelegationsRepo) ListForDelegate(ctx context.Context, delegateId uuid.UUID) ([]models.Delegation, error) {
+	op := "postgres.DelegationsRepo.ListForDelegate"
+
+	res, err := dr.list(ctx, sq.Eq{"delegate_id": delegateId})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, nil
+}
+
+func (dr *DelegationsRepo) Delete(ctx context.Context, userId, delegateId uuid.UUID) error {
+	op := "postgres.DelegationsRepo.Delete"
+
+	query, args, err := dr.sq.
+		Delete(delegationsTable).
+		Where(sq.Eq{"user_id": userId, "delegate_id": delegateId}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	res, err := dr.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%s: db.ExecContext: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: res.RowsAffected: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		return models.ErrDelegationNotFound
+	}
+
+	return nil
+}
+
+func (dr *DelegationsRepo) list(ctx context.Context, where sq.Eq) ([]models.Delegation, error) {
+	query, args, err := dr.sq.
+		Select("*").
+		From(delegationsTable).
+		Where(where).
+		OrderBy("created_at").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	res := []models.Delegation{}
+	if err := dr.db.SelectContext(ctx, &res, query, args...); err != nil {
+		return nil, fmt.Errorf("db.SelectContext: %w", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,174 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"REDACTED/team-11/backend/booking/internal/dto"
+	"REDACTED/team-11/backend/booking/internal/models"
+)
+
+var (
+	quotasTable = "booking_quota"
+)
+
+type QuotasRepo struct {
+	db *sqlx.DB
+	sq sq.StatementBuilderType
+}
+
+func NewQuotasRepo(db *sqlx.DB) *QuotasRepo {
+	return &QuotasRepo{
+		db: db,
+		sq: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (qr *QuotasRepo) GetOverrides(ctx context.Context, userId uuid.UUID, teamIds []uuid.UUID, role models.Role) ([]models.QuotaOverride, error) {
+	op := "postgres.QuotasRepo.GetOverrides"
+
+	query, args, err := qr.sq.
+		Select("*").
+		From(quotasTable).
+		Where(sq.Or{
+			sq.Eq{"user_id": userId},
+			sq.Eq{"team_id": teamIds},
+			sq.Eq{"role": role},
+		}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	res := []models.QuotaOverride{}
+	if err := qr.db.SelectContext(ctx, &res, query, args...); err != nil {
+		return nil, fmt.Errorf("%s: db.SelectContext: %w", op, err)
+	}
+
+	return res, nil
+}
+
+func (qr *QuotasRepo) ListOverrides(ctx context.Context) ([]models.QuotaOverride, error) {
+	op := "postgres.QuotasRepo.ListOverrides"
+
+	query, args, err := qr.sq.
+		Select("*").
+		From(quotasTable).
+		OrderBy("created_at").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	res := []models.QuotaOverride{}
+	if err := qr.db.SelectContext(ctx, &res, query, args...); err != nil {
+		return nil, fmt.Errorf("%s: db.SelectContext: %w", op, err)
+	}
+
+	return res, nil
+}
+
+func (qr *QuotasRepo) SetForUser(ctx context.Context, userId uuid.UUID, input dto.QuotaOverrideDto) (models.QuotaOverride, error) {
+	return qr.set(ctx, "postgres.QuotasRepo.SetForUser", "user_id", userId, input)
+}
+
+func (qr *QuotasRepo) SetForTeam(ctx context.Context, teamId uuid.UUID, input dto.QuotaOverrideDto) (models.QuotaOverride, error) {
+	return qr.set(ctx, "postgres.QuotasRepo.SetForTeam", "team_id", teamId, input)
+}
+
+func (qr *QuotasRepo) SetForRole(ctx context.Context, role models.Role, input dto.QuotaOverrideDto) (models.QuotaOverride, error) {
+	return qr.set(ctx, "postgres.QuotasRepo.SetForRole", "role", role, input)
+}
+
+func (qr *QuotasRepo) DeleteForUser(ctx context.Context, userId uuid.UUID) error {
+	return qr.delete(ctx, "postgres.QuotasRepo.DeleteForUser", "user_id", userId)
+}
+
+func (qr *QuotasRepo) DeleteForTeam(ctx context.Context, teamId uuid.UUID) error {
+	return qr.delete(ctx, "postgres.QuotasRepo.DeleteForTeam", "team_id", teamId)
+}
+
+func (qr *QuotasRepo) DeleteForRole(ctx context.Context, role models.Role) error {
+	return qr.delete(ctx, "postgres.QuotasRepo.DeleteForRole", "role", role)
+}
+
+func (qr *QuotasRepo) ListBookedIntervals(ctx context.Context, userId uuid.UUID, after time.Time) ([]models.BookedInterval, error) {
+	op := "postgres.QuotasRepo.ListBookedIntervals"
+
+	query, args, err := qr.sq.
+		Select("b.id", "b.entity_id", "b.time_from", "b.time_to", "e.is_premium").
+		From(bookingsTable + " AS b").
+		Join(bookingEntitiesTable + " AS e ON e.id = b.entity_id").
+		Where(sq.And{
+			sq.Eq{"b.user_id": userId},
+			sq.Gt{"b.time_to": after},
+		}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	res := []models.BookedInterval{}
+	if err := qr.db.SelectContext(ctx, &res, query, args...); err != nil {
+		return nil, fmt.Errorf("%s: db.SelectContext: %w", op, err)
+	}
+
+	return res, nil
+}
+
+// set inserts override for subject or replaces all limits of the existing one.
+func (qr *QuotasRepo) set(ctx context.Context, op string, column string, subject any, input dto.QuotaOverrideDto) (models.QuotaOverride, error) {
+	query, args, err := qr.sq.
+		Insert(quotasTable).
+		Columns(column, "hours_per_week", "active_bookings", "premium_hours_per_week").
+		Values(subject, input.HoursPerWeek, input.ActiveBookings, input.PremiumHoursPerWeek).
+		Suffix(fmt.Sprintf(
+			"ON CONFLICT (%s) DO UPDATE SET "+
+				"hours_per_week = EXCLUDED.hours_per_week, "+
+				"active_bookings = EXCLUDED.active_bookings, "+
+				"premium_hours_per_week = EXCLUDED.premium_hours_per_week "+
+				"RETURNING *",
+			column,
+		)).
+		ToSql()
+	if err != nil {
+		return models.QuotaOverride{}, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	var res models.QuotaOverride
+	if err := qr.db.GetContext(ctx, &res, query, args...); err != nil {
+		return models.QuotaOverride{}, fmt.Errorf("%s: db.GetContext: %w", op, err)
+	}
+
+	return res, nil
+}
+
+func (qr *QuotasRepo) delete(ctx context.Context, op string, column string, subject any) error {
+	query, args, err := qr.sq.
+		Delete(quotasTable).
+		Where(sq.Eq{column: subject}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	res, err := qr.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%s: db.ExecContext: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: res.RowsAffected: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		return models.ErrQuotaOverrideNotFound
+	}
+
+	return nil
+}
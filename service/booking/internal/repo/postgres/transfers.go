@@ -0,0 +1,272 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"REDACTED/team-11/backend/booking/internal/models"
+)
+
+var (
+	transfersTable = "booking_transfer"
+)
+
+type TransfersRepo struct {
+	db *sqlx.DB
+	sq sq.StatementBuilderType
+}
+
+func NewTransfersRepo(db *sqlx.DB) *TransfersRepo {
+	return &TransfersRepo{
+		db: db,
+		sq: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (tr *TransfersRepo) Create(ctx context.Context, transfer models.BookingTransfer) (models.BookingTransfer, error) {
+	op := "postgres.TransfersRepo.Create"
+
+	query, args, err := tr.sq.
+		Insert(transfersTable).
+		Columns("booking_id", "from_user_id", "to_user_id", "to_email", "swap_booking_id").
+		Values(transfer.BookingId, transfer.FromUserId, transfer.ToUserId, transfer.ToEmail, transfer.SwapBookingId).
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		return models.BookingTransfer{}, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	var res models.BookingTransfer
+	if err := tr.db.GetContext(ctx, &res, query, args...); err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			case "23505":
+				return models.BookingTransfer{}, models.ErrTransferAlreadyExists
+			case "23503":
+				return models.BookingTransfer{}, models.ErrBookingNotFound
+			case "23514":
+				return models.BookingTransfer{}, models.ErrSelfTransfer
+			}
+		}
+
+		return models.BookingTransfer{}, fmt.Errorf("%s: db.GetContext: %w", op, err)
+	}
+
+	return res, nil
+}
+
+func (tr *TransfersRepo) GetById(ctx context.Context, id uuid.UUID) (models.BookingTransfer, error) {
+	op := "postgres.TransfersRepo.GetById"
+
+	query, args, err := tr.sq.
+		Select("*").
+		From(transfersTable).
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return models.BookingTransfer{}, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	var res models.BookingTransfer
+	if err := tr.db.GetContext(ctx, &res, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.BookingTransfer{}, models.ErrTransferNotFound
+		}
+
+		return models.BookingTransfer{}, fmt.Errorf("%s: db.GetContext: %w", op, err)
+	}
+
+	return res, nil
+}
+
+func (tr *TransfersRepo) ListFromUser(ctx context.Context, userId uuid.UUID) ([]models.BookingTransfer, error) {
+	op := "postgres.TransfersRepo.ListFromUser"
+
+	res, err := tr.list(ctx, sq.Eq{"from_user_id": userId})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, nil
+}
+
+func (tr *TransfersRepo) ListToUser(ctx context.Context, userId uuid.UUID) ([]models.BookingTransfer, error) {
+	op := "postgres.TransfersRepo.ListToUser"
+
+	res, err := tr.list(ctx, sq.Eq{"to_user_id": userId})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, nil
+}
+
+func (tr *TransfersRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	op := "postgres.TransfersRepo.Delete"
+
+	if err := tr.delete(ctx, tr.db, id); err != nil {
+		if errors.Is(err, models.ErrTransferNotFound) {
+			return models.ErrTransferNotFound
+		}
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Accept hands booking over to user and removes the transfer in one transaction.
+// Booking is changed only if it still has the version it was checked with.
+func (tr *TransfersRepo) Accept(ctx context.Context, transferId uuid.UUID, booking models.Booking, userId uuid.UUID) (models.Booking, error) {
+	op := "postgres.TransfersRepo.Accept"
+
+	tx, err := tr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return models.Booking{}, fmt.Errorf("%s: db.BeginTxx: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if err := tr.delete(ctx, tx, transferId); err != nil {
+		if errors.Is(err, models.ErrTransferNotFound) {
+			return models.Booking{}, models.ErrTransferNotFound
+		}
+
+		return models.Booking{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	res, err := tr.updateBooking(ctx, tx, booking, map[string]any{
+		"user_id":   userId,
+		"booked_by": userId,
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrBookingModified) {
+			return models.Booking{}, models.ErrBookingModified
+		}
+
+		return models.Booking{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.Booking{}, fmt.Errorf("%s: tx.Commit: %w", op, err)
+	}
+
+	return res, nil
+}
+
+// Swap exchanges entities of two bookings and removes the transfer in one
+// transaction. Bookings are changed only if both still have the versions
+// they were checked with.
+func (tr *TransfersRepo) Swap(ctx context.Context, transferId uuid.UUID, booking, swapBooking models.Booking) ([]models.Booking, error) {
+	op := "postgres.TransfersRepo.Swap"
+
+	tx, err := tr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: db.BeginTxx: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if err := tr.delete(ctx, tx, transferId); err != nil {
+		if errors.Is(err, models.ErrTransferNotFound) {
+			return nil, models.ErrTransferNotFound
+		}
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	res := make([]models.Booking, 0, 2)
+	for _, swap := range [][2]models.Booking{{booking, swapBooking}, {swapBooking, booking}} {
+		updated, err := tr.updateBooking(ctx, tx, swap[0], map[string]any{
+			"entity_id": swap[1].EntityId,
+		})
+		if err != nil {
+			if errors.Is(err, models.ErrBookingModified) {
+				return nil, models.ErrBookingModified
+			}
+
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		res = append(res, updated)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: tx.Commit: %w", op, err)
+	}
+
+	return res, nil
+}
+
+func (tr *TransfersRepo) list(ctx context.Context, where sq.Eq) ([]models.BookingTransfer, error) {
+	query, args, err := tr.sq.
+		Select("*").
+		From(transfersTable).
+		Where(where).
+		OrderBy("created_at").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	res := []models.BookingTransfer{}
+	if err := tr.db.SelectContext(ctx, &res, query, args...); err != nil {
+		return nil, fmt.Errorf("db.SelectContext: %w", err)
+	}
+
+	return res, nil
+}
+
+func (tr *TransfersRepo) delete(ctx context.Context, db sqlx.ExtContext, id uuid.UUID) error {
+	query, args, err := tr.sq.
+		Delete(transfersTable).
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build transfer query: %w", err)
+	}
+
+	res, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("db.ExecContext: %w", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("res.RowsAffected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return models.ErrTransferNotFound
+	}
+
+	return nil
+}
+
+// updateBooking sets columns of booking if it still has the same version.
+func (tr *TransfersRepo) updateBooking(ctx context.Context, tx *sqlx.Tx, booking models.Booking, set map[string]any) (models.Booking, error) {
+	query, args, err := tr.sq.
+		Update(bookingsTable).
+		SetMap(set).
+		Where(sq.Eq{"id": booking.Id, "version": booking.Version}).
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		return models.Booking{}, fmt.Errorf("build booking query: %w", err)
+	}
+
+	var res models.Booking
+	if err := tx.GetContext(ctx, &res, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Booking{}, models.ErrBookingModified
+		}
+
+		return models.Booking{}, fmt.Errorf("tx.GetContext: %w", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,25 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"REDACTED/team-11/backend/booking/internal/dto"
+	"REDACTED/team-11/backend/booking/internal/models"
+)
+
+type QuotasRepo interface {
+	// GetOverrides returns overrides of user, teams of user and role.
+	GetOverrides(ctx context.Context, userId uuid.UUID, teamIds []uuid.UUID, role models.Role) ([]models.QuotaOverride, error)
+	ListOverrides(ctx context.Context) ([]models.QuotaOverride, error)
+	SetForUser(ctx context.Context, userId uuid.UUID, input dto.QuotaOverrideDto) (models.QuotaOverride, error)
+	SetForTeam(ctx context.Context, teamId uuid.UUID, input dto.QuotaOverrideDto) (models.QuotaOverride, error)
+	SetForRole(ctx context.Context, role models.Role, input dto.QuotaOverrideDto) (models.QuotaOverride, error)
+	DeleteForUser(ctx context.Context, userId uuid.UUID) error
+	DeleteForTeam(ctx context.Context, teamId uuid.UUID) error
+	DeleteForRole(ctx context.Context, role models.Role) error
+
+	// ListBookedIntervals returns bookings of user which end after the given time.
+	ListBookedIntervals(ctx context.Context, userId uuid.UUID, after time.Time) ([]models.BookedInterval, error)
+}
@@ -0,0 +1,19 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"REDACTED/team-11/backend/booking/internal/models"
+)
+
+type TransfersRepo interface {
+	Create(ctx context.Context, transfer models.BookingTransfer) (models.BookingTransfer, error)
+	GetById(ctx context.Context, id uuid.UUID) (models.BookingTransfer, error)
+	ListFromUser(ctx context.Context, userId uuid.UUID) ([]models.BookingTransfer, error)
+	ListToUser(ctx context.Context, userId uuid.UUID) ([]models.BookingTransfer, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	Accept(ctx context.Context, transferId uuid.UUID, booking models.Booking, userId uuid.UUID) (models.Booking, error)
+	Swap(ctx context.Context, transferId uuid.UUID, booking, swapBooking models.Booking) ([]models.Booking, error)
+}
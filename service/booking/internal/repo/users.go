@@ -9,4 +9,7 @@ import (
 
 type UsersRepo interface {
 	GetById(ctx context.Context, id uuid.UUID) (models.User, error)
+	GetByIds(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]models.User, error)
+	GetByEmail(ctx context.Context, email string) (models.User, error)
+	ListTeams(ctx context.Context, id uuid.UUID) ([]models.Team, error)
 }
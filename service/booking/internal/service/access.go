@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"REDACTED/team-11/backend/booking/internal/models"
+	"REDACTED/team-11/backend/booking/internal/repo"
+)
+
+type AccessService interface {
+	CheckEntity(ctx context.Context, entityId uuid.UUID, userId uuid.UUID, token models.Token) error
+	ListRestricted(ctx context.Context, entityIds []uuid.UUID, token models.Token) (map[uuid.UUID]bool, error)
+}
+
+var (
+	_ AccessService = NewAccessService(nil, nil)
+)
+
+type accessServiceImpl struct {
+	accessRulesRepo repo.AccessRulesRepo
+	usersRepo       repo.UsersRepo
+}
+
+func NewAccessService(accessRulesRepo repo.AccessRulesRepo, usersRepo repo.UsersRepo) *accessServiceImpl {
+	return &accessServiceImpl{
+		accessRulesRepo: accessRulesRepo,
+		usersRepo:       usersRepo,
+	}
+}
+
+// CheckEntity returns ErrEntityRestricted if user can't book entity.
+// Holders of booking.write.any may book any entity for anyone.
+func (as *accessServiceImpl) CheckEntity(ctx context.Context, entityId uuid.UUID, userId uuid.UUID, token models.Token) error {
+	op := "service.accessServiceImpl.CheckEntity"
+
+	if token.Can(models.BOOKING_WRITE_ANY) {
+		return nil
+	}
+
+	restricted, err := as.listRestricted(ctx, []uuid.UUID{entityId}, userId)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if restricted[entityId] {
+		return models.ErrEntityRestricted
+	}
+
+	return nil
+}
+
+// ListRestricted returns set of entities, which token owner can't book.
+func (as *accessServiceImpl) ListRestricted(ctx context.Context, entityIds []uuid.UUID, token models.Token) (map[uuid.UUID]bool, error) {
+	op := "service.accessServiceImpl.ListRestricted"
+
+	if token.Can(models.BOOKING_WRITE_ANY) {
+		return map[uuid.UUID]bool{}, nil
+	}
+
+	restricted, err := as.listRestricted(ctx, entityIds, token.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return restricted, nil
+}
+
+func (as *accessServiceImpl) listRestricted(ctx context.Context, entityIds []uuid.UUID, userId uuid.UUID) (map[uuid.UUID]bool, error) {
+	rules, err := as.accessRulesRepo.ListForEntities(ctx, entityIds)
+	if err != nil {
+		return nil, fmt.Errorf("accessRulesRepo.ListForEntities: %w", err)
+	}
+
+	res := make(map[uuid.UUID]bool, len(rules))
+	if len(rules) == 0 {
+		return res, nil
+	}
+
+	teams, err := as.usersRepo.ListTeams(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("usersRepo.ListTeams: %w", err)
+	}
+
+	teamIds := make([]uuid.UUID, 0, len(teams))
+	for _, team := range teams {
+		teamIds = append(teamIds, team.Id)
+	}
+
+	for entityId, entityRules := range rules {
+		res[entityId] = !isAllowed(entityRules, userId, teamIds)
+	}
+
+	return res, nil
+}
+
+func isAllowed(rules []models.EntityAccessRule, userId uuid.UUID, teamIds []uuid.UUID) bool {
+	if len(rules) == 0 {
+		return true
+	}
+
+	for _, rule := range rules {
+		if rule.UserId != nil && *rule.UserId == userId {
+			return true
+		}
+		if rule.TeamId != nil && slices.Contains(teamIds, *rule.TeamId) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"REDACTED/team-11/backend/booking/internal/models"
+)
+
+func TestIsAllowed(t *testing.T) {
+	userId := uuid.New()
+	teamId := uuid.New()
+
+	userRule := models.EntityAccessRule{UserId: &userId}
+	teamRule := models.EntityAccessRule{TeamId: &teamId}
+
+	otherUser := uuid.New()
+	otherTeam := uuid.New()
+	otherUserRule := models.EntityAccessRule{UserId: &otherUser}
+	otherTeamRule := models.EntityAccessRule{TeamId: &otherTeam}
+
+	assert.True(t, isAllowed(nil, userId, nil))
+	assert.True(t, isAllowed([]models.EntityAccessRule{userRule}, userId, nil))
+	assert.True(t, isAllowed([]models.EntityAccessRule{otherUserRule, teamRule}, userId, []uuid.UUID{teamId}))
+	assert.False(t, isAllowed([]models.EntityAccessRule{otherUserRule}, userId, []uuid.UUID{teamId}))
+	assert.False(t, isAllowed([]models.EntityAccessRule{otherTeamRule}, userId, []uuid.UUID{teamId}))
+}
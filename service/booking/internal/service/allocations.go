@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"REDACTED/team-11/backend/booking/internal/dto"
+	"REDACTED/team-11/backend/booking/internal/models"
+)
+
+type AllocationsService interface {
+	Allocate(ctx context.Context, input dto.AllocationDto) (models.Allocation, error)
+}
+
+var (
+	_ AllocationsService = NewAllocationsService(nil, nil)
+)
+
+type allocationsServiceImpl struct {
+	workloadsService     WorkloadsService
+	bookingGroupsService BookingGroupsService
+}
+
+func NewAllocationsService(
+	workloadsService WorkloadsService,
+	bookingGroupsService BookingGroupsService,
+) *allocationsServiceImpl {
+	return &allocationsServiceImpl{
+		workloadsService:     workloadsService,
+		bookingGroupsService: bookingGroupsService,
+	}
+}
+
+func (as *allocationsServiceImpl) Allocate(ctx context.Context, input dto.AllocationDto) (models.Allocation, error) {
+	op := "service.allocationsServiceImpl.Allocate"
+
+	floorWorkload, err := as.workloadsService.GetForFloor(ctx, input.FloorId, input.TimeFrom, input.TimeTo, input.Requester, input.Filter)
+	if err != nil {
+		if errors.Is(err, models.ErrFloorNotFound) || errors.Is(err, models.ErrInvalidBookingSlot) {
+			return models.Allocation{}, err
+		}
+
+		return models.Allocation{}, fmt.Errorf("%s: workloadsService.GetForFloor: %w", op, err)
+	}
+
+	seats := allocateSeats(floorWorkload, input.Seats)
+	if seats == nil {
+		return models.Allocation{}, models.ErrNotEnoughSeats
+	}
+
+	res := models.Allocation{
+		Seats: seats,
+	}
+
+	if !input.Book {
+		return res, nil
+	}
+
+	items := make([]dto.BookingGroupItem, 0, input.Seats)
+	for _, seat := range seats {
+		for range seat.Places {
+			userId := input.Requester.UserId
+			if len(items) < len(input.Attendees) {
+				userId = input.Attendees[len(items)]
+			}
+
+			items = append(items, dto.BookingGroupItem{
+				EntityId: seat.Entity.Id,
+				UserId:   userId,
+			})
+		}
+	}
+
+	group, err := as.bookingGroupsService.Create(ctx, dto.BookingGroupCreateDto{
+		TimeFrom: input.TimeFrom,
+		TimeTo:   input.TimeTo,
+		Items:    items,
+
+		Requester: input.Requester,
+	})
+	if err != nil {
+		return models.Allocation{}, fmt.Errorf("%s: bookingGroupsService.Create: %w", op, err)
+	}
+
+	res.Group = &group
+
+	return res, nil
+}
+
+// allocateSeats picks seats places in OPEN_SPACE entities as close to each other as possible.
+// Every entity with free places is tried as a center, the rest of seats are taken
+// from the nearest entities. The center with the least total distance of seats wins.
+// Returns nil if floor has not enough free places.
+func allocateSeats(floorWorkload models.FloorWorkload, seats int) []models.AllocatedSeats {
+	candidates := make([]models.FloorWorkloadItem, 0, len(floorWorkload))
+	total := 0
+	for _, item := range floorWorkload {
+		if item.Entity.Type != models.BookingEntityTypeOpenSpace || item.FreePlaces <= 0 {
+			continue
+		}
+
+		candidates = append(candidates, item)
+		total += item.FreePlaces
+	}
+
+	if seats <= 0 || total < seats {
+		return nil
+	}
+
+	var (
+		best     []models.AllocatedSeats
+		bestCost = math.Inf(1)
+	)
+
+	for _, center := range candidates {
+		nearest := make([]models.FloorWorkloadItem, len(candidates))
+		copy(nearest, candidates)
+		sort.SliceStable(nearest, func(i, j int) bool {
+			return entitiesDistance(center.Entity, nearest[i].Entity) < entitiesDistance(center.Entity, nearest[j].Entity)
+		})
+
+		var (
+			allocated []models.AllocatedSeats
+			cost      float64
+			left      = seats
+		)
+
+		for _, item := range nearest {
+			if left == 0 {
+				break
+			}
+
+			places := min(item.FreePlaces, left)
+			left -= places
+			cost += float64(places) * entitiesDistance(center.Entity, item.Entity)
+
+			allocated = append(allocated, models.AllocatedSeats{
+				Entity: item.Entity,
+				Places: places,
+			})
+		}
+
+		if cost < bestCost || (cost == bestCost && len(allocated) < len(best)) {
+			best, bestCost = allocated, cost
+		}
+	}
+
+	return best
+}
+
+// entitiesDistance returns distance between closest points of entities rectangles.
+// Touching or overlapping entities have zero distance.
+func entitiesDistance(a, b models.BookingEntity) float64 {
+	dx := max(0, max(a.X, b.X)-min(a.X+a.Width, b.X+b.Width))
+	dy := max(0, max(a.Y, b.Y)-min(a.Y+a.Height, b.Y+b.Height))
+
+	return math.Hypot(float64(dx), float64(dy))
+}
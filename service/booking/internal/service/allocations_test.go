@@ -0,0 +1,65 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"REDACTED/team-11/backend/booking/internal/models"
+)
+
+func TestEntitiesDistance(t *testing.T) {
+	a := models.BookingEntity{X: 0, Y: 0, Width: 10, Height: 10}
+	touching := models.BookingEntity{X: 10, Y: 0, Width: 10, Height: 10}
+	right := models.BookingEntity{X: 13, Y: 0, Width: 10, Height: 10}
+	diagonal := models.BookingEntity{X: 13, Y: 14, Width: 10, Height: 10}
+
+	assert.Equal(t, 0.0, entitiesDistance(a, a))
+	assert.Equal(t, 0.0, entitiesDistance(a, touching))
+	assert.Equal(t, 3.0, entitiesDistance(a, right))
+	assert.Equal(t, 5.0, entitiesDistance(a, diagonal))
+	assert.Equal(t, entitiesDistance(diagonal, a), entitiesDistance(a, diagonal))
+}
+
+func TestAllocateSeats(t *testing.T) {
+	openSpace := func(x, y, free int) models.FloorWorkloadItem {
+		return models.FloorWorkloadItem{
+			Entity: models.BookingEntity{
+				Id:     uuid.New(),
+				Type:   models.BookingEntityTypeOpenSpace,
+				X:      x,
+				Y:      y,
+				Width:  10,
+				Height: 10,
+			},
+			FreePlaces: free,
+		}
+	}
+
+	far := openSpace(100, 100, 2)
+	left := openSpace(0, 0, 2)
+	right := openSpace(10, 0, 1)
+	room := models.FloorWorkloadItem{
+		Entity: models.BookingEntity{
+			Id:    uuid.New(),
+			Type:  models.BookingEntityTypeRoom,
+			X:     20,
+			Width: 10,
+		},
+		FreePlaces: 1,
+	}
+
+	floorWorkload := models.FloorWorkload{far, left, right, room}
+
+	seats := allocateSeats(floorWorkload, 3)
+	assert.ElementsMatch(t, []models.AllocatedSeats{
+		{Entity: left.Entity, Places: 2},
+		{Entity: right.Entity, Places: 1},
+	}, seats)
+
+	seats = allocateSeats(floorWorkload, 2)
+	assert.Len(t, seats, 1)
+
+	assert.Nil(t, allocateSeats(floorWorkload, 6))
+	assert.Nil(t, allocateSeats(floorWorkload, 0))
+}
@@ -0,0 +1,442 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"REDACTED/team-11/backend/booking/internal/dto"
+	"REDACTED/team-11/backend/booking/internal/models"
+	"REDACTED/team-11/backend/booking/internal/repo"
+	"REDACTED/team-11/backend/booking/pkg/metrics"
+)
+
+type BookingGroupsService interface {
+	Create(ctx context.Context, input dto.BookingGroupCreateDto) (models.BookingGroup, error)
+	GetById(ctx context.Context, groupId uuid.UUID, token models.Token) (models.BookingGroup, error)
+	Update(ctx context.Context, input dto.BookingGroupUpdateDto, token models.Token) (models.BookingGroup, error)
+	Delete(ctx context.Context, groupId uuid.UUID, token models.Token) error
+
+	AddMember(ctx context.Context, groupId uuid.UUID, item dto.BookingGroupItem, token models.Token) (models.BookingGroup, error)
+	RemoveMember(ctx context.Context, groupId uuid.UUID, userId uuid.UUID, token models.Token) error
+}
+
+var (
+	_ BookingGroupsService = NewBookingGroupsService(nil, nil, nil, nil, nil, nil, nil)
+)
+
+type bookingGroupsServiceImpl struct {
+	bookingGroupsRepo repo.BookingGroupsRepo
+	bookingsRepo      repo.BookingsRepo
+	bookingsService   *BookingsService
+	workloadsService  WorkloadsService
+	buildingsService  BuildingsService
+	accessService     AccessService
+	quotasService     QuotasService
+}
+
+func NewBookingGroupsService(
+	bookingGroupsRepo repo.BookingGroupsRepo,
+	bookingsRepo repo.BookingsRepo,
+	bookingsService *BookingsService,
+	workloadsService WorkloadsService,
+	buildingsService BuildingsService,
+	accessService AccessService,
+	quotasService QuotasService,
+) *bookingGroupsServiceImpl {
+	return &bookingGroupsServiceImpl{
+		bookingGroupsRepo: bookingGroupsRepo,
+		bookingsRepo:      bookingsRepo,
+		bookingsService:   bookingsService,
+		workloadsService:  workloadsService,
+		buildingsService:  buildingsService,
+		accessService:     accessService,
+		quotasService:     quotasService,
+	}
+}
+
+func (bgs *bookingGroupsServiceImpl) Create(ctx context.Context, input dto.BookingGroupCreateDto) (models.BookingGroup, error) {
+	op := "service.bookingGroupsServiceImpl.Create"
+
+	if err := bgs.checkMembers(ctx, input.Items, input.TimeFrom, input.TimeTo, input.Requester, nil); err != nil {
+		return models.BookingGroup{}, countConflict(err)
+	}
+
+	if err := bgs.checkQuotas(ctx, input.Items, input.TimeFrom, input.TimeTo, input.Requester, nil); err != nil {
+		return models.BookingGroup{}, countConflict(err)
+	}
+
+	if err := bgs.checkCapacity(ctx, input.Items, input.TimeFrom, input.TimeTo, nil); err != nil {
+		return models.BookingGroup{}, countConflict(err)
+	}
+
+	// Checks above fail fast, repo repeats member and capacity checks
+	// under locks, since other bookings may be made in the meantime.
+	group, err := bgs.bookingGroupsRepo.Create(ctx, input)
+	if err != nil {
+		if errors.Is(err, models.ErrBookingEntityNotFound) {
+			return models.BookingGroup{}, models.ErrBookingEntityNotFound
+		}
+		if errors.Is(err, models.ErrAlreadyHaveBooking) || errors.Is(err, models.ErrNoFreePlaces) {
+			return models.BookingGroup{}, countConflict(err)
+		}
+
+		return models.BookingGroup{}, fmt.Errorf("%s: bookingGroupsRepo.Create: %w", op, err)
+	}
+
+	metrics.BookingsCreated.Add(float64(len(group.Bookings)))
+
+	return group, nil
+}
+
+func (bgs *bookingGroupsServiceImpl) GetById(ctx context.Context, groupId uuid.UUID, token models.Token) (models.BookingGroup, error) {
+	return bgs.getForManage(ctx, groupId, token)
+}
+
+func (bgs *bookingGroupsServiceImpl) Update(ctx context.Context, input dto.BookingGroupUpdateDto, token models.Token) (models.BookingGroup, error) {
+	op := "service.bookingGroupsServiceImpl.Update"
+
+	group, err := bgs.getForManage(ctx, input.GroupId, token)
+	if err != nil {
+		return models.BookingGroup{}, err
+	}
+
+	timeFrom, timeTo := group.TimeFrom, group.TimeTo
+	if input.TimeFrom != nil {
+		timeFrom = *input.TimeFrom
+	}
+	if input.TimeTo != nil {
+		timeTo = *input.TimeTo
+	}
+
+	if !timeFrom.Before(timeTo) {
+		return models.BookingGroup{}, models.ErrInvalidBookingTime
+	}
+
+	items := make([]dto.BookingGroupItem, 0, len(group.Bookings))
+	for _, booking := range group.Bookings {
+		items = append(items, dto.BookingGroupItem{
+			EntityId: booking.EntityId,
+			UserId:   booking.UserId,
+		})
+	}
+
+	for _, item := range items {
+		if err := bgs.buildingsService.ValidateBooking(ctx, item.EntityId, timeFrom, timeTo); err != nil {
+			if errors.Is(err, models.ErrInvalidBookingSlot) || errors.Is(err, models.ErrBuildingClosed) {
+				return models.BookingGroup{}, err
+			}
+
+			return models.BookingGroup{}, fmt.Errorf("%s: buildingsService.ValidateBooking: %w", op, err)
+		}
+	}
+
+	for userId := range groupUsers(items) {
+		if err := bgs.checkIntersected(ctx, userId, timeFrom, timeTo, group.Id); err != nil {
+			return models.BookingGroup{}, countConflict(err)
+		}
+	}
+
+	if err := bgs.checkQuotas(ctx, items, timeFrom, timeTo, token, group.Bookings); err != nil {
+		return models.BookingGroup{}, countConflict(err)
+	}
+
+	if err := bgs.checkCapacity(ctx, items, timeFrom, timeTo, &group); err != nil {
+		return models.BookingGroup{}, countConflict(err)
+	}
+
+	updated, err := bgs.bookingGroupsRepo.UpdateTime(ctx, group.Id, timeFrom, timeTo)
+	if err != nil {
+		if errors.Is(err, models.ErrBookingGroupNotFound) {
+			return models.BookingGroup{}, models.ErrBookingGroupNotFound
+		}
+		if errors.Is(err, models.ErrAlreadyHaveBooking) || errors.Is(err, models.ErrNoFreePlaces) {
+			return models.BookingGroup{}, countConflict(err)
+		}
+
+		return models.BookingGroup{}, fmt.Errorf("%s: bookingGroupsRepo.UpdateTime: %w", op, err)
+	}
+
+	return updated, nil
+}
+
+func (bgs *bookingGroupsServiceImpl) Delete(ctx context.Context, groupId uuid.UUID, token models.Token) error {
+	op := "service.bookingGroupsServiceImpl.Delete"
+
+	if _, err := bgs.getForManage(ctx, groupId, token); err != nil {
+		return err
+	}
+
+	if err := bgs.bookingGroupsRepo.Delete(ctx, groupId); err != nil {
+		if errors.Is(err, models.ErrBookingGroupNotFound) {
+			return models.ErrBookingGroupNotFound
+		}
+
+		return fmt.Errorf("%s: bookingGroupsRepo.Delete: %w", op, err)
+	}
+
+	return nil
+}
+
+func (bgs *bookingGroupsServiceImpl) AddMember(ctx context.Context, groupId uuid.UUID, item dto.BookingGroupItem, token models.Token) (models.BookingGroup, error) {
+	op := "service.bookingGroupsServiceImpl.AddMember"
+
+	group, err := bgs.getForManage(ctx, groupId, token)
+	if err != nil {
+		return models.BookingGroup{}, err
+	}
+
+	items := []dto.BookingGroupItem{item}
+
+	if err := bgs.checkMembers(ctx, items, group.TimeFrom, group.TimeTo, token, &group.Id); err != nil {
+		return models.BookingGroup{}, countConflict(err)
+	}
+
+	if err := bgs.checkQuotas(ctx, items, group.TimeFrom, group.TimeTo, token, nil); err != nil {
+		return models.BookingGroup{}, countConflict(err)
+	}
+
+	if err := bgs.checkCapacity(ctx, items, group.TimeFrom, group.TimeTo, nil); err != nil {
+		return models.BookingGroup{}, countConflict(err)
+	}
+
+	booking, err := bgs.bookingGroupsRepo.AddMember(ctx, group.Id, item, token.UserId)
+	if err != nil {
+		if errors.Is(err, models.ErrBookingGroupNotFound) {
+			return models.BookingGroup{}, models.ErrBookingGroupNotFound
+		}
+		if errors.Is(err, models.ErrBookingEntityNotFound) {
+			return models.BookingGroup{}, models.ErrBookingEntityNotFound
+		}
+		if errors.Is(err, models.ErrAlreadyHaveBooking) || errors.Is(err, models.ErrNoFreePlaces) {
+			return models.BookingGroup{}, countConflict(err)
+		}
+
+		return models.BookingGroup{}, fmt.Errorf("%s: bookingGroupsRepo.AddMember: %w", op, err)
+	}
+
+	metrics.BookingsCreated.Inc()
+
+	group.Bookings = append(group.Bookings, booking)
+
+	return group, nil
+}
+
+func (bgs *bookingGroupsServiceImpl) RemoveMember(ctx context.Context, groupId uuid.UUID, userId uuid.UUID, token models.Token) error {
+	op := "service.bookingGroupsServiceImpl.RemoveMember"
+
+	// Members may leave the group by themselves.
+	if token.UserId != userId {
+		if _, err := bgs.getForManage(ctx, groupId, token); err != nil {
+			return err
+		}
+	}
+
+	if err := bgs.bookingGroupsRepo.DeleteMember(ctx, groupId, userId); err != nil {
+		if errors.Is(err, models.ErrBookingGroupMemberNotFound) {
+			return models.ErrBookingGroupMemberNotFound
+		}
+
+		return fmt.Errorf("%s: bookingGroupsRepo.DeleteMember: %w", op, err)
+	}
+
+	return nil
+}
+
+// getForManage returns group if token owner is its owner or holds booking.write.any.
+func (bgs *bookingGroupsServiceImpl) getForManage(ctx context.Context, groupId uuid.UUID, token models.Token) (models.BookingGroup, error) {
+	group, err := bgs.bookingGroupsRepo.GetById(ctx, groupId)
+	if err != nil {
+		if errors.Is(err, models.ErrBookingGroupNotFound) {
+			return models.BookingGroup{}, models.ErrBookingGroupNotFound
+		}
+
+		return models.BookingGroup{}, fmt.Errorf("bookingGroupsRepo.GetById: %w", err)
+	}
+
+	if group.OwnerId != token.UserId && !token.Can(models.BOOKING_WRITE_ANY) {
+		return models.BookingGroup{}, models.ErrNoAccessToBookingGroup
+	}
+
+	return group, nil
+}
+
+// checkMembers runs the same per booking checks as BookingsService.Create for every item.
+// Bookings of groupId are not treated as intersecting with items.
+func (bgs *bookingGroupsServiceImpl) checkMembers(
+	ctx context.Context,
+	items []dto.BookingGroupItem,
+	timeFrom, timeTo time.Time,
+	requester models.Token,
+	groupId *uuid.UUID,
+) error {
+	for userId := range groupUsers(items) {
+		if err := bgs.bookingsService.checkBeneficiary(ctx, requester, userId); err != nil {
+			if errors.Is(err, models.ErrNoDelegation) || errors.Is(err, models.ErrUserNotFound) {
+				return err
+			}
+
+			return fmt.Errorf("bookingsService.checkBeneficiary: %w", err)
+		}
+	}
+
+	for _, item := range items {
+		if err := bgs.buildingsService.ValidateBooking(ctx, item.EntityId, timeFrom, timeTo); err != nil {
+			if errors.Is(err, models.ErrInvalidBookingSlot) || errors.Is(err, models.ErrBuildingClosed) {
+				return err
+			}
+
+			return fmt.Errorf("buildingsService.ValidateBooking: %w", err)
+		}
+
+		if err := bgs.accessService.CheckEntity(ctx, item.EntityId, item.UserId, requester); err != nil {
+			if errors.Is(err, models.ErrEntityRestricted) {
+				return models.ErrEntityRestricted
+			}
+
+			return fmt.Errorf("accessService.CheckEntity: %w", err)
+		}
+	}
+
+	var exclude uuid.UUID
+	if groupId != nil {
+		exclude = *groupId
+	}
+
+	for userId := range groupUsers(items) {
+		if err := bgs.checkIntersected(ctx, userId, timeFrom, timeTo, exclude); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkQuotas checks quota of every user for all items of the user at once.
+// replaced bookings are excluded from the current usage.
+func (bgs *bookingGroupsServiceImpl) checkQuotas(
+	ctx context.Context,
+	items []dto.BookingGroupItem,
+	timeFrom, timeTo time.Time,
+	requester models.Token,
+	replaced []models.Booking,
+) error {
+	for userId := range groupUsers(items) {
+		input := dto.QuotaCheckDto{
+			UserId:    userId,
+			Requester: requester,
+		}
+
+		for _, item := range items {
+			if item.UserId == userId {
+				input.Bookings = append(input.Bookings, models.BookedInterval{
+					EntityId: item.EntityId,
+					TimeFrom: timeFrom,
+					TimeTo:   timeTo,
+				})
+			}
+		}
+
+		for _, booking := range replaced {
+			if booking.UserId == userId {
+				input.Exclude = append(input.Exclude, booking.Id)
+			}
+		}
+
+		if err := bgs.quotasService.Check(ctx, input); err != nil {
+			if errors.Is(err, models.ErrQuotaExceeded) || errors.Is(err, models.ErrBookingEntityNotFound) {
+				return err
+			}
+
+			return fmt.Errorf("quotasService.Check: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// checkIntersected returns ErrAlreadyHaveBooking if user has a booking
+// intersecting with interval, which is not a part of group groupId.
+func (bgs *bookingGroupsServiceImpl) checkIntersected(ctx context.Context, userId uuid.UUID, timeFrom, timeTo time.Time, groupId uuid.UUID) error {
+	intersected, err := bgs.bookingsRepo.ListIntersectedForUser(ctx, userId, timeFrom, timeTo)
+	if err != nil {
+		return fmt.Errorf("bookingsRepo.ListIntersectedForUser: %w", err)
+	}
+
+	for _, booking := range intersected {
+		if booking.GroupId == nil || *booking.GroupId != groupId {
+			return models.ErrAlreadyHaveBooking
+		}
+	}
+
+	return nil
+}
+
+// checkCapacity returns ErrNoFreePlaces if any entity has not enough free places
+// for all items booked on it. Places held by group itself within its current
+// interval are counted as free.
+func (bgs *bookingGroupsServiceImpl) checkCapacity(
+	ctx context.Context,
+	items []dto.BookingGroupItem,
+	timeFrom, timeTo time.Time,
+	group *models.BookingGroup,
+) error {
+	required := make(map[uuid.UUID]int)
+	for _, item := range items {
+		required[item.EntityId]++
+	}
+
+	held := make(map[uuid.UUID]int)
+	if group != nil {
+		for _, booking := range group.Bookings {
+			held[booking.EntityId]++
+		}
+	}
+
+	for entityId, places := range required {
+		workload, err := bgs.workloadsService.Get(ctx, entityId, timeFrom, timeTo)
+		if err != nil {
+			if errors.Is(err, models.ErrBookingEntityNotFound) {
+				return models.ErrBookingEntityNotFound
+			}
+
+			return fmt.Errorf("workloadsService.Get: %w", err)
+		}
+
+		if !hasFreePlaces(workload, places, group, held[entityId]) {
+			return models.ErrNoFreePlaces
+		}
+	}
+
+	return nil
+}
+
+// hasFreePlaces reports whether every snapshot of workload has at least places free places.
+// held places of group are added to snapshots within current group interval.
+func hasFreePlaces(workload models.Workload, places int, group *models.BookingGroup, held int) bool {
+	for _, snapshot := range workload {
+		free := snapshot.FreePlaces
+		if group != nil &&
+			group.TimeFrom.UTC().Unix() <= snapshot.Time.UTC().Unix() &&
+			snapshot.Time.UTC().Unix() <= group.TimeTo.UTC().Unix() {
+			free += held
+		}
+
+		if free < places {
+			return false
+		}
+	}
+
+	return true
+}
+
+func groupUsers(items []dto.BookingGroupItem) map[uuid.UUID]struct{} {
+	users := make(map[uuid.UUID]struct{}, len(items))
+	for _, item := range items {
+		users[item.UserId] = struct{}{}
+	}
+
+	return users
+}
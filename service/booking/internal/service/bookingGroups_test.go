@@ -0,0 +1,48 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"REDACTED/team-11/backend/booking/internal/dto"
+	"REDACTED/team-11/backend/booking/internal/models"
+)
+
+func TestHasFreePlaces(t *testing.T) {
+	start := time.Date(2025, 3, 3, 10, 0, 0, 0, time.UTC)
+	step := time.Duration(intervalMinutes) * time.Minute
+
+	workload := models.Workload{
+		{Time: start, FreePlaces: 3},
+		{Time: start.Add(step), FreePlaces: 1},
+		{Time: start.Add(2 * step), FreePlaces: 0},
+	}
+
+	assert.True(t, hasFreePlaces(workload[:1], 3, nil, 0))
+	assert.False(t, hasFreePlaces(workload[:2], 2, nil, 0))
+	assert.False(t, hasFreePlaces(workload, 1, nil, 0))
+
+	group := &models.BookingGroup{
+		TimeFrom: start.Add(step),
+		TimeTo:   start.Add(2 * step),
+	}
+
+	assert.True(t, hasFreePlaces(workload, 2, group, 2))
+	assert.False(t, hasFreePlaces(workload, 3, group, 2))
+}
+
+func TestGroupUsers(t *testing.T) {
+	first, second := uuid.New(), uuid.New()
+
+	users := groupUsers([]dto.BookingGroupItem{
+		{EntityId: uuid.New(), UserId: first},
+		{EntityId: uuid.New(), UserId: second},
+		{EntityId: uuid.New(), UserId: first},
+	})
+
+	assert.Len(t, users, 2)
+	assert.Contains(t, users, first)
+	assert.Contains(t, users, second)
+}
@@ -10,6 +10,9 @@ import (
 	"REDACTED/team-11/backend/booking/internal/dto"
 	"REDACTED/team-11/backend/booking/internal/models"
 	"REDACTED/team-11/backend/booking/internal/repo"
+	"REDACTED/team-11/backend/booking/pkg/logger"
+	"REDACTED/team-11/backend/booking/pkg/metrics"
+	"go.uber.org/zap"
 )
 
 type BookingsService struct {
@@ -18,6 +21,10 @@ type BookingsService struct {
 	ordersRepo          repo.OrdersRepo
 	workloadsService    WorkloadsService
 	usersRepo           repo.UsersRepo
+	buildingsService    BuildingsService
+	accessService       AccessService
+	delegationsService  DelegationsService
+	quotasService       QuotasService
 }
 
 func NewBookingsService(
@@ -26,6 +33,10 @@ func NewBookingsService(
 	ordersRepo repo.OrdersRepo,
 	workloadsService WorkloadsService,
 	usersRepo repo.UsersRepo,
+	buildingsService BuildingsService,
+	accessService AccessService,
+	delegationsService DelegationsService,
+	quotasService QuotasService,
 ) *BookingsService {
 	return &BookingsService{
 		bookingsRepo:        bookingsRepo,
@@ -33,19 +44,63 @@ func NewBookingsService(
 		ordersRepo:          ordersRepo,
 		workloadsService:    workloadsService,
 		usersRepo:           usersRepo,
+		buildingsService:    buildingsService,
+		accessService:       accessService,
+		delegationsService:  delegationsService,
+		quotasService:       quotasService,
 	}
 }
 
 func (bs *BookingsService) Create(ctx context.Context, input dto.BookingCreateDto) (models.Booking, error) {
 	op := "service.BookingsService.Create"
 
+	if err := bs.checkBeneficiary(ctx, input.Requester, input.UserId); err != nil {
+		if errors.Is(err, models.ErrNoDelegation) || errors.Is(err, models.ErrUserNotFound) {
+			return models.Booking{}, err
+		}
+
+		return models.Booking{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := bs.buildingsService.ValidateBooking(ctx, input.EntityId, input.TimeFrom, input.TimeTo); err != nil {
+		if errors.Is(err, models.ErrInvalidBookingSlot) || errors.Is(err, models.ErrBuildingClosed) {
+			return models.Booking{}, err
+		}
+
+		return models.Booking{}, fmt.Errorf("%s: buildingsService.ValidateBooking: %w", op, err)
+	}
+
+	if err := bs.accessService.CheckEntity(ctx, input.EntityId, input.UserId, input.Requester); err != nil {
+		if errors.Is(err, models.ErrEntityRestricted) {
+			return models.Booking{}, models.ErrEntityRestricted
+		}
+
+		return models.Booking{}, fmt.Errorf("%s: accessService.CheckEntity: %w", op, err)
+	}
+
+	if err := bs.quotasService.Check(ctx, dto.QuotaCheckDto{
+		UserId:    input.UserId,
+		Requester: input.Requester,
+		Bookings: []models.BookedInterval{{
+			EntityId: input.EntityId,
+			TimeFrom: input.TimeFrom,
+			TimeTo:   input.TimeTo,
+		}},
+	}); err != nil {
+		if errors.Is(err, models.ErrQuotaExceeded) || errors.Is(err, models.ErrBookingEntityNotFound) {
+			return models.Booking{}, countConflict(err)
+		}
+
+		return models.Booking{}, fmt.Errorf("%s: quotasService.Check: %w", op, err)
+	}
+
 	intersected, err := bs.bookingsRepo.ListIntersectedForUser(ctx, input.UserId, input.TimeFrom, input.TimeTo)
 	if err != nil {
 		return models.Booking{}, fmt.Errorf("%s: bookingsRepo.ListIntersectedForUser: %w", op, err)
 	}
 
 	if len(intersected) != 0 {
-		return models.Booking{}, models.ErrAlreadyHaveBooking
+		return models.Booking{}, countConflict(models.ErrAlreadyHaveBooking)
 	}
 
 	workload, err := bs.workloadsService.Get(ctx, input.EntityId, input.TimeFrom, input.TimeTo)
@@ -66,7 +121,7 @@ func (bs *BookingsService) Create(ctx context.Context, input dto.BookingCreateDt
 	}
 
 	if !free {
-		return models.Booking{}, models.ErrNoFreePlaces
+		return models.Booking{}, countConflict(models.ErrNoFreePlaces)
 	}
 
 	res, err := bs.bookingsRepo.Create(ctx, input)
@@ -74,6 +129,8 @@ func (bs *BookingsService) Create(ctx context.Context, input dto.BookingCreateDt
 		return models.Booking{}, fmt.Errorf("%s: bookingsRepo.Create: %w", op, err)
 	}
 
+	metrics.BookingsCreated.Inc()
+
 	return res, nil
 }
 
@@ -89,8 +146,14 @@ func (bs *BookingsService) GetById(ctx context.Context, bookingId uuid.UUID, tok
 		return models.BookingInfo{}, fmt.Errorf("%s: bookingsRepo.GetById: %w", op, err)
 	}
 
-	if booking.UserId != token.UserId && token.Role != models.RoleAdmin && token.Role != models.RoleSuperAdmin {
-		return models.BookingInfo{}, models.ErrNoAccessToBooking
+	if !token.Can(models.BOOKING_READ_ANY) {
+		if err := bs.checkManage(ctx, booking, token); err != nil {
+			if errors.Is(err, models.ErrNoAccessToBooking) {
+				return models.BookingInfo{}, models.ErrNoAccessToBooking
+			}
+
+			return models.BookingInfo{}, fmt.Errorf("%s: %w", op, err)
+		}
 	}
 
 	orders, err := bs.ordersRepo.GetForBooking(ctx, bookingId)
@@ -118,82 +181,32 @@ func (bs *BookingsService) GetById(ctx context.Context, bookingId uuid.UUID, tok
 	return bookingInfo, nil
 }
 
-func (bs *BookingsService) ListForUser(ctx context.Context, userId uuid.UUID) ([]models.BookingInfo, error) {
+func (bs *BookingsService) ListForUser(ctx context.Context, userId uuid.UUID, filter dto.BookingListFilter) (models.BookingPage, error) {
 	op := "service.BookingsService.ListForUser"
 
-	bookings, err := bs.bookingsRepo.ListForUser(ctx, userId)
-	if err != nil {
-		return nil, fmt.Errorf("%s: bookingsRepo.ListForUser: %w", op, err)
-	}
-
-	bookingsInfos := make([]models.BookingInfo, 0, len(bookings))
-
-	for _, booking := range bookings {
-		user, err := bs.usersRepo.GetById(ctx, booking.UserId)
-		if err != nil {
-			return nil, fmt.Errorf("%s: usersRepo.GetById: %w", op, err)
-		}
-
-		orders, err := bs.ordersRepo.GetForBooking(ctx, booking.Id)
-		if err != nil {
-			return nil, fmt.Errorf("%s: ordersRepo.GetForBooking: %w", op, err)
-		}
+	filter.UserId = &userId
 
-		entity, err := bs.bookingEntitiesRepo.GetById(ctx, booking.EntityId)
-		if err != nil {
-			return nil, fmt.Errorf("%s: bookingEntitiesRepo.GetById: %w", op, err)
-		}
-
-		bookingsInfos = append(bookingsInfos, models.BookingInfo{
-			Booking: booking,
-			Entity:  entity,
-			User:    user,
-			Orders:  orders,
-		})
+	page, err := bs.list(ctx, filter)
+	if err != nil {
+		return models.BookingPage{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return bookingsInfos, nil
+	return page, nil
 }
 
-func (bs *BookingsService) ListAll(ctx context.Context, token models.Token) ([]models.BookingInfo, error) {
+func (bs *BookingsService) ListAll(ctx context.Context, token models.Token, filter dto.BookingListFilter) (models.BookingPage, error) {
 	op := "service.BookingService.ListAll"
 
-	if token.Role != models.RoleAdmin && token.Role != models.RoleSuperAdmin {
-		return nil, models.ErrNoRights
+	if !token.Can(models.BOOKING_READ_ANY) {
+		return models.BookingPage{}, models.ErrNoRights
 	}
 
-	bookings, err := bs.bookingsRepo.ListAll(ctx)
+	page, err := bs.list(ctx, filter)
 	if err != nil {
-		return nil, fmt.Errorf("%s: bookingsRepo.ListForUser: %w", op, err)
-	}
-
-	bookingsInfos := make([]models.BookingInfo, 0, len(bookings))
-
-	for _, booking := range bookings {
-		user, err := bs.usersRepo.GetById(ctx, booking.UserId)
-		if err != nil {
-			return nil, fmt.Errorf("%s: usersRepo.GetById: %w", op, err)
-		}
-
-		orders, err := bs.ordersRepo.GetForBooking(ctx, booking.Id)
-		if err != nil {
-			return nil, fmt.Errorf("%s: ordersRepo.GetForBooking: %w", op, err)
-		}
-
-		entity, err := bs.bookingEntitiesRepo.GetById(ctx, booking.EntityId)
-		if err != nil {
-			return nil, fmt.Errorf("%s: bookingEntitiesRepo.GetById: %w", op, err)
-		}
-
-		bookingsInfos = append(bookingsInfos, models.BookingInfo{
-			Booking: booking,
-			Entity:  entity,
-			User:    user,
-			Orders:  orders,
-		})
+		return models.BookingPage{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return bookingsInfos, nil
+	return page, nil
 }
 
 func (bs *BookingsService) Update(ctx context.Context, input dto.BookingUpdateDto, token models.Token) (models.Booking, error) {
@@ -208,8 +221,44 @@ func (bs *BookingsService) Update(ctx context.Context, input dto.BookingUpdateDt
 		return models.Booking{}, fmt.Errorf("%s: bookingsRepo.GetById: %w", op, err)
 	}
 
-	if booking.UserId != token.UserId && token.Role != models.RoleAdmin && token.Role != models.RoleSuperAdmin {
-		return models.Booking{}, models.ErrNoAccessToBooking
+	if err := bs.checkManage(ctx, booking, token); err != nil {
+		if errors.Is(err, models.ErrNoAccessToBooking) {
+			return models.Booking{}, models.ErrNoAccessToBooking
+		}
+
+		return models.Booking{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if input.Version != nil && *input.Version != booking.Version {
+		return models.Booking{}, models.ErrBookingModified
+	}
+
+	if booking.GroupId != nil && (input.TimeFrom != nil || input.TimeTo != nil) {
+		return models.Booking{}, models.ErrBookingInGroup
+	}
+
+	userId := booking.UserId
+	if input.UserId != nil && *input.UserId != booking.UserId {
+		if err := bs.checkBeneficiary(ctx, token, *input.UserId); err != nil {
+			if errors.Is(err, models.ErrNoDelegation) || errors.Is(err, models.ErrUserNotFound) {
+				return models.Booking{}, err
+			}
+
+			return models.Booking{}, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if err := bs.accessService.CheckEntity(ctx, booking.EntityId, *input.UserId, token); err != nil {
+			if errors.Is(err, models.ErrEntityRestricted) {
+				return models.Booking{}, models.ErrEntityRestricted
+			}
+
+			return models.Booking{}, fmt.Errorf("%s: accessService.CheckEntity: %w", op, err)
+		}
+
+		userId = *input.UserId
+		input.BookedBy = &token.UserId
+	} else {
+		input.UserId = nil
 	}
 
 	var (
@@ -228,7 +277,32 @@ func (bs *BookingsService) Update(ctx context.Context, input dto.BookingUpdateDt
 		return models.Booking{}, models.ErrInvalidBookingTime
 	}
 
-	intersectedMayBeWithSame, err := bs.bookingsRepo.ListIntersectedForUser(ctx, token.UserId, resTimeFrom, resTimeTo)
+	if err := bs.buildingsService.ValidateBooking(ctx, booking.EntityId, resTimeFrom, resTimeTo); err != nil {
+		if errors.Is(err, models.ErrInvalidBookingSlot) || errors.Is(err, models.ErrBuildingClosed) {
+			return models.Booking{}, err
+		}
+
+		return models.Booking{}, fmt.Errorf("%s: buildingsService.ValidateBooking: %w", op, err)
+	}
+
+	if err := bs.quotasService.Check(ctx, dto.QuotaCheckDto{
+		UserId:    userId,
+		Requester: token,
+		Bookings: []models.BookedInterval{{
+			EntityId: booking.EntityId,
+			TimeFrom: resTimeFrom,
+			TimeTo:   resTimeTo,
+		}},
+		Exclude: []uuid.UUID{booking.Id},
+	}); err != nil {
+		if errors.Is(err, models.ErrQuotaExceeded) {
+			return models.Booking{}, countConflict(models.ErrQuotaExceeded)
+		}
+
+		return models.Booking{}, fmt.Errorf("%s: quotasService.Check: %w", op, err)
+	}
+
+	intersectedMayBeWithSame, err := bs.bookingsRepo.ListIntersectedForUser(ctx, userId, resTimeFrom, resTimeTo)
 	if err != nil {
 		return models.Booking{}, fmt.Errorf("%s: bookingsRepo.ListIntersectedForUser: %w", op, err)
 	}
@@ -241,7 +315,7 @@ func (bs *BookingsService) Update(ctx context.Context, input dto.BookingUpdateDt
 	}
 
 	if len(intersected) != 0 {
-		return models.Booking{}, models.ErrAlreadyHaveBooking
+		return models.Booking{}, countConflict(models.ErrAlreadyHaveBooking)
 	}
 
 	workload, err := bs.workloadsService.Get(ctx, booking.EntityId, resTimeFrom, resTimeTo)
@@ -262,7 +336,7 @@ func (bs *BookingsService) Update(ctx context.Context, input dto.BookingUpdateDt
 	}
 
 	if !free {
-		return models.Booking{}, models.ErrNoFreePlaces
+		return models.Booking{}, countConflict(models.ErrNoFreePlaces)
 	}
 
 	updated, err := bs.bookingsRepo.Update(ctx, input)
@@ -273,7 +347,9 @@ func (bs *BookingsService) Update(ctx context.Context, input dto.BookingUpdateDt
 	return updated, nil
 }
 
-func (bs *BookingsService) Delete(ctx context.Context, bookingId uuid.UUID, token models.Token) error {
+// Delete removes the booking. If version is set, booking is removed only
+// if it was not modified since.
+func (bs *BookingsService) Delete(ctx context.Context, bookingId uuid.UUID, version *int, token models.Token) error {
 	op := "service.BookingsService.Delete"
 
 	booking, err := bs.bookingsRepo.GetById(ctx, bookingId)
@@ -285,14 +361,328 @@ func (bs *BookingsService) Delete(ctx context.Context, bookingId uuid.UUID, toke
 		return fmt.Errorf("%s: bookingsRepo.GetById: %w", op, err)
 	}
 
-	if booking.UserId != token.UserId && token.Role != models.RoleAdmin && token.Role != models.RoleSuperAdmin {
-		return models.ErrNoAccessToBooking
+	if err := bs.checkManage(ctx, booking, token); err != nil {
+		if errors.Is(err, models.ErrNoAccessToBooking) {
+			return models.ErrNoAccessToBooking
+		}
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if version != nil && *version != booking.Version {
+		return models.ErrBookingModified
 	}
 
-	err = bs.bookingsRepo.Delete(ctx, bookingId)
+	err = bs.bookingsRepo.Delete(ctx, bookingId, version)
 	if err != nil {
 		return fmt.Errorf("%s: bookingsRepo.Delete: %w", op, err)
 	}
 
 	return nil
 }
+
+// Extend prolongs active booking by slots of 15 minutes. Only the added
+// slots are validated and checked for free places.
+func (bs *BookingsService) Extend(ctx context.Context, bookingId uuid.UUID, slots int, version *int, token models.Token) (models.Booking, error) {
+	op := "service.BookingsService.Extend"
+
+	if slots < 1 {
+		return models.Booking{}, models.ErrInvalidBookingTime
+	}
+
+	booking, err := bs.getActive(ctx, bookingId, version, token)
+	if err != nil {
+		return models.Booking{}, err
+	}
+
+	timeTo := extendedTimeTo(booking.TimeTo, slots)
+
+	if err := bs.buildingsService.ValidateBooking(ctx, booking.EntityId, booking.TimeTo, timeTo); err != nil {
+		if errors.Is(err, models.ErrInvalidBookingSlot) || errors.Is(err, models.ErrBuildingClosed) {
+			return models.Booking{}, err
+		}
+
+		return models.Booking{}, fmt.Errorf("%s: buildingsService.ValidateBooking: %w", op, err)
+	}
+
+	if err := bs.quotasService.Check(ctx, dto.QuotaCheckDto{
+		UserId:    booking.UserId,
+		Requester: token,
+		Bookings: []models.BookedInterval{{
+			EntityId: booking.EntityId,
+			TimeFrom: booking.TimeFrom,
+			TimeTo:   timeTo,
+		}},
+		Exclude: []uuid.UUID{booking.Id},
+	}); err != nil {
+		if errors.Is(err, models.ErrQuotaExceeded) {
+			return models.Booking{}, countConflict(models.ErrQuotaExceeded)
+		}
+
+		return models.Booking{}, fmt.Errorf("%s: quotasService.Check: %w", op, err)
+	}
+
+	intersected, err := bs.bookingsRepo.ListIntersectedForUser(ctx, booking.UserId, booking.TimeTo, timeTo)
+	if err != nil {
+		return models.Booking{}, fmt.Errorf("%s: bookingsRepo.ListIntersectedForUser: %w", op, err)
+	}
+
+	if len(intersected) != 0 {
+		return models.Booking{}, countConflict(models.ErrAlreadyHaveBooking)
+	}
+
+	workload, err := bs.workloadsService.Get(ctx, booking.EntityId, booking.TimeTo, timeTo)
+	if err != nil {
+		return models.Booking{}, fmt.Errorf("%s: workloadsService.Get: %w", op, err)
+	}
+
+	for _, snapshot := range workload {
+		if !snapshot.IsFree {
+			return models.Booking{}, countConflict(models.ErrNoFreePlaces)
+		}
+	}
+
+	res, err := bs.bookingsRepo.Resize(ctx, dto.BookingResizeDto{
+		BookingId:  booking.Id,
+		Action:     models.BookingActionExtend,
+		ActorId:    token.UserId,
+		PrevTimeTo: booking.TimeTo,
+		TimeTo:     timeTo,
+		Version:    version,
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrBookingModified) {
+			return models.Booking{}, models.ErrBookingModified
+		}
+
+		return models.Booking{}, fmt.Errorf("%s: bookingsRepo.Resize: %w", op, err)
+	}
+
+	return res, nil
+}
+
+// Release ends active booking at the next slot boundary, so the rest of
+// its time is free for others right away.
+func (bs *BookingsService) Release(ctx context.Context, bookingId uuid.UUID, version *int, token models.Token) (models.Booking, error) {
+	op := "service.BookingsService.Release"
+
+	booking, err := bs.getActive(ctx, bookingId, version, token)
+	if err != nil {
+		return models.Booking{}, err
+	}
+
+	res, err := bs.bookingsRepo.Resize(ctx, dto.BookingResizeDto{
+		BookingId:  booking.Id,
+		Action:     models.BookingActionRelease,
+		ActorId:    token.UserId,
+		PrevTimeTo: booking.TimeTo,
+		TimeTo:     releasedTimeTo(booking.TimeTo, time.Now()),
+		Version:    version,
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrBookingModified) {
+			return models.Booking{}, models.ErrBookingModified
+		}
+
+		return models.Booking{}, fmt.Errorf("%s: bookingsRepo.Resize: %w", op, err)
+	}
+
+	return res, nil
+}
+
+// History returns extensions and releases of booking.
+func (bs *BookingsService) History(ctx context.Context, bookingId uuid.UUID, token models.Token) ([]models.BookingHistoryItem, error) {
+	op := "service.BookingsService.History"
+
+	booking, err := bs.bookingsRepo.GetById(ctx, bookingId)
+	if err != nil {
+		if errors.Is(err, models.ErrBookingNotFound) {
+			return nil, models.ErrBookingNotFound
+		}
+
+		return nil, fmt.Errorf("%s: bookingsRepo.GetById: %w", op, err)
+	}
+
+	if !token.Can(models.BOOKING_READ_ANY) {
+		if err := bs.checkManage(ctx, booking, token); err != nil {
+			if errors.Is(err, models.ErrNoAccessToBooking) {
+				return nil, models.ErrNoAccessToBooking
+			}
+
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	history, err := bs.bookingsRepo.ListHistory(ctx, bookingId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: bookingsRepo.ListHistory: %w", op, err)
+	}
+
+	return history, nil
+}
+
+// getActive returns booking, which may be extended or released by token
+// owner right now.
+func (bs *BookingsService) getActive(ctx context.Context, bookingId uuid.UUID, version *int, token models.Token) (models.Booking, error) {
+	op := "service.BookingsService.getActive"
+
+	booking, err := bs.bookingsRepo.GetById(ctx, bookingId)
+	if err != nil {
+		if errors.Is(err, models.ErrBookingNotFound) {
+			return models.Booking{}, models.ErrBookingNotFound
+		}
+
+		return models.Booking{}, fmt.Errorf("%s: bookingsRepo.GetById: %w", op, err)
+	}
+
+	if err := bs.checkManage(ctx, booking, token); err != nil {
+		if errors.Is(err, models.ErrNoAccessToBooking) {
+			return models.Booking{}, models.ErrNoAccessToBooking
+		}
+
+		return models.Booking{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if version != nil && *version != booking.Version {
+		return models.Booking{}, models.ErrBookingModified
+	}
+
+	if booking.Status(time.Now()) != models.BookingStatusActive {
+		return models.Booking{}, models.ErrBookingNotActive
+	}
+
+	if booking.GroupId != nil {
+		return models.Booking{}, models.ErrBookingInGroup
+	}
+
+	return booking, nil
+}
+
+// extendedTimeTo returns end of booking prolonged by slots.
+func extendedTimeTo(timeTo time.Time, slots int) time.Time {
+	return timeTo.Add(time.Duration(slots*intervalMinutes) * time.Minute)
+}
+
+// releasedTimeTo returns the first slot boundary after now, but not later
+// than the current end of booking.
+func releasedTimeTo(timeTo, now time.Time) time.Time {
+	slot := time.Duration(intervalMinutes) * time.Minute
+
+	return minTime(now.Truncate(slot).Add(slot), timeTo)
+}
+
+// list returns a page of bookings with their entities, floors, orders and users.
+func (bs *BookingsService) list(ctx context.Context, filter dto.BookingListFilter) (models.BookingPage, error) {
+	if filter.Sort == "" {
+		filter.Sort = dto.BookingSortCreatedAtDesc
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = dto.DefaultBookingListLimit
+	}
+	limit = min(limit, dto.MaxBookingListLimit)
+
+	var after *dto.BookingCursor
+	if filter.Cursor != "" {
+		cursor, err := dto.DecodeBookingCursor(filter.Cursor)
+		if err != nil || cursor.Sort != filter.Sort {
+			return models.BookingPage{}, models.ErrInvalidCursor
+		}
+
+		after = &cursor
+	}
+
+	// One extra booking tells whether there is a next page.
+	filter.Limit = limit + 1
+
+	infos, err := bs.bookingsRepo.ListInfo(ctx, filter, after)
+	if err != nil {
+		return models.BookingPage{}, fmt.Errorf("bookingsRepo.ListInfo: %w", err)
+	}
+
+	var page models.BookingPage
+	if len(infos) > limit {
+		infos = infos[:limit]
+		page.NextCursor = dto.NewBookingCursor(filter.Sort, infos[limit-1].Booking).Encode()
+	}
+
+	bookings := make([]models.Booking, 0, len(infos))
+	for _, info := range infos {
+		bookings = append(bookings, info.Booking)
+	}
+
+	users := bs.users(ctx, bookings)
+	for i := range infos {
+		user, ok := users[infos[i].UserId]
+		if !ok {
+			user = models.UnknownUser(infos[i].UserId)
+		}
+
+		infos[i].User = user
+	}
+
+	page.Items = infos
+
+	return page, nil
+}
+
+// users reads owners of bookings from coffee-id in one batch. List is still shown
+// when coffee-id is down, its users are shown as unknown then.
+func (bs *BookingsService) users(ctx context.Context, bookings []models.Booking) map[uuid.UUID]models.User {
+	ids := make([]uuid.UUID, 0, len(bookings))
+
+	for _, booking := range bookings {
+		ids = append(ids, booking.UserId)
+	}
+
+	users, err := bs.usersRepo.GetByIds(ctx, ids)
+	if err != nil {
+		logger.FromCtx(ctx).Warn("users of bookings are unavailable", zap.Error(err))
+	}
+
+	return users
+}
+
+// checkBeneficiary checks that requester may book on behalf of user and user exists.
+func (bs *BookingsService) checkBeneficiary(ctx context.Context, requester models.Token, userId uuid.UUID) error {
+	if requester.UserId == userId {
+		return nil
+	}
+
+	if err := bs.delegationsService.CheckActFor(ctx, requester, userId); err != nil {
+		if errors.Is(err, models.ErrNoDelegation) {
+			return models.ErrNoDelegation
+		}
+
+		return fmt.Errorf("delegationsService.CheckActFor: %w", err)
+	}
+
+	if _, err := bs.usersRepo.GetById(ctx, userId); err != nil {
+		if errors.Is(err, models.ErrUserNotFound) {
+			return models.ErrUserNotFound
+		}
+
+		return fmt.Errorf("usersRepo.GetById: %w", err)
+	}
+
+	return nil
+}
+
+// checkManage returns ErrNoAccessToBooking if token owner is neither beneficiary,
+// nor booker, nor delegate of beneficiary, nor holder of booking.write.any.
+func (bs *BookingsService) checkManage(ctx context.Context, booking models.Booking, token models.Token) error {
+	if booking.UserId == token.UserId || booking.BookedBy == token.UserId {
+		return nil
+	}
+
+	if err := bs.delegationsService.CheckActFor(ctx, token, booking.UserId); err != nil {
+		if errors.Is(err, models.ErrNoDelegation) {
+			return models.ErrNoAccessToBooking
+		}
+
+		return fmt.Errorf("delegationsService.CheckActFor: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"REDACTED/team-11/backend/booking/internal/dto"
+	"REDACTED/team-11/backend/booking/internal/models"
+	"REDACTED/team-11/backend/booking/internal/repo"
+)
+
+func TestExtendedTimeTo(t *testing.T) {
+	timeTo := time.Date(2025, 3, 3, 11, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, timeTo.Add(15*time.Minute), extendedTimeTo(timeTo, 1))
+	assert.Equal(t, timeTo.Add(2*time.Hour), extendedTimeTo(timeTo, 8))
+}
+
+func TestReleasedTimeTo(t *testing.T) {
+	timeTo := time.Date(2025, 3, 3, 12, 0, 0, 0, time.UTC)
+
+	now := time.Date(2025, 3, 3, 10, 7, 30, 0, time.UTC)
+	assert.Equal(t, time.Date(2025, 3, 3, 10, 15, 0, 0, time.UTC), releasedTimeTo(timeTo, now))
+
+	now = time.Date(2025, 3, 3, 10, 15, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2025, 3, 3, 10, 30, 0, 0, time.UTC), releasedTimeTo(timeTo, now))
+
+	now = time.Date(2025, 3, 3, 11, 50, 0, 0, time.UTC)
+	assert.Equal(t, timeTo, releasedTimeTo(timeTo, now))
+}
+
+// stubDelegationsRepo keeps delegations as user id to delegate ids.
+type stubDelegationsRepo struct {
+	repo.DelegationsRepo
+	delegates map[uuid.UUID][]uuid.UUID
+}
+
+func (sr stubDelegationsRepo) Get(ctx context.Context, userId, delegateId uuid.UUID) (models.Delegation, error) {
+	for _, id := range sr.delegates[userId] {
+		if id == delegateId {
+			return models.Delegation{UserId: userId, DelegateId: delegateId}, nil
+		}
+	}
+
+	return models.Delegation{}, models.ErrDelegationNotFound
+}
+
+func TestBookOnBehalf(t *testing.T) {
+	executive := models.User{Id: uuid.New()}
+	assistant := models.User{Id: uuid.New()}
+	stranger := models.User{Id: uuid.New()}
+
+	usersRepo := stubUsersRepo{users: map[uuid.UUID]models.User{
+		executive.Id: executive,
+		assistant.Id: assistant,
+		stranger.Id:  stranger,
+	}}
+	delegationsService := NewDelegationsService(stubDelegationsRepo{
+		delegates: map[uuid.UUID][]uuid.UUID{executive.Id: {assistant.Id}},
+	}, usersRepo)
+
+	bs := NewBookingsService(nil, nil, nil, nil, usersRepo, nil, nil, delegationsService, nil)
+	ctx := context.Background()
+
+	// Beneficiary is checked before anything else, so the rest of services isn't needed.
+	_, err := bs.Create(ctx, dto.BookingCreateDto{
+		EntityId:  uuid.New(),
+		UserId:    executive.Id,
+		Requester: models.Token{UserId: stranger.Id},
+	})
+	assert.ErrorIs(t, err, models.ErrNoDelegation)
+
+	// Delegation is one way.
+	assert.ErrorIs(t, bs.checkBeneficiary(ctx, models.Token{UserId: executive.Id}, assistant.Id), models.ErrNoDelegation)
+
+	assert.NoError(t, bs.checkBeneficiary(ctx, models.Token{UserId: assistant.Id}, executive.Id))
+	assert.NoError(t, bs.checkBeneficiary(ctx, models.Token{UserId: stranger.Id}, stranger.Id))
+
+	admin := models.Token{UserId: stranger.Id, Permissions: []models.Permission{models.BOOKING_WRITE_ANY}}
+	assert.NoError(t, bs.checkBeneficiary(ctx, admin, executive.Id))
+	assert.ErrorIs(t, bs.checkBeneficiary(ctx, admin, uuid.New()), models.ErrUserNotFound)
+
+	booking := models.Booking{Id: uuid.New(), UserId: executive.Id, BookedBy: assistant.Id}
+	assert.NoError(t, bs.checkManage(ctx, booking, models.Token{UserId: assistant.Id}))
+	assert.NoError(t, bs.checkManage(ctx, booking, models.Token{UserId: executive.Id}))
+	assert.ErrorIs(t, bs.checkManage(ctx, booking, models.Token{UserId: stranger.Id}), models.ErrNoAccessToBooking)
+}
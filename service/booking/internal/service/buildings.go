@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"REDACTED/team-11/backend/booking/internal/models"
+	"REDACTED/team-11/backend/booking/internal/repo"
+)
+
+type BuildingsService interface {
+	GetById(ctx context.Context, id uuid.UUID) (models.Building, error)
+	GetForEntity(ctx context.Context, entityId uuid.UUID) (models.Building, error)
+	GetForFloor(ctx context.Context, floor models.Floor) (models.Building, error)
+	ValidateInterval(building models.Building, timeFrom, timeTo time.Time) error
+	ValidateBooking(ctx context.Context, entityId uuid.UUID, timeFrom, timeTo time.Time) error
+}
+
+var (
+	_ BuildingsService = NewBuildingsService(nil)
+)
+
+var (
+	defaultBuilding = models.Building{Timezone: time.UTC.String()}
+)
+
+type buildingsServiceImpl struct {
+	buildingsRepo repo.BuildingsRepo
+}
+
+func NewBuildingsService(buildingsRepo repo.BuildingsRepo) *buildingsServiceImpl {
+	return &buildingsServiceImpl{
+		buildingsRepo: buildingsRepo,
+	}
+}
+
+func (bs *buildingsServiceImpl) GetById(ctx context.Context, id uuid.UUID) (models.Building, error) {
+	op := "service.buildingsServiceImpl.GetById"
+
+	building, err := bs.buildingsRepo.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, models.ErrBuildingNotFound) {
+			return models.Building{}, models.ErrBuildingNotFound
+		}
+
+		return models.Building{}, fmt.Errorf("%s: buildingsRepo.GetById: %w", op, err)
+	}
+
+	return building, nil
+}
+
+// GetForEntity returns building of entity. Entities on floors
+// without building get default building, which works in UTC around the clock.
+func (bs *buildingsServiceImpl) GetForEntity(ctx context.Context, entityId uuid.UUID) (models.Building, error) {
+	op := "service.buildingsServiceImpl.GetForEntity"
+
+	building, err := bs.buildingsRepo.GetForEntity(ctx, entityId)
+	if err != nil {
+		if errors.Is(err, models.ErrBuildingNotFound) {
+			return defaultBuilding, nil
+		}
+
+		return models.Building{}, fmt.Errorf("%s: buildingsRepo.GetForEntity: %w", op, err)
+	}
+
+	return building, nil
+}
+
+func (bs *buildingsServiceImpl) GetForFloor(ctx context.Context, floor models.Floor) (models.Building, error) {
+	op := "service.buildingsServiceImpl.GetForFloor"
+
+	if floor.BuildingId == nil {
+		return defaultBuilding, nil
+	}
+
+	building, err := bs.buildingsRepo.GetById(ctx, *floor.BuildingId)
+	if err != nil {
+		if errors.Is(err, models.ErrBuildingNotFound) {
+			return defaultBuilding, nil
+		}
+
+		return models.Building{}, fmt.Errorf("%s: buildingsRepo.GetById: %w", op, err)
+	}
+
+	return building, nil
+}
+
+// ValidateInterval checks that interval bounds are aligned to slots in building local time.
+func (bs *buildingsServiceImpl) ValidateInterval(building models.Building, timeFrom, timeTo time.Time) error {
+	op := "service.buildingsServiceImpl.ValidateInterval"
+
+	loc, err := building.Location()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !isSlotAligned(timeFrom.In(loc)) || !isSlotAligned(timeTo.In(loc)) {
+		return models.ErrInvalidBookingSlot
+	}
+
+	return nil
+}
+
+func (bs *buildingsServiceImpl) ValidateBooking(ctx context.Context, entityId uuid.UUID, timeFrom, timeTo time.Time) error {
+	op := "service.buildingsServiceImpl.ValidateBooking"
+
+	building, err := bs.GetForEntity(ctx, entityId)
+	if err != nil {
+		return fmt.Errorf("%s: bs.GetForEntity: %w", op, err)
+	}
+
+	if err := bs.ValidateInterval(building, timeFrom, timeTo); err != nil {
+		return err
+	}
+
+	isOpen, err := building.IsOpen(timeFrom, timeTo)
+	if err != nil {
+		return fmt.Errorf("%s: building.IsOpen: %w", op, err)
+	}
+	if !isOpen {
+		return models.ErrBuildingClosed
+	}
+
+	return nil
+}
+
+func isSlotAligned(t time.Time) bool {
+	return t.Nanosecond() == 0 && t.Second() == 0 && t.Minute()%intervalMinutes == 0
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"REDACTED/team-11/backend/booking/internal/models"
+)
+
+func TestValidateIntervalInBuildingTimezone(t *testing.T) {
+	bs := NewBuildingsService(nil)
+	building := models.Building{Timezone: "Asia/Kathmandu"}
+
+	loc, err := building.Location()
+	require.NoError(t, err)
+	timeFrom := time.Date(2025, 3, 1, 9, 0, 0, 0, loc)
+	timeTo := time.Date(2025, 3, 1, 10, 15, 0, 0, loc)
+
+	assert.NoError(t, bs.ValidateInterval(building, timeFrom, timeTo))
+	assert.ErrorIs(t, bs.ValidateInterval(building, timeFrom.Add(5*time.Minute), timeTo), models.ErrInvalidBookingSlot)
+}
+
+func TestBuildingIsOpen(t *testing.T) {
+	opensAt, closesAt := "09:00", "18:00"
+	building := models.Building{
+		Timezone: "Europe/Moscow",
+		OpensAt:  &opensAt,
+		ClosesAt: &closesAt,
+	}
+
+	loc, err := building.Location()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		building models.Building
+		from, to time.Time
+		want     bool
+	}{
+		{"inside hours", building, time.Date(2025, 3, 1, 9, 0, 0, 0, loc), time.Date(2025, 3, 1, 18, 0, 0, 0, loc), true},
+		{"before opening", building, time.Date(2025, 3, 1, 8, 45, 0, 0, loc), time.Date(2025, 3, 1, 10, 0, 0, 0, loc), false},
+		{"after closing", building, time.Date(2025, 3, 1, 17, 0, 0, 0, loc), time.Date(2025, 3, 1, 18, 15, 0, 0, loc), false},
+		{"no hours", models.Building{Timezone: "UTC"}, time.Date(2025, 3, 1, 2, 0, 0, 0, time.UTC), time.Date(2025, 3, 1, 3, 0, 0, 0, time.UTC), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isOpen, err := tt.building.IsOpen(tt.from, tt.to)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, isOpen)
+		})
+	}
+}
+
+func TestBuildingIsOpenInvalidHours(t *testing.T) {
+	for _, clock := range []string{"9:00", "evening", ""} {
+		opensAt, closesAt := clock, "18:00"
+		building := models.Building{Timezone: "UTC", OpensAt: &opensAt, ClosesAt: &closesAt}
+
+		_, err := building.IsOpen(
+			time.Date(2025, 3, 1, 2, 0, 0, 0, time.UTC),
+			time.Date(2025, 3, 1, 3, 0, 0, 0, time.UTC),
+		)
+		assert.ErrorIs(t, err, models.ErrInvalidOpeningHours, clock)
+	}
+}
+
+func TestBuildingIsOpenOnDSTChange(t *testing.T) {
+	opensAt, closesAt := "09:00", "18:00"
+	building := models.Building{Timezone: "Europe/Berlin", OpensAt: &opensAt, ClosesAt: &closesAt}
+
+	loc, err := building.Location()
+	require.NoError(t, err)
+
+	// Clocks go forward at 02:00, the day is 23 hours long.
+	isOpen, err := building.IsOpen(
+		time.Date(2025, 3, 30, 9, 0, 0, 0, loc),
+		time.Date(2025, 3, 30, 10, 0, 0, 0, loc),
+	)
+	require.NoError(t, err)
+	assert.True(t, isOpen)
+
+	isOpen, err = building.IsOpen(
+		time.Date(2025, 3, 30, 17, 0, 0, 0, loc),
+		time.Date(2025, 3, 30, 18, 15, 0, 0, loc),
+	)
+	require.NoError(t, err)
+	assert.False(t, isOpen)
+}
+
+func TestBuildingUnknownTimezone(t *testing.T) {
+	building := models.Building{Timezone: "Mars/Olympus_Mons"}
+
+	_, err := building.Location()
+	assert.ErrorIs(t, err, models.ErrInvalidTimezone)
+
+	from := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	assert.ErrorIs(t, NewBuildingsService(nil).ValidateInterval(building, from, from.Add(time.Hour)), models.ErrInvalidTimezone)
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"REDACTED/team-11/backend/booking/internal/models"
+	"REDACTED/team-11/backend/booking/internal/repo"
+)
+
+type DelegationsService interface {
+	Create(ctx context.Context, token models.Token, delegateId uuid.UUID) (models.Delegation, error)
+	Delete(ctx context.Context, token models.Token, delegateId uuid.UUID) error
+	List(ctx context.Context, token models.Token) (granted []models.Delegation, received []models.Delegation, err error)
+	CheckActFor(ctx context.Context, token models.Token, userId uuid.UUID) error
+}
+
+var (
+	_ DelegationsService = NewDelegationsService(nil, nil)
+)
+
+type delegationsServiceImpl struct {
+	delegationsRepo repo.DelegationsRepo
+	usersRepo       repo.UsersRepo
+}
+
+func NewDelegationsService(delegationsRepo repo.DelegationsRepo, usersRepo repo.UsersRepo) *delegationsServiceImpl {
+	return &delegationsServiceImpl{
+		delegationsRepo: delegationsRepo,
+		usersRepo:       usersRepo,
+	}
+}
+
+// Create allows delegate to book on behalf of token owner.
+func (ds *delegationsServiceImpl) Create(ctx context.Context, token models.Token, delegateId uuid.UUID) (models.Delegation, error) {
+	op := "service.delegationsServiceImpl.Create"
+
+	if token.UserId == delegateId {
+		return models.Delegation{}, models.ErrSelfDelegation
+	}
+
+	if _, err := ds.usersRepo.GetById(ctx, delegateId); err != nil {
+		if errors.Is(err, models.ErrUserNotFound) {
+			return models.Delegation{}, models.ErrUserNotFound
+		}
+
+		return models.Delegation{}, fmt.Errorf("%s: usersRepo.GetById: %w", op, err)
+	}
+
+	delegation, err := ds.delegationsRepo.Create(ctx, token.UserId, delegateId)
+	if err != nil {
+		if errors.Is(err, models.ErrDelegationAlreadyExists) || errors.Is(err, models.ErrSelfDelegation) {
+			return models.Delegation{}, err
+		}
+
+		return models.Delegation{}, fmt.Errorf("%s: delegationsRepo.Create: %w", op, err)
+	}
+
+	return delegation, nil
+}
+
+func (ds *delegationsServiceImpl) Delete(ctx context.Context, token models.Token, delegateId uuid.UUID) error {
+	op := "service.delegationsServiceImpl.Delete"
+
+	if err := ds.delegationsRepo.Delete(ctx, token.UserId, delegateId); err != nil {
+		if errors.Is(err, models.ErrDelegationNotFound) {
+			return models.ErrDelegationNotFound
+		}
+
+		return fmt.Errorf("%s: delegationsRepo.Delete: %w", op, err)
+	}
+
+	return nil
+}
+
+func (ds *delegationsServiceImpl) List(ctx context.Context, token models.Token) ([]models.Delegation, []models.Delegation, error) {
+	op := "service.delegationsServiceImpl.List"
+
+	granted, err := ds.delegationsRepo.ListForUser(ctx, token.UserId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: delegationsRepo.ListForUser: %w", op, err)
+	}
+
+	received, err := ds.delegationsRepo.ListForDelegate(ctx, token.UserId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: delegationsRepo.ListForDelegate: %w", op, err)
+	}
+
+	return granted, received, nil
+}
+
+// CheckActFor returns ErrNoDelegation if token owner can't book on behalf of user.
+// Holders of booking.write.any may act for any user.
+func (ds *delegationsServiceImpl) CheckActFor(ctx context.Context, token models.Token, userId uuid.UUID) error {
+	op := "service.delegationsServiceImpl.CheckActFor"
+
+	if token.UserId == userId || token.Can(models.BOOKING_WRITE_ANY) {
+		return nil
+	}
+
+	if _, err := ds.delegationsRepo.Get(ctx, userId, token.UserId); err != nil {
+		if errors.Is(err, models.ErrDelegationNotFound) {
+			return models.ErrNoDelegation
+		}
+
+		return fmt.Errorf("%s: delegationsRepo.Get: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package service
+
+import (
+	"errors"
+
+	"REDACTED/team-11/backend/booking/internal/models"
+	"REDACTED/team-11/backend/booking/pkg/metrics"
+)
+
+// countConflict counts booking rejected because of other bookings or quotas
+// and returns err as is.
+func countConflict(err error) error {
+	switch {
+	case errors.Is(err, models.ErrAlreadyHaveBooking):
+		metrics.BookingConflicts.WithLabelValues("already_have_booking").Inc()
+	case errors.Is(err, models.ErrNoFreePlaces):
+		metrics.BookingConflicts.WithLabelValues("no_free_places").Inc()
+	case errors.Is(err, models.ErrQuotaExceeded):
+		metrics.BookingConflicts.WithLabelValues("quota_exceeded").Inc()
+	}
+
+	return err
+}
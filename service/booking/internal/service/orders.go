@@ -9,6 +9,7 @@ import (
 	"REDACTED/team-11/backend/booking/internal/dto"
 	"REDACTED/team-11/backend/booking/internal/models"
 	"REDACTED/team-11/backend/booking/internal/repo"
+	"REDACTED/team-11/backend/booking/pkg/metrics"
 )
 
 type OrdersService struct {
@@ -26,7 +27,7 @@ func NewOrdersService(
 	}
 }
 
-func (os *OrdersService) Create(ctx context.Context, input dto.OrderCreateDto, userId uuid.UUID) (models.Order, error) {
+func (os *OrdersService) Create(ctx context.Context, input dto.OrderCreateDto, token models.Token) (models.Order, error) {
 	op := "service.OrdersService.Create"
 
 	booking, err := os.bookingsRepo.GetById(ctx, input.BookingId)
@@ -38,7 +39,7 @@ func (os *OrdersService) Create(ctx context.Context, input dto.OrderCreateDto, u
 		return models.Order{}, fmt.Errorf("%s: bookingsRepo.GetById: %w", op, err)
 	}
 
-	if booking.UserId != userId {
+	if booking.UserId != token.UserId && !token.Can(models.ORDER_WRITE_ANY) {
 		return models.Order{}, models.ErrNoAccessToBooking
 	}
 
@@ -47,6 +48,8 @@ func (os *OrdersService) Create(ctx context.Context, input dto.OrderCreateDto, u
 		return models.Order{}, fmt.Errorf("%s: ordersRepo.Create: %w", op, err)
 	}
 
+	metrics.OrdersCreated.Inc()
+
 	return created, nil
 }
 
@@ -62,7 +65,7 @@ func (os *OrdersService) GetForBooking(ctx context.Context, bookingId uuid.UUID,
 		return nil, fmt.Errorf("%s: bookingsRepo.GetById: %w", op, err)
 	}
 
-	if booking.UserId != token.UserId && token.Role != models.RoleAdmin && token.Role != models.RoleSuperAdmin {
+	if booking.UserId != token.UserId && !token.Can(models.ORDER_READ_ANY) {
 		return nil, models.ErrNoAccessToBooking
 	}
 
@@ -99,7 +102,7 @@ func (os *OrdersService) Delete(ctx context.Context, bookingId, orderId uuid.UUI
 		return fmt.Errorf("%s: bookingsRepo.GetById: %w", op, err)
 	}
 
-	if booking.UserId != token.UserId && token.Role != models.RoleAdmin && token.Role != models.RoleSuperAdmin {
+	if booking.UserId != token.UserId && !token.Can(models.ORDER_WRITE_ANY) {
 		return models.ErrNoAccessToBooking
 	}
 
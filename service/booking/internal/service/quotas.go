@@ -0,0 +1,441 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"REDACTED/team-11/backend/booking/internal/dto"
+	"REDACTED/team-11/backend/booking/internal/models"
+	"REDACTED/team-11/backend/booking/internal/repo"
+)
+
+type QuotasService interface {
+	Check(ctx context.Context, input dto.QuotaCheckDto) error
+	GetStatus(ctx context.Context, token models.Token, buildingId *uuid.UUID) (models.QuotaStatus, error)
+
+	ListOverrides(ctx context.Context, token models.Token) ([]models.QuotaOverride, error)
+	SetForUser(ctx context.Context, token models.Token, userId uuid.UUID, input dto.QuotaOverrideDto) (models.QuotaOverride, error)
+	SetForTeam(ctx context.Context, token models.Token, teamId uuid.UUID, input dto.QuotaOverrideDto) (models.QuotaOverride, error)
+	SetForRole(ctx context.Context, token models.Token, role models.Role, input dto.QuotaOverrideDto) (models.QuotaOverride, error)
+	DeleteForUser(ctx context.Context, token models.Token, userId uuid.UUID) error
+	DeleteForTeam(ctx context.Context, token models.Token, teamId uuid.UUID) error
+	DeleteForRole(ctx context.Context, token models.Token, role models.Role) error
+}
+
+var (
+	_ QuotasService = NewQuotasService(nil, nil, nil, nil, models.QuotaLimits{})
+)
+
+type quotasServiceImpl struct {
+	quotasRepo          repo.QuotasRepo
+	bookingEntitiesRepo repo.BookingEntitiesRepo
+	usersRepo           repo.UsersRepo
+	buildingsService    BuildingsService
+	defaults            models.QuotaLimits
+}
+
+func NewQuotasService(
+	quotasRepo repo.QuotasRepo,
+	bookingEntitiesRepo repo.BookingEntitiesRepo,
+	usersRepo repo.UsersRepo,
+	buildingsService BuildingsService,
+	defaults models.QuotaLimits,
+) *quotasServiceImpl {
+	return &quotasServiceImpl{
+		quotasRepo:          quotasRepo,
+		bookingEntitiesRepo: bookingEntitiesRepo,
+		usersRepo:           usersRepo,
+		buildingsService:    buildingsService,
+		defaults:            defaults,
+	}
+}
+
+func (qs *quotasServiceImpl) Check(ctx context.Context, input dto.QuotaCheckDto) error {
+	op := "service.quotasServiceImpl.Check"
+
+	if len(input.Bookings) == 0 {
+		return nil
+	}
+
+	role, err := qs.getRole(ctx, input.Requester, input.UserId)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	limits, err := qs.getLimits(ctx, input.UserId, role)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if limits == (models.QuotaLimits{}) {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	after := now
+
+	bookings := make([]models.BookedInterval, 0, len(input.Bookings))
+	for _, booking := range input.Bookings {
+		entity, err := qs.bookingEntitiesRepo.GetById(ctx, booking.EntityId)
+		if err != nil {
+			if errors.Is(err, models.ErrBookingEntityNotFound) {
+				return models.ErrBookingEntityNotFound
+			}
+
+			return fmt.Errorf("%s: bookingEntitiesRepo.GetById: %w", op, err)
+		}
+
+		building, err := qs.buildingsService.GetForEntity(ctx, booking.EntityId)
+		if err != nil {
+			return fmt.Errorf("%s: buildingsService.GetForEntity: %w", op, err)
+		}
+
+		booking.IsPremium = entity.IsPremium
+		booking.Location, err = building.Location()
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		bookings = append(bookings, booking)
+		after = minTime(after, weekStart(booking.TimeFrom, booking.Location))
+	}
+
+	existing, err := qs.quotasRepo.ListBookedIntervals(ctx, input.UserId, after)
+	if err != nil {
+		return fmt.Errorf("%s: quotasRepo.ListBookedIntervals: %w", op, err)
+	}
+
+	existing = slices.DeleteFunc(existing, func(booking models.BookedInterval) bool {
+		return slices.Contains(input.Exclude, booking.BookingId)
+	})
+
+	return checkQuota(limits, existing, bookings, now)
+}
+
+// GetStatus returns quota of token owner for the current week in time zone
+// of building, or in UTC if building is not set.
+func (qs *quotasServiceImpl) GetStatus(ctx context.Context, token models.Token, buildingId *uuid.UUID) (models.QuotaStatus, error) {
+	op := "service.quotasServiceImpl.GetStatus"
+
+	loc := time.UTC
+	if buildingId != nil {
+		building, err := qs.buildingsService.GetById(ctx, *buildingId)
+		if err != nil {
+			if errors.Is(err, models.ErrBuildingNotFound) {
+				return models.QuotaStatus{}, models.ErrBuildingNotFound
+			}
+
+			return models.QuotaStatus{}, fmt.Errorf("%s: buildingsService.GetById: %w", op, err)
+		}
+
+		loc, err = building.Location()
+		if err != nil {
+			return models.QuotaStatus{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	limits, err := qs.getLimits(ctx, token.UserId, token.Role)
+	if err != nil {
+		return models.QuotaStatus{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	now := time.Now().UTC()
+	from := weekStart(now, loc)
+
+	existing, err := qs.quotasRepo.ListBookedIntervals(ctx, token.UserId, from)
+	if err != nil {
+		return models.QuotaStatus{}, fmt.Errorf("%s: quotasRepo.ListBookedIntervals: %w", op, err)
+	}
+
+	used := weekUsage(existing, from)
+	used.ActiveBookings = countActive(existing, now)
+
+	return models.QuotaStatus{
+		Limits: limits,
+		Used:   used,
+	}, nil
+}
+
+func (qs *quotasServiceImpl) ListOverrides(ctx context.Context, token models.Token) ([]models.QuotaOverride, error) {
+	op := "service.quotasServiceImpl.ListOverrides"
+
+	if !token.Can(models.QUOTA_MANAGE) {
+		return nil, models.ErrNoRights
+	}
+
+	res, err := qs.quotasRepo.ListOverrides(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: quotasRepo.ListOverrides: %w", op, err)
+	}
+
+	return res, nil
+}
+
+func (qs *quotasServiceImpl) SetForUser(ctx context.Context, token models.Token, userId uuid.UUID, input dto.QuotaOverrideDto) (models.QuotaOverride, error) {
+	op := "service.quotasServiceImpl.SetForUser"
+
+	if !token.Can(models.QUOTA_MANAGE) {
+		return models.QuotaOverride{}, models.ErrNoRights
+	}
+
+	res, err := qs.quotasRepo.SetForUser(ctx, userId, input)
+	if err != nil {
+		return models.QuotaOverride{}, fmt.Errorf("%s: quotasRepo.SetForUser: %w", op, err)
+	}
+
+	return res, nil
+}
+
+func (qs *quotasServiceImpl) SetForTeam(ctx context.Context, token models.Token, teamId uuid.UUID, input dto.QuotaOverrideDto) (models.QuotaOverride, error) {
+	op := "service.quotasServiceImpl.SetForTeam"
+
+	if !token.Can(models.QUOTA_MANAGE) {
+		return models.QuotaOverride{}, models.ErrNoRights
+	}
+
+	res, err := qs.quotasRepo.SetForTeam(ctx, teamId, input)
+	if err != nil {
+		return models.QuotaOverride{}, fmt.Errorf("%s: quotasRepo.SetForTeam: %w", op, err)
+	}
+
+	return res, nil
+}
+
+func (qs *quotasServiceImpl) SetForRole(ctx context.Context, token models.Token, role models.Role, input dto.QuotaOverrideDto) (models.QuotaOverride, error) {
+	op := "service.quotasServiceImpl.SetForRole"
+
+	if !token.Can(models.QUOTA_MANAGE) {
+		return models.QuotaOverride{}, models.ErrNoRights
+	}
+
+	res, err := qs.quotasRepo.SetForRole(ctx, role, input)
+	if err != nil {
+		return models.QuotaOverride{}, fmt.Errorf("%s: quotasRepo.SetForRole: %w", op, err)
+	}
+
+	return res, nil
+}
+
+func (qs *quotasServiceImpl) DeleteForUser(ctx context.Context, token models.Token, userId uuid.UUID) error {
+	op := "service.quotasServiceImpl.DeleteForUser"
+
+	if !token.Can(models.QUOTA_MANAGE) {
+		return models.ErrNoRights
+	}
+
+	if err := qs.quotasRepo.DeleteForUser(ctx, userId); err != nil {
+		if errors.Is(err, models.ErrQuotaOverrideNotFound) {
+			return models.ErrQuotaOverrideNotFound
+		}
+
+		return fmt.Errorf("%s: quotasRepo.DeleteForUser: %w", op, err)
+	}
+
+	return nil
+}
+
+func (qs *quotasServiceImpl) DeleteForTeam(ctx context.Context, token models.Token, teamId uuid.UUID) error {
+	op := "service.quotasServiceImpl.DeleteForTeam"
+
+	if !token.Can(models.QUOTA_MANAGE) {
+		return models.ErrNoRights
+	}
+
+	if err := qs.quotasRepo.DeleteForTeam(ctx, teamId); err != nil {
+		if errors.Is(err, models.ErrQuotaOverrideNotFound) {
+			return models.ErrQuotaOverrideNotFound
+		}
+
+		return fmt.Errorf("%s: quotasRepo.DeleteForTeam: %w", op, err)
+	}
+
+	return nil
+}
+
+func (qs *quotasServiceImpl) DeleteForRole(ctx context.Context, token models.Token, role models.Role) error {
+	op := "service.quotasServiceImpl.DeleteForRole"
+
+	if !token.Can(models.QUOTA_MANAGE) {
+		return models.ErrNoRights
+	}
+
+	if err := qs.quotasRepo.DeleteForRole(ctx, role); err != nil {
+		if errors.Is(err, models.ErrQuotaOverrideNotFound) {
+			return models.ErrQuotaOverrideNotFound
+		}
+
+		return fmt.Errorf("%s: quotasRepo.DeleteForRole: %w", op, err)
+	}
+
+	return nil
+}
+
+// getLimits applies overrides of role, teams and user to default limits.
+func (qs *quotasServiceImpl) getLimits(ctx context.Context, userId uuid.UUID, role models.Role) (models.QuotaLimits, error) {
+	teams, err := qs.usersRepo.ListTeams(ctx, userId)
+	if err != nil && !errors.Is(err, models.ErrUserNotFound) {
+		return models.QuotaLimits{}, fmt.Errorf("usersRepo.ListTeams: %w", err)
+	}
+
+	teamIds := make([]uuid.UUID, 0, len(teams))
+	for _, team := range teams {
+		teamIds = append(teamIds, team.Id)
+	}
+
+	overrides, err := qs.quotasRepo.GetOverrides(ctx, userId, teamIds, role)
+	if err != nil {
+		return models.QuotaLimits{}, fmt.Errorf("quotasRepo.GetOverrides: %w", err)
+	}
+
+	return resolveLimits(qs.defaults, overrides), nil
+}
+
+// resolveLimits applies role override, then overrides of teams and then user override,
+// so more specific overrides win. Overrides of several teams are merged into one,
+// where every limit is the most generous one set by any of the teams.
+func resolveLimits(defaults models.QuotaLimits, overrides []models.QuotaOverride) models.QuotaLimits {
+	limits := defaults
+
+	for _, override := range overrides {
+		if override.Role != nil {
+			limits = override.Apply(limits)
+		}
+	}
+
+	var teams models.QuotaOverride
+	for _, override := range overrides {
+		if override.TeamId != nil {
+			teams.HoursPerWeek = generousLimit(teams.HoursPerWeek, override.HoursPerWeek)
+			teams.ActiveBookings = generousLimit(teams.ActiveBookings, override.ActiveBookings)
+			teams.PremiumHoursPerWeek = generousLimit(teams.PremiumHoursPerWeek, override.PremiumHoursPerWeek)
+		}
+	}
+	limits = teams.Apply(limits)
+
+	for _, override := range overrides {
+		if override.UserId != nil {
+			limits = override.Apply(limits)
+		}
+	}
+
+	return limits
+}
+
+// generousLimit returns the larger of limits, 0 means no limit and wins.
+// Nil limits are not set and lose to any set one.
+func generousLimit(a, b *int) *int {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+
+	limit := max(*a, *b)
+	if *a == 0 || *b == 0 {
+		limit = 0
+	}
+
+	return &limit
+}
+
+// checkQuota returns ErrQuotaExceeded if existing bookings together with new ones exceed limits
+// in any week touched by new bookings.
+func checkQuota(limits models.QuotaLimits, existing, bookings []models.BookedInterval, now time.Time) error {
+	all := append(slices.Clone(existing), bookings...)
+
+	if limits.ActiveBookings > 0 && countActive(all, now) > limits.ActiveBookings {
+		return models.ErrQuotaExceeded
+	}
+
+	for _, booking := range bookings {
+		for from := weekStart(booking.TimeFrom, booking.Location); from.Before(booking.TimeTo); from = from.AddDate(0, 0, 7) {
+			usage := weekUsage(all, from)
+
+			if limits.HoursPerWeek > 0 && usage.Week > time.Duration(limits.HoursPerWeek)*time.Hour {
+				return models.ErrQuotaExceeded
+			}
+			if limits.PremiumHoursPerWeek > 0 && usage.PremiumWeek > time.Duration(limits.PremiumHoursPerWeek)*time.Hour {
+				return models.ErrQuotaExceeded
+			}
+		}
+	}
+
+	return nil
+}
+
+// weekUsage sums durations of bookings within the week starting at from.
+// Week ends on the next Monday in location of from, so it may be shorter
+// or longer than 7 days around DST changes.
+func weekUsage(bookings []models.BookedInterval, from time.Time) models.QuotaUsage {
+	to := from.AddDate(0, 0, 7)
+
+	var usage models.QuotaUsage
+	for _, booking := range bookings {
+		intersectionFrom := maxTime(booking.TimeFrom, from)
+		intersectionTo := minTime(booking.TimeTo, to)
+		if !intersectionFrom.Before(intersectionTo) {
+			continue
+		}
+
+		duration := intersectionTo.Sub(intersectionFrom)
+		usage.Week += duration
+		if booking.IsPremium {
+			usage.PremiumWeek += duration
+		}
+	}
+
+	return usage
+}
+
+func countActive(bookings []models.BookedInterval, now time.Time) int {
+	count := 0
+	for _, booking := range bookings {
+		if booking.TimeTo.After(now) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// weekStart returns start of the week containing t, weeks start on Monday 00:00
+// in loc. Nil loc means UTC.
+func weekStart(t time.Time, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	t = t.In(loc)
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	offset := (int(day.Weekday()) + 6) % 7
+
+	return day.AddDate(0, 0, -offset)
+}
+
+// getRole returns role used for quotas of user. Role of requester is taken from token,
+// roles of other users, e.g. when booking on behalf of them, are read from coffee-id.
+func (qs *quotasServiceImpl) getRole(ctx context.Context, requester models.Token, userId uuid.UUID) (models.Role, error) {
+	if requester.UserId == userId {
+		return requester.Role, nil
+	}
+
+	user, err := qs.usersRepo.GetById(ctx, userId)
+	if err != nil {
+		if errors.Is(err, models.ErrUserNotFound) {
+			return models.RoleUser, nil
+		}
+
+		return "", fmt.Errorf("usersRepo.GetById: %w", err)
+	}
+
+	role, err := models.ParseRole(string(user.Role))
+	if err != nil {
+		return models.RoleUser, nil
+	}
+
+	return role, nil
+}
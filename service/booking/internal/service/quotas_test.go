@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"REDACTED/team-11/backend/booking/internal/models"
+	"REDACTED/team-11/backend/booking/internal/repo"
+)
+
+const week = 7 * 24 * time.Hour
+
+func TestWeekStart(t *testing.T) {
+	monday := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, monday, weekStart(monday, nil))
+	assert.Equal(t, monday, weekStart(monday.Add(36*time.Hour), time.UTC))
+	assert.Equal(t, monday, weekStart(time.Date(2025, 3, 9, 23, 45, 0, 0, time.UTC), nil))
+	assert.Equal(t, monday.Add(week), weekStart(time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), nil))
+
+	// Sunday evening in UTC is already Monday in Novosibirsk.
+	loc, err := time.LoadLocation("Asia/Novosibirsk")
+	require.NoError(t, err)
+
+	localMonday := time.Date(2025, 3, 10, 0, 0, 0, 0, loc)
+	assert.True(t, localMonday.Equal(weekStart(time.Date(2025, 3, 9, 20, 0, 0, 0, time.UTC), loc)))
+	assert.True(t, monday.Equal(weekStart(time.Date(2025, 3, 9, 20, 0, 0, 0, time.UTC), nil)))
+}
+
+func TestResolveLimits(t *testing.T) {
+	userId := uuid.New()
+	role := models.RoleAdmin
+	ten, twenty, zero := 10, 20, 0
+
+	defaults := models.QuotaLimits{HoursPerWeek: 40, ActiveBookings: 5, PremiumHoursPerWeek: 8}
+
+	limits := resolveLimits(defaults, []models.QuotaOverride{
+		{UserId: &userId, HoursPerWeek: &ten},
+		{Role: &role, HoursPerWeek: &twenty, PremiumHoursPerWeek: &zero},
+	})
+
+	assert.Equal(t, models.QuotaLimits{HoursPerWeek: 10, ActiveBookings: 5, PremiumHoursPerWeek: 0}, limits)
+	assert.Equal(t, defaults, resolveLimits(defaults, nil))
+	// Teams override role, user overrides teams. Of several teams the most
+	// generous limit wins, 0 is no limit.
+	firstTeam, secondTeam := uuid.New(), uuid.New()
+	thirty, fifteen, three := 30, 15, 3
+
+	limits = resolveLimits(defaults, []models.QuotaOverride{
+		{TeamId: &firstTeam, HoursPerWeek: &thirty, PremiumHoursPerWeek: &zero},
+		{UserId: &userId, ActiveBookings: &three},
+		{TeamId: &secondTeam, HoursPerWeek: &fifteen, PremiumHoursPerWeek: &ten},
+		{Role: &role, HoursPerWeek: &twenty, ActiveBookings: &ten},
+	})
+
+	assert.Equal(t, models.QuotaLimits{HoursPerWeek: 30, ActiveBookings: 3, PremiumHoursPerWeek: 0}, limits)
+}
+
+func TestCheckQuota(t *testing.T) {
+	monday := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	now := monday.Add(-time.Hour)
+
+	interval := func(from time.Time, hours int, premium bool) models.BookedInterval {
+		return models.BookedInterval{
+			TimeFrom:  from,
+			TimeTo:    from.Add(time.Duration(hours) * time.Hour),
+			IsPremium: premium,
+		}
+	}
+
+	limits := models.QuotaLimits{HoursPerWeek: 10, ActiveBookings: 2, PremiumHoursPerWeek: 4}
+	existing := []models.BookedInterval{interval(monday.Add(9*time.Hour), 6, false)}
+
+	assert.NoError(t, checkQuota(limits, existing, []models.BookedInterval{interval(monday.Add(33*time.Hour), 4, true)}, now))
+	assert.ErrorIs(t, checkQuota(limits, existing, []models.BookedInterval{interval(monday.Add(33*time.Hour), 5, false)}, now), models.ErrQuotaExceeded)
+	assert.ErrorIs(t, checkQuota(limits, existing, []models.BookedInterval{interval(monday.Add(33*time.Hour), 1, true), interval(monday.Add(57*time.Hour), 1, false)}, now), models.ErrQuotaExceeded)
+	assert.ErrorIs(t, checkQuota(limits, nil, []models.BookedInterval{interval(monday.Add(33*time.Hour), 5, true)}, now), models.ErrQuotaExceeded)
+
+	// Booking in the next week does not use hours of this one.
+	assert.NoError(t, checkQuota(limits, existing, []models.BookedInterval{interval(monday.Add(week+9*time.Hour), 8, false)}, now))
+
+	// Booking over the week boundary is split between weeks.
+	assert.NoError(t, checkQuota(limits, existing, []models.BookedInterval{interval(monday.Add(week-4*time.Hour), 8, false)}, now))
+	assert.ErrorIs(t, checkQuota(limits, existing, []models.BookedInterval{interval(monday.Add(week-5*time.Hour), 10, false)}, now), models.ErrQuotaExceeded)
+
+	// The same booking in Novosibirsk starts on local Monday, so it is in the next week.
+	loc, err := time.LoadLocation("Asia/Novosibirsk")
+	require.NoError(t, err)
+
+	local := interval(monday.Add(week-5*time.Hour), 10, false)
+	local.Location = loc
+	assert.NoError(t, checkQuota(limits, existing, []models.BookedInterval{local}, now))
+
+	assert.NoError(t, checkQuota(models.QuotaLimits{}, existing, []models.BookedInterval{interval(monday, 100, true)}, now))
+}
+
+// stubUsersRepo finds users by id and email, teams are not used by tests.
+type stubUsersRepo struct {
+	repo.UsersRepo
+	users map[uuid.UUID]models.User
+}
+
+func (sr stubUsersRepo) GetById(ctx context.Context, id uuid.UUID) (models.User, error) {
+	user, ok := sr.users[id]
+	if !ok {
+		return models.User{}, models.ErrUserNotFound
+	}
+
+	return user, nil
+}
+
+func (sr stubUsersRepo) GetByEmail(ctx context.Context, email string) (models.User, error) {
+	for _, user := range sr.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+
+	return models.User{}, models.ErrUserNotFound
+}
+
+func TestGetRole(t *testing.T) {
+	requester := models.Token{UserId: uuid.New(), Role: models.RoleSupport}
+	admin := models.User{Id: uuid.New(), Role: models.RoleAdmin}
+
+	qs := NewQuotasService(nil, nil, stubUsersRepo{users: map[uuid.UUID]models.User{admin.Id: admin}}, nil, models.QuotaLimits{})
+
+	role, err := qs.getRole(context.Background(), requester, requester.UserId)
+	require.NoError(t, err)
+	assert.Equal(t, models.RoleSupport, role)
+
+	// Bookings on behalf of other users and transfers use role of the beneficiary.
+	role, err = qs.getRole(context.Background(), requester, admin.Id)
+	require.NoError(t, err)
+	assert.Equal(t, models.RoleAdmin, role)
+
+	role, err = qs.getRole(context.Background(), requester, uuid.New())
+	require.NoError(t, err)
+	assert.Equal(t, models.RoleUser, role)
+}
@@ -0,0 +1,400 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"REDACTED/team-11/backend/booking/internal/dto"
+	"REDACTED/team-11/backend/booking/internal/models"
+	"REDACTED/team-11/backend/booking/internal/repo"
+)
+
+type TransfersService interface {
+	Create(ctx context.Context, input dto.BookingTransferCreateDto) (models.BookingTransfer, error)
+	Accept(ctx context.Context, token models.Token, transferId uuid.UUID) ([]models.Booking, error)
+	Delete(ctx context.Context, token models.Token, transferId uuid.UUID) error
+	List(ctx context.Context, token models.Token) (outgoing []models.BookingTransfer, incoming []models.BookingTransfer, err error)
+}
+
+var (
+	_ TransfersService = NewTransfersService(nil, nil, nil, nil, nil)
+)
+
+type transfersServiceImpl struct {
+	transfersRepo repo.TransfersRepo
+	bookingsRepo  repo.BookingsRepo
+	usersRepo     repo.UsersRepo
+	accessService AccessService
+	quotasService QuotasService
+}
+
+func NewTransfersService(
+	transfersRepo repo.TransfersRepo,
+	bookingsRepo repo.BookingsRepo,
+	usersRepo repo.UsersRepo,
+	accessService AccessService,
+	quotasService QuotasService,
+) *transfersServiceImpl {
+	return &transfersServiceImpl{
+		transfersRepo: transfersRepo,
+		bookingsRepo:  bookingsRepo,
+		usersRepo:     usersRepo,
+		accessService: accessService,
+		quotasService: quotasService,
+	}
+}
+
+// Create proposes to hand booking of requester over to user with the email.
+// Booking must not be finished or be a part of group. If swap booking is set,
+// it must be booking of recipient for the same time. Unknown email is not an
+// error, the transfer is saved without recipient and can't be accepted.
+func (ts *transfersServiceImpl) Create(ctx context.Context, input dto.BookingTransferCreateDto) (models.BookingTransfer, error) {
+	op := "service.transfersServiceImpl.Create"
+
+	booking, err := ts.bookingsRepo.GetById(ctx, input.BookingId)
+	if err != nil {
+		if errors.Is(err, models.ErrBookingNotFound) {
+			return models.BookingTransfer{}, models.ErrBookingNotFound
+		}
+
+		return models.BookingTransfer{}, fmt.Errorf("%s: bookingsRepo.GetById: %w", op, err)
+	}
+
+	if booking.UserId != input.Requester.UserId {
+		return models.BookingTransfer{}, models.ErrNoAccessToBooking
+	}
+
+	if err := checkTransferable(booking, time.Now()); err != nil {
+		return models.BookingTransfer{}, err
+	}
+
+	var recipientId *uuid.UUID
+
+	recipient, err := ts.usersRepo.GetByEmail(ctx, input.Email)
+	if err == nil {
+		recipientId = &recipient.Id
+	} else if !errors.Is(err, models.ErrUserNotFound) {
+		return models.BookingTransfer{}, fmt.Errorf("%s: usersRepo.GetByEmail: %w", op, err)
+	}
+
+	if recipientId != nil && *recipientId == booking.UserId {
+		return models.BookingTransfer{}, models.ErrSelfTransfer
+	}
+
+	if input.SwapBookingId != nil {
+		if recipientId == nil {
+			return models.BookingTransfer{}, models.ErrSwapMismatch
+		}
+
+		swapBooking, err := ts.bookingsRepo.GetById(ctx, *input.SwapBookingId)
+		if err != nil {
+			if errors.Is(err, models.ErrBookingNotFound) {
+				return models.BookingTransfer{}, models.ErrSwapMismatch
+			}
+
+			return models.BookingTransfer{}, fmt.Errorf("%s: bookingsRepo.GetById: %w", op, err)
+		}
+
+		if swapBooking.UserId != recipient.Id || !swappable(booking, swapBooking) {
+			return models.BookingTransfer{}, models.ErrSwapMismatch
+		}
+	}
+
+	transfer, err := ts.transfersRepo.Create(ctx, models.BookingTransfer{
+		BookingId:     booking.Id,
+		FromUserId:    booking.UserId,
+		ToUserId:      recipientId,
+		ToEmail:       input.Email,
+		SwapBookingId: input.SwapBookingId,
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrTransferAlreadyExists) ||
+			errors.Is(err, models.ErrBookingNotFound) ||
+			errors.Is(err, models.ErrSelfTransfer) {
+			return models.BookingTransfer{}, err
+		}
+
+		return models.BookingTransfer{}, fmt.Errorf("%s: transfersRepo.Create: %w", op, err)
+	}
+
+	return transfer, nil
+}
+
+// Accept hands booking over to the recipient, who must be the token owner.
+// The same checks as for a new booking of recipient are done. For swap, the
+// bookings exchange entities instead, and each user is checked for entity
+// of the other one. Returns the changed bookings.
+func (ts *transfersServiceImpl) Accept(ctx context.Context, token models.Token, transferId uuid.UUID) ([]models.Booking, error) {
+	op := "service.transfersServiceImpl.Accept"
+
+	transfer, err := ts.get(ctx, token, transferId)
+	if err != nil {
+		if errors.Is(err, models.ErrTransferNotFound) {
+			return nil, models.ErrTransferNotFound
+		}
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !transfer.IsTo(token.UserId) {
+		return nil, models.ErrTransferNotFound
+	}
+
+	booking, err := ts.getProposed(ctx, transfer.BookingId, transfer.FromUserId)
+	if err != nil {
+		if errors.Is(err, models.ErrTransferNotFound) || errors.Is(err, models.ErrBookingModified) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := checkTransferable(booking, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if transfer.SwapBookingId == nil {
+		res, err := ts.accept(ctx, token, transfer, booking)
+		if err != nil {
+			if isAcceptError(err) {
+				return nil, err
+			}
+
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		return []models.Booking{res}, nil
+	}
+
+	swapBooking, err := ts.getProposed(ctx, *transfer.SwapBookingId, token.UserId)
+	if err != nil {
+		if errors.Is(err, models.ErrTransferNotFound) || errors.Is(err, models.ErrBookingModified) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !swappable(booking, swapBooking) {
+		return nil, models.ErrSwapMismatch
+	}
+
+	res, err := ts.swap(ctx, token, transfer, booking, swapBooking)
+	if err != nil {
+		if isAcceptError(err) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, nil
+}
+
+// Delete withdraws transfer by its sender or declines it by its recipient.
+func (ts *transfersServiceImpl) Delete(ctx context.Context, token models.Token, transferId uuid.UUID) error {
+	op := "service.transfersServiceImpl.Delete"
+
+	if _, err := ts.get(ctx, token, transferId); err != nil {
+		if errors.Is(err, models.ErrTransferNotFound) {
+			return models.ErrTransferNotFound
+		}
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := ts.transfersRepo.Delete(ctx, transferId); err != nil {
+		if errors.Is(err, models.ErrTransferNotFound) {
+			return models.ErrTransferNotFound
+		}
+
+		return fmt.Errorf("%s: transfersRepo.Delete: %w", op, err)
+	}
+
+	return nil
+}
+
+func (ts *transfersServiceImpl) List(ctx context.Context, token models.Token) ([]models.BookingTransfer, []models.BookingTransfer, error) {
+	op := "service.transfersServiceImpl.List"
+
+	outgoing, err := ts.transfersRepo.ListFromUser(ctx, token.UserId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: transfersRepo.ListFromUser: %w", op, err)
+	}
+
+	incoming, err := ts.transfersRepo.ListToUser(ctx, token.UserId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: transfersRepo.ListToUser: %w", op, err)
+	}
+
+	return outgoing, incoming, nil
+}
+
+func (ts *transfersServiceImpl) accept(ctx context.Context, token models.Token, transfer models.BookingTransfer, booking models.Booking) (models.Booking, error) {
+	if err := ts.accessService.CheckEntity(ctx, booking.EntityId, token.UserId, token); err != nil {
+		if errors.Is(err, models.ErrEntityRestricted) {
+			return models.Booking{}, models.ErrEntityRestricted
+		}
+
+		return models.Booking{}, fmt.Errorf("accessService.CheckEntity: %w", err)
+	}
+
+	if err := ts.quotasService.Check(ctx, dto.QuotaCheckDto{
+		UserId:    token.UserId,
+		Requester: token,
+		Bookings: []models.BookedInterval{{
+			EntityId: booking.EntityId,
+			TimeFrom: booking.TimeFrom,
+			TimeTo:   booking.TimeTo,
+		}},
+	}); err != nil {
+		if errors.Is(err, models.ErrQuotaExceeded) {
+			return models.Booking{}, countConflict(models.ErrQuotaExceeded)
+		}
+
+		return models.Booking{}, fmt.Errorf("quotasService.Check: %w", err)
+	}
+
+	intersected, err := ts.bookingsRepo.ListIntersectedForUser(ctx, token.UserId, booking.TimeFrom, booking.TimeTo)
+	if err != nil {
+		return models.Booking{}, fmt.Errorf("bookingsRepo.ListIntersectedForUser: %w", err)
+	}
+
+	if len(intersected) != 0 {
+		return models.Booking{}, countConflict(models.ErrAlreadyHaveBooking)
+	}
+
+	res, err := ts.transfersRepo.Accept(ctx, transfer.Id, booking, token.UserId)
+	if err != nil {
+		if errors.Is(err, models.ErrTransferNotFound) || errors.Is(err, models.ErrBookingModified) {
+			return models.Booking{}, err
+		}
+
+		return models.Booking{}, fmt.Errorf("transfersRepo.Accept: %w", err)
+	}
+
+	return res, nil
+}
+
+func (ts *transfersServiceImpl) swap(ctx context.Context, token models.Token, transfer models.BookingTransfer, booking, swapBooking models.Booking) ([]models.Booking, error) {
+	// Each user gets entity of the other one for the same time.
+	exchanges := []struct {
+		userId uuid.UUID
+		gives  models.Booking
+		gets   models.Booking
+	}{
+		{userId: booking.UserId, gives: booking, gets: swapBooking},
+		{userId: swapBooking.UserId, gives: swapBooking, gets: booking},
+	}
+
+	for _, exchange := range exchanges {
+		if err := ts.accessService.CheckEntity(ctx, exchange.gets.EntityId, exchange.userId, token); err != nil {
+			if errors.Is(err, models.ErrEntityRestricted) {
+				return nil, models.ErrEntityRestricted
+			}
+
+			return nil, fmt.Errorf("accessService.CheckEntity: %w", err)
+		}
+
+		if err := ts.quotasService.Check(ctx, dto.QuotaCheckDto{
+			UserId:    exchange.userId,
+			Requester: token,
+			Bookings: []models.BookedInterval{{
+				EntityId: exchange.gets.EntityId,
+				TimeFrom: exchange.gives.TimeFrom,
+				TimeTo:   exchange.gives.TimeTo,
+			}},
+			Exclude: []uuid.UUID{exchange.gives.Id},
+		}); err != nil {
+			if errors.Is(err, models.ErrQuotaExceeded) {
+				return nil, countConflict(models.ErrQuotaExceeded)
+			}
+
+			return nil, fmt.Errorf("quotasService.Check: %w", err)
+		}
+	}
+
+	res, err := ts.transfersRepo.Swap(ctx, transfer.Id, booking, swapBooking)
+	if err != nil {
+		if errors.Is(err, models.ErrTransferNotFound) || errors.Is(err, models.ErrBookingModified) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("transfersRepo.Swap: %w", err)
+	}
+
+	return res, nil
+}
+
+// get returns transfer, if token owner is its sender or recipient.
+func (ts *transfersServiceImpl) get(ctx context.Context, token models.Token, transferId uuid.UUID) (models.BookingTransfer, error) {
+	transfer, err := ts.transfersRepo.GetById(ctx, transferId)
+	if err != nil {
+		if errors.Is(err, models.ErrTransferNotFound) {
+			return models.BookingTransfer{}, models.ErrTransferNotFound
+		}
+
+		return models.BookingTransfer{}, fmt.Errorf("transfersRepo.GetById: %w", err)
+	}
+
+	if transfer.FromUserId != token.UserId && !transfer.IsTo(token.UserId) {
+		return models.BookingTransfer{}, models.ErrTransferNotFound
+	}
+
+	return transfer, nil
+}
+
+// getProposed returns booking of transfer. ErrBookingModified is returned,
+// if booking has changed its owner since transfer was proposed.
+func (ts *transfersServiceImpl) getProposed(ctx context.Context, bookingId, userId uuid.UUID) (models.Booking, error) {
+	booking, err := ts.bookingsRepo.GetById(ctx, bookingId)
+	if err != nil {
+		// Transfer is removed together with its bookings.
+		if errors.Is(err, models.ErrBookingNotFound) {
+			return models.Booking{}, models.ErrTransferNotFound
+		}
+
+		return models.Booking{}, fmt.Errorf("bookingsRepo.GetById: %w", err)
+	}
+
+	if booking.UserId != userId {
+		return models.Booking{}, models.ErrBookingModified
+	}
+
+	return booking, nil
+}
+
+// isAcceptError reports whether err is expected outcome of accepting transfer.
+func isAcceptError(err error) bool {
+	return errors.Is(err, models.ErrTransferNotFound) ||
+		errors.Is(err, models.ErrBookingModified) ||
+		errors.Is(err, models.ErrEntityRestricted) ||
+		errors.Is(err, models.ErrQuotaExceeded) ||
+		errors.Is(err, models.ErrAlreadyHaveBooking)
+}
+
+// checkTransferable returns error if booking can't be handed over: finished
+// bookings are history, and bookings in group are managed by group owner.
+func checkTransferable(booking models.Booking, now time.Time) error {
+	if booking.Status(now) == models.BookingStatusFinished {
+		return models.ErrBookingFinished
+	}
+
+	if booking.GroupId != nil {
+		return models.ErrBookingInGroup
+	}
+
+	return nil
+}
+
+// swappable reports whether bookings can exchange entities: both must be
+// standalone bookings for exactly the same time.
+func swappable(booking, swapBooking models.Booking) bool {
+	return booking.Id != swapBooking.Id &&
+		booking.GroupId == nil && swapBooking.GroupId == nil &&
+		booking.TimeFrom.Equal(swapBooking.TimeFrom) &&
+		booking.TimeTo.Equal(swapBooking.TimeTo)
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"REDACTED/team-11/backend/booking/internal/dto"
+	"REDACTED/team-11/backend/booking/internal/models"
+	"REDACTED/team-11/backend/booking/internal/repo"
+)
+
+func TestCheckTransferable(t *testing.T) {
+	now := time.Date(2025, 3, 3, 10, 0, 0, 0, time.UTC)
+	booking := models.Booking{
+		Id:       uuid.New(),
+		TimeFrom: now.Add(-time.Hour),
+		TimeTo:   now.Add(time.Hour),
+	}
+
+	assert.NoError(t, checkTransferable(booking, now))
+	assert.ErrorIs(t, checkTransferable(booking, now.Add(time.Hour)), models.ErrBookingFinished)
+
+	groupId := uuid.New()
+	booking.GroupId = &groupId
+	assert.ErrorIs(t, checkTransferable(booking, now), models.ErrBookingInGroup)
+}
+
+func TestSwappable(t *testing.T) {
+	timeFrom := time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC)
+	timeTo := time.Date(2025, 3, 3, 18, 0, 0, 0, time.UTC)
+
+	booking := models.Booking{Id: uuid.New(), EntityId: uuid.New(), TimeFrom: timeFrom, TimeTo: timeTo}
+	swapBooking := models.Booking{Id: uuid.New(), EntityId: uuid.New(), TimeFrom: timeFrom.In(time.FixedZone("MSK", 3*60*60)), TimeTo: timeTo}
+
+	assert.True(t, swappable(booking, swapBooking))
+	assert.False(t, swappable(booking, booking))
+
+	shifted := swapBooking
+	shifted.TimeTo = timeTo.Add(15 * time.Minute)
+	assert.False(t, swappable(booking, shifted))
+
+	groupId := uuid.New()
+	grouped := swapBooking
+	grouped.GroupId = &groupId
+	assert.False(t, swappable(booking, grouped))
+}
+
+// stubBookingsRepo finds bookings by id, intersections are always empty.
+type stubBookingsRepo struct {
+	repo.BookingsRepo
+	bookings map[uuid.UUID]models.Booking
+}
+
+func (sr stubBookingsRepo) GetById(ctx context.Context, id uuid.UUID) (models.Booking, error) {
+	booking, ok := sr.bookings[id]
+	if !ok {
+		return models.Booking{}, models.ErrBookingNotFound
+	}
+
+	return booking, nil
+}
+
+func (sr stubBookingsRepo) ListIntersectedForUser(ctx context.Context, userId uuid.UUID, timeFrom, timeTo time.Time) ([]models.Booking, error) {
+	return nil, nil
+}
+
+func (sr stubBookingsRepo) ListIntersectedForEntity(ctx context.Context, entityId uuid.UUID, timeFrom, timeTo time.Time) ([]models.Booking, error) {
+	return nil, nil
+}
+
+// stubTransfersRepo saves created transfers.
+type stubTransfersRepo struct {
+	repo.TransfersRepo
+	created []models.BookingTransfer
+}
+
+func (sr *stubTransfersRepo) Create(ctx context.Context, transfer models.BookingTransfer) (models.BookingTransfer, error) {
+	transfer.Id = uuid.New()
+	sr.created = append(sr.created, transfer)
+
+	return transfer, nil
+}
+
+func TestCreateTransferUnknownEmail(t *testing.T) {
+	owner := models.User{Id: uuid.New(), Email: "owner@example.com"}
+	colleague := models.User{Id: uuid.New(), Email: "colleague@example.com"}
+
+	booking := models.Booking{
+		Id:       uuid.New(),
+		UserId:   owner.Id,
+		TimeFrom: time.Now().Add(time.Hour),
+		TimeTo:   time.Now().Add(2 * time.Hour),
+	}
+
+	transfersRepo := &stubTransfersRepo{}
+	ts := NewTransfersService(
+		transfersRepo,
+		stubBookingsRepo{bookings: map[uuid.UUID]models.Booking{booking.Id: booking}},
+		stubUsersRepo{users: map[uuid.UUID]models.User{owner.Id: owner, colleague.Id: colleague}},
+		nil, nil,
+	)
+
+	create := func(email string, swapBookingId *uuid.UUID) (models.BookingTransfer, error) {
+		return ts.Create(context.Background(), dto.BookingTransferCreateDto{
+			BookingId:     booking.Id,
+			Email:         email,
+			Requester:     models.Token{UserId: owner.Id},
+			SwapBookingId: swapBookingId,
+		})
+	}
+
+	known, err := create(colleague.Email, nil)
+	require.NoError(t, err)
+	assert.True(t, known.IsTo(colleague.Id))
+
+	unknown, err := create("nobody@example.com", nil)
+	require.NoError(t, err)
+	assert.Nil(t, unknown.ToUserId)
+	assert.Equal(t, "nobody@example.com", unknown.ToEmail)
+	assert.Len(t, transfersRepo.created, 2)
+
+	// Swap with a booking recipient doesn't have fails the same way for both.
+	otherBookingId := uuid.New()
+	_, err = create(colleague.Email, &otherBookingId)
+	assert.ErrorIs(t, err, models.ErrSwapMismatch)
+	_, err = create("nobody@example.com", &otherBookingId)
+	assert.ErrorIs(t, err, models.ErrSwapMismatch)
+
+	_, err = create(owner.Email, nil)
+	assert.ErrorIs(t, err, models.ErrSelfTransfer)
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"REDACTED/team-11/backend/booking/internal/dto"
 	"REDACTED/team-11/backend/booking/internal/models"
 	"REDACTED/team-11/backend/booking/internal/repo"
 	"REDACTED/team-11/backend/booking/pkg/logger"
@@ -14,12 +15,13 @@ import (
 )
 
 type WorkloadsService interface {
-	GetForFloor(ctx context.Context, floorId uuid.UUID, timeFrom, timeTo time.Time, userId uuid.UUID) (models.FloorWorkload, error)
+	GetForFloor(ctx context.Context, floorId uuid.UUID, timeFrom, timeTo time.Time, token models.Token, filter dto.BookingEntityFilter) (models.FloorWorkload, error)
+	GetForBuilding(ctx context.Context, buildingId uuid.UUID, timeFrom, timeTo time.Time, token models.Token, filter dto.BookingEntityFilter) (models.FloorWorkload, error)
 	Get(ctx context.Context, entityId uuid.UUID, timeFrom, timeTo time.Time) (models.Workload, error)
 }
 
 var (
-	_ WorkloadsService = NewWorkloadService(nil, nil, nil)
+	_ WorkloadsService = NewWorkloadService(nil, nil, nil, nil, nil, nil, nil)
 )
 
 var (
@@ -30,17 +32,29 @@ type workloadsServiceImpl struct {
 	bookingEntitiesRepo repo.BookingEntitiesRepo
 	bookingsRepo        repo.BookingsRepo
 	floorsRepo          repo.FloorsRepo
+	buildingsRepo       repo.BuildingsRepo
+	buildingsService    BuildingsService
+	amenitiesRepo       repo.AmenitiesRepo
+	accessService       AccessService
 }
 
 func NewWorkloadService(
 	bookingEntitiesRepo repo.BookingEntitiesRepo,
 	bookingsRepo repo.BookingsRepo,
 	floorsRepo repo.FloorsRepo,
+	buildingsRepo repo.BuildingsRepo,
+	buildingsService BuildingsService,
+	amenitiesRepo repo.AmenitiesRepo,
+	accessService AccessService,
 ) *workloadsServiceImpl {
 	return &workloadsServiceImpl{
 		bookingEntitiesRepo: bookingEntitiesRepo,
 		bookingsRepo:        bookingsRepo,
 		floorsRepo:          floorsRepo,
+		buildingsRepo:       buildingsRepo,
+		buildingsService:    buildingsService,
+		amenitiesRepo:       amenitiesRepo,
+		accessService:       accessService,
 	}
 }
 
@@ -56,6 +70,15 @@ func (ws *workloadsServiceImpl) Get(ctx context.Context, entityId uuid.UUID, tim
 		return nil, fmt.Errorf("%s: bookingEntitiesRepo.GetById: %w", op, err)
 	}
 
+	building, err := ws.buildingsService.GetForEntity(ctx, entityId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: buildingsService.GetForEntity: %w", op, err)
+	}
+
+	if err := ws.buildingsService.ValidateInterval(building, timeFrom, timeTo); err != nil {
+		return nil, err
+	}
+
 	intersected, err := ws.bookingsRepo.ListIntersectedForEntity(ctx, entityId, timeFrom, timeTo)
 	if err != nil {
 		return nil, fmt.Errorf("%s: bookingsRepo.ListInterSected: %w", op, err)
@@ -82,26 +105,29 @@ func (ws *workloadsServiceImpl) Get(ctx context.Context, entityId uuid.UUID, tim
 
 	res := make(models.Workload, 0, snapshotsCount)
 	for i, takenPlacesSnapshot := range takenPlaces {
-		var isFree bool
+		var freePlaces int
 		switch bookingEntity.Type {
 		case models.BookingEntityTypeOpenSpace:
-			isFree = takenPlacesSnapshot < bookingEntity.Capacity
+			freePlaces = max(bookingEntity.Capacity-takenPlacesSnapshot, 0)
 		case models.BookingEntityTypeRoom:
-			isFree = takenPlacesSnapshot == 0
+			if takenPlacesSnapshot == 0 {
+				freePlaces = 1
+			}
 		}
 		res = append(res, models.WorkloadItem{
-			Time:   timeFrom.Add(time.Duration(i*intervalMinutes) * time.Minute),
-			IsFree: isFree,
+			Time:       timeFrom.Add(time.Duration(i*intervalMinutes) * time.Minute),
+			IsFree:     freePlaces > 0,
+			FreePlaces: freePlaces,
 		})
 	}
 
 	return res, nil
 }
 
-func (ws *workloadsServiceImpl) GetForFloor(ctx context.Context, floorId uuid.UUID, timeFrom, timeTo time.Time, userId uuid.UUID) (models.FloorWorkload, error) {
+func (ws *workloadsServiceImpl) GetForFloor(ctx context.Context, floorId uuid.UUID, timeFrom, timeTo time.Time, token models.Token, filter dto.BookingEntityFilter) (models.FloorWorkload, error) {
 	op := "service.workloadsServiceImpl.GetForFloor"
 
-	_, err := ws.floorsRepo.GetById(ctx, floorId)
+	floor, err := ws.floorsRepo.GetById(ctx, floorId)
 	if err != nil {
 		if errors.Is(err, models.ErrFloorNotFound) {
 			return nil, models.ErrFloorNotFound
@@ -110,11 +136,65 @@ func (ws *workloadsServiceImpl) GetForFloor(ctx context.Context, floorId uuid.UU
 		return nil, fmt.Errorf("%s: floorsRepo.GetById: %w", op, err)
 	}
 
-	entities, err := ws.bookingEntitiesRepo.GetForFloor(ctx, floorId)
+	building, err := ws.buildingsService.GetForFloor(ctx, floor)
+	if err != nil {
+		return nil, fmt.Errorf("%s: buildingsService.GetForFloor: %w", op, err)
+	}
+
+	if err := ws.buildingsService.ValidateInterval(building, timeFrom, timeTo); err != nil {
+		return nil, err
+	}
+
+	entities, err := ws.bookingEntitiesRepo.GetForFloor(ctx, floorId, filter)
 	if err != nil {
 		return nil, fmt.Errorf("%s: bookingEntitiesRepo.GetForFloor: %w", op, err)
 	}
 
+	return ws.getForEntities(ctx, entities, timeFrom, timeTo, token)
+}
+
+func (ws *workloadsServiceImpl) GetForBuilding(ctx context.Context, buildingId uuid.UUID, timeFrom, timeTo time.Time, token models.Token, filter dto.BookingEntityFilter) (models.FloorWorkload, error) {
+	op := "service.workloadsServiceImpl.GetForBuilding"
+
+	building, err := ws.buildingsRepo.GetById(ctx, buildingId)
+	if err != nil {
+		if errors.Is(err, models.ErrBuildingNotFound) {
+			return nil, models.ErrBuildingNotFound
+		}
+
+		return nil, fmt.Errorf("%s: buildingsRepo.GetById: %w", op, err)
+	}
+
+	if err := ws.buildingsService.ValidateInterval(building, timeFrom, timeTo); err != nil {
+		return nil, err
+	}
+
+	entities, err := ws.bookingEntitiesRepo.GetForBuilding(ctx, buildingId, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: bookingEntitiesRepo.GetForBuilding: %w", op, err)
+	}
+
+	return ws.getForEntities(ctx, entities, timeFrom, timeTo, token)
+}
+
+func (ws *workloadsServiceImpl) getForEntities(ctx context.Context, entities []models.BookingEntity, timeFrom, timeTo time.Time, token models.Token) (models.FloorWorkload, error) {
+	op := "service.workloadsServiceImpl.getForEntities"
+
+	entityIds := make([]uuid.UUID, 0, len(entities))
+	for _, entity := range entities {
+		entityIds = append(entityIds, entity.Id)
+	}
+
+	amenities, err := ws.amenitiesRepo.ListForEntities(ctx, entityIds)
+	if err != nil {
+		return nil, fmt.Errorf("%s: amenitiesRepo.ListForEntities: %w", op, err)
+	}
+
+	restricted, err := ws.accessService.ListRestricted(ctx, entityIds, token)
+	if err != nil {
+		return nil, fmt.Errorf("%s: accessService.ListRestricted: %w", op, err)
+	}
+
 	floorWorkload := make(models.FloorWorkload, 0, len(entities))
 	for _, entity := range entities {
 		workload, err := ws.Get(ctx, entity.Id, timeFrom, timeTo)
@@ -130,7 +210,9 @@ func (ws *workloadsServiceImpl) GetForFloor(ctx context.Context, floorId uuid.UU
 			}
 		}
 
-		intersected, err := ws.bookingsRepo.ListIntersectedForUser(ctx, userId, timeFrom, timeTo)
+		freePlaces := minFreePlaces(workload)
+
+		intersected, err := ws.bookingsRepo.ListIntersectedForUser(ctx, token.UserId, timeFrom, timeTo)
 		if err != nil {
 			return nil, fmt.Errorf("%s: bookingsRepo.ListIntersectedForUser: %w", op, err)
 		}
@@ -139,15 +221,39 @@ func (ws *workloadsServiceImpl) GetForFloor(ctx context.Context, floorId uuid.UU
 			isFree = false
 		}
 
+		isRestricted := restricted[entity.Id]
+		if isRestricted {
+			isFree = false
+			freePlaces = 0
+		}
+
+		entity.Amenities = amenities[entity.Id]
+
 		floorWorkload = append(floorWorkload, models.FloorWorkloadItem{
-			Entity: entity,
-			IsFree: isFree,
+			Entity:       entity,
+			IsFree:       isFree,
+			IsRestricted: isRestricted,
+			FreePlaces:   freePlaces,
 		})
 	}
 
 	return floorWorkload, nil
 }
 
+// minFreePlaces returns number of places free during the whole workload interval.
+func minFreePlaces(workload models.Workload) int {
+	if len(workload) == 0 {
+		return 0
+	}
+
+	res := workload[0].FreePlaces
+	for _, snapshot := range workload[1:] {
+		res = min(res, snapshot.FreePlaces)
+	}
+
+	return res
+}
+
 func maxTime(times ...time.Time) time.Time {
 	if len(times) == 0 {
 		return time.Time{}
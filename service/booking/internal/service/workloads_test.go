@@ -1,10 +1,16 @@
 package service
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"REDACTED/team-11/backend/booking/internal/dto"
+	"REDACTED/team-11/backend/booking/internal/models"
+	"REDACTED/team-11/backend/booking/internal/repo"
 )
 
 func TestMinTime(t *testing.T) {
@@ -28,3 +34,139 @@ func TestMaxTime(t *testing.T) {
 	res := maxTime(times...)
 	assert.Equal(t, times[2], res)
 }
+
+// stubBookingEntitiesRepo keeps entities with their amenities and selects
+// those which have all amenities of filter, as the postgres repo does.
+type stubBookingEntitiesRepo struct {
+	repo.BookingEntitiesRepo
+	entities  []models.BookingEntity
+	amenities map[uuid.UUID][]models.Amenity
+}
+
+func (sr stubBookingEntitiesRepo) GetById(ctx context.Context, id uuid.UUID) (models.BookingEntity, error) {
+	for _, entity := range sr.entities {
+		if entity.Id == id {
+			return entity, nil
+		}
+	}
+
+	return models.BookingEntity{}, models.ErrBookingEntityNotFound
+}
+
+func (sr stubBookingEntitiesRepo) GetForFloor(ctx context.Context, floorId uuid.UUID, filter dto.BookingEntityFilter) ([]models.BookingEntity, error) {
+	var res []models.BookingEntity
+	for _, entity := range sr.entities {
+		has := make(map[uuid.UUID]bool)
+		for _, amenity := range sr.amenities[entity.Id] {
+			has[amenity.Id] = true
+		}
+
+		matches := true
+		for _, id := range filter.Amenities {
+			matches = matches && has[id]
+		}
+
+		if entity.FloorId == floorId && matches {
+			res = append(res, entity)
+		}
+	}
+
+	return res, nil
+}
+
+func (sr stubBookingEntitiesRepo) ListForEntities(ctx context.Context, entityIds []uuid.UUID) (map[uuid.UUID][]models.Amenity, error) {
+	res := make(map[uuid.UUID][]models.Amenity, len(entityIds))
+	for _, id := range entityIds {
+		res[id] = sr.amenities[id]
+	}
+
+	return res, nil
+}
+
+type stubFloorsRepo struct {
+	floor models.Floor
+}
+
+func (sr stubFloorsRepo) GetById(ctx context.Context, id uuid.UUID) (models.Floor, error) {
+	if id != sr.floor.Id {
+		return models.Floor{}, models.ErrFloorNotFound
+	}
+
+	return sr.floor, nil
+}
+
+// stubBuildingsRepo has no buildings, so entities work in UTC around the clock.
+type stubBuildingsRepo struct {
+	repo.BuildingsRepo
+}
+
+func (sr stubBuildingsRepo) GetForEntity(ctx context.Context, entityId uuid.UUID) (models.Building, error) {
+	return models.Building{}, models.ErrBuildingNotFound
+}
+
+type stubAccessService struct {
+	AccessService
+}
+
+func (sa stubAccessService) ListRestricted(ctx context.Context, entityIds []uuid.UUID, token models.Token) (map[uuid.UUID]bool, error) {
+	return map[uuid.UUID]bool{}, nil
+}
+
+func TestGetForFloorAmenities(t *testing.T) {
+	floor := models.Floor{Id: uuid.New()}
+	projector := models.Amenity{Id: uuid.New(), Name: "projector"}
+	videoCall := models.Amenity{Id: uuid.New(), Name: "video-conferencing"}
+
+	bare := models.BookingEntity{Id: uuid.New(), FloorId: floor.Id, Type: models.BookingEntityTypeRoom}
+	withProjector := models.BookingEntity{Id: uuid.New(), FloorId: floor.Id, Type: models.BookingEntityTypeRoom}
+	equipped := models.BookingEntity{Id: uuid.New(), FloorId: floor.Id, Type: models.BookingEntityTypeRoom}
+
+	entitiesRepo := stubBookingEntitiesRepo{
+		entities: []models.BookingEntity{bare, withProjector, equipped},
+		amenities: map[uuid.UUID][]models.Amenity{
+			withProjector.Id: {projector},
+			equipped.Id:      {projector, videoCall},
+		},
+	}
+
+	ws := NewWorkloadService(
+		entitiesRepo,
+		stubBookingsRepo{},
+		stubFloorsRepo{floor: floor},
+		nil,
+		NewBuildingsService(stubBuildingsRepo{}),
+		entitiesRepo,
+		stubAccessService{},
+	)
+
+	timeFrom := time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC)
+	timeTo := timeFrom.Add(time.Hour)
+
+	tests := []struct {
+		name      string
+		amenities []uuid.UUID
+		want      []uuid.UUID
+	}{
+		{"no filter", nil, []uuid.UUID{bare.Id, withProjector.Id, equipped.Id}},
+		{"one amenity", []uuid.UUID{projector.Id}, []uuid.UUID{withProjector.Id, equipped.Id}},
+		{"all amenities", []uuid.UUID{projector.Id, videoCall.Id}, []uuid.UUID{equipped.Id}},
+		{"unknown amenity", []uuid.UUID{uuid.New()}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workload, err := ws.GetForFloor(context.Background(), floor.Id, timeFrom, timeTo, models.Token{UserId: uuid.New()}, dto.BookingEntityFilter{
+				Amenities: tt.amenities,
+			})
+			require.NoError(t, err)
+
+			var got []uuid.UUID
+			for _, item := range workload {
+				got = append(got, item.Entity.Id)
+				assert.Equal(t, entitiesRepo.amenities[item.Entity.Id], item.Entity.Amenities)
+				assert.True(t, item.IsFree)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
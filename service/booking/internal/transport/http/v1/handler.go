@@ -6,16 +6,31 @@ type Handler struct {
 	api.BookingsHandler
 	api.OrdersHandler
 	api.WorkloadsHandler
+	api.DelegationsHandler
+	api.BookingGroupsHandler
+	api.AllocationsHandler
+	api.QuotasHandler
+	api.TransfersHandler
 }
 
 func NewHandler(
 	bookingsHandler api.BookingsHandler,
 	ordersHandler api.OrdersHandler,
 	workloadsHandler api.WorkloadsHandler,
+	delegationsHandler api.DelegationsHandler,
+	bookingGroupsHandler api.BookingGroupsHandler,
+	allocationsHandler api.AllocationsHandler,
+	quotasHandler api.QuotasHandler,
+	transfersHandler api.TransfersHandler,
 ) api.Handler {
 	return &Handler{
-		BookingsHandler:  bookingsHandler,
-		OrdersHandler:    ordersHandler,
-		WorkloadsHandler: workloadsHandler,
+		BookingsHandler:      bookingsHandler,
+		OrdersHandler:        ordersHandler,
+		WorkloadsHandler:     workloadsHandler,
+		DelegationsHandler:   delegationsHandler,
+		BookingGroupsHandler: bookingGroupsHandler,
+		AllocationsHandler:   allocationsHandler,
+		QuotasHandler:        quotasHandler,
+		TransfersHandler:     transfersHandler,
 	}
 }
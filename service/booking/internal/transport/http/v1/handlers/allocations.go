@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"REDACTED/team-11/backend/booking/internal/dto"
+	"REDACTED/team-11/backend/booking/internal/models"
+	"REDACTED/team-11/backend/booking/internal/transport/http/v1/security"
+	"REDACTED/team-11/backend/booking/pkg/logger"
+	api "REDACTED/team-11/backend/booking/pkg/ogen"
+	"go.uber.org/zap"
+)
+
+type AllocationsUsecase interface {
+	Allocate(ctx context.Context, input dto.AllocationDto) (models.Allocation, error)
+}
+
+type AllocationsHandler struct {
+	usecase AllocationsUsecase
+}
+
+func NewAllocationsHandler(usecase AllocationsUsecase) *AllocationsHandler {
+	return &AllocationsHandler{
+		usecase: usecase,
+	}
+}
+
+// AllocateSeats implements allocateSeats operation.
+//
+// Подбирает указанное количество свободных мест в открытых пространствах этажа
+// так, чтобы они находились как можно ближе друг к другу.
+//
+// POST /allocations
+func (ah *AllocationsHandler) AllocateSeats(ctx context.Context, req *api.AllocationRequest) (api.AllocateSeatsRes, error) {
+	token := security.TokenFromCtx(ctx)
+
+	if req.GetTimeFrom() >= req.GetTimeTo() {
+		return &api.Response400{
+			Message: api.NewOptString("time_from must be before time_to"),
+		}, nil
+	}
+
+	if len(req.GetAttendees()) != 0 && len(req.GetAttendees()) != req.GetSeats() {
+		return &api.Response400{
+			Message: api.NewOptString("number of attendees must be equal to seats"),
+		}, nil
+	}
+
+	allocation, err := ah.usecase.Allocate(ctx, dto.AllocationDto{
+		FloorId:  req.GetFloorID(),
+		TimeFrom: time.Unix(int64(req.GetTimeFrom()), 0).UTC(),
+		TimeTo:   time.Unix(int64(req.GetTimeTo()), 0).UTC(),
+		Seats:    req.GetSeats(),
+		Filter: dto.BookingEntityFilter{
+			Amenities: req.GetAmenities(),
+		},
+
+		Book:      req.GetBook().Or(false),
+		Attendees: req.GetAttendees(),
+
+		Requester: token,
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrFloorNotFound) {
+			return &api.Response404{
+				Resource: api.NewOptResponse404Resource(api.Response404ResourceFloor),
+			}, nil
+		}
+		if res, ok := bookingGroupMemberError(err); ok {
+			return res, nil
+		}
+		if errors.Is(err, models.ErrAlreadyHaveBooking) {
+			return &api.AllocateSeatsConflict{}, nil
+		}
+		if errors.Is(err, models.ErrNotEnoughSeats) ||
+			errors.Is(err, models.ErrNoFreePlaces) ||
+			errors.Is(err, models.ErrEntityRestricted) ||
+			errors.Is(err, models.ErrNoDelegation) ||
+			errors.Is(err, models.ErrQuotaExceeded) {
+			return &api.AllocateSeatsForbidden{}, nil
+		}
+
+		logger.FromCtx(ctx).Error("allocate seats", zap.Error(err))
+		return nil, err
+	}
+
+	res := api.Allocation{
+		Seats: make([]api.AllocatedSeats, 0, len(allocation.Seats)),
+	}
+	for _, seat := range allocation.Seats {
+		res.Seats = append(res.Seats, api.AllocatedSeats{
+			Entity: convertBookingEntity(seat.Entity),
+			Places: seat.Places,
+		})
+	}
+	if allocation.Group != nil {
+		res.Group = api.NewOptBookingGroup(convertBookingGroup(*allocation.Group))
+	}
+
+	return &res, nil
+}
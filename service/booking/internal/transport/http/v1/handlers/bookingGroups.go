@@ -0,0 +1,312 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"REDACTED/team-11/backend/booking/internal/dto"
+	"REDACTED/team-11/backend/booking/internal/models"
+	"REDACTED/team-11/backend/booking/internal/transport/http/v1/security"
+	"REDACTED/team-11/backend/booking/pkg/logger"
+	api "REDACTED/team-11/backend/booking/pkg/ogen"
+	"go.uber.org/zap"
+)
+
+type BookingGroupsUsecase interface {
+	Create(ctx context.Context, input dto.BookingGroupCreateDto) (models.BookingGroup, error)
+	GetById(ctx context.Context, groupId uuid.UUID, token models.Token) (models.BookingGroup, error)
+	Update(ctx context.Context, input dto.BookingGroupUpdateDto, token models.Token) (models.BookingGroup, error)
+	Delete(ctx context.Context, groupId uuid.UUID, token models.Token) error
+	AddMember(ctx context.Context, groupId uuid.UUID, item dto.BookingGroupItem, token models.Token) (models.BookingGroup, error)
+	RemoveMember(ctx context.Context, groupId uuid.UUID, userId uuid.UUID, token models.Token) error
+}
+
+type BookingGroupsHandler struct {
+	usecase BookingGroupsUsecase
+}
+
+func NewBookingGroupsHandler(usecase BookingGroupsUsecase) *BookingGroupsHandler {
+	return &BookingGroupsHandler{
+		usecase: usecase,
+	}
+}
+
+// CreateBookingGroup implements createBookingGroup operation.
+//
+// Бронирует несколько рабочих мест на один и тот же период времени одной операцией.
+//
+// POST /booking-groups
+func (bgh *BookingGroupsHandler) CreateBookingGroup(ctx context.Context, req *api.BookingGroupCreate) (api.CreateBookingGroupRes, error) {
+	token := security.TokenFromCtx(ctx)
+
+	if req.GetTimeFrom() >= req.GetTimeTo() {
+		return &api.Response400{
+			Message: api.NewOptString("time_from must be before time_to"),
+		}, nil
+	}
+
+	items := make([]dto.BookingGroupItem, 0, len(req.GetItems()))
+	for _, item := range req.GetItems() {
+		items = append(items, convertBookingGroupItem(item, token))
+	}
+
+	group, err := bgh.usecase.Create(ctx, dto.BookingGroupCreateDto{
+		TimeFrom: time.Unix(int64(req.GetTimeFrom()), 0).UTC(),
+		TimeTo:   time.Unix(int64(req.GetTimeTo()), 0).UTC(),
+		Items:    items,
+
+		Requester: token,
+	})
+	if err != nil {
+		if res, ok := bookingGroupMemberError(err); ok {
+			return res, nil
+		}
+		if errors.Is(err, models.ErrAlreadyHaveBooking) {
+			return &api.CreateBookingGroupConflict{}, nil
+		}
+		if errors.Is(err, models.ErrNoFreePlaces) ||
+			errors.Is(err, models.ErrEntityRestricted) ||
+			errors.Is(err, models.ErrNoDelegation) ||
+			errors.Is(err, models.ErrQuotaExceeded) {
+			return &api.CreateBookingGroupForbidden{}, nil
+		}
+
+		logger.FromCtx(ctx).Error("create booking group", zap.Error(err))
+		return nil, err
+	}
+
+	res := convertBookingGroup(group)
+	return &res, nil
+}
+
+// GetBookingGroup implements getBookingGroup operation.
+//
+// Групповое бронирование доступно его создателю и администратору.
+//
+// GET /booking-groups/{groupId}
+func (bgh *BookingGroupsHandler) GetBookingGroup(ctx context.Context, params api.GetBookingGroupParams) (api.GetBookingGroupRes, error) {
+	token := security.TokenFromCtx(ctx)
+
+	group, err := bgh.usecase.GetById(ctx, params.GroupId, token)
+	if err != nil {
+		if errors.Is(err, models.ErrBookingGroupNotFound) || errors.Is(err, models.ErrNoAccessToBookingGroup) {
+			return &api.Response404{
+				Resource: api.NewOptResponse404Resource(api.Response404ResourceBookingGroup),
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("get booking group", zap.Error(err))
+		return nil, err
+	}
+
+	res := convertBookingGroup(group)
+	return &res, nil
+}
+
+// UpdateBookingGroup implements updateBookingGroup operation.
+//
+// Изменяет время начала и/или окончания всех бронирований группы одной транзакцией.
+//
+// PATCH /booking-groups/{groupId}
+func (bgh *BookingGroupsHandler) UpdateBookingGroup(ctx context.Context, req *api.BookingGroupUpdate, params api.UpdateBookingGroupParams) (api.UpdateBookingGroupRes, error) {
+	token := security.TokenFromCtx(ctx)
+
+	if req.GetTimeFrom().IsSet() && req.GetTimeTo().IsSet() && req.GetTimeFrom().Value >= req.GetTimeTo().Value {
+		return &api.Response400{
+			Message: api.NewOptString("time_from must be before time_to"),
+		}, nil
+	}
+
+	var (
+		timeFrom *time.Time
+		timeTo   *time.Time
+	)
+
+	if req.GetTimeFrom().IsSet() {
+		timeFrom = pointer(time.Unix(int64(req.GetTimeFrom().Value), 0).UTC())
+	}
+	if req.GetTimeTo().IsSet() {
+		timeTo = pointer(time.Unix(int64(req.GetTimeTo().Value), 0).UTC())
+	}
+
+	group, err := bgh.usecase.Update(ctx, dto.BookingGroupUpdateDto{
+		GroupId:  params.GroupId,
+		TimeFrom: timeFrom,
+		TimeTo:   timeTo,
+	}, token)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidBookingTime) {
+			return &api.Response400{
+				Message: api.NewOptString("time_from must be before time_to"),
+			}, nil
+		}
+		if errors.Is(err, models.ErrBookingGroupNotFound) || errors.Is(err, models.ErrNoAccessToBookingGroup) {
+			return &api.Response404{
+				Resource: api.NewOptResponse404Resource(api.Response404ResourceBookingGroup),
+			}, nil
+		}
+		if res, ok := bookingGroupMemberError(err); ok {
+			return res, nil
+		}
+		if errors.Is(err, models.ErrAlreadyHaveBooking) {
+			return &api.UpdateBookingGroupConflict{}, nil
+		}
+		if errors.Is(err, models.ErrNoFreePlaces) || errors.Is(err, models.ErrQuotaExceeded) {
+			return &api.UpdateBookingGroupForbidden{}, nil
+		}
+
+		logger.FromCtx(ctx).Error("update booking group", zap.Error(err))
+		return nil, err
+	}
+
+	res := convertBookingGroup(group)
+	return &res, nil
+}
+
+// DeleteBookingGroup implements deleteBookingGroup operation.
+//
+// Удаляет групповое бронирование вместе со всеми его бронированиями.
+//
+// DELETE /booking-groups/{groupId}
+func (bgh *BookingGroupsHandler) DeleteBookingGroup(ctx context.Context, params api.DeleteBookingGroupParams) (api.DeleteBookingGroupRes, error) {
+	token := security.TokenFromCtx(ctx)
+
+	if err := bgh.usecase.Delete(ctx, params.GroupId, token); err != nil {
+		if errors.Is(err, models.ErrBookingGroupNotFound) || errors.Is(err, models.ErrNoAccessToBookingGroup) {
+			return &api.Response404{
+				Resource: api.NewOptResponse404Resource(api.Response404ResourceBookingGroup),
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("delete booking group", zap.Error(err))
+		return nil, err
+	}
+
+	return &api.DeleteBookingGroupNoContent{}, nil
+}
+
+// AddBookingGroupMember implements addBookingGroupMember operation.
+//
+// Бронирует рабочее место для участника на время группового бронирования.
+//
+// POST /booking-groups/{groupId}/members
+func (bgh *BookingGroupsHandler) AddBookingGroupMember(ctx context.Context, req *api.BookingGroupItem, params api.AddBookingGroupMemberParams) (api.AddBookingGroupMemberRes, error) {
+	token := security.TokenFromCtx(ctx)
+
+	group, err := bgh.usecase.AddMember(ctx, params.GroupId, convertBookingGroupItem(*req, token), token)
+	if err != nil {
+		if errors.Is(err, models.ErrBookingGroupNotFound) || errors.Is(err, models.ErrNoAccessToBookingGroup) {
+			return &api.Response404{
+				Resource: api.NewOptResponse404Resource(api.Response404ResourceBookingGroup),
+			}, nil
+		}
+		if res, ok := bookingGroupMemberError(err); ok {
+			return res, nil
+		}
+		if errors.Is(err, models.ErrAlreadyHaveBooking) {
+			return &api.AddBookingGroupMemberConflict{}, nil
+		}
+		if errors.Is(err, models.ErrNoFreePlaces) ||
+			errors.Is(err, models.ErrEntityRestricted) ||
+			errors.Is(err, models.ErrNoDelegation) ||
+			errors.Is(err, models.ErrQuotaExceeded) {
+			return &api.AddBookingGroupMemberForbidden{}, nil
+		}
+
+		logger.FromCtx(ctx).Error("add booking group member", zap.Error(err))
+		return nil, err
+	}
+
+	res := convertBookingGroup(group)
+	return &res, nil
+}
+
+// RemoveBookingGroupMember implements removeBookingGroupMember operation.
+//
+// Удаляет все бронирования участника в группе.
+//
+// DELETE /booking-groups/{groupId}/members/{userId}
+func (bgh *BookingGroupsHandler) RemoveBookingGroupMember(ctx context.Context, params api.RemoveBookingGroupMemberParams) (api.RemoveBookingGroupMemberRes, error) {
+	token := security.TokenFromCtx(ctx)
+
+	if err := bgh.usecase.RemoveMember(ctx, params.GroupId, params.UserId, token); err != nil {
+		if errors.Is(err, models.ErrBookingGroupNotFound) || errors.Is(err, models.ErrNoAccessToBookingGroup) {
+			return &api.Response404{
+				Resource: api.NewOptResponse404Resource(api.Response404ResourceBookingGroup),
+			}, nil
+		}
+		if errors.Is(err, models.ErrBookingGroupMemberNotFound) {
+			return &api.Response404{
+				Resource: api.NewOptResponse404Resource(api.Response404ResourceUser),
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("remove booking group member", zap.Error(err))
+		return nil, err
+	}
+
+	return &api.RemoveBookingGroupMemberNoContent{}, nil
+}
+
+// bookingGroupMemberError converts errors of per booking checks,
+// which are shared by all group operations.
+func bookingGroupMemberError(err error) (interface {
+	api.CreateBookingGroupRes
+	api.UpdateBookingGroupRes
+	api.AddBookingGroupMemberRes
+	api.AllocateSeatsRes
+}, bool) {
+	if errors.Is(err, models.ErrBookingEntityNotFound) {
+		return &api.Response404{
+			Resource: api.NewOptResponse404Resource(api.Response404ResourceBookingEntity),
+		}, true
+	}
+	if errors.Is(err, models.ErrUserNotFound) {
+		return &api.Response404{
+			Resource: api.NewOptResponse404Resource(api.Response404ResourceUser),
+		}, true
+	}
+	if errors.Is(err, models.ErrInvalidBookingSlot) {
+		return &api.Response400{
+			Message: api.NewOptString(invalidSlotMessage),
+		}, true
+	}
+	if errors.Is(err, models.ErrBuildingClosed) {
+		return &api.Response400{
+			Message: api.NewOptString(buildingClosedMessage),
+		}, true
+	}
+
+	return nil, false
+}
+
+func convertBookingGroupItem(item api.BookingGroupItem, token models.Token) dto.BookingGroupItem {
+	userId := token.UserId
+	if item.GetUserID().IsSet() {
+		userId = item.GetUserID().Value
+	}
+
+	return dto.BookingGroupItem{
+		EntityId: item.GetEntityID(),
+		UserId:   userId,
+	}
+}
+
+func convertBookingGroup(group models.BookingGroup) api.BookingGroup {
+	bookings := make([]api.Booking, 0, len(group.Bookings))
+	for _, booking := range group.Bookings {
+		bookings = append(bookings, convertBooking(booking))
+	}
+
+	return api.BookingGroup{
+		ID:        group.Id,
+		OwnerID:   group.OwnerId,
+		TimeFrom:  api.Time(group.TimeFrom.Unix()),
+		TimeTo:    api.Time(group.TimeTo.Unix()),
+		Bookings:  bookings,
+		CreatedAt: api.Time(group.CreatedAt.Unix()),
+		UpdatedAt: api.Time(group.UpdatedAt.Unix()),
+	}
+}
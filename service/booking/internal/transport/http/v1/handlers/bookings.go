@@ -15,16 +15,20 @@ import (
 )
 
 var (
-	bookingIntervalMinutes int64 = 15
+	invalidSlotMessage    = "time_from and time_to must be multiple of 15 minutes in building time zone"
+	buildingClosedMessage = "booking must be within building opening hours"
 )
 
 type BookingUsecase interface {
 	Create(ctx context.Context, input dto.BookingCreateDto) (models.Booking, error)
 	GetById(ctx context.Context, bookingId uuid.UUID, token models.Token) (models.BookingInfo, error)
-	ListAll(ctx context.Context, token models.Token) ([]models.BookingInfo, error)
-	ListForUser(ctx context.Context, userId uuid.UUID) ([]models.BookingInfo, error)
+	ListAll(ctx context.Context, token models.Token, filter dto.BookingListFilter) (models.BookingPage, error)
+	ListForUser(ctx context.Context, userId uuid.UUID, filter dto.BookingListFilter) (models.BookingPage, error)
 	Update(ctx context.Context, input dto.BookingUpdateDto, token models.Token) (models.Booking, error)
-	Delete(ctx context.Context, bookingId uuid.UUID, token models.Token) error
+	Delete(ctx context.Context, bookingId uuid.UUID, version *int, token models.Token) error
+	Extend(ctx context.Context, bookingId uuid.UUID, slots int, version *int, token models.Token) (models.Booking, error)
+	Release(ctx context.Context, bookingId uuid.UUID, version *int, token models.Token) (models.Booking, error)
+	History(ctx context.Context, bookingId uuid.UUID, token models.Token) ([]models.BookingHistoryItem, error)
 }
 
 type BookingsHandler struct {
@@ -53,26 +57,21 @@ func (bh *BookingsHandler) CreateBooking(ctx context.Context, req *api.BookingCr
 		}, nil
 	}
 
-	if int64(req.GetTimeFrom())%(bookingIntervalMinutes*60) != 0 {
-		return &api.Response400{
-			Message: api.NewOptString("time_from must be multiple of 15 minutes"),
-		}, nil
-	}
-
-	if int64(req.GetTimeTo())%(bookingIntervalMinutes*60) != 0 {
-		return &api.Response400{
-			Message: api.NewOptString("time_to must be multiple of 15 minutes"),
-		}, nil
-	}
-
 	timeFrom := time.Unix(int64(req.GetTimeFrom()), 0).UTC()
 	timeTo := time.Unix(int64(req.GetTimeTo()), 0).UTC()
 
+	userId := token.UserId
+	if req.GetOnBehalfOf().IsSet() {
+		userId = req.GetOnBehalfOf().Value
+	}
+
 	booking, err := bh.usecase.Create(ctx, dto.BookingCreateDto{
 		EntityId: req.GetEntityID(),
-		UserId:   token.UserId,
+		UserId:   userId,
 		TimeFrom: timeFrom,
 		TimeTo:   timeTo,
+
+		Requester: token,
 	})
 
 	if err != nil {
@@ -81,12 +80,32 @@ func (bh *BookingsHandler) CreateBooking(ctx context.Context, req *api.BookingCr
 				Resource: api.NewOptResponse404Resource(api.Response404ResourceBookingEntity),
 			}, nil
 		}
+		if errors.Is(err, models.ErrInvalidBookingSlot) {
+			return &api.Response400{
+				Message: api.NewOptString(invalidSlotMessage),
+			}, nil
+		}
+		if errors.Is(err, models.ErrBuildingClosed) {
+			return &api.Response400{
+				Message: api.NewOptString(buildingClosedMessage),
+			}, nil
+		}
 		if errors.Is(err, models.ErrAlreadyHaveBooking) {
 			return &api.CreateBookingConflict{}, nil
 		}
 		if errors.Is(err, models.ErrNoFreePlaces) {
 			return &api.CreateBookingForbidden{}, nil
 		}
+		if errors.Is(err, models.ErrEntityRestricted) ||
+			errors.Is(err, models.ErrNoDelegation) ||
+			errors.Is(err, models.ErrQuotaExceeded) {
+			return &api.CreateBookingForbidden{}, nil
+		}
+		if errors.Is(err, models.ErrUserNotFound) {
+			return &api.Response404{
+				Resource: api.NewOptResponse404Resource(api.Response404ResourceUser),
+			}, nil
+		}
 
 		logger.FromCtx(ctx).Error("create booking", zap.Error(err))
 		return nil, err
@@ -100,7 +119,7 @@ func (bh *BookingsHandler) CreateBooking(ctx context.Context, req *api.BookingCr
 func (bh *BookingsHandler) CreateBookingForAdmin(ctx context.Context, req *api.BookingCreate, params api.CreateBookingForAdminParams) (api.CreateBookingForAdminRes, error) {
 	token := security.TokenFromCtx(ctx)
 
-	if token.Role != "ADMIN" {
+	if !token.Can(models.BOOKING_WRITE_ANY) {
 		return &api.CreateBookingForAdminForbidden{}, nil
 	}
 
@@ -110,18 +129,6 @@ func (bh *BookingsHandler) CreateBookingForAdmin(ctx context.Context, req *api.B
 		}, nil
 	}
 
-	if int64(req.GetTimeFrom())%(bookingIntervalMinutes*60) != 0 {
-		return &api.Response400{
-			Message: api.NewOptString("time_from must be multiple of 15 minutes"),
-		}, nil
-	}
-
-	if int64(req.GetTimeTo())%(bookingIntervalMinutes*60) != 0 {
-		return &api.Response400{
-			Message: api.NewOptString("time_to must be multiple of 15 minutes"),
-		}, nil
-	}
-
 	timeFrom := time.Unix(int64(req.GetTimeFrom()), 0).UTC()
 	timeTo := time.Unix(int64(req.GetTimeTo()), 0).UTC()
 
@@ -130,6 +137,8 @@ func (bh *BookingsHandler) CreateBookingForAdmin(ctx context.Context, req *api.B
 		UserId:   params.UserId,
 		TimeFrom: timeFrom,
 		TimeTo:   timeTo,
+
+		Requester: token,
 	})
 
 	if err != nil {
@@ -138,12 +147,27 @@ func (bh *BookingsHandler) CreateBookingForAdmin(ctx context.Context, req *api.B
 				Resource: api.NewOptResponse404Resource(api.Response404ResourceBookingEntity),
 			}, nil
 		}
+		if errors.Is(err, models.ErrInvalidBookingSlot) {
+			return &api.Response400{
+				Message: api.NewOptString(invalidSlotMessage),
+			}, nil
+		}
+		if errors.Is(err, models.ErrBuildingClosed) {
+			return &api.Response400{
+				Message: api.NewOptString(buildingClosedMessage),
+			}, nil
+		}
 		if errors.Is(err, models.ErrAlreadyHaveBooking) {
 			return &api.CreateBookingForAdminConflict{}, nil
 		}
-		if errors.Is(err, models.ErrNoFreePlaces) {
+		if errors.Is(err, models.ErrNoFreePlaces) || errors.Is(err, models.ErrQuotaExceeded) {
 			return &api.CreateBookingForAdminForbidden{}, nil
 		}
+		if errors.Is(err, models.ErrUserNotFound) {
+			return &api.Response404{
+				Resource: api.NewOptResponse404Resource(api.Response404ResourceUser),
+			}, nil
+		}
 
 		logger.FromCtx(ctx).Error("create booking", zap.Error(err))
 		return nil, err
@@ -161,8 +185,13 @@ func (bh *BookingsHandler) CreateBookingForAdmin(ctx context.Context, req *api.B
 func (bh *BookingsHandler) DeleteBooking(ctx context.Context, params api.DeleteBookingParams) (api.DeleteBookingRes, error) {
 	token := security.TokenFromCtx(ctx)
 
-	err := bh.usecase.Delete(ctx, params.BookingId, token)
+	err := bh.usecase.Delete(ctx, params.BookingId, ifMatch(params.IfMatch), token)
 	if err != nil {
+		if errors.Is(err, models.ErrBookingModified) {
+			return &api.Response412{
+				Message: api.NewOptString(modifiedMessage),
+			}, nil
+		}
 		if errors.Is(err, models.ErrBookingNotFound) {
 			return &api.Response404{
 				Resource: api.NewOptResponse404Resource(api.Response404ResourceBooking),
@@ -206,55 +235,84 @@ func (bh *BookingsHandler) GetBookingById(ctx context.Context, params api.GetBoo
 		return nil, err
 	}
 
-	res := convertBookingInfo(bookingInfo)
-	return &res, nil
+	return &api.BookingInfoHeaders{
+		ETag:     etag(bookingInfo.Version),
+		Response: convertBookingInfo(bookingInfo),
+	}, nil
 }
 
 // ListMyBookings implements listMyBookings operation.
 //
-// Get list of my bookings.
+// Get page of my bookings.
 //
 // GET /bookings/my
-func (bh *BookingsHandler) ListMyBookings(ctx context.Context) (api.ListMyBookingsRes, error) {
+func (bh *BookingsHandler) ListMyBookings(ctx context.Context, params api.ListMyBookingsParams) (api.ListMyBookingsRes, error) {
 	token := security.TokenFromCtx(ctx)
 
-	bookings, err := bh.usecase.ListForUser(ctx, token.UserId)
+	filter, ok := convertBookingListFilter(api.ListAllBookingsParams{
+		BuildingId: params.BuildingId,
+		FloorId:    params.FloorId,
+		EntityId:   params.EntityId,
+		From:       params.From,
+		To:         params.To,
+		Status:     params.Status,
+		Sort:       params.Sort,
+		Limit:      params.Limit,
+		Cursor:     params.Cursor,
+	})
+	if !ok {
+		return &api.Response400{
+			Message: api.NewOptString("from must be before to"),
+		}, nil
+	}
+
+	page, err := bh.usecase.ListForUser(ctx, token.UserId, filter)
 	if err != nil {
+		if errors.Is(err, models.ErrInvalidCursor) {
+			return &api.Response400{
+				Message: api.NewOptString("invalid cursor"),
+			}, nil
+		}
+
 		logger.FromCtx(ctx).Error("list my bookings", zap.Error(err))
 		return nil, err
 	}
 
-	res := api.ListMyBookingsOKApplicationJSON(make([]api.BookingInfo, 0, len(bookings)))
-	for _, booking := range bookings {
-		res = append(res, convertBookingInfo(booking))
-	}
-
+	res := convertBookingPage(page)
 	return &res, nil
 }
 
 // ListAllBookings implements listAllBookings operation.
 //
-// Возвращает список всех бронирований.
+// Возвращает страницу бронирований.
 //
 // GET /bookings
-func (bh *BookingsHandler) ListAllBookings(ctx context.Context) (api.ListAllBookingsRes, error) {
+func (bh *BookingsHandler) ListAllBookings(ctx context.Context, params api.ListAllBookingsParams) (api.ListAllBookingsRes, error) {
 	token := security.TokenFromCtx(ctx)
 
-	bookings, err := bh.usecase.ListAll(ctx, token)
+	filter, ok := convertBookingListFilter(params)
+	if !ok {
+		return &api.Response400{
+			Message: api.NewOptString("from must be before to"),
+		}, nil
+	}
+
+	page, err := bh.usecase.ListAll(ctx, token, filter)
 	if err != nil {
 		if errors.Is(err, models.ErrNoRights) {
 			return &api.ListAllBookingsForbidden{}, nil
 		}
+		if errors.Is(err, models.ErrInvalidCursor) {
+			return &api.Response400{
+				Message: api.NewOptString("invalid cursor"),
+			}, nil
+		}
 
 		logger.FromCtx(ctx).Error("list all books", zap.Error(err))
 		return nil, err
 	}
 
-	res := api.ListAllBookingsOKApplicationJSON(make([]api.BookingInfo, 0, len(bookings)))
-	for _, booking := range bookings {
-		res = append(res, convertBookingInfo(booking))
-	}
-
+	res := convertBookingPage(page)
 	return &res, nil
 }
 
@@ -266,18 +324,6 @@ func (bh *BookingsHandler) ListAllBookings(ctx context.Context) (api.ListAllBook
 func (bh *BookingsHandler) UpdateBooking(ctx context.Context, req *api.BookingUpdate, params api.UpdateBookingParams) (api.UpdateBookingRes, error) {
 	token := security.TokenFromCtx(ctx)
 
-	if req.GetTimeFrom().IsSet() && int64(req.GetTimeFrom().Value)%(bookingIntervalMinutes*60) != 0 {
-		return &api.Response400{
-			Message: api.NewOptString("time_from must be multiple of 15 minutes"),
-		}, nil
-	}
-
-	if req.GetTimeTo().IsSet() && int64(req.GetTimeTo().Value)%(bookingIntervalMinutes*60) != 0 {
-		return &api.Response400{
-			Message: api.NewOptString("time_to must be multiple of 15 minutes"),
-		}, nil
-	}
-
 	if req.GetTimeFrom().IsSet() && req.GetTimeTo().IsSet() && req.GetTimeFrom().Value >= req.GetTimeTo().Value {
 		return &api.Response400{
 			Message: api.NewOptString("time_from must be before time_to"),
@@ -296,17 +342,34 @@ func (bh *BookingsHandler) UpdateBooking(ctx context.Context, req *api.BookingUp
 		timeTo = pointer(time.Unix(int64(req.GetTimeTo().Value), 0).UTC())
 	}
 
+	var userId *uuid.UUID
+	if req.GetOnBehalfOf().IsSet() {
+		userId = pointer(req.GetOnBehalfOf().Value)
+	}
+
 	updated, err := bh.usecase.Update(ctx, dto.BookingUpdateDto{
 		BookingId: params.BookingId,
 		TimeFrom:  timeFrom,
 		TimeTo:    timeTo,
+		UserId:    userId,
+		Version:   ifMatch(params.IfMatch),
 	}, token)
 	if err != nil {
+		if errors.Is(err, models.ErrBookingModified) {
+			return &api.Response412{
+				Message: api.NewOptString(modifiedMessage),
+			}, nil
+		}
 		if errors.Is(err, models.ErrInvalidBookingTime) {
 			return &api.Response400{
 				Message: api.NewOptString("time_from must be before time_to"),
 			}, nil
 		}
+		if errors.Is(err, models.ErrBookingInGroup) {
+			return &api.Response400{
+				Message: api.NewOptString("booking is a part of group, change time of the whole group"),
+			}, nil
+		}
 		if errors.Is(err, models.ErrBookingNotFound) {
 			return &api.Response404{
 				Resource: api.NewOptResponse404Resource(api.Response404ResourceBooking),
@@ -317,30 +380,189 @@ func (bh *BookingsHandler) UpdateBooking(ctx context.Context, req *api.BookingUp
 				Resource: api.NewOptResponse404Resource(api.Response404ResourceBooking),
 			}, nil
 		}
+		if errors.Is(err, models.ErrInvalidBookingSlot) {
+			return &api.Response400{
+				Message: api.NewOptString(invalidSlotMessage),
+			}, nil
+		}
+		if errors.Is(err, models.ErrBuildingClosed) {
+			return &api.Response400{
+				Message: api.NewOptString(buildingClosedMessage),
+			}, nil
+		}
 		if errors.Is(err, models.ErrAlreadyHaveBooking) {
 			return &api.UpdateBookingConflict{}, nil
 		}
 		if errors.Is(err, models.ErrNoFreePlaces) {
 			return &api.UpdateBookingForbidden{}, nil
 		}
+		if errors.Is(err, models.ErrEntityRestricted) ||
+			errors.Is(err, models.ErrNoDelegation) ||
+			errors.Is(err, models.ErrQuotaExceeded) {
+			return &api.UpdateBookingForbidden{}, nil
+		}
+		if errors.Is(err, models.ErrUserNotFound) {
+			return &api.Response404{
+				Resource: api.NewOptResponse404Resource(api.Response404ResourceUser),
+			}, nil
+		}
 
 		logger.FromCtx(ctx).Error("update booking", zap.Error(err))
 		return nil, err
 	}
 
-	res := convertBooking(updated)
+	return &api.BookingHeaders{
+		ETag:     etag(updated.Version),
+		Response: convertBooking(updated),
+	}, nil
+}
+
+// ExtendBooking implements extendBooking operation.
+//
+// Extend active booking.
+//
+// POST /bookings/{bookingId}/extend
+func (bh *BookingsHandler) ExtendBooking(ctx context.Context, req *api.BookingExtend, params api.ExtendBookingParams) (api.ExtendBookingRes, error) {
+	token := security.TokenFromCtx(ctx)
+
+	extended, err := bh.usecase.Extend(ctx, params.BookingId, req.GetSlots(), ifMatch(params.IfMatch), token)
+	if err != nil {
+		if res, ok := activeBookingError(err); ok {
+			return res, nil
+		}
+		if errors.Is(err, models.ErrInvalidBookingTime) {
+			return &api.Response400{
+				Message: api.NewOptString("slots must be positive"),
+			}, nil
+		}
+		if errors.Is(err, models.ErrInvalidBookingSlot) {
+			return &api.Response400{
+				Message: api.NewOptString(invalidSlotMessage),
+			}, nil
+		}
+		if errors.Is(err, models.ErrBuildingClosed) {
+			return &api.Response400{
+				Message: api.NewOptString(buildingClosedMessage),
+			}, nil
+		}
+		if errors.Is(err, models.ErrAlreadyHaveBooking) {
+			return &api.ExtendBookingConflict{}, nil
+		}
+		if errors.Is(err, models.ErrNoFreePlaces) || errors.Is(err, models.ErrQuotaExceeded) {
+			return &api.ExtendBookingForbidden{}, nil
+		}
+
+		logger.FromCtx(ctx).Error("extend booking", zap.Error(err))
+		return nil, err
+	}
+
+	return &api.BookingHeaders{
+		ETag:     etag(extended.Version),
+		Response: convertBooking(extended),
+	}, nil
+}
+
+// ReleaseBooking implements releaseBooking operation.
+//
+// Release active booking.
+//
+// POST /bookings/{bookingId}/release
+func (bh *BookingsHandler) ReleaseBooking(ctx context.Context, params api.ReleaseBookingParams) (api.ReleaseBookingRes, error) {
+	token := security.TokenFromCtx(ctx)
+
+	released, err := bh.usecase.Release(ctx, params.BookingId, ifMatch(params.IfMatch), token)
+	if err != nil {
+		if res, ok := activeBookingError(err); ok {
+			return res, nil
+		}
+
+		logger.FromCtx(ctx).Error("release booking", zap.Error(err))
+		return nil, err
+	}
+
+	return &api.BookingHeaders{
+		ETag:     etag(released.Version),
+		Response: convertBooking(released),
+	}, nil
+}
+
+// GetBookingHistory implements getBookingHistory operation.
+//
+// Get booking history.
+//
+// GET /bookings/{bookingId}/history
+func (bh *BookingsHandler) GetBookingHistory(ctx context.Context, params api.GetBookingHistoryParams) (api.GetBookingHistoryRes, error) {
+	token := security.TokenFromCtx(ctx)
+
+	history, err := bh.usecase.History(ctx, params.BookingId, token)
+	if err != nil {
+		if errors.Is(err, models.ErrBookingNotFound) || errors.Is(err, models.ErrNoAccessToBooking) {
+			return &api.Response404{
+				Resource: api.NewOptResponse404Resource(api.Response404ResourceBooking),
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("get booking history", zap.Error(err))
+		return nil, err
+	}
+
+	res := make(api.GetBookingHistoryOKApplicationJSON, 0, len(history))
+	for _, item := range history {
+		res = append(res, api.BookingHistoryItem{
+			ID:         item.Id,
+			Action:     api.BookingHistoryAction(item.Action),
+			ActorID:    item.ActorId,
+			PrevTimeTo: api.Time(item.PrevTimeTo.Unix()),
+			TimeTo:     api.Time(item.TimeTo.Unix()),
+			CreatedAt:  api.Time(item.CreatedAt.Unix()),
+		})
+	}
+
 	return &res, nil
 }
 
+// activeBookingError converts errors of getting active booking,
+// which are shared by extend and release.
+func activeBookingError(err error) (interface {
+	api.ExtendBookingRes
+	api.ReleaseBookingRes
+}, bool) {
+	if errors.Is(err, models.ErrBookingModified) {
+		return &api.Response412{
+			Message: api.NewOptString(modifiedMessage),
+		}, true
+	}
+	if errors.Is(err, models.ErrBookingNotFound) || errors.Is(err, models.ErrNoAccessToBooking) {
+		return &api.Response404{
+			Resource: api.NewOptResponse404Resource(api.Response404ResourceBooking),
+		}, true
+	}
+	if errors.Is(err, models.ErrBookingNotActive) {
+		return &api.Response400{
+			Message: api.NewOptString("booking is not active"),
+		}, true
+	}
+	if errors.Is(err, models.ErrBookingInGroup) {
+		return &api.Response400{
+			Message: api.NewOptString("booking is a part of group, change time of the whole group"),
+		}, true
+	}
+
+	return nil, false
+}
+
 func convertBooking(booking models.Booking) api.Booking {
 	return api.Booking{
 		ID:        booking.Id,
 		EntityID:  booking.EntityId,
 		UserID:    booking.UserId,
+		BookedBy:  booking.BookedBy,
+		GroupID:   convertOptUUID(booking.GroupId),
 		TimeFrom:  api.Time(booking.TimeFrom.Unix()),
 		TimeTo:    api.Time(booking.TimeTo.Unix()),
 		CreatedAt: api.Time(booking.CreatedAt.Unix()),
 		UpdatedAt: api.Time(booking.UpdatedAt.Unix()),
+		Version:   booking.Version,
 	}
 }
 
@@ -350,14 +572,26 @@ func convertBookingInfo(bookingInfo models.BookingInfo) api.BookingInfo {
 		orders = append(orders, convertOrder(order))
 	}
 
+	var floor api.OptFloor
+	if bookingInfo.Floor.Id != uuid.Nil {
+		floor = api.NewOptFloor(api.Floor{
+			ID:         bookingInfo.Floor.Id,
+			Name:       bookingInfo.Floor.Name,
+			BuildingID: convertOptUUID(bookingInfo.Floor.BuildingId),
+		})
+	}
+
 	return api.BookingInfo{
 		ID:     bookingInfo.Id,
+		Status: api.BookingStatus(bookingInfo.Status(time.Now())),
 		Entity: convertBookingEntity(bookingInfo.Entity),
+		Floor:  floor,
 		User: api.User{
 			ID:    bookingInfo.User.Id,
 			Email: bookingInfo.User.Email,
 			Name:  bookingInfo.User.Name,
 		},
+		BookedBy:  bookingInfo.BookedBy,
 		Orders:    orders,
 		TimeFrom:  api.Time(bookingInfo.TimeFrom.Unix()),
 		TimeTo:    api.Time(bookingInfo.TimeTo.Unix()),
@@ -367,6 +601,14 @@ func convertBookingInfo(bookingInfo models.BookingInfo) api.BookingInfo {
 }
 
 func convertBookingEntity(entity models.BookingEntity) api.BookingEntity {
+	amenities := make([]api.Amenity, 0, len(entity.Amenities))
+	for _, amenity := range entity.Amenities {
+		amenities = append(amenities, api.Amenity{
+			ID:   amenity.Id,
+			Name: amenity.Name,
+		})
+	}
+
 	return api.BookingEntity{
 		ID:        entity.Id,
 		Type:      api.BookingEntityType(entity.Type),
@@ -377,11 +619,74 @@ func convertBookingEntity(entity models.BookingEntity) api.BookingEntity {
 		Width:     entity.Width,
 		Height:    entity.Height,
 		Capacity:  entity.Capacity,
+		IsPremium: entity.IsPremium,
+		Amenities: amenities,
 		CreatedAt: api.Time(entity.CreatedAt.UTC().Unix()),
 		UpdatedAt: api.Time(entity.UpdatedAt.UTC().Unix()),
 	}
 }
 
+func convertBookingPage(page models.BookingPage) api.BookingInfoPage {
+	items := make([]api.BookingInfo, 0, len(page.Items))
+	for _, item := range page.Items {
+		items = append(items, convertBookingInfo(item))
+	}
+
+	res := api.BookingInfoPage{
+		Items: items,
+	}
+	if page.NextCursor != "" {
+		res.NextCursor = api.NewOptString(page.NextCursor)
+	}
+
+	return res
+}
+
+// convertBookingListFilter returns false if the time range of the filter is empty.
+func convertBookingListFilter(params api.ListAllBookingsParams) (dto.BookingListFilter, bool) {
+	filter := dto.BookingListFilter{
+		Sort:   dto.BookingSort(params.Sort.Or(api.BookingSortMinusCreatedAt)),
+		Limit:  params.Limit.Or(dto.DefaultBookingListLimit),
+		Cursor: params.Cursor.Or(""),
+	}
+
+	if params.BuildingId.IsSet() {
+		filter.BuildingId = pointer(params.BuildingId.Value)
+	}
+	if params.FloorId.IsSet() {
+		filter.FloorId = pointer(params.FloorId.Value)
+	}
+	if params.EntityId.IsSet() {
+		filter.EntityId = pointer(params.EntityId.Value)
+	}
+	if params.UserId.IsSet() {
+		filter.UserId = pointer(params.UserId.Value)
+	}
+	if params.From.IsSet() {
+		filter.TimeFrom = pointer(time.Unix(int64(params.From.Value), 0).UTC())
+	}
+	if params.To.IsSet() {
+		filter.TimeTo = pointer(time.Unix(int64(params.To.Value), 0).UTC())
+	}
+	if params.Status.IsSet() {
+		filter.Status = pointer(models.BookingStatus(params.Status.Value))
+	}
+
+	if filter.TimeFrom != nil && filter.TimeTo != nil && !filter.TimeFrom.Before(*filter.TimeTo) {
+		return dto.BookingListFilter{}, false
+	}
+
+	return filter, true
+}
+
 func pointer[T any](v T) *T {
 	return &v
 }
+
+func convertOptUUID(v *uuid.UUID) api.OptUUID {
+	if v == nil {
+		return api.OptUUID{}
+	}
+
+	return api.NewOptUUID(*v)
+}
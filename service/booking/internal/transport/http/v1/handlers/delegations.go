@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"REDACTED/team-11/backend/booking/internal/models"
+	"REDACTED/team-11/backend/booking/internal/transport/http/v1/security"
+	"REDACTED/team-11/backend/booking/pkg/logger"
+	api "REDACTED/team-11/backend/booking/pkg/ogen"
+	"go.uber.org/zap"
+)
+
+type DelegationsUsecase interface {
+	Create(ctx context.Context, token models.Token, delegateId uuid.UUID) (models.Delegation, error)
+	Delete(ctx context.Context, token models.Token, delegateId uuid.UUID) error
+	List(ctx context.Context, token models.Token) (granted []models.Delegation, received []models.Delegation, err error)
+}
+
+type DelegationsHandler struct {
+	usecase DelegationsUsecase
+}
+
+func NewDelegationsHandler(usecase DelegationsUsecase) *DelegationsHandler {
+	return &DelegationsHandler{
+		usecase: usecase,
+	}
+}
+
+// CreateDelegation implements createDelegation operation.
+//
+// Разрешает указанному пользователю создавать и изменять бронирования
+// от имени текущего пользователя.
+//
+// POST /delegations
+func (dh *DelegationsHandler) CreateDelegation(ctx context.Context, req *api.DelegationCreate) (api.CreateDelegationRes, error) {
+	token := security.TokenFromCtx(ctx)
+
+	delegation, err := dh.usecase.Create(ctx, token, req.GetDelegateID())
+	if err != nil {
+		if errors.Is(err, models.ErrSelfDelegation) {
+			return &api.Response400{
+				Message: api.NewOptString("delegate_id must differ from current user"),
+			}, nil
+		}
+		if errors.Is(err, models.ErrUserNotFound) {
+			return &api.Response404{
+				Resource: api.NewOptResponse404Resource(api.Response404ResourceUser),
+			}, nil
+		}
+		if errors.Is(err, models.ErrDelegationAlreadyExists) {
+			return &api.CreateDelegationConflict{}, nil
+		}
+
+		logger.FromCtx(ctx).Error("create delegation", zap.Error(err))
+		return nil, err
+	}
+
+	res := convertDelegation(delegation)
+	return &res, nil
+}
+
+// DeleteDelegation implements deleteDelegation operation.
+//
+// Отзывает право бронировать от имени текущего пользователя.
+//
+// DELETE /delegations/{delegateId}
+func (dh *DelegationsHandler) DeleteDelegation(ctx context.Context, params api.DeleteDelegationParams) (api.DeleteDelegationRes, error) {
+	token := security.TokenFromCtx(ctx)
+
+	if err := dh.usecase.Delete(ctx, token, params.DelegateId); err != nil {
+		if errors.Is(err, models.ErrDelegationNotFound) {
+			return &api.Response404{
+				Resource: api.NewOptResponse404Resource(api.Response404ResourceDelegation),
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("delete delegation", zap.Error(err))
+		return nil, err
+	}
+
+	return &api.DeleteDelegationNoContent{}, nil
+}
+
+// ListDelegations implements listDelegations operation.
+//
+// Возвращает выданные и полученные текущим пользователем права.
+//
+// GET /delegations
+func (dh *DelegationsHandler) ListDelegations(ctx context.Context) (api.ListDelegationsRes, error) {
+	token := security.TokenFromCtx(ctx)
+
+	granted, received, err := dh.usecase.List(ctx, token)
+	if err != nil {
+		logger.FromCtx(ctx).Error("list delegations", zap.Error(err))
+		return nil, err
+	}
+
+	res := api.DelegationList{
+		Granted:  make([]api.Delegation, 0, len(granted)),
+		Received: make([]api.Delegation, 0, len(received)),
+	}
+	for _, delegation := range granted {
+		res.Granted = append(res.Granted, convertDelegation(delegation))
+	}
+	for _, delegation := range received {
+		res.Received = append(res.Received, convertDelegation(delegation))
+	}
+
+	return &res, nil
+}
+
+func convertDelegation(delegation models.Delegation) api.Delegation {
+	return api.Delegation{
+		ID:         delegation.Id,
+		UserID:     delegation.UserId,
+		DelegateID: delegation.DelegateId,
+		CreatedAt:  api.Time(delegation.CreatedAt.Unix()),
+	}
+}
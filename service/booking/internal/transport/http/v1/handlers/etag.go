@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"strconv"
+	"strings"
+
+	api "REDACTED/team-11/backend/booking/pkg/ogen"
+)
+
+var modifiedMessage = "booking was modified, get it again and retry"
+
+// etag formats version of resource as strong entity tag.
+func etag(version int) api.OptString {
+	return api.NewOptString(strconv.Quote(strconv.Itoa(version)))
+}
+
+// ifMatch converts If-Match to version, which is passed down to repo.
+// Missing header and "*" give nil, a foreign tag gives version 0, so
+// update fails with 412 instead of overwriting booking.
+func ifMatch(header api.OptString) *int {
+	tag := strings.TrimSpace(header.Or("*"))
+	if tag == "*" {
+		return nil
+	}
+
+	tag = strings.TrimPrefix(tag, "W/")
+	version, err := strconv.Atoi(strings.Trim(tag, `"`))
+	if err != nil || version < 1 {
+		return pointer(0)
+	}
+
+	return &version
+}
@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	api "REDACTED/team-11/backend/booking/pkg/ogen"
+)
+
+func TestIfMatch(t *testing.T) {
+	assert.Nil(t, ifMatch(api.OptString{}))
+	assert.Nil(t, ifMatch(api.NewOptString("*")))
+
+	assert.Equal(t, pointer(3), ifMatch(api.NewOptString(`"3"`)))
+	assert.Equal(t, pointer(3), ifMatch(api.NewOptString(`W/"3"`)))
+	assert.Equal(t, pointer(0), ifMatch(api.NewOptString(`"abc"`)))
+	assert.Equal(t, pointer(0), ifMatch(api.NewOptString(`"-1"`)))
+
+	assert.Equal(t, `"7"`, etag(7).Value)
+	assert.Equal(t, pointer(7), ifMatch(etag(7)))
+}
@@ -14,7 +14,7 @@ import (
 )
 
 type OrdersUsecase interface {
-	Create(ctx context.Context, input dto.OrderCreateDto, userId uuid.UUID) (models.Order, error)
+	Create(ctx context.Context, input dto.OrderCreateDto, token models.Token) (models.Order, error)
 	GetForBooking(ctx context.Context, bookingId uuid.UUID, token models.Token) ([]models.Order, error)
 	Delete(ctx context.Context, bookingId, orderId uuid.UUID, token models.Token) error
 }
@@ -42,7 +42,7 @@ func (oh *OrdersHandler) CreateOrder(ctx context.Context, req *api.OrderCreate,
 	created, err := oh.usecase.Create(ctx, dto.OrderCreateDto{
 		BookingId: params.BookingId,
 		Thing:     string(req.GetThing()),
-	}, token.UserId)
+	}, token)
 	if err != nil {
 		if errors.Is(err, models.ErrBookingNotFound) {
 			return &api.Response404{
@@ -0,0 +1,288 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"REDACTED/team-11/backend/booking/internal/dto"
+	"REDACTED/team-11/backend/booking/internal/models"
+	"REDACTED/team-11/backend/booking/internal/transport/http/v1/security"
+	"REDACTED/team-11/backend/booking/pkg/logger"
+	api "REDACTED/team-11/backend/booking/pkg/ogen"
+	"go.uber.org/zap"
+)
+
+type QuotasUsecase interface {
+	GetStatus(ctx context.Context, token models.Token, buildingId *uuid.UUID) (models.QuotaStatus, error)
+	ListOverrides(ctx context.Context, token models.Token) ([]models.QuotaOverride, error)
+	SetForUser(ctx context.Context, token models.Token, userId uuid.UUID, input dto.QuotaOverrideDto) (models.QuotaOverride, error)
+	SetForTeam(ctx context.Context, token models.Token, teamId uuid.UUID, input dto.QuotaOverrideDto) (models.QuotaOverride, error)
+	SetForRole(ctx context.Context, token models.Token, role models.Role, input dto.QuotaOverrideDto) (models.QuotaOverride, error)
+	DeleteForUser(ctx context.Context, token models.Token, userId uuid.UUID) error
+	DeleteForTeam(ctx context.Context, token models.Token, teamId uuid.UUID) error
+	DeleteForRole(ctx context.Context, token models.Token, role models.Role) error
+}
+
+type QuotasHandler struct {
+	usecase QuotasUsecase
+}
+
+func NewQuotasHandler(usecase QuotasUsecase) *QuotasHandler {
+	return &QuotasHandler{
+		usecase: usecase,
+	}
+}
+
+// GetMyQuota implements getMyQuota operation.
+//
+// Возвращает действующие лимиты текущего пользователя, их использование и остаток.
+//
+// GET /quotas/my
+func (qh *QuotasHandler) GetMyQuota(ctx context.Context, params api.GetMyQuotaParams) (api.GetMyQuotaRes, error) {
+	token := security.TokenFromCtx(ctx)
+
+	var buildingId *uuid.UUID
+	if id, ok := params.BuildingId.Get(); ok {
+		buildingId = &id
+	}
+
+	status, err := qh.usecase.GetStatus(ctx, token, buildingId)
+	if err != nil {
+		if errors.Is(err, models.ErrBuildingNotFound) {
+			return &api.Response404{
+				Resource: api.NewOptResponse404Resource(api.Response404ResourceBuilding),
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("get my quota", zap.Error(err))
+		return nil, err
+	}
+
+	res := convertQuotaStatus(status)
+	return &res, nil
+}
+
+// ListQuotaOverrides implements listQuotaOverrides operation.
+//
+// GET /quotas/overrides
+func (qh *QuotasHandler) ListQuotaOverrides(ctx context.Context) (api.ListQuotaOverridesRes, error) {
+	token := security.TokenFromCtx(ctx)
+
+	overrides, err := qh.usecase.ListOverrides(ctx, token)
+	if err != nil {
+		if errors.Is(err, models.ErrNoRights) {
+			return &api.ListQuotaOverridesForbidden{}, nil
+		}
+
+		logger.FromCtx(ctx).Error("list quota overrides", zap.Error(err))
+		return nil, err
+	}
+
+	res := api.ListQuotaOverridesOKApplicationJSON(make([]api.QuotaOverride, 0, len(overrides)))
+	for _, override := range overrides {
+		res = append(res, convertQuotaOverride(override))
+	}
+
+	return &res, nil
+}
+
+// SetUserQuotaOverride implements setUserQuotaOverride operation.
+//
+// PUT /quotas/overrides/users/{userId}
+func (qh *QuotasHandler) SetUserQuotaOverride(ctx context.Context, req *api.QuotaOverrideUpdate, params api.SetUserQuotaOverrideParams) (api.SetUserQuotaOverrideRes, error) {
+	token := security.TokenFromCtx(ctx)
+
+	override, err := qh.usecase.SetForUser(ctx, token, params.UserId, convertQuotaOverrideUpdate(req))
+	if err != nil {
+		if errors.Is(err, models.ErrNoRights) {
+			return &api.SetUserQuotaOverrideForbidden{}, nil
+		}
+
+		logger.FromCtx(ctx).Error("set user quota override", zap.Error(err))
+		return nil, err
+	}
+
+	res := convertQuotaOverride(override)
+	return &res, nil
+}
+
+// DeleteUserQuotaOverride implements deleteUserQuotaOverride operation.
+//
+// DELETE /quotas/overrides/users/{userId}
+func (qh *QuotasHandler) DeleteUserQuotaOverride(ctx context.Context, params api.DeleteUserQuotaOverrideParams) (api.DeleteUserQuotaOverrideRes, error) {
+	token := security.TokenFromCtx(ctx)
+
+	if err := qh.usecase.DeleteForUser(ctx, token, params.UserId); err != nil {
+		if errors.Is(err, models.ErrNoRights) {
+			return &api.DeleteUserQuotaOverrideForbidden{}, nil
+		}
+		if errors.Is(err, models.ErrQuotaOverrideNotFound) {
+			return &api.Response404{
+				Resource: api.NewOptResponse404Resource(api.Response404ResourceQuotaOverride),
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("delete user quota override", zap.Error(err))
+		return nil, err
+	}
+
+	return &api.DeleteUserQuotaOverrideNoContent{}, nil
+}
+
+// SetTeamQuotaOverride implements setTeamQuotaOverride operation.
+//
+// PUT /quotas/overrides/teams/{teamId}
+func (qh *QuotasHandler) SetTeamQuotaOverride(ctx context.Context, req *api.QuotaOverrideUpdate, params api.SetTeamQuotaOverrideParams) (api.SetTeamQuotaOverrideRes, error) {
+	token := security.TokenFromCtx(ctx)
+
+	override, err := qh.usecase.SetForTeam(ctx, token, params.TeamId, convertQuotaOverrideUpdate(req))
+	if err != nil {
+		if errors.Is(err, models.ErrNoRights) {
+			return &api.SetTeamQuotaOverrideForbidden{}, nil
+		}
+
+		logger.FromCtx(ctx).Error("set team quota override", zap.Error(err))
+		return nil, err
+	}
+
+	res := convertQuotaOverride(override)
+	return &res, nil
+}
+
+// DeleteTeamQuotaOverride implements deleteTeamQuotaOverride operation.
+//
+// DELETE /quotas/overrides/teams/{teamId}
+func (qh *QuotasHandler) DeleteTeamQuotaOverride(ctx context.Context, params api.DeleteTeamQuotaOverrideParams) (api.DeleteTeamQuotaOverrideRes, error) {
+	token := security.TokenFromCtx(ctx)
+
+	if err := qh.usecase.DeleteForTeam(ctx, token, params.TeamId); err != nil {
+		if errors.Is(err, models.ErrNoRights) {
+			return &api.DeleteTeamQuotaOverrideForbidden{}, nil
+		}
+		if errors.Is(err, models.ErrQuotaOverrideNotFound) {
+			return &api.Response404{
+				Resource: api.NewOptResponse404Resource(api.Response404ResourceQuotaOverride),
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("delete team quota override", zap.Error(err))
+		return nil, err
+	}
+
+	return &api.DeleteTeamQuotaOverrideNoContent{}, nil
+}
+
+// SetRoleQuotaOverride implements setRoleQuotaOverride operation.
+//
+// PUT /quotas/overrides/roles/{role}
+func (qh *QuotasHandler) SetRoleQuotaOverride(ctx context.Context, req *api.QuotaOverrideUpdate, params api.SetRoleQuotaOverrideParams) (api.SetRoleQuotaOverrideRes, error) {
+	token := security.TokenFromCtx(ctx)
+
+	override, err := qh.usecase.SetForRole(ctx, token, models.Role(params.Role), convertQuotaOverrideUpdate(req))
+	if err != nil {
+		if errors.Is(err, models.ErrNoRights) {
+			return &api.SetRoleQuotaOverrideForbidden{}, nil
+		}
+
+		logger.FromCtx(ctx).Error("set role quota override", zap.Error(err))
+		return nil, err
+	}
+
+	res := convertQuotaOverride(override)
+	return &res, nil
+}
+
+// DeleteRoleQuotaOverride implements deleteRoleQuotaOverride operation.
+//
+// DELETE /quotas/overrides/roles/{role}
+func (qh *QuotasHandler) DeleteRoleQuotaOverride(ctx context.Context, params api.DeleteRoleQuotaOverrideParams) (api.DeleteRoleQuotaOverrideRes, error) {
+	token := security.TokenFromCtx(ctx)
+
+	if err := qh.usecase.DeleteForRole(ctx, token, models.Role(params.Role)); err != nil {
+		if errors.Is(err, models.ErrNoRights) {
+			return &api.DeleteRoleQuotaOverrideForbidden{}, nil
+		}
+		if errors.Is(err, models.ErrQuotaOverrideNotFound) {
+			return &api.Response404{
+				Resource: api.NewOptResponse404Resource(api.Response404ResourceQuotaOverride),
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("delete role quota override", zap.Error(err))
+		return nil, err
+	}
+
+	return &api.DeleteRoleQuotaOverrideNoContent{}, nil
+}
+
+func convertQuotaOverrideUpdate(req *api.QuotaOverrideUpdate) dto.QuotaOverrideDto {
+	var input dto.QuotaOverrideDto
+
+	if req.GetHoursPerWeek().IsSet() {
+		input.HoursPerWeek = pointer(req.GetHoursPerWeek().Value)
+	}
+	if req.GetActiveBookings().IsSet() {
+		input.ActiveBookings = pointer(req.GetActiveBookings().Value)
+	}
+	if req.GetPremiumHoursPerWeek().IsSet() {
+		input.PremiumHoursPerWeek = pointer(req.GetPremiumHoursPerWeek().Value)
+	}
+
+	return input
+}
+
+func convertQuotaOverride(override models.QuotaOverride) api.QuotaOverride {
+	res := api.QuotaOverride{
+		ID:        override.Id,
+		UserID:    convertOptUUID(override.UserId),
+		TeamID:    convertOptUUID(override.TeamId),
+		CreatedAt: api.Time(override.CreatedAt.Unix()),
+		UpdatedAt: api.Time(override.UpdatedAt.Unix()),
+	}
+
+	if override.Role != nil {
+		res.Role = api.NewOptRole(api.Role(*override.Role))
+	}
+	if override.HoursPerWeek != nil {
+		res.HoursPerWeek = api.NewOptInt(*override.HoursPerWeek)
+	}
+	if override.ActiveBookings != nil {
+		res.ActiveBookings = api.NewOptInt(*override.ActiveBookings)
+	}
+	if override.PremiumHoursPerWeek != nil {
+		res.PremiumHoursPerWeek = api.NewOptInt(*override.PremiumHoursPerWeek)
+	}
+
+	return res
+}
+
+func convertQuotaStatus(status models.QuotaStatus) api.QuotaStatus {
+	res := api.QuotaStatus{
+		Limits: api.QuotaLimits{
+			HoursPerWeek:        status.Limits.HoursPerWeek,
+			ActiveBookings:      status.Limits.ActiveBookings,
+			PremiumHoursPerWeek: status.Limits.PremiumHoursPerWeek,
+		},
+		Used: api.QuotaUsage{
+			HoursPerWeek:        status.Used.Week.Hours(),
+			ActiveBookings:      status.Used.ActiveBookings,
+			PremiumHoursPerWeek: status.Used.PremiumWeek.Hours(),
+		},
+	}
+
+	if status.Limits.HoursPerWeek > 0 {
+		left := time.Duration(status.Limits.HoursPerWeek)*time.Hour - status.Used.Week
+		res.Remaining.HoursPerWeek = api.NewOptFloat64(max(left, 0).Hours())
+	}
+	if status.Limits.ActiveBookings > 0 {
+		res.Remaining.ActiveBookings = api.NewOptInt(max(status.Limits.ActiveBookings-status.Used.ActiveBookings, 0))
+	}
+	if status.Limits.PremiumHoursPerWeek > 0 {
+		left := time.Duration(status.Limits.PremiumHoursPerWeek)*time.Hour - status.Used.PremiumWeek
+		res.Remaining.PremiumHoursPerWeek = api.NewOptFloat64(max(left, 0).Hours())
+	}
+
+	return res
+}
@@ -0,0 +1,206 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"REDACTED/team-11/backend/booking/internal/dto"
+	"REDACTED/team-11/backend/booking/internal/models"
+	"REDACTED/team-11/backend/booking/internal/transport/http/v1/security"
+	"REDACTED/team-11/backend/booking/pkg/logger"
+	api "REDACTED/team-11/backend/booking/pkg/ogen"
+	"go.uber.org/zap"
+)
+
+type TransfersUsecase interface {
+	Create(ctx context.Context, input dto.BookingTransferCreateDto) (models.BookingTransfer, error)
+	Accept(ctx context.Context, token models.Token, transferId uuid.UUID) ([]models.Booking, error)
+	Delete(ctx context.Context, token models.Token, transferId uuid.UUID) error
+	List(ctx context.Context, token models.Token) (outgoing []models.BookingTransfer, incoming []models.BookingTransfer, err error)
+}
+
+type TransfersHandler struct {
+	usecase TransfersUsecase
+}
+
+func NewTransfersHandler(usecase TransfersUsecase) *TransfersHandler {
+	return &TransfersHandler{
+		usecase: usecase,
+	}
+}
+
+// CreateBookingTransfer implements createBookingTransfer operation.
+//
+// Предлагает передать бронирование текущего пользователя
+// сотруднику с указанной почтой.
+//
+// POST /bookings/{bookingId}/transfers
+func (th *TransfersHandler) CreateBookingTransfer(ctx context.Context, req *api.BookingTransferCreate, params api.CreateBookingTransferParams) (api.CreateBookingTransferRes, error) {
+	token := security.TokenFromCtx(ctx)
+
+	input := dto.BookingTransferCreateDto{
+		BookingId: params.BookingId,
+		Email:     req.GetEmail(),
+		Requester: token,
+	}
+	if swapBookingId, ok := req.GetSwapBookingID().Get(); ok {
+		input.SwapBookingId = &swapBookingId
+	}
+
+	transfer, err := th.usecase.Create(ctx, input)
+	if err != nil {
+		if errors.Is(err, models.ErrBookingNotFound) || errors.Is(err, models.ErrNoAccessToBooking) {
+			return &api.Response404{
+				Resource: api.NewOptResponse404Resource(api.Response404ResourceBooking),
+			}, nil
+		}
+		if errors.Is(err, models.ErrSelfTransfer) {
+			return &api.Response400{
+				Message: api.NewOptString("recipient must differ from current user"),
+			}, nil
+		}
+		if errors.Is(err, models.ErrSwapMismatch) {
+			return &api.Response400{
+				Message: api.NewOptString("swap booking must be a booking of recipient for the same time"),
+			}, nil
+		}
+		if res, ok := transferBookingError(err); ok {
+			return res, nil
+		}
+		if errors.Is(err, models.ErrTransferAlreadyExists) {
+			return &api.CreateBookingTransferConflict{}, nil
+		}
+
+		logger.FromCtx(ctx).Error("create booking transfer", zap.Error(err))
+		return nil, err
+	}
+
+	res := convertBookingTransfer(transfer)
+	return &res, nil
+}
+
+// AcceptBookingTransfer implements acceptBookingTransfer operation.
+//
+// Получатель принимает предложение, бронирование переходит к нему
+// или бронирования обмениваются местами.
+//
+// POST /transfers/{transferId}/accept
+func (th *TransfersHandler) AcceptBookingTransfer(ctx context.Context, params api.AcceptBookingTransferParams) (api.AcceptBookingTransferRes, error) {
+	token := security.TokenFromCtx(ctx)
+
+	bookings, err := th.usecase.Accept(ctx, token, params.TransferId)
+	if err != nil {
+		if errors.Is(err, models.ErrTransferNotFound) {
+			return &api.Response404{
+				Resource: api.NewOptResponse404Resource(api.Response404ResourceTransfer),
+			}, nil
+		}
+		if errors.Is(err, models.ErrSwapMismatch) {
+			return &api.Response400{
+				Message: api.NewOptString("bookings no longer have the same time"),
+			}, nil
+		}
+		if res, ok := transferBookingError(err); ok {
+			return res, nil
+		}
+		if errors.Is(err, models.ErrEntityRestricted) || errors.Is(err, models.ErrQuotaExceeded) {
+			return &api.AcceptBookingTransferForbidden{}, nil
+		}
+		if errors.Is(err, models.ErrAlreadyHaveBooking) || errors.Is(err, models.ErrBookingModified) {
+			return &api.AcceptBookingTransferConflict{}, nil
+		}
+
+		logger.FromCtx(ctx).Error("accept booking transfer", zap.Error(err))
+		return nil, err
+	}
+
+	res := make(api.AcceptBookingTransferOKApplicationJSON, 0, len(bookings))
+	for _, booking := range bookings {
+		res = append(res, convertBooking(booking))
+	}
+
+	return &res, nil
+}
+
+// DeleteBookingTransfer implements deleteBookingTransfer operation.
+//
+// Отправитель отзывает предложение, получатель отклоняет его.
+//
+// DELETE /transfers/{transferId}
+func (th *TransfersHandler) DeleteBookingTransfer(ctx context.Context, params api.DeleteBookingTransferParams) (api.DeleteBookingTransferRes, error) {
+	token := security.TokenFromCtx(ctx)
+
+	if err := th.usecase.Delete(ctx, token, params.TransferId); err != nil {
+		if errors.Is(err, models.ErrTransferNotFound) {
+			return &api.Response404{
+				Resource: api.NewOptResponse404Resource(api.Response404ResourceTransfer),
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("delete booking transfer", zap.Error(err))
+		return nil, err
+	}
+
+	return &api.DeleteBookingTransferNoContent{}, nil
+}
+
+// ListBookingTransfers implements listBookingTransfers operation.
+//
+// Возвращает предложения передачи, отправленные текущим
+// пользователем и адресованные ему.
+//
+// GET /transfers
+func (th *TransfersHandler) ListBookingTransfers(ctx context.Context) (api.ListBookingTransfersRes, error) {
+	token := security.TokenFromCtx(ctx)
+
+	outgoing, incoming, err := th.usecase.List(ctx, token)
+	if err != nil {
+		logger.FromCtx(ctx).Error("list booking transfers", zap.Error(err))
+		return nil, err
+	}
+
+	res := api.BookingTransferList{
+		Outgoing: make([]api.BookingTransfer, 0, len(outgoing)),
+		Incoming: make([]api.BookingTransfer, 0, len(incoming)),
+	}
+	for _, transfer := range outgoing {
+		res.Outgoing = append(res.Outgoing, convertBookingTransfer(transfer))
+	}
+	for _, transfer := range incoming {
+		res.Incoming = append(res.Incoming, convertBookingTransfer(transfer))
+	}
+
+	return &res, nil
+}
+
+// transferBookingError converts errors of booking, which can't be handed
+// over, shared by create and accept.
+func transferBookingError(err error) (interface {
+	api.CreateBookingTransferRes
+	api.AcceptBookingTransferRes
+}, bool) {
+	if errors.Is(err, models.ErrBookingFinished) {
+		return &api.Response400{
+			Message: api.NewOptString("booking is finished"),
+		}, true
+	}
+	if errors.Is(err, models.ErrBookingInGroup) {
+		return &api.Response400{
+			Message: api.NewOptString("booking is a part of group"),
+		}, true
+	}
+
+	return nil, false
+}
+
+func convertBookingTransfer(transfer models.BookingTransfer) api.BookingTransfer {
+	return api.BookingTransfer{
+		ID:            transfer.Id,
+		BookingID:     transfer.BookingId,
+		FromUserID:    transfer.FromUserId,
+		Email:         transfer.ToEmail,
+		SwapBookingID: convertOptUUID(transfer.SwapBookingId),
+		CreatedAt:     api.Time(transfer.CreatedAt.Unix()),
+	}
+}
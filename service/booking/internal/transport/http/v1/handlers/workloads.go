@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"REDACTED/team-11/backend/booking/internal/dto"
 	"REDACTED/team-11/backend/booking/internal/models"
 	"REDACTED/team-11/backend/booking/internal/transport/http/v1/security"
 	"REDACTED/team-11/backend/booking/pkg/logger"
@@ -14,7 +15,8 @@ import (
 )
 
 type WorkloadsUsecase interface {
-	GetForFloor(ctx context.Context, floorId uuid.UUID, timeFrom, timeTo time.Time, userId uuid.UUID) (models.FloorWorkload, error)
+	GetForFloor(ctx context.Context, floorId uuid.UUID, timeFrom, timeTo time.Time, token models.Token, filter dto.BookingEntityFilter) (models.FloorWorkload, error)
+	GetForBuilding(ctx context.Context, buildingId uuid.UUID, timeFrom, timeTo time.Time, token models.Token, filter dto.BookingEntityFilter) (models.FloorWorkload, error)
 	Get(ctx context.Context, entityId uuid.UUID, timeFrom, timeTo time.Time) (models.Workload, error)
 }
 
@@ -41,18 +43,6 @@ func (wh *WorkloadsHandler) GetWorkload(ctx context.Context, params api.GetWorkl
 		}, nil
 	}
 
-	if int64(params.TimeFrom)%(bookingIntervalMinutes*60) != 0 {
-		return &api.Response400{
-			Message: api.NewOptString("time_from must be multiple of 15 minutes"),
-		}, nil
-	}
-
-	if int64(params.TimeTo)%(bookingIntervalMinutes*60) != 0 {
-		return &api.Response400{
-			Message: api.NewOptString("time_to must be multiple of 15 minutes"),
-		}, nil
-	}
-
 	timeFrom := time.Unix(int64(params.TimeFrom), 0).UTC()
 	timeTo := time.Unix(int64(params.TimeTo), 0).UTC()
 
@@ -61,6 +51,11 @@ func (wh *WorkloadsHandler) GetWorkload(ctx context.Context, params api.GetWorkl
 		if errors.Is(err, models.ErrBookingEntityNotFound) {
 			return &api.Response404{}, nil
 		}
+		if errors.Is(err, models.ErrInvalidBookingSlot) {
+			return &api.Response400{
+				Message: api.NewOptString(invalidSlotMessage),
+			}, nil
+		}
 
 		logger.FromCtx(ctx).Error("get workload", zap.Error(err))
 		return nil, err
@@ -92,22 +87,12 @@ func (wh *WorkloadsHandler) GetFloorWorkload(ctx context.Context, params api.Get
 		}, nil
 	}
 
-	if int64(params.TimeFrom)%(bookingIntervalMinutes*60) != 0 {
-		return &api.Response400{
-			Message: api.NewOptString("time_from must be multiple of 15 minutes"),
-		}, nil
-	}
-
-	if int64(params.TimeTo)%(bookingIntervalMinutes*60) != 0 {
-		return &api.Response400{
-			Message: api.NewOptString("time_to must be multiple of 15 minutes"),
-		}, nil
-	}
-
 	timeFrom := time.Unix(int64(params.TimeFrom), 0).UTC()
 	timeTo := time.Unix(int64(params.TimeTo), 0).UTC()
 
-	floorWorkload, err := wh.usecase.GetForFloor(ctx, params.FloorId, timeFrom, timeTo, token.UserId)
+	floorWorkload, err := wh.usecase.GetForFloor(ctx, params.FloorId, timeFrom, timeTo, token, dto.BookingEntityFilter{
+		Amenities: params.Amenity,
+	})
 
 	if err != nil {
 		if errors.Is(err, models.ErrFloorNotFound) {
@@ -115,6 +100,11 @@ func (wh *WorkloadsHandler) GetFloorWorkload(ctx context.Context, params api.Get
 				Resource: api.NewOptResponse404Resource(api.Response404ResourceFloor),
 			}, nil
 		}
+		if errors.Is(err, models.ErrInvalidBookingSlot) {
+			return &api.Response400{
+				Message: api.NewOptString(invalidSlotMessage),
+			}, nil
+		}
 		logger.FromCtx(ctx).Error("get floor workload", zap.Error(err))
 		return nil, err
 	}
@@ -122,8 +112,57 @@ func (wh *WorkloadsHandler) GetFloorWorkload(ctx context.Context, params api.Get
 	res := make(api.FloorWorkload, 0, len(floorWorkload))
 	for _, entityWorkload := range floorWorkload {
 		res = append(res, api.FloorWorkloadItem{
-			Entity: convertBookingEntity(entityWorkload.Entity),
-			IsFree: entityWorkload.IsFree,
+			Entity:       convertBookingEntity(entityWorkload.Entity),
+			IsFree:       entityWorkload.IsFree,
+			IsRestricted: entityWorkload.IsRestricted,
+		})
+	}
+
+	return &res, nil
+}
+
+// GetBuildingWorkload implements getBuildingWorkload operation.
+//
+// Возвращает информацию о нагрузке на все рабочие места здания
+// за указанный период времени.
+//
+// GET /workloads/buildings/{buildingId}
+func (wh *WorkloadsHandler) GetBuildingWorkload(ctx context.Context, params api.GetBuildingWorkloadParams) (api.GetBuildingWorkloadRes, error) {
+	token := security.TokenFromCtx(ctx)
+
+	if params.TimeFrom >= params.TimeTo {
+		return &api.Response400{
+			Message: api.NewOptString("time_from must be before time_to"),
+		}, nil
+	}
+
+	timeFrom := time.Unix(int64(params.TimeFrom), 0).UTC()
+	timeTo := time.Unix(int64(params.TimeTo), 0).UTC()
+
+	buildingWorkload, err := wh.usecase.GetForBuilding(ctx, params.BuildingId, timeFrom, timeTo, token, dto.BookingEntityFilter{
+		Amenities: params.Amenity,
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrBuildingNotFound) {
+			return &api.Response404{
+				Resource: api.NewOptResponse404Resource(api.Response404ResourceBuilding),
+			}, nil
+		}
+		if errors.Is(err, models.ErrInvalidBookingSlot) {
+			return &api.Response400{
+				Message: api.NewOptString(invalidSlotMessage),
+			}, nil
+		}
+		logger.FromCtx(ctx).Error("get building workload", zap.Error(err))
+		return nil, err
+	}
+
+	res := make(api.FloorWorkload, 0, len(buildingWorkload))
+	for _, entityWorkload := range buildingWorkload {
+		res = append(res, api.FloorWorkloadItem{
+			Entity:       convertBookingEntity(entityWorkload.Entity),
+			IsFree:       entityWorkload.IsFree,
+			IsRestricted: entityWorkload.IsRestricted,
 		})
 	}
 
@@ -0,0 +1,39 @@
+package http
+
+import (
+	"context"
+
+	"REDACTED/team-11/backend/booking/internal/transport/http/v1/security"
+	"REDACTED/team-11/backend/booking/pkg/idempotency"
+	api "REDACTED/team-11/backend/booking/pkg/ogen"
+)
+
+// Idempotency returns middleware which deduplicates retries of mutating
+// operations per user. Successful responses of these operations have to
+// be listed here to be replayed.
+func Idempotency(store idempotency.Store, cfg idempotency.Config) api.Middleware {
+	scope := func(ctx context.Context) string {
+		return security.TokenFromCtx(ctx).UserId.String()
+	}
+
+	return idempotency.New(store, cfg, scope,
+		(*api.Booking)(nil),
+		(*api.BookingHeaders)(nil),
+		(*api.DeleteBookingNoContent)(nil),
+		(*api.Order)(nil),
+		(*api.DeleteOrdersNoContent)(nil),
+		(*api.Delegation)(nil),
+		(*api.DeleteDelegationNoContent)(nil),
+		(*api.BookingGroup)(nil),
+		(*api.DeleteBookingGroupNoContent)(nil),
+		(*api.RemoveBookingGroupMemberNoContent)(nil),
+		(*api.Allocation)(nil),
+		(*api.QuotaOverride)(nil),
+		(*api.DeleteUserQuotaOverrideNoContent)(nil),
+		(*api.DeleteRoleQuotaOverrideNoContent)(nil),
+		(*api.DeleteTeamQuotaOverrideNoContent)(nil),
+		(*api.BookingTransfer)(nil),
+		(*api.AcceptBookingTransferOKApplicationJSON)(nil),
+		(*api.DeleteBookingTransferNoContent)(nil),
+	)
+}
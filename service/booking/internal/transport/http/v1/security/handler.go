@@ -5,25 +5,35 @@ import (
 
 	"github.com/golang-jwt/jwt/v5"
 	"REDACTED/team-11/backend/booking/internal/models"
+	"REDACTED/team-11/backend/booking/pkg/jwks"
 	api "REDACTED/team-11/backend/booking/pkg/ogen"
 )
 
 type tokenCtxKey struct{}
 
 type SecurityHandler struct {
-	secret string
+	keys     *jwks.Client
+	issuer   string
+	audience string
 }
 
-func NewSecurityHandler(secret string) *SecurityHandler {
+func NewSecurityHandler(keys *jwks.Client, issuer, audience string) *SecurityHandler {
 	return &SecurityHandler{
-		secret: secret,
+		keys:     keys,
+		issuer:   issuer,
+		audience: audience,
 	}
 }
 
 func (sh *SecurityHandler) HandleBearerAuth(ctx context.Context, operationName api.OperationName, t api.BearerAuth) (context.Context, error) {
-	jwtToken, err := jwt.Parse(t.GetToken(), func(t *jwt.Token) (interface{}, error) {
-		return []byte(sh.secret), nil
-	})
+	jwtToken, err := jwt.Parse(
+		t.GetToken(),
+		sh.keys.Keyfunc(ctx),
+		jwt.WithValidMethods(jwks.Methods),
+		jwt.WithIssuer(sh.issuer),
+		jwt.WithAudience(sh.audience),
+		jwt.WithExpirationRequired(),
+	)
 	if err != nil {
 		return ctx, err
 	}
@@ -3,11 +3,15 @@ package http
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/ogen-go/ogen/ogenerrors"
+	"REDACTED/team-11/backend/booking/pkg/health"
+	"REDACTED/team-11/backend/booking/pkg/idempotency"
 	"REDACTED/team-11/backend/booking/pkg/logger"
+	"REDACTED/team-11/backend/booking/pkg/metrics"
 	"REDACTED/team-11/backend/booking/pkg/middlewares"
 	api "REDACTED/team-11/backend/booking/pkg/ogen"
 	"go.uber.org/zap"
@@ -20,18 +24,36 @@ type Server struct {
 func NewServer(
 	ogenHandler api.Handler,
 	securityHandler api.SecurityHandler,
+	checker *health.Checker,
+	idempotencyMiddleware api.Middleware,
 	l *zap.Logger,
 ) (*Server, error) {
-	ogenServer, err := api.NewServer(ogenHandler, securityHandler, api.WithErrorHandler(errorHandler))
+	ogenServer, err := api.NewServer(
+		ogenHandler,
+		securityHandler,
+		api.WithErrorHandler(errorHandler),
+		api.WithMiddleware(idempotencyMiddleware),
+	)
 	if err != nil {
 		return nil, err
 	}
 
+	operation := func(r *http.Request) string {
+		route, ok := ogenServer.FindPath(r.Method, r.URL)
+		if !ok {
+			return "unknown"
+		}
+
+		return route.OperationID()
+	}
+
 	handler := middlewares.Apply(
 		ogenServer,
+		middlewares.Tracing(operation),
 		middlewares.Recover(),
 		middlewares.LoggerProvider(l),
 		middlewares.Logging(),
+		middlewares.Metrics(operation),
 	)
 
 	mux := http.NewServeMux()
@@ -39,6 +61,9 @@ func NewServer(
 		w.Write([]byte("POOOOOOOOOOOOOOOOOOOOOOOOOOOOOOOOOOOOOOOOOOD"))
 	})
 	mux.Handle("/api/v1/", http.StripPrefix("/api/v1", handler))
+	mux.Handle("/metrics", metrics.Handler())
+	mux.HandleFunc("GET /healthz", checker.Live)
+	mux.HandleFunc("GET /readyz", checker.Ready)
 
 	return &Server{
 		server: &http.Server{
@@ -58,12 +83,12 @@ func (s *Server) Shutdown(ctx context.Context) error {
 }
 
 func errorHandler(ctx context.Context, w http.ResponseWriter, r *http.Request, err error) {
-	code := ogenerrors.ErrorCode(err)
+	code := errorCode(err)
 	if err != nil {
 		logger.FromCtx(ctx).Debug("handling error", zap.Error(err))
 	}
 	switch code {
-	case http.StatusBadRequest:
+	case http.StatusBadRequest, http.StatusConflict, http.StatusUnprocessableEntity:
 		w.Header().Add("Content-type", "application/json")
 		w.WriteHeader(code)
 		json.NewEncoder(w).Encode(map[string]interface{}{
@@ -73,3 +98,16 @@ func errorHandler(ctx context.Context, w http.ResponseWriter, r *http.Request, e
 	}
 	w.WriteHeader(code)
 }
+
+func errorCode(err error) int {
+	switch {
+	case errors.Is(err, idempotency.ErrInvalidKey):
+		return http.StatusBadRequest
+	case errors.Is(err, idempotency.ErrInProgress):
+		return http.StatusConflict
+	case errors.Is(err, idempotency.ErrKeyReused):
+		return http.StatusUnprocessableEntity
+	default:
+		return ogenerrors.ErrorCode(err)
+	}
+}
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS booking_relocation;
+
+DROP TYPE IF EXISTS relocation_status;
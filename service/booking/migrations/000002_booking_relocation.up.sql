@@ -0,0 +1,26 @@
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'relocation_status') THEN
+        CREATE TYPE relocation_status AS ENUM (
+            'RELOCATED',
+            'CANCELLED'
+        );
+    END IF;
+END $$;
+
+CREATE TABLE IF NOT EXISTS booking_relocation (
+    id UUID PRIMARY KEY DEFAULT (gen_random_uuid()),
+    report_id UUID NOT NULL,
+    booking_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    from_entity_id UUID NOT NULL,
+    to_entity_id UUID,
+    time_from TIMESTAMP NOT NULL,
+    time_to TIMESTAMP NOT NULL,
+    status relocation_status NOT NULL,
+    reason VARCHAR(255) NOT NULL DEFAULT (''),
+    created_at TIMESTAMP NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX IF NOT EXISTS booking_relocation_report_id_idx ON booking_relocation (report_id);
+CREATE INDEX IF NOT EXISTS booking_relocation_user_id_idx ON booking_relocation (user_id);
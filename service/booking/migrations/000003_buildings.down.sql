@@ -0,0 +1,3 @@
+ALTER TABLE entity_floor DROP COLUMN IF EXISTS building_id;
+
+DROP TABLE IF EXISTS building;
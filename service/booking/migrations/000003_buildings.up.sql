@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS building (
+    id UUID PRIMARY KEY DEFAULT (gen_random_uuid()),
+    name VARCHAR(255) NOT NULL,
+    address VARCHAR(255) NOT NULL DEFAULT (''),
+    timezone VARCHAR(64) NOT NULL DEFAULT ('UTC'),
+    opens_at VARCHAR(5),
+    closes_at VARCHAR(5),
+    created_at TIMESTAMP NOT NULL DEFAULT (now()),
+    updated_at TIMESTAMP NOT NULL DEFAULT (now()),
+    CHECK (opens_at IS NULL OR opens_at ~ '^([01][0-9]|2[0-3]):[0-5][0-9]$'),
+    CHECK (closes_at IS NULL OR closes_at ~ '^([01][0-9]|2[0-3]):[0-5][0-9]$|^24:00$')
+);
+
+CREATE TRIGGER update_building_updated_at
+BEFORE UPDATE ON building
+FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+
+ALTER TABLE entity_floor
+    ADD COLUMN IF NOT EXISTS building_id UUID REFERENCES building (id) ON DELETE RESTRICT;
+
+CREATE INDEX IF NOT EXISTS entity_floor_building_id_idx ON entity_floor (building_id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS booking_entity_amenity;
+
+DROP TABLE IF EXISTS amenity;
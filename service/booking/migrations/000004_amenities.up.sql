@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS amenity (
+    id UUID PRIMARY KEY DEFAULT (gen_random_uuid()),
+    name VARCHAR(255) NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT (now()),
+    updated_at TIMESTAMP NOT NULL DEFAULT (now())
+);
+
+CREATE TRIGGER update_amenity_updated_at
+BEFORE UPDATE ON amenity
+FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS booking_entity_amenity (
+    entity_id UUID NOT NULL,
+    amenity_id UUID NOT NULL,
+    PRIMARY KEY (entity_id, amenity_id),
+    FOREIGN KEY (entity_id) REFERENCES booking_entity (id) ON DELETE CASCADE,
+    FOREIGN KEY (amenity_id) REFERENCES amenity (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS booking_entity_amenity_amenity_id_idx ON booking_entity_amenity (amenity_id);
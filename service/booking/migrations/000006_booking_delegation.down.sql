@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS booking_delegation;
+
+ALTER TABLE booking DROP COLUMN IF EXISTS booked_by;
@@ -0,0 +1,16 @@
+ALTER TABLE booking ADD COLUMN IF NOT EXISTS booked_by UUID;
+
+UPDATE booking SET booked_by = user_id WHERE booked_by IS NULL;
+
+ALTER TABLE booking ALTER COLUMN booked_by SET NOT NULL;
+
+CREATE TABLE IF NOT EXISTS booking_delegation (
+    id UUID PRIMARY KEY DEFAULT (gen_random_uuid()),
+    user_id UUID NOT NULL,
+    delegate_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT (now()),
+    UNIQUE (user_id, delegate_id),
+    CHECK (user_id <> delegate_id)
+);
+
+CREATE INDEX IF NOT EXISTS booking_delegation_delegate_id_idx ON booking_delegation (delegate_id);
@@ -0,0 +1,3 @@
+ALTER TABLE booking DROP COLUMN IF EXISTS group_id;
+
+DROP TABLE IF EXISTS booking_group;
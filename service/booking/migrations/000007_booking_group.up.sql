@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS booking_group (
+    id UUID PRIMARY KEY DEFAULT (gen_random_uuid()),
+    owner_id UUID NOT NULL,
+    time_from TIMESTAMP NOT NULL,
+    time_to TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT (now()),
+    updated_at TIMESTAMP NOT NULL DEFAULT (now())
+);
+
+CREATE TRIGGER update_booking_group_updated_at
+BEFORE UPDATE ON booking_group
+FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+
+ALTER TABLE booking ADD COLUMN IF NOT EXISTS group_id UUID REFERENCES booking_group (id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS booking_group_id_idx ON booking (group_id);
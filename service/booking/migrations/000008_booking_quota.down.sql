@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS booking_quota;
+
+ALTER TABLE booking_entity DROP COLUMN IF EXISTS is_premium;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS orders_booking_id_idx;
+DROP INDEX IF EXISTS booking_entity_floor_id_idx;
+DROP INDEX IF EXISTS booking_entity_id_time_from_idx;
+DROP INDEX IF EXISTS booking_user_id_created_at_idx;
+DROP INDEX IF EXISTS booking_time_from_id_idx;
+DROP INDEX IF EXISTS booking_created_at_id_idx;
@@ -0,0 +1,6 @@
+CREATE INDEX IF NOT EXISTS booking_created_at_id_idx ON booking (created_at, id);
+CREATE INDEX IF NOT EXISTS booking_time_from_id_idx ON booking (time_from, id);
+CREATE INDEX IF NOT EXISTS booking_user_id_created_at_idx ON booking (user_id, created_at);
+CREATE INDEX IF NOT EXISTS booking_entity_id_time_from_idx ON booking (entity_id, time_from);
+CREATE INDEX IF NOT EXISTS booking_entity_floor_id_idx ON booking_entity (floor_id);
+CREATE INDEX IF NOT EXISTS orders_booking_id_idx ON orders (booking_id);
@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS increment_booking_version ON booking;
+DROP FUNCTION IF EXISTS increment_version();
+
+ALTER TABLE booking DROP COLUMN IF EXISTS version;
+ALTER TABLE booking_entity DROP COLUMN IF EXISTS version;
+ALTER TABLE entity_floor DROP COLUMN IF EXISTS version;
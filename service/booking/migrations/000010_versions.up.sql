@@ -0,0 +1,16 @@
+ALTER TABLE entity_floor ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT (1);
+ALTER TABLE booking_entity ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT (1);
+ALTER TABLE booking ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT (1);
+
+CREATE OR REPLACE FUNCTION increment_version()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.version = OLD.version + 1;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER increment_booking_version
+BEFORE UPDATE ON booking
+FOR EACH ROW
+EXECUTE FUNCTION increment_version();
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS booking_transfer (
+    id UUID PRIMARY KEY DEFAULT (gen_random_uuid()),
+    booking_id UUID NOT NULL UNIQUE,
+    from_user_id UUID NOT NULL,
+    to_user_id UUID NOT NULL,
+    swap_booking_id UUID,
+    created_at TIMESTAMP NOT NULL DEFAULT (now()),
+    FOREIGN KEY (booking_id) REFERENCES booking (id) ON DELETE CASCADE,
+    FOREIGN KEY (swap_booking_id) REFERENCES booking (id) ON DELETE CASCADE,
+    CHECK (from_user_id <> to_user_id)
+);
+
+CREATE INDEX IF NOT EXISTS booking_transfer_from_user_id_idx ON booking_transfer (from_user_id);
+CREATE INDEX IF NOT EXISTS booking_transfer_to_user_id_idx ON booking_transfer (to_user_id);
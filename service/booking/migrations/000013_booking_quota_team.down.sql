@@ -0,0 +1,6 @@
+DELETE FROM booking_quota WHERE team_id IS NOT NULL;
+
+ALTER TABLE booking_quota DROP CONSTRAINT IF EXISTS booking_quota_check;
+ALTER TABLE booking_quota ADD CONSTRAINT booking_quota_check CHECK ((user_id IS NULL) <> (role IS NULL));
+
+ALTER TABLE booking_quota DROP COLUMN IF EXISTS team_id;
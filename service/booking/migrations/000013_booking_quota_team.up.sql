@@ -0,0 +1,4 @@
+ALTER TABLE booking_quota ADD COLUMN IF NOT EXISTS team_id UUID UNIQUE;
+
+ALTER TABLE booking_quota DROP CONSTRAINT IF EXISTS booking_quota_check;
+ALTER TABLE booking_quota ADD CONSTRAINT booking_quota_check CHECK (num_nonnulls(user_id, role, team_id) = 1);
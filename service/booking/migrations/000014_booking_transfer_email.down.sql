@@ -0,0 +1,4 @@
+DELETE FROM booking_transfer WHERE to_user_id IS NULL;
+
+ALTER TABLE booking_transfer ALTER COLUMN to_user_id SET NOT NULL;
+ALTER TABLE booking_transfer DROP COLUMN IF EXISTS to_email;
@@ -0,0 +1,3 @@
+ALTER TABLE booking_transfer ADD COLUMN IF NOT EXISTS to_email TEXT NOT NULL DEFAULT '';
+ALTER TABLE booking_transfer ALTER COLUMN to_email DROP DEFAULT;
+ALTER TABLE booking_transfer ALTER COLUMN to_user_id DROP NOT NULL;
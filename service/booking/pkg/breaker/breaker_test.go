@@ -0,0 +1,35 @@
+package breaker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBreaker(t *testing.T) {
+	b := New(2, time.Millisecond*10)
+
+	assert.NoError(t, b.Allow())
+	b.Failure()
+	assert.NoError(t, b.Allow())
+	b.Failure()
+
+	assert.ErrorIs(t, b.Allow(), ErrOpen)
+
+	time.Sleep(time.Millisecond * 15)
+
+	// The only trial call is let through after cooldown.
+	assert.NoError(t, b.Allow())
+	assert.ErrorIs(t, b.Allow(), ErrOpen)
+
+	b.Failure()
+	assert.ErrorIs(t, b.Allow(), ErrOpen)
+
+	time.Sleep(time.Millisecond * 15)
+
+	assert.NoError(t, b.Allow())
+	b.Success()
+	assert.NoError(t, b.Allow())
+	assert.NoError(t, b.Allow())
+}
@@ -0,0 +1,8 @@
+package credentials
+
+type Config struct {
+	TokenUrl     string `env:"SERVICE_TOKEN_URL" env-default:"http://localhost:8090/api/v1/oauth/token"`
+	ClientId     string `env:"SERVICE_CLIENT_ID" env-default:"booking"`
+	ClientSecret string `env:"SERVICE_CLIENT_SECRET"`
+	Scope        string `env:"SERVICE_SCOPE" env-default:"users.read"`
+}
@@ -0,0 +1,111 @@
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+var (
+	fetchTimeout = time.Second * 5
+	// Token is refreshed a bit earlier than it expires, so it doesn't expire on the way.
+	expiryLeeway = time.Second * 30
+)
+
+// Client gets service token of booking from coffee-id with client credentials grant
+// and caches it until it is about to expire.
+type Client struct {
+	cfg        Config
+	httpClient *http.Client
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+func New(cfg Config) *Client {
+	return &Client{
+		cfg:        cfg,
+		httpClient: &http.Client{Timeout: fetchTimeout, Transport: otelhttp.NewTransport(http.DefaultTransport)},
+	}
+}
+
+// Token returns cached token or fetches a new one.
+func (c *Client) Token(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != "" && time.Now().Before(c.expires) {
+		return c.token, nil
+	}
+
+	token, expiresIn, err := c.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	c.token = token
+	c.expires = time.Now().Add(expiresIn - expiryLeeway)
+
+	return c.token, nil
+}
+
+// Authorize sets service token to Authorization header of request.
+func (c *Client) Authorize(req *http.Request) error {
+	token, err := c.Token(req.Context())
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	return nil
+}
+
+func (c *Client) fetch(ctx context.Context) (string, time.Duration, error) {
+	op := "credentials.Client.fetch"
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("scope", c.cfg.Scope)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.cfg.TokenUrl, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", 0, fmt.Errorf("%s: new request: %w", op, err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(c.cfg.ClientId), url.QueryEscape(c.cfg.ClientSecret))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", 0, fmt.Errorf("%s: make request: %w", op, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", 0, fmt.Errorf("%s: unexpected code %d", op, resp.StatusCode)
+	}
+
+	var body struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", 0, fmt.Errorf("%s: json.Decode: %w", op, err)
+	}
+
+	if body.AccessToken == "" {
+		return "", 0, fmt.Errorf("%s: empty access token", op)
+	}
+
+	return body.AccessToken, time.Duration(body.ExpiresIn) * time.Second, nil
+}
@@ -0,0 +1,48 @@
+package credentials
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientToken(t *testing.T) {
+	var fetches atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+
+		id, secret, ok := r.BasicAuth()
+		if !ok || id != "booking" || secret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		assert.Equal(t, "client_credentials", r.FormValue("grant_type"))
+		assert.Equal(t, "users.read", r.FormValue("scope"))
+
+		w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":3600}`))
+	}))
+	defer server.Close()
+
+	client := New(Config{TokenUrl: server.URL, ClientId: "booking", ClientSecret: "secret", Scope: "users.read"})
+
+	token, err := client.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token", token)
+
+	// Token is cached until it expires.
+	token, err = client.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token", token)
+	assert.Equal(t, int32(1), fetches.Load())
+
+	client = New(Config{TokenUrl: server.URL, ClientId: "booking", ClientSecret: "wrong"})
+
+	_, err = client.Token(context.Background())
+	assert.Error(t, err)
+}
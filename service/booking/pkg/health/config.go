@@ -0,0 +1,9 @@
+package health
+
+import "time"
+
+type Config struct {
+	Timeout     time.Duration `env:"HEALTH_TIMEOUT" env-default:"2s"`
+	DrainDelay  time.Duration `env:"HEALTH_DRAIN_DELAY" env-default:"5s"`
+	CoffeeIdUrl string        `env:"HEALTH_COFFEE_ID_URL" env-default:"http://localhost:8090/healthz"`
+}
@@ -0,0 +1,102 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ready(t *testing.T, c *Checker) (int, Response) {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	c.Ready(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	var res Response
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&res))
+
+	return w.Code, res
+}
+
+func TestChecker(t *testing.T) {
+	ok := func(ctx context.Context) error { return nil }
+	failing := func(ctx context.Context) error { return errors.New("connection refused") }
+	slow := func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	t.Run("ready", func(t *testing.T) {
+		c := New(time.Second)
+		c.Add("postgres", ok)
+
+		code, res := ready(t, c)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, StatusOk, res.Status)
+		assert.Equal(t, StatusOk, res.Checks["postgres"].Status)
+		assert.Equal(t, Version, res.Build.Version)
+	})
+
+	t.Run("critical check fails", func(t *testing.T) {
+		c := New(time.Second)
+		c.Add("postgres", failing)
+		c.AddOptional("coffee-id", ok)
+
+		code, res := ready(t, c)
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, StatusFail, res.Status)
+		assert.Equal(t, "connection refused", res.Checks["postgres"].Error)
+	})
+
+	t.Run("optional check fails", func(t *testing.T) {
+		c := New(time.Second)
+		c.Add("postgres", ok)
+		c.AddOptional("coffee-id", failing)
+
+		code, res := ready(t, c)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, StatusDegraded, res.Status)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		c := New(time.Millisecond * 10)
+		c.Add("postgres", slow)
+
+		code, res := ready(t, c)
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, context.DeadlineExceeded.Error(), res.Checks["postgres"].Error)
+	})
+
+	t.Run("draining", func(t *testing.T) {
+		c := New(time.Second)
+		c.Add("postgres", ok)
+		c.Drain()
+
+		code, res := ready(t, c)
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, StatusDraining, res.Status)
+
+		w := httptest.NewRecorder()
+		c.Live(w, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestHTTP(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/healthz" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	assert.NoError(t, HTTP(srv.Client(), srv.URL+"/healthz")(context.Background()))
+	assert.Error(t, HTTP(srv.Client(), srv.URL+"/missing")(context.Background()))
+}
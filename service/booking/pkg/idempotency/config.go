@@ -0,0 +1,8 @@
+package idempotency
+
+import "time"
+
+type Config struct {
+	TTL     time.Duration `env:"IDEMPOTENCY_TTL" env-default:"24h"`
+	LockTTL time.Duration `env:"IDEMPOTENCY_LOCK_TTL" env-default:"1m"`
+}
@@ -0,0 +1,169 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+
+	"REDACTED/team-11/backend/booking/pkg/logger"
+	"github.com/ogen-go/ogen/middleware"
+	"go.uber.org/zap"
+)
+
+const (
+	Header       = "Idempotency-Key"
+	maxKeyLength = 255
+	keyPrefix    = "idempotency:"
+)
+
+var (
+	ErrInvalidKey = errors.New("invalid idempotency key")
+	ErrKeyReused  = errors.New("idempotency key was used for another request")
+	ErrInProgress = errors.New("request with this idempotency key is in progress")
+)
+
+// ScopeFunc returns namespace of idempotency keys for request, so clients
+// can't replay responses of each other.
+type ScopeFunc func(ctx context.Context) string
+
+// New returns ogen middleware which makes mutating operations with
+// Idempotency-Key header safe to retry. The first response is stored along
+// with request fingerprint and replayed for retries within TTL; retries with
+// another operation, params or body are rejected with ErrKeyReused.
+//
+// Only responses of given types are stored, these are expected to be
+// successful ones. Other responses release the key, so a request rejected
+// e.g. because of conflict can be retried with the same key.
+// If store is unavailable requests are handled without deduplication.
+func New(store Store, cfg Config, scope ScopeFunc, responses ...any) middleware.Middleware {
+	types := make(map[string]reflect.Type, len(responses))
+	for _, response := range responses {
+		t := reflect.TypeOf(response)
+		types[t.String()] = t
+	}
+
+	return func(req middleware.Request, next middleware.Next) (middleware.Response, error) {
+		key := req.Raw.Header.Get(Header)
+		if key == "" || !mutating(req.Raw.Method) {
+			return next(req)
+		}
+
+		if len(key) > maxKeyLength {
+			return middleware.Response{}, ErrInvalidKey
+		}
+
+		ctx := req.Context
+		l := logger.FromCtx(ctx).With(zap.String("idempotency_key", key))
+
+		fingerprint, err := fingerprint(req)
+		if err != nil {
+			return middleware.Response{}, err
+		}
+
+		key = keyPrefix + scope(ctx) + ":" + key
+
+		existing, err := store.Reserve(ctx, key, Record{Fingerprint: fingerprint}, cfg.LockTTL)
+		if errors.Is(err, ErrInProgress) {
+			return middleware.Response{}, err
+		}
+
+		if err != nil {
+			l.Error("reserve idempotency key", zap.Error(err))
+			return next(req)
+		}
+
+		if existing != nil {
+			return replay(*existing, fingerprint, types)
+		}
+
+		resp, err := next(req)
+
+		t := reflect.TypeOf(resp.Type)
+		if err != nil || t == nil || types[t.String()] != t {
+			if err := store.Release(context.WithoutCancel(ctx), key); err != nil {
+				l.Error("release idempotency key", zap.Error(err))
+			}
+
+			return resp, err
+		}
+
+		body, err := json.Marshal(resp.Type)
+		if err != nil {
+			l.Error("encode idempotent response", zap.Error(err))
+			return resp, nil
+		}
+
+		rec := Record{Fingerprint: fingerprint, Type: t.String(), Body: body}
+		if err := store.Save(context.WithoutCancel(ctx), key, rec, cfg.TTL); err != nil {
+			l.Error("save idempotent response", zap.Error(err))
+		}
+
+		return resp, nil
+	}
+}
+
+func replay(rec Record, fingerprint string, types map[string]reflect.Type) (middleware.Response, error) {
+	if rec.Fingerprint != fingerprint {
+		return middleware.Response{}, ErrKeyReused
+	}
+
+	if rec.InProgress() {
+		return middleware.Response{}, ErrInProgress
+	}
+
+	t, ok := types[rec.Type]
+	if !ok {
+		return middleware.Response{}, fmt.Errorf("unknown idempotent response type %s", rec.Type)
+	}
+
+	v := reflect.New(t.Elem())
+	if err := json.Unmarshal(rec.Body, v.Interface()); err != nil {
+		return middleware.Response{}, fmt.Errorf("decode idempotent response: %w", err)
+	}
+
+	return middleware.Response{Type: v.Interface()}, nil
+}
+
+// fingerprint identifies operation with its params and body.
+func fingerprint(req middleware.Request) (string, error) {
+	h := sha256.New()
+	h.Write([]byte(req.OperationName))
+
+	params := make([]string, 0, len(req.Params))
+	for k, v := range req.Params {
+		params = append(params, fmt.Sprintf("%s.%s=%v", k.In, k.Name, v))
+	}
+	sort.Strings(params)
+
+	for _, p := range params {
+		h.Write([]byte{0})
+		h.Write([]byte(p))
+	}
+
+	if req.Body != nil {
+		body, err := json.Marshal(req.Body)
+		if err != nil {
+			return "", err
+		}
+
+		h.Write([]byte{0})
+		h.Write(body)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func mutating(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
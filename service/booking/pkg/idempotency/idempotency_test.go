@@ -0,0 +1,153 @@
+package idempotency
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ogen-go/ogen/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryStore struct {
+	mu      sync.Mutex
+	records map[string]Record
+}
+
+func (s *memoryStore) Reserve(ctx context.Context, key string, rec Record, ttl time.Duration) (*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if existing, ok := s.records[key]; ok {
+		return &existing, nil
+	}
+
+	s.records[key] = rec
+
+	return nil, nil
+}
+
+func (s *memoryStore) Save(ctx context.Context, key string, rec Record, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.records[key] = rec
+
+	return nil
+}
+
+func (s *memoryStore) Release(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.records, key)
+
+	return nil
+}
+
+type created struct {
+	Id    int    `json:"id"`
+	Title string `json:"title"`
+}
+
+type conflict struct{}
+
+type body struct {
+	Title string `json:"title"`
+}
+
+func TestMiddleware(t *testing.T) {
+	store := &memoryStore{records: map[string]Record{}}
+	scope := func(ctx context.Context) string { return "user" }
+	m := New(store, Config{TTL: time.Hour, LockTTL: time.Minute}, scope, (*created)(nil))
+
+	calls := 0
+	handler := func(req middleware.Request) (middleware.Response, error) {
+		calls++
+		b := req.Body.(*body)
+		if b.Title == "taken" {
+			return middleware.Response{Type: &conflict{}}, nil
+		}
+
+		return middleware.Response{Type: &created{Id: calls, Title: b.Title}}, nil
+	}
+
+	request := func(method, key string, b *body) middleware.Request {
+		raw := httptest.NewRequest(method, "/bookings", nil)
+		if key != "" {
+			raw.Header.Set(Header, key)
+		}
+
+		return middleware.Request{
+			Context:       context.Background(),
+			OperationName: "CreateBooking",
+			Body:          b,
+			Raw:           raw,
+		}
+	}
+
+	t.Run("replay", func(t *testing.T) {
+		first, err := m(request(http.MethodPost, "k1", &body{Title: "a"}), handler)
+		require.NoError(t, err)
+
+		second, err := m(request(http.MethodPost, "k1", &body{Title: "a"}), handler)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, first.Type, second.Type)
+	})
+
+	t.Run("another body", func(t *testing.T) {
+		_, err := m(request(http.MethodPost, "k1", &body{Title: "b"}), handler)
+		assert.ErrorIs(t, err, ErrKeyReused)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("without key", func(t *testing.T) {
+		calls = 0
+		for range 2 {
+			_, err := m(request(http.MethodPost, "", &body{Title: "a"}), handler)
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("not stored response releases key", func(t *testing.T) {
+		calls = 0
+		for range 2 {
+			resp, err := m(request(http.MethodPost, "k2", &body{Title: "taken"}), handler)
+			require.NoError(t, err)
+			assert.IsType(t, &conflict{}, resp.Type)
+		}
+
+		assert.Equal(t, 2, calls)
+		assert.NotContains(t, store.records, "idempotency:user:k2")
+	})
+
+	t.Run("in progress", func(t *testing.T) {
+		store.records["idempotency:user:k3"] = Record{Fingerprint: mustFingerprint(t, request(http.MethodPost, "k3", &body{Title: "a"}))}
+
+		_, err := m(request(http.MethodPost, "k3", &body{Title: "a"}), handler)
+		assert.ErrorIs(t, err, ErrInProgress)
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		_, err := m(request(http.MethodPost, strings.Repeat("a", maxKeyLength+1), &body{Title: "a"}), handler)
+		assert.ErrorIs(t, err, ErrInvalidKey)
+	})
+}
+
+func mustFingerprint(t *testing.T, req middleware.Request) string {
+	t.Helper()
+
+	fp, err := fingerprint(req)
+	require.NoError(t, err)
+
+	return fp
+}
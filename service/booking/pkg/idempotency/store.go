@@ -0,0 +1,88 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Record is stored under idempotency key. Type and Body are empty while
+// the first request is in progress.
+type Record struct {
+	Fingerprint string          `json:"fingerprint"`
+	Type        string          `json:"type,omitempty"`
+	Body        json.RawMessage `json:"body,omitempty"`
+}
+
+func (r Record) InProgress() bool {
+	return r.Type == ""
+}
+
+type Store interface {
+	// Reserve saves rec unless key already exists. Existing record is returned
+	// otherwise.
+	Reserve(ctx context.Context, key string, rec Record, ttl time.Duration) (*Record, error)
+	Save(ctx context.Context, key string, rec Record, ttl time.Duration) error
+	Release(ctx context.Context, key string) error
+}
+
+type RedisStore struct {
+	client *redis.Client
+}
+
+func NewRedisStore(client *redis.Client) *RedisStore {
+	return &RedisStore{client: client}
+}
+
+func (s *RedisStore) Reserve(ctx context.Context, key string, rec Record, ttl time.Duration) (*Record, error) {
+	raw, err := json.Marshal(rec)
+	if err != nil {
+		return nil, err
+	}
+
+	// Existing key may expire between SETNX and GET, then reservation is retried.
+	for range 2 {
+		ok, err := s.client.SetNX(ctx, key, raw, ttl).Result()
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			return nil, nil
+		}
+
+		stored, err := s.client.Get(ctx, key).Bytes()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		var existing Record
+		if err := json.Unmarshal(stored, &existing); err != nil {
+			return nil, err
+		}
+
+		return &existing, nil
+	}
+
+	return nil, ErrInProgress
+}
+
+func (s *RedisStore) Save(ctx context.Context, key string, rec Record, ttl time.Duration) error {
+	raw, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	return s.client.Set(ctx, key, raw, ttl).Err()
+}
+
+func (s *RedisStore) Release(ctx context.Context, key string) error {
+	return s.client.Del(ctx, key).Err()
+}
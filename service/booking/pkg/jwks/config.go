@@ -0,0 +1,8 @@
+package jwks
+
+import "time"
+
+type Config struct {
+	Url      string        `env:"JWKS_URL" env-default:"http://localhost:8090/.well-known/jwks.json"`
+	CacheTTL time.Duration `env:"JWKS_CACHE_TTL" env-default:"10m"`
+}
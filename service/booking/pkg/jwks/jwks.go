@@ -0,0 +1,230 @@
+package jwks
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+var (
+	ErrUnknownKid      = errors.New("unknown kid")
+	ErrUnexpectedAlg   = errors.New("unexpected signing algorithm")
+	minRefetchInterval = time.Minute
+	fetchTimeout       = time.Second * 5
+)
+
+// Methods are signing algorithms issued by coffee-id.
+var Methods = []string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}
+
+type Key struct {
+	Alg    string
+	Public crypto.PublicKey
+}
+
+// Client fetches JSON Web Key Set of coffee-id and caches keys by kid.
+type Client struct {
+	url        string
+	ttl        time.Duration
+	httpClient *http.Client
+
+	fetchMu     sync.Mutex
+	mu          sync.RWMutex
+	keys        map[string]Key
+	fetchedAt   time.Time
+	attemptedAt time.Time
+}
+
+func New(cfg Config) *Client {
+	return &Client{
+		url:        cfg.Url,
+		ttl:        cfg.CacheTTL,
+		httpClient: &http.Client{Timeout: fetchTimeout, Transport: otelhttp.NewTransport(http.DefaultTransport)},
+		keys:       map[string]Key{},
+	}
+}
+
+// Key returns key by kid. Keys are refetched when cache expires or kid is unknown,
+// but not more often than once a minute, so tokens with random kid can't flood coffee-id.
+// Stale keys are used while coffee-id is unavailable.
+func (c *Client) Key(ctx context.Context, kid string) (Key, error) {
+	c.mu.RLock()
+	key, ok := c.keys[kid]
+	fresh := time.Since(c.fetchedAt) < c.ttl
+	c.mu.RUnlock()
+
+	if ok && fresh {
+		return key, nil
+	}
+
+	if err := c.refetch(ctx); err != nil && !ok {
+		return Key{}, err
+	}
+
+	c.mu.RLock()
+	key, ok = c.keys[kid]
+	c.mu.RUnlock()
+
+	if !ok {
+		return Key{}, ErrUnknownKid
+	}
+
+	return key, nil
+}
+
+// Keyfunc resolves key by kid header and rejects tokens signed with algorithm other than key's.
+func (c *Client) Keyfunc(ctx context.Context) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+
+		key, err := c.Key(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+
+		if token.Method.Alg() != key.Alg {
+			return nil, ErrUnexpectedAlg
+		}
+
+		return key.Public, nil
+	}
+}
+
+func (c *Client) refetch(ctx context.Context) error {
+	c.fetchMu.Lock()
+	defer c.fetchMu.Unlock()
+
+	c.mu.RLock()
+	attemptedAt := c.attemptedAt
+	c.mu.RUnlock()
+
+	// Someone has just fetched keys while we were waiting for lock.
+	if time.Since(attemptedAt) < minRefetchInterval {
+		return nil
+	}
+
+	keys, err := c.fetch(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.attemptedAt = time.Now()
+
+	if err != nil {
+		return err
+	}
+
+	c.keys = keys
+	c.fetchedAt = c.attemptedAt
+
+	return nil
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+}
+
+func (c *Client) fetch(ctx context.Context) (map[string]Key, error) {
+	op := "jwks.Client.fetch"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: new request: %w", op, err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s: make request: %w", op, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: unexpected code %d", op, resp.StatusCode)
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, fmt.Errorf("%s: json.Decode: %w", op, err)
+	}
+
+	keys := make(map[string]Key, len(set.Keys))
+
+	for _, raw := range set.Keys {
+		if raw.Use != "" && raw.Use != "sig" {
+			continue
+		}
+
+		key, err := parseKey(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%s: key %q: %w", op, raw.Kid, err)
+		}
+
+		keys[raw.Kid] = key
+	}
+
+	return keys, nil
+}
+
+func parseKey(raw jwk) (Key, error) {
+	switch raw.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(raw.N)
+		if err != nil {
+			return Key{}, fmt.Errorf("decode n: %w", err)
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(raw.E)
+		if err != nil {
+			return Key{}, fmt.Errorf("decode e: %w", err)
+		}
+
+		return Key{
+			Alg: jwt.SigningMethodRS256.Alg(),
+			Public: &rsa.PublicKey{
+				N: new(big.Int).SetBytes(n),
+				E: int(new(big.Int).SetBytes(e).Int64()),
+			},
+		}, nil
+	case "OKP":
+		if raw.Crv != "Ed25519" {
+			return Key{}, fmt.Errorf("unsupported curve %s", raw.Crv)
+		}
+
+		x, err := base64.RawURLEncoding.DecodeString(raw.X)
+		if err != nil {
+			return Key{}, fmt.Errorf("decode x: %w", err)
+		}
+
+		if len(x) != ed25519.PublicKeySize {
+			return Key{}, errors.New("invalid ed25519 key size")
+		}
+
+		return Key{
+			Alg:    jwt.SigningMethodEdDSA.Alg(),
+			Public: ed25519.PublicKey(x),
+		}, nil
+	default:
+		return Key{}, fmt.Errorf("unsupported key type %s", raw.Kty)
+	}
+}
@@ -0,0 +1,75 @@
+package jwks
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientKeyfunc(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	var fetches atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{{
+				"kty": "OKP",
+				"crv": "Ed25519",
+				"kid": "key-1",
+				"use": "sig",
+				"alg": "EdDSA",
+				"x":   base64.RawURLEncoding.EncodeToString(public),
+			}},
+		})
+	}))
+	defer server.Close()
+
+	client := New(Config{Url: server.URL, CacheTTL: time.Hour})
+	keyfunc := client.Keyfunc(context.Background())
+
+	sign := func(kid string) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{"id": "user"})
+		token.Header["kid"] = kid
+
+		signed, err := token.SignedString(private)
+		require.NoError(t, err)
+
+		return signed
+	}
+
+	_, err = jwt.Parse(sign("key-1"), keyfunc, jwt.WithValidMethods(Methods))
+	assert.NoError(t, err)
+
+	_, err = jwt.Parse(sign("key-1"), keyfunc, jwt.WithValidMethods(Methods))
+	assert.NoError(t, err)
+
+	// Unknown kid triggers refetch, which is throttled.
+	_, err = jwt.Parse(sign("key-2"), keyfunc, jwt.WithValidMethods(Methods))
+	assert.ErrorIs(t, err, ErrUnknownKid)
+
+	_, err = jwt.Parse(sign("key-3"), keyfunc, jwt.WithValidMethods(Methods))
+	assert.ErrorIs(t, err, ErrUnknownKid)
+
+	assert.Equal(t, int32(1), fetches.Load())
+
+	hs := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"id": "user"})
+	hs.Header["kid"] = "key-1"
+	signed, err := hs.SignedString([]byte("root"))
+	require.NoError(t, err)
+
+	_, err = jwt.Parse(signed, keyfunc, jwt.WithValidMethods(Methods))
+	assert.Error(t, err)
+}
@@ -0,0 +1,40 @@
+package lru
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCache(t *testing.T) {
+	cache := New[string, int](2, time.Hour)
+
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+
+	// "a" becomes recently used, so "b" is evicted.
+	_, ok := cache.Get("a")
+	assert.True(t, ok)
+
+	cache.Set("c", 3)
+
+	_, ok = cache.Get("b")
+	assert.False(t, ok)
+
+	value, ok := cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+	assert.Equal(t, 2, cache.Len())
+}
+
+func TestCacheTTL(t *testing.T) {
+	cache := New[string, int](2, time.Millisecond)
+
+	cache.Set("a", 1)
+	time.Sleep(time.Millisecond * 2)
+
+	_, ok := cache.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, 0, cache.Len())
+}
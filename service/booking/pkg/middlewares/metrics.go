@@ -0,0 +1,24 @@
+package middlewares
+
+import (
+	"net/http"
+	"time"
+
+	"REDACTED/team-11/backend/booking/pkg/metrics"
+)
+
+// OperationResolver returns name of the API operation handling the request.
+type OperationResolver func(r *http.Request) string
+
+func Metrics(operation OperationResolver) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			lrw := newLoggingResponseWriter(w)
+			defer func(start time.Time) {
+				metrics.ObserveRequest(operation(r), r.Method, lrw.statusCode, time.Since(start))
+			}(time.Now())
+
+			next.ServeHTTP(lrw, r)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+// Tracing starts a server span for every request, continuing the trace
+// propagated by the caller. Spans are named after the API operation.
+func Tracing(operation OperationResolver) Middleware {
+	return func(next http.Handler) http.Handler {
+		return otelhttp.NewHandler(next, "http.server",
+			otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+				return operation(r)
+			}),
+		)
+	}
+}
@@ -25,14 +25,20 @@ func (c *codeRecorder) WriteHeader(status int) {
 
 func recordError(string, error) {}
 
-// handleCreateBookingRequest handles createBooking operation.
+// handleAcceptBookingTransferRequest handles acceptBookingTransfer operation.
 //
-// Создает новое бронирование для указанного рабочего
-// места на заданный период времени.
-// В случае успеха возвращает созданное бронирование.
+// Получатель принимает предложение. Бронирование
+// переходит к получателю,
+// если он может бронировать это место, не превышает
+// квоту и не имеет других бронирований на это время.
+// При обмене бронирования обмениваются местами в одной
+// транзакции,
+// и каждый из участников должен иметь возможность
+// бронировать полученное место.
+// Возвращает измененные бронирования.
 //
-// POST /bookings
-func (s *Server) handleCreateBookingRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /transfers/{transferId}/accept
+func (s *Server) handleAcceptBookingTransferRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -40,15 +46,15 @@ func (s *Server) handleCreateBookingRequest(args [0]string, argsEscaped bool, w
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreateBookingOperation,
-			ID:   "createBooking",
+			Name: AcceptBookingTransferOperation,
+			ID:   "acceptBookingTransfer",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, CreateBookingOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, AcceptBookingTransferOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -88,38 +94,38 @@ func (s *Server) handleCreateBookingRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
-	request, close, err := s.decodeCreateBookingRequest(r)
+	params, err := decodeAcceptBookingTransferParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response CreateBookingRes
+	var response AcceptBookingTransferRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreateBookingOperation,
-			OperationSummary: "Создать бронирование",
-			OperationID:      "createBooking",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    AcceptBookingTransferOperation,
+			OperationSummary: "Принять предложение передачи",
+			OperationID:      "acceptBookingTransfer",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "transferId",
+					In:   "path",
+				}: params.TransferId,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *BookingCreate
-			Params   = struct{}
-			Response = CreateBookingRes
+			Request  = struct{}
+			Params   = AcceptBookingTransferParams
+			Response = AcceptBookingTransferRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -128,14 +134,14 @@ func (s *Server) handleCreateBookingRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackAcceptBookingTransferParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreateBooking(ctx, request)
+				response, err = s.h.AcceptBookingTransfer(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreateBooking(ctx, request)
+		response, err = s.h.AcceptBookingTransfer(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -143,7 +149,7 @@ func (s *Server) handleCreateBookingRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeCreateBookingResponse(response, w); err != nil {
+	if err := encodeAcceptBookingTransferResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -152,14 +158,13 @@ func (s *Server) handleCreateBookingRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleCreateBookingForAdminRequest handles createBookingForAdmin operation.
+// handleAddBookingGroupMemberRequest handles addBookingGroupMember operation.
 //
-// Создает новое бронирование для указанного рабочего
-// места на заданный период времени.
-// В случае успеха возвращает созданное бронирование.
+// Бронирует рабочее место для участника на время
+// группового бронирования.
 //
-// POST /bookings/admin/{userId}
-func (s *Server) handleCreateBookingForAdminRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /booking-groups/{groupId}/members
+func (s *Server) handleAddBookingGroupMemberRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -167,15 +172,15 @@ func (s *Server) handleCreateBookingForAdminRequest(args [1]string, argsEscaped
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreateBookingForAdminOperation,
-			ID:   "createBookingForAdmin",
+			Name: AddBookingGroupMemberOperation,
+			ID:   "addBookingGroupMember",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, CreateBookingForAdminOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, AddBookingGroupMemberOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -215,7 +220,7 @@ func (s *Server) handleCreateBookingForAdminRequest(args [1]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeCreateBookingForAdminParams(args, argsEscaped, r)
+	params, err := decodeAddBookingGroupMemberParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -225,7 +230,7 @@ func (s *Server) handleCreateBookingForAdminRequest(args [1]string, argsEscaped
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeCreateBookingForAdminRequest(r)
+	request, close, err := s.decodeAddBookingGroupMemberRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -241,27 +246,27 @@ func (s *Server) handleCreateBookingForAdminRequest(args [1]string, argsEscaped
 		}
 	}()
 
-	var response CreateBookingForAdminRes
+	var response AddBookingGroupMemberRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreateBookingForAdminOperation,
-			OperationSummary: "Создать бронирование",
-			OperationID:      "createBookingForAdmin",
+			OperationName:    AddBookingGroupMemberOperation,
+			OperationSummary: "Добавить участника в групповое бронирование",
+			OperationID:      "addBookingGroupMember",
 			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "userId",
+					Name: "groupId",
 					In:   "path",
-				}: params.UserId,
+				}: params.GroupId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *BookingCreate
-			Params   = CreateBookingForAdminParams
-			Response = CreateBookingForAdminRes
+			Request  = *BookingGroupItem
+			Params   = AddBookingGroupMemberParams
+			Response = AddBookingGroupMemberRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -270,14 +275,14 @@ func (s *Server) handleCreateBookingForAdminRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackCreateBookingForAdminParams,
+			unpackAddBookingGroupMemberParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreateBookingForAdmin(ctx, request, params)
+				response, err = s.h.AddBookingGroupMember(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreateBookingForAdmin(ctx, request, params)
+		response, err = s.h.AddBookingGroupMember(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -285,7 +290,7 @@ func (s *Server) handleCreateBookingForAdminRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeCreateBookingForAdminResponse(response, w); err != nil {
+	if err := encodeAddBookingGroupMemberResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -294,13 +299,25 @@ func (s *Server) handleCreateBookingForAdminRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleCreateOrderRequest handles createOrder operation.
+// handleAllocateSeatsRequest handles allocateSeats operation.
 //
-// Создает новый заказ для указанного бронирования.
-// В случае успеха возвращает созданный заказ.
+// Подбирает указанное количество свободных мест в
+// открытых пространствах этажа
+// на заданный период времени так, чтобы они находились
+// как можно ближе друг к другу.
+// Близость определяется по расстоянию между
+// прямоугольниками рабочих мест на плане этажа.
+// Места, закрепленные за другими командами или
+// сотрудниками, не предлагаются.
+// Если указан book, подобранные места сразу бронируются
+// одним групповым бронированием;
+// места распределяются между участниками из attendees в
+// порядке перечисления,
+// а если участники не указаны, бронируются для текущего
+// пользователя.
 //
-// POST /bookings/{bookingId}/orders
-func (s *Server) handleCreateOrderRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /allocations
+func (s *Server) handleAllocateSeatsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -308,15 +325,15 @@ func (s *Server) handleCreateOrderRequest(args [1]string, argsEscaped bool, w ht
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreateOrderOperation,
-			ID:   "createOrder",
+			Name: AllocateSeatsOperation,
+			ID:   "allocateSeats",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, CreateOrderOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, AllocateSeatsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -356,17 +373,7 @@ func (s *Server) handleCreateOrderRequest(args [1]string, argsEscaped bool, w ht
 			return
 		}
 	}
-	params, err := decodeCreateOrderParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	request, close, err := s.decodeCreateOrderRequest(r)
+	request, close, err := s.decodeAllocateSeatsRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -382,27 +389,22 @@ func (s *Server) handleCreateOrderRequest(args [1]string, argsEscaped bool, w ht
 		}
 	}()
 
-	var response CreateOrderRes
+	var response AllocateSeatsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreateOrderOperation,
-			OperationSummary: "Создать заказ",
-			OperationID:      "createOrder",
+			OperationName:    AllocateSeatsOperation,
+			OperationSummary: "Подобрать свободные места рядом друг с другом",
+			OperationID:      "allocateSeats",
 			Body:             request,
-			Params: middleware.Parameters{
-				{
-					Name: "bookingId",
-					In:   "path",
-				}: params.BookingId,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = *OrderCreate
-			Params   = CreateOrderParams
-			Response = CreateOrderRes
+			Request  = *AllocationRequest
+			Params   = struct{}
+			Response = AllocateSeatsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -411,14 +413,14 @@ func (s *Server) handleCreateOrderRequest(args [1]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			unpackCreateOrderParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreateOrder(ctx, request, params)
+				response, err = s.h.AllocateSeats(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreateOrder(ctx, request, params)
+		response, err = s.h.AllocateSeats(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -426,7 +428,7 @@ func (s *Server) handleCreateOrderRequest(args [1]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeCreateOrderResponse(response, w); err != nil {
+	if err := encodeAllocateSeatsResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -435,13 +437,22 @@ func (s *Server) handleCreateOrderRequest(args [1]string, argsEscaped bool, w ht
 	}
 }
 
-// handleDeleteBookingRequest handles deleteBooking operation.
+// handleCreateBookingRequest handles createBooking operation.
 //
-// Удаляет бронирование по его уникальному
-// идентификатору.
+// Создает новое бронирование для указанного рабочего
+// места на заданный период времени.
+// Время должно быть кратно 15 минутам в часовом поясе
+// здания и попадать в часы его работы.
+// Если указан on_behalf_of, бронирование создается для этого
+// пользователя;
+// для этого он должен выдать текущему пользователю
+// право бронировать за него,
+// либо текущий пользователь должен быть
+// администратором.
+// В случае успеха возвращает созданное бронирование.
 //
-// DELETE /bookings/{bookingId}
-func (s *Server) handleDeleteBookingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /bookings
+func (s *Server) handleCreateBookingRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -449,15 +460,15 @@ func (s *Server) handleDeleteBookingRequest(args [1]string, argsEscaped bool, w
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteBookingOperation,
-			ID:   "deleteBooking",
+			Name: CreateBookingOperation,
+			ID:   "createBooking",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DeleteBookingOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateBookingOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -497,38 +508,38 @@ func (s *Server) handleDeleteBookingRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeDeleteBookingParams(args, argsEscaped, r)
+	request, close, err := s.decodeCreateBookingRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response DeleteBookingRes
+	var response CreateBookingRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteBookingOperation,
-			OperationSummary: "Удалить бронирование по ID",
-			OperationID:      "deleteBooking",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "bookingId",
-					In:   "path",
-				}: params.BookingId,
-			},
-			Raw: r,
+			OperationName:    CreateBookingOperation,
+			OperationSummary: "Создать бронирование",
+			OperationID:      "createBooking",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DeleteBookingParams
-			Response = DeleteBookingRes
+			Request  = *BookingCreate
+			Params   = struct{}
+			Response = CreateBookingRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -537,14 +548,14 @@ func (s *Server) handleDeleteBookingRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackDeleteBookingParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteBooking(ctx, params)
+				response, err = s.h.CreateBooking(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteBooking(ctx, params)
+		response, err = s.h.CreateBooking(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -552,7 +563,7 @@ func (s *Server) handleDeleteBookingRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeDeleteBookingResponse(response, w); err != nil {
+	if err := encodeCreateBookingResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -561,12 +572,14 @@ func (s *Server) handleDeleteBookingRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleDeleteOrdersRequest handles deleteOrders operation.
+// handleCreateBookingForAdminRequest handles createBookingForAdmin operation.
 //
-// Удаляет заказ по его уникальному идентификатору.
+// Создает новое бронирование для указанного рабочего
+// места на заданный период времени.
+// В случае успеха возвращает созданное бронирование.
 //
-// DELETE /bookings/{bookingId}/orders/{orderId}
-func (s *Server) handleDeleteOrdersRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /bookings/admin/{userId}
+func (s *Server) handleCreateBookingForAdminRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -574,15 +587,15 @@ func (s *Server) handleDeleteOrdersRequest(args [2]string, argsEscaped bool, w h
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteOrdersOperation,
-			ID:   "deleteOrders",
+			Name: CreateBookingForAdminOperation,
+			ID:   "createBookingForAdmin",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DeleteOrdersOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateBookingForAdminOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -622,7 +635,7 @@ func (s *Server) handleDeleteOrdersRequest(args [2]string, argsEscaped bool, w h
 			return
 		}
 	}
-	params, err := decodeDeleteOrdersParams(args, argsEscaped, r)
+	params, err := decodeCreateBookingForAdminParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -632,32 +645,43 @@ func (s *Server) handleDeleteOrdersRequest(args [2]string, argsEscaped bool, w h
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeCreateBookingForAdminRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response DeleteOrdersRes
+	var response CreateBookingForAdminRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteOrdersOperation,
-			OperationSummary: "Удалить заказ",
-			OperationID:      "deleteOrders",
-			Body:             nil,
+			OperationName:    CreateBookingForAdminOperation,
+			OperationSummary: "Создать бронирование",
+			OperationID:      "createBookingForAdmin",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "bookingId",
-					In:   "path",
-				}: params.BookingId,
-				{
-					Name: "orderId",
+					Name: "userId",
 					In:   "path",
-				}: params.OrderId,
+				}: params.UserId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DeleteOrdersParams
-			Response = DeleteOrdersRes
+			Request  = *BookingCreate
+			Params   = CreateBookingForAdminParams
+			Response = CreateBookingForAdminRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -666,14 +690,14 @@ func (s *Server) handleDeleteOrdersRequest(args [2]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			unpackDeleteOrdersParams,
+			unpackCreateBookingForAdminParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteOrders(ctx, params)
+				response, err = s.h.CreateBookingForAdmin(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteOrders(ctx, params)
+		response, err = s.h.CreateBookingForAdmin(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -681,7 +705,7 @@ func (s *Server) handleDeleteOrdersRequest(args [2]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeDeleteOrdersResponse(response, w); err != nil {
+	if err := encodeCreateBookingForAdminResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -690,13 +714,22 @@ func (s *Server) handleDeleteOrdersRequest(args [2]string, argsEscaped bool, w h
 	}
 }
 
-// handleGetBookingByIdRequest handles getBookingById operation.
+// handleCreateBookingGroupRequest handles createBookingGroup operation.
 //
-// Возвращает информацию о бронировании по его
-// уникальному идентификатору.
+// Бронирует несколько рабочих мест на один и тот же
+// период времени одной операцией.
+// Для каждого места можно указать участника, для
+// которого оно бронируется;
+// если участник не указан, место бронируется для
+// текущего пользователя.
+// Проверки те же, что и при создании обычного
+// бронирования.
+// Бронирования создаются в одной транзакции: если хотя
+// бы одно место недоступно,
+// не создается ни одно бронирование.
 //
-// GET /bookings/{bookingId}
-func (s *Server) handleGetBookingByIdRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /booking-groups
+func (s *Server) handleCreateBookingGroupRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -704,15 +737,15 @@ func (s *Server) handleGetBookingByIdRequest(args [1]string, argsEscaped bool, w
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetBookingByIdOperation,
-			ID:   "getBookingById",
+			Name: CreateBookingGroupOperation,
+			ID:   "createBookingGroup",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetBookingByIdOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateBookingGroupOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -752,26 +785,3216 @@ func (s *Server) handleGetBookingByIdRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeGetBookingByIdParams(args, argsEscaped, r)
+	request, close, err := s.decodeCreateBookingGroupRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response GetBookingByIdRes
+	var response CreateBookingGroupRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetBookingByIdOperation,
-			OperationSummary: "Получить бронирование по ID",
-			OperationID:      "getBookingById",
-			Body:             nil,
-			Params: middleware.Parameters{
+			OperationName:    CreateBookingGroupOperation,
+			OperationSummary: "Создать групповое бронирование",
+			OperationID:      "createBookingGroup",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *BookingGroupCreate
+			Params   = struct{}
+			Response = CreateBookingGroupRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.CreateBookingGroup(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.CreateBookingGroup(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeCreateBookingGroupResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleCreateBookingTransferRequest handles createBookingTransfer operation.
+//
+// Предлагает передать бронирование текущего
+// пользователя сотруднику с указанной почтой.
+// Бронирование переходит к получателю, только когда он
+// примет предложение.
+// Если передан swap_booking_id, получатель взамен отдает свое
+// бронирование на то же время,
+// и бронирования обмениваются местами.
+// Завершенные бронирования и бронирования, входящие в
+// группу, передать нельзя.
+// На одно бронирование может быть только одно
+// предложение.
+// Если сотрудника с указанной почтой нет, ответ такой
+// же, как для существующего,
+// чтобы по нему нельзя было проверить почту, но принять
+// такое предложение некому.
+//
+// POST /bookings/{bookingId}/transfers
+func (s *Server) handleCreateBookingTransferRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: CreateBookingTransferOperation,
+			ID:   "createBookingTransfer",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateBookingTransferOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeCreateBookingTransferParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeCreateBookingTransferRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response CreateBookingTransferRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    CreateBookingTransferOperation,
+			OperationSummary: "Предложить передачу бронирования",
+			OperationID:      "createBookingTransfer",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "bookingId",
+					In:   "path",
+				}: params.BookingId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *BookingTransferCreate
+			Params   = CreateBookingTransferParams
+			Response = CreateBookingTransferRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackCreateBookingTransferParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.CreateBookingTransfer(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.CreateBookingTransfer(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeCreateBookingTransferResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleCreateDelegationRequest handles createDelegation operation.
+//
+// Разрешает указанному пользователю создавать и
+// изменять бронирования от имени текущего
+// пользователя.
+//
+// POST /delegations
+func (s *Server) handleCreateDelegationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: CreateDelegationOperation,
+			ID:   "createDelegation",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateDelegationOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	request, close, err := s.decodeCreateDelegationRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response CreateDelegationRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    CreateDelegationOperation,
+			OperationSummary: "Выдать право бронировать от своего имени",
+			OperationID:      "createDelegation",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *DelegationCreate
+			Params   = struct{}
+			Response = CreateDelegationRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.CreateDelegation(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.CreateDelegation(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeCreateDelegationResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleCreateOrderRequest handles createOrder operation.
+//
+// Создает новый заказ для указанного бронирования.
+// В случае успеха возвращает созданный заказ.
+//
+// POST /bookings/{bookingId}/orders
+func (s *Server) handleCreateOrderRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: CreateOrderOperation,
+			ID:   "createOrder",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateOrderOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeCreateOrderParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeCreateOrderRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response CreateOrderRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    CreateOrderOperation,
+			OperationSummary: "Создать заказ",
+			OperationID:      "createOrder",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "bookingId",
+					In:   "path",
+				}: params.BookingId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *OrderCreate
+			Params   = CreateOrderParams
+			Response = CreateOrderRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackCreateOrderParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.CreateOrder(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.CreateOrder(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeCreateOrderResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleDeleteBookingRequest handles deleteBooking operation.
+//
+// Удаляет бронирование по его уникальному
+// идентификатору.
+// Если передан If-Match, бронирование удаляется, только
+// если не изменилось с тех пор.
+//
+// DELETE /bookings/{bookingId}
+func (s *Server) handleDeleteBookingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: DeleteBookingOperation,
+			ID:   "deleteBooking",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteBookingOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeDeleteBookingParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response DeleteBookingRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    DeleteBookingOperation,
+			OperationSummary: "Удалить бронирование по ID",
+			OperationID:      "deleteBooking",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "If-Match",
+					In:   "header",
+				}: params.IfMatch,
+				{
+					Name: "bookingId",
+					In:   "path",
+				}: params.BookingId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = DeleteBookingParams
+			Response = DeleteBookingRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackDeleteBookingParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.DeleteBooking(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.DeleteBooking(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeDeleteBookingResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleDeleteBookingGroupRequest handles deleteBookingGroup operation.
+//
+// Удаляет групповое бронирование вместе со всеми его
+// бронированиями.
+//
+// DELETE /booking-groups/{groupId}
+func (s *Server) handleDeleteBookingGroupRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: DeleteBookingGroupOperation,
+			ID:   "deleteBookingGroup",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteBookingGroupOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeDeleteBookingGroupParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response DeleteBookingGroupRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    DeleteBookingGroupOperation,
+			OperationSummary: "Отменить групповое бронирование",
+			OperationID:      "deleteBookingGroup",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "groupId",
+					In:   "path",
+				}: params.GroupId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = DeleteBookingGroupParams
+			Response = DeleteBookingGroupRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackDeleteBookingGroupParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.DeleteBookingGroup(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.DeleteBookingGroup(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeDeleteBookingGroupResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleDeleteBookingTransferRequest handles deleteBookingTransfer operation.
+//
+// Отправитель отзывает предложение, получатель
+// отклоняет его.
+//
+// DELETE /transfers/{transferId}
+func (s *Server) handleDeleteBookingTransferRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: DeleteBookingTransferOperation,
+			ID:   "deleteBookingTransfer",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteBookingTransferOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeDeleteBookingTransferParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response DeleteBookingTransferRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    DeleteBookingTransferOperation,
+			OperationSummary: "Отозвать или отклонить предложение передачи",
+			OperationID:      "deleteBookingTransfer",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "transferId",
+					In:   "path",
+				}: params.TransferId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = DeleteBookingTransferParams
+			Response = DeleteBookingTransferRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackDeleteBookingTransferParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.DeleteBookingTransfer(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.DeleteBookingTransfer(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeDeleteBookingTransferResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleDeleteDelegationRequest handles deleteDelegation operation.
+//
+// Отозвать право бронировать от своего имени.
+//
+// DELETE /delegations/{delegateId}
+func (s *Server) handleDeleteDelegationRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: DeleteDelegationOperation,
+			ID:   "deleteDelegation",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteDelegationOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeDeleteDelegationParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response DeleteDelegationRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    DeleteDelegationOperation,
+			OperationSummary: "Отозвать право бронировать от своего имени",
+			OperationID:      "deleteDelegation",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "delegateId",
+					In:   "path",
+				}: params.DelegateId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = DeleteDelegationParams
+			Response = DeleteDelegationRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackDeleteDelegationParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.DeleteDelegation(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.DeleteDelegation(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeDeleteDelegationResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleDeleteOrdersRequest handles deleteOrders operation.
+//
+// Удаляет заказ по его уникальному идентификатору.
+//
+// DELETE /bookings/{bookingId}/orders/{orderId}
+func (s *Server) handleDeleteOrdersRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: DeleteOrdersOperation,
+			ID:   "deleteOrders",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteOrdersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeDeleteOrdersParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response DeleteOrdersRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    DeleteOrdersOperation,
+			OperationSummary: "Удалить заказ",
+			OperationID:      "deleteOrders",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "bookingId",
+					In:   "path",
+				}: params.BookingId,
+				{
+					Name: "orderId",
+					In:   "path",
+				}: params.OrderId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = DeleteOrdersParams
+			Response = DeleteOrdersRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackDeleteOrdersParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.DeleteOrders(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.DeleteOrders(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeDeleteOrdersResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleDeleteRoleQuotaOverrideRequest handles deleteRoleQuotaOverride operation.
+//
+// Удалить переопределение квоты роли (только для
+// админа).
+//
+// DELETE /quotas/overrides/roles/{role}
+func (s *Server) handleDeleteRoleQuotaOverrideRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: DeleteRoleQuotaOverrideOperation,
+			ID:   "deleteRoleQuotaOverride",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteRoleQuotaOverrideOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeDeleteRoleQuotaOverrideParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response DeleteRoleQuotaOverrideRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    DeleteRoleQuotaOverrideOperation,
+			OperationSummary: "Удалить переопределение квоты роли (только для админа)",
+			OperationID:      "deleteRoleQuotaOverride",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "role",
+					In:   "path",
+				}: params.Role,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = DeleteRoleQuotaOverrideParams
+			Response = DeleteRoleQuotaOverrideRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackDeleteRoleQuotaOverrideParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.DeleteRoleQuotaOverride(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.DeleteRoleQuotaOverride(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeDeleteRoleQuotaOverrideResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleDeleteTeamQuotaOverrideRequest handles deleteTeamQuotaOverride operation.
+//
+// Удалить переопределение квоты команды (только для
+// админа).
+//
+// DELETE /quotas/overrides/teams/{teamId}
+func (s *Server) handleDeleteTeamQuotaOverrideRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: DeleteTeamQuotaOverrideOperation,
+			ID:   "deleteTeamQuotaOverride",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteTeamQuotaOverrideOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeDeleteTeamQuotaOverrideParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response DeleteTeamQuotaOverrideRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    DeleteTeamQuotaOverrideOperation,
+			OperationSummary: "Удалить переопределение квоты команды (только для админа)",
+			OperationID:      "deleteTeamQuotaOverride",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "teamId",
+					In:   "path",
+				}: params.TeamId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = DeleteTeamQuotaOverrideParams
+			Response = DeleteTeamQuotaOverrideRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackDeleteTeamQuotaOverrideParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.DeleteTeamQuotaOverride(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.DeleteTeamQuotaOverride(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeDeleteTeamQuotaOverrideResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleDeleteUserQuotaOverrideRequest handles deleteUserQuotaOverride operation.
+//
+// Удалить переопределение квоты пользователя (только
+// для админа).
+//
+// DELETE /quotas/overrides/users/{userId}
+func (s *Server) handleDeleteUserQuotaOverrideRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: DeleteUserQuotaOverrideOperation,
+			ID:   "deleteUserQuotaOverride",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteUserQuotaOverrideOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeDeleteUserQuotaOverrideParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response DeleteUserQuotaOverrideRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    DeleteUserQuotaOverrideOperation,
+			OperationSummary: "Удалить переопределение квоты пользователя (только для админа)",
+			OperationID:      "deleteUserQuotaOverride",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "userId",
+					In:   "path",
+				}: params.UserId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = DeleteUserQuotaOverrideParams
+			Response = DeleteUserQuotaOverrideRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackDeleteUserQuotaOverrideParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.DeleteUserQuotaOverride(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.DeleteUserQuotaOverride(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeDeleteUserQuotaOverrideResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleExtendBookingRequest handles extendBooking operation.
+//
+// Продлевает идущее сейчас бронирование на указанное
+// число 15-минутных слотов.
+// Проверяются только добавленные слоты: место должно
+// быть свободно,
+// а слоты должны попадать в часы работы здания и не
+// превышать квоту.
+// Бронирование, входящее в группу, продлевается только
+// вместе с группой.
+// Продление записывается в историю бронирования.
+// Если передан If-Match, бронирование продлевается, только
+// если не изменилось с тех пор.
+//
+// POST /bookings/{bookingId}/extend
+func (s *Server) handleExtendBookingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ExtendBookingOperation,
+			ID:   "extendBooking",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ExtendBookingOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeExtendBookingParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeExtendBookingRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response ExtendBookingRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ExtendBookingOperation,
+			OperationSummary: "Продлить активное бронирование",
+			OperationID:      "extendBooking",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "If-Match",
+					In:   "header",
+				}: params.IfMatch,
+				{
+					Name: "bookingId",
+					In:   "path",
+				}: params.BookingId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *BookingExtend
+			Params   = ExtendBookingParams
+			Response = ExtendBookingRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackExtendBookingParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ExtendBooking(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ExtendBooking(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeExtendBookingResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetBookingByIdRequest handles getBookingById operation.
+//
+// Возвращает информацию о бронировании по его
+// уникальному идентификатору.
+//
+// GET /bookings/{bookingId}
+func (s *Server) handleGetBookingByIdRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetBookingByIdOperation,
+			ID:   "getBookingById",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetBookingByIdOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetBookingByIdParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetBookingByIdRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetBookingByIdOperation,
+			OperationSummary: "Получить бронирование по ID",
+			OperationID:      "getBookingById",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "bookingId",
+					In:   "path",
+				}: params.BookingId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetBookingByIdParams
+			Response = GetBookingByIdRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetBookingByIdParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetBookingById(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetBookingById(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetBookingByIdResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetBookingGroupRequest handles getBookingGroup operation.
+//
+// Групповое бронирование доступно его создателю и
+// администратору.
+//
+// GET /booking-groups/{groupId}
+func (s *Server) handleGetBookingGroupRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetBookingGroupOperation,
+			ID:   "getBookingGroup",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetBookingGroupOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetBookingGroupParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetBookingGroupRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetBookingGroupOperation,
+			OperationSummary: "Получить групповое бронирование по ID",
+			OperationID:      "getBookingGroup",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "groupId",
+					In:   "path",
+				}: params.GroupId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetBookingGroupParams
+			Response = GetBookingGroupRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetBookingGroupParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetBookingGroup(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetBookingGroup(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetBookingGroupResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetBookingHistoryRequest handles getBookingHistory operation.
+//
+// Возвращает продления и досрочные завершения
+// бронирования в порядке их совершения.
+//
+// GET /bookings/{bookingId}/history
+func (s *Server) handleGetBookingHistoryRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetBookingHistoryOperation,
+			ID:   "getBookingHistory",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetBookingHistoryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetBookingHistoryParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetBookingHistoryRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetBookingHistoryOperation,
+			OperationSummary: "Получить историю бронирования",
+			OperationID:      "getBookingHistory",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "bookingId",
+					In:   "path",
+				}: params.BookingId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetBookingHistoryParams
+			Response = GetBookingHistoryRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetBookingHistoryParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetBookingHistory(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetBookingHistory(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetBookingHistoryResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetBuildingWorkloadRequest handles getBuildingWorkload operation.
+//
+// Возвращает информацию о нагрузке на все рабочие
+// места здания за указанный период времени.
+//
+// GET /workloads/buildings/{buildingId}
+func (s *Server) handleGetBuildingWorkloadRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetBuildingWorkloadOperation,
+			ID:   "getBuildingWorkload",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetBuildingWorkloadOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetBuildingWorkloadParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetBuildingWorkloadRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetBuildingWorkloadOperation,
+			OperationSummary: "Получить нагрузку на здание",
+			OperationID:      "getBuildingWorkload",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "buildingId",
+					In:   "path",
+				}: params.BuildingId,
+				{
+					Name: "timeFrom",
+					In:   "query",
+				}: params.TimeFrom,
+				{
+					Name: "timeTo",
+					In:   "query",
+				}: params.TimeTo,
+				{
+					Name: "amenity",
+					In:   "query",
+				}: params.Amenity,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetBuildingWorkloadParams
+			Response = GetBuildingWorkloadRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetBuildingWorkloadParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetBuildingWorkload(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetBuildingWorkload(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetBuildingWorkloadResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetFloorWorkloadRequest handles getFloorWorkload operation.
+//
+// Возвращает информацию о нагрузке на указанный этаж
+// за указанный период времени.
+//
+// GET /workloads/floors/{floorId}
+func (s *Server) handleGetFloorWorkloadRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetFloorWorkloadOperation,
+			ID:   "getFloorWorkload",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetFloorWorkloadOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetFloorWorkloadParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetFloorWorkloadRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetFloorWorkloadOperation,
+			OperationSummary: "Получить нагрузку на этаж",
+			OperationID:      "getFloorWorkload",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "floorId",
+					In:   "path",
+				}: params.FloorId,
+				{
+					Name: "timeFrom",
+					In:   "query",
+				}: params.TimeFrom,
+				{
+					Name: "timeTo",
+					In:   "query",
+				}: params.TimeTo,
+				{
+					Name: "amenity",
+					In:   "query",
+				}: params.Amenity,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetFloorWorkloadParams
+			Response = GetFloorWorkloadRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetFloorWorkloadParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetFloorWorkload(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetFloorWorkload(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetFloorWorkloadResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetMyQuotaRequest handles getMyQuota operation.
+//
+// Возвращает действующие лимиты текущего пользователя,
+//
+//	их использование и остаток.
+//
+// Часы считаются за текущую неделю, которая начинается
+// в понедельник по местному
+// времени здания. Без здания неделя считается по UTC.
+// При бронировании неделя считается по часовому поясу
+// здания бронируемого места.
+// Лимит, равный 0, означает отсутствие ограничения;
+// остаток для такого лимита не возвращается.
+//
+// GET /quotas/my
+func (s *Server) handleGetMyQuotaRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetMyQuotaOperation,
+			ID:   "getMyQuota",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetMyQuotaOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetMyQuotaParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetMyQuotaRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetMyQuotaOperation,
+			OperationSummary: "Получить остаток своей квоты",
+			OperationID:      "getMyQuota",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "buildingId",
+					In:   "query",
+				}: params.BuildingId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetMyQuotaParams
+			Response = GetMyQuotaRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetMyQuotaParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetMyQuota(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetMyQuota(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetMyQuotaResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWorkloadRequest handles getWorkload operation.
+//
+// Возвращает информацию о нагрузке на указанное
+// рабочее место за указанный период времени.
+//
+// GET /workloads/{entityId}
+func (s *Server) handleGetWorkloadRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetWorkloadOperation,
+			ID:   "getWorkload",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetWorkloadOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetWorkloadParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetWorkloadRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetWorkloadOperation,
+			OperationSummary: "Получить нагрузку на рабочее место",
+			OperationID:      "getWorkload",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "entityId",
+					In:   "path",
+				}: params.EntityId,
+				{
+					Name: "timeFrom",
+					In:   "query",
+				}: params.TimeFrom,
+				{
+					Name: "timeTo",
+					In:   "query",
+				}: params.TimeTo,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetWorkloadParams
+			Response = GetWorkloadRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetWorkloadParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWorkload(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWorkload(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetWorkloadResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListAllBookingsRequest handles listAllBookings operation.
+//
+// Возвращает страницу бронирований вместе с рабочими
+// местами, этажами и заказами.
+// Следующая страница запрашивается с курсором next_cursor
+// из ответа и теми же сортировкой и фильтрами.
+//
+// GET /bookings
+func (s *Server) handleListAllBookingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListAllBookingsOperation,
+			ID:   "listAllBookings",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListAllBookingsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeListAllBookingsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ListAllBookingsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListAllBookingsOperation,
+			OperationSummary: "Получить список всех бронирований (только для админа)",
+			OperationID:      "listAllBookings",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "buildingId",
+					In:   "query",
+				}: params.BuildingId,
+				{
+					Name: "floorId",
+					In:   "query",
+				}: params.FloorId,
+				{
+					Name: "entityId",
+					In:   "query",
+				}: params.EntityId,
+				{
+					Name: "userId",
+					In:   "query",
+				}: params.UserId,
+				{
+					Name: "from",
+					In:   "query",
+				}: params.From,
+				{
+					Name: "to",
+					In:   "query",
+				}: params.To,
+				{
+					Name: "status",
+					In:   "query",
+				}: params.Status,
+				{
+					Name: "sort",
+					In:   "query",
+				}: params.Sort,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "cursor",
+					In:   "query",
+				}: params.Cursor,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListAllBookingsParams
+			Response = ListAllBookingsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListAllBookingsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListAllBookings(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListAllBookings(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListAllBookingsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListBookingTransfersRequest handles listBookingTransfers operation.
+//
+// Возвращает предложения передачи, отправленные
+// текущим пользователем и адресованные ему.
+//
+// GET /transfers
+func (s *Server) handleListBookingTransfersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListBookingTransfersOperation,
+			ID:   "listBookingTransfers",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListBookingTransfersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var response ListBookingTransfersRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListBookingTransfersOperation,
+			OperationSummary: "Получить список предложений передачи",
+			OperationID:      "listBookingTransfers",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = ListBookingTransfersRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListBookingTransfers(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListBookingTransfers(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListBookingTransfersResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListDelegationsRequest handles listDelegations operation.
+//
+// Возвращает пользователей, которым текущий
+// пользователь выдал право бронировать за него,
+// и пользователей, которые выдали такое право текущему
+// пользователю.
+//
+// GET /delegations
+func (s *Server) handleListDelegationsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListDelegationsOperation,
+			ID:   "listDelegations",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListDelegationsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var response ListDelegationsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListDelegationsOperation,
+			OperationSummary: "Получить список делегирований",
+			OperationID:      "listDelegations",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = ListDelegationsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListDelegations(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListDelegations(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListDelegationsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListMyBookingsRequest handles listMyBookings operation.
+//
+// Возвращает страницу бронирований текущего
+// пользователя вместе с рабочими местами, этажами и
+// заказами.
+// Следующая страница запрашивается с курсором next_cursor
+// из ответа и теми же сортировкой и фильтрами.
+//
+// GET /bookings/my
+func (s *Server) handleListMyBookingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListMyBookingsOperation,
+			ID:   "listMyBookings",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListMyBookingsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeListMyBookingsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ListMyBookingsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListMyBookingsOperation,
+			OperationSummary: "Получить список моих бронирований",
+			OperationID:      "listMyBookings",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "buildingId",
+					In:   "query",
+				}: params.BuildingId,
+				{
+					Name: "floorId",
+					In:   "query",
+				}: params.FloorId,
+				{
+					Name: "entityId",
+					In:   "query",
+				}: params.EntityId,
+				{
+					Name: "from",
+					In:   "query",
+				}: params.From,
+				{
+					Name: "to",
+					In:   "query",
+				}: params.To,
+				{
+					Name: "status",
+					In:   "query",
+				}: params.Status,
+				{
+					Name: "sort",
+					In:   "query",
+				}: params.Sort,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "cursor",
+					In:   "query",
+				}: params.Cursor,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListMyBookingsParams
+			Response = ListMyBookingsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListMyBookingsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListMyBookings(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListMyBookings(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListMyBookingsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListOrdersRequest handles listOrders operation.
+//
+// Возвращает список всех заказов для указанного
+// бронирования.
+//
+// GET /bookings/{bookingId}/orders
+func (s *Server) handleListOrdersRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListOrdersOperation,
+			ID:   "listOrders",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListOrdersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeListOrdersParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ListOrdersRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListOrdersOperation,
+			OperationSummary: "Получить список заказов",
+			OperationID:      "listOrders",
+			Body:             nil,
+			Params: middleware.Parameters{
 				{
 					Name: "bookingId",
 					In:   "path",
@@ -782,8 +4005,8 @@ func (s *Server) handleGetBookingByIdRequest(args [1]string, argsEscaped bool, w
 
 		type (
 			Request  = struct{}
-			Params   = GetBookingByIdParams
-			Response = GetBookingByIdRes
+			Params   = ListOrdersParams
+			Response = ListOrdersRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -792,14 +4015,262 @@ func (s *Server) handleGetBookingByIdRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetBookingByIdParams,
+			unpackListOrdersParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetBookingById(ctx, params)
+				response, err = s.h.ListOrders(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListOrders(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListOrdersResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListQuotaOverridesRequest handles listQuotaOverrides operation.
+//
+// Получить переопределения квот (только для админа).
+//
+// GET /quotas/overrides
+func (s *Server) handleListQuotaOverridesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListQuotaOverridesOperation,
+			ID:   "listQuotaOverrides",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListQuotaOverridesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var response ListQuotaOverridesRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListQuotaOverridesOperation,
+			OperationSummary: "Получить переопределения квот (только для админа)",
+			OperationID:      "listQuotaOverrides",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = ListQuotaOverridesRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListQuotaOverrides(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListQuotaOverrides(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListQuotaOverridesResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleReleaseBookingRequest handles releaseBooking operation.
+//
+// Завершает идущее сейчас бронирование: время
+// окончания переносится
+// на ближайшую следующую границу 15-минутного слота, и
+// оставшееся время
+// сразу становится доступно для других.
+// Бронирование, входящее в группу, завершается только
+// вместе с группой.
+// Завершение записывается в историю бронирования.
+// Если передан If-Match, бронирование завершается, только
+// если не изменилось с тех пор.
+//
+// POST /bookings/{bookingId}/release
+func (s *Server) handleReleaseBookingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ReleaseBookingOperation,
+			ID:   "releaseBooking",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ReleaseBookingOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeReleaseBookingParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ReleaseBookingRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ReleaseBookingOperation,
+			OperationSummary: "Досрочно завершить активное бронирование",
+			OperationID:      "releaseBooking",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "If-Match",
+					In:   "header",
+				}: params.IfMatch,
+				{
+					Name: "bookingId",
+					In:   "path",
+				}: params.BookingId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ReleaseBookingParams
+			Response = ReleaseBookingRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackReleaseBookingParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ReleaseBooking(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetBookingById(ctx, params)
+		response, err = s.h.ReleaseBooking(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -807,7 +4278,7 @@ func (s *Server) handleGetBookingByIdRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetBookingByIdResponse(response, w); err != nil {
+	if err := encodeReleaseBookingResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -816,13 +4287,13 @@ func (s *Server) handleGetBookingByIdRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleGetFloorWorkloadRequest handles getFloorWorkload operation.
+// handleRemoveBookingGroupMemberRequest handles removeBookingGroupMember operation.
 //
-// Возвращает информацию о нагрузке на указанный этаж
-// за указанный период времени.
+// Удаляет все бронирования участника в группе.
+// Если в группе не осталось бронирований, она удаляется.
 //
-// GET /workloads/floors/{floorId}
-func (s *Server) handleGetFloorWorkloadRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /booking-groups/{groupId}/members/{userId}
+func (s *Server) handleRemoveBookingGroupMemberRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -830,15 +4301,15 @@ func (s *Server) handleGetFloorWorkloadRequest(args [1]string, argsEscaped bool,
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetFloorWorkloadOperation,
-			ID:   "getFloorWorkload",
+			Name: RemoveBookingGroupMemberOperation,
+			ID:   "removeBookingGroupMember",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetFloorWorkloadOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, RemoveBookingGroupMemberOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -878,7 +4349,7 @@ func (s *Server) handleGetFloorWorkloadRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeGetFloorWorkloadParams(args, argsEscaped, r)
+	params, err := decodeRemoveBookingGroupMemberParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -889,35 +4360,31 @@ func (s *Server) handleGetFloorWorkloadRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	var response GetFloorWorkloadRes
+	var response RemoveBookingGroupMemberRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetFloorWorkloadOperation,
-			OperationSummary: "Получить нагрузку на этаж",
-			OperationID:      "getFloorWorkload",
+			OperationName:    RemoveBookingGroupMemberOperation,
+			OperationSummary: "Удалить участника из группового бронирования",
+			OperationID:      "removeBookingGroupMember",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "floorId",
+					Name: "groupId",
 					In:   "path",
-				}: params.FloorId,
-				{
-					Name: "timeFrom",
-					In:   "query",
-				}: params.TimeFrom,
+				}: params.GroupId,
 				{
-					Name: "timeTo",
-					In:   "query",
-				}: params.TimeTo,
+					Name: "userId",
+					In:   "path",
+				}: params.UserId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetFloorWorkloadParams
-			Response = GetFloorWorkloadRes
+			Params   = RemoveBookingGroupMemberParams
+			Response = RemoveBookingGroupMemberRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -926,14 +4393,14 @@ func (s *Server) handleGetFloorWorkloadRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetFloorWorkloadParams,
+			unpackRemoveBookingGroupMemberParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetFloorWorkload(ctx, params)
+				response, err = s.h.RemoveBookingGroupMember(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetFloorWorkload(ctx, params)
+		response, err = s.h.RemoveBookingGroupMember(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -941,7 +4408,7 @@ func (s *Server) handleGetFloorWorkloadRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetFloorWorkloadResponse(response, w); err != nil {
+	if err := encodeRemoveBookingGroupMemberResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -950,13 +4417,13 @@ func (s *Server) handleGetFloorWorkloadRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleGetWorkloadRequest handles getWorkload operation.
+// handleSetRoleQuotaOverrideRequest handles setRoleQuotaOverride operation.
 //
-// Возвращает информацию о нагрузке на указанное
-// рабочее место за указанный период времени.
+// Неуказанные лимиты наследуются от значений по
+// умолчанию.
 //
-// GET /workloads/{entityId}
-func (s *Server) handleGetWorkloadRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /quotas/overrides/roles/{role}
+func (s *Server) handleSetRoleQuotaOverrideRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -964,15 +4431,15 @@ func (s *Server) handleGetWorkloadRequest(args [1]string, argsEscaped bool, w ht
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetWorkloadOperation,
-			ID:   "getWorkload",
+			Name: SetRoleQuotaOverrideOperation,
+			ID:   "setRoleQuotaOverride",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetWorkloadOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, SetRoleQuotaOverrideOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1012,7 +4479,7 @@ func (s *Server) handleGetWorkloadRequest(args [1]string, argsEscaped bool, w ht
 			return
 		}
 	}
-	params, err := decodeGetWorkloadParams(args, argsEscaped, r)
+	params, err := decodeSetRoleQuotaOverrideParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1022,36 +4489,43 @@ func (s *Server) handleGetWorkloadRequest(args [1]string, argsEscaped bool, w ht
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeSetRoleQuotaOverrideRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response GetWorkloadRes
+	var response SetRoleQuotaOverrideRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetWorkloadOperation,
-			OperationSummary: "Получить нагрузку на рабочее место",
-			OperationID:      "getWorkload",
-			Body:             nil,
+			OperationName:    SetRoleQuotaOverrideOperation,
+			OperationSummary: "Переопределить квоту роли (только для админа)",
+			OperationID:      "setRoleQuotaOverride",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "entityId",
+					Name: "role",
 					In:   "path",
-				}: params.EntityId,
-				{
-					Name: "timeFrom",
-					In:   "query",
-				}: params.TimeFrom,
-				{
-					Name: "timeTo",
-					In:   "query",
-				}: params.TimeTo,
+				}: params.Role,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetWorkloadParams
-			Response = GetWorkloadRes
+			Request  = *QuotaOverrideUpdate
+			Params   = SetRoleQuotaOverrideParams
+			Response = SetRoleQuotaOverrideRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1060,14 +4534,14 @@ func (s *Server) handleGetWorkloadRequest(args [1]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			unpackGetWorkloadParams,
+			unpackSetRoleQuotaOverrideParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetWorkload(ctx, params)
+				response, err = s.h.SetRoleQuotaOverride(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetWorkload(ctx, params)
+		response, err = s.h.SetRoleQuotaOverride(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1075,7 +4549,7 @@ func (s *Server) handleGetWorkloadRequest(args [1]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeGetWorkloadResponse(response, w); err != nil {
+	if err := encodeSetRoleQuotaOverrideResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1084,12 +4558,17 @@ func (s *Server) handleGetWorkloadRequest(args [1]string, argsEscaped bool, w ht
 	}
 }
 
-// handleListAllBookingsRequest handles listAllBookings operation.
+// handleSetTeamQuotaOverrideRequest handles setTeamQuotaOverride operation.
 //
-// Возвращает список всех бронирований.
+// Квота действует для всех участников команды.
+// Неуказанные лимиты наследуются от квоты роли и
+// значений по умолчанию.
+// Если пользователь состоит в нескольких командах, для
+// каждого лимита
+// действует наибольшее значение из квот его команд.
 //
-// GET /bookings
-func (s *Server) handleListAllBookingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /quotas/overrides/teams/{teamId}
+func (s *Server) handleSetTeamQuotaOverrideRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -1097,15 +4576,15 @@ func (s *Server) handleListAllBookingsRequest(args [0]string, argsEscaped bool,
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListAllBookingsOperation,
-			ID:   "listAllBookings",
+			Name: SetTeamQuotaOverrideOperation,
+			ID:   "setTeamQuotaOverride",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListAllBookingsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, SetTeamQuotaOverrideOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1145,23 +4624,53 @@ func (s *Server) handleListAllBookingsRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
+	params, err := decodeSetTeamQuotaOverrideParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeSetTeamQuotaOverrideRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response ListAllBookingsRes
+	var response SetTeamQuotaOverrideRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListAllBookingsOperation,
-			OperationSummary: "Получить список всех бронирований (только для админа)",
-			OperationID:      "listAllBookings",
-			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    SetTeamQuotaOverrideOperation,
+			OperationSummary: "Переопределить квоту команды (только для админа)",
+			OperationID:      "setTeamQuotaOverride",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "teamId",
+					In:   "path",
+				}: params.TeamId,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = struct{}
-			Response = ListAllBookingsRes
+			Request  = *QuotaOverrideUpdate
+			Params   = SetTeamQuotaOverrideParams
+			Response = SetTeamQuotaOverrideRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1170,14 +4679,14 @@ func (s *Server) handleListAllBookingsRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			nil,
+			unpackSetTeamQuotaOverrideParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListAllBookings(ctx)
+				response, err = s.h.SetTeamQuotaOverride(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListAllBookings(ctx)
+		response, err = s.h.SetTeamQuotaOverride(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1185,7 +4694,7 @@ func (s *Server) handleListAllBookingsRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeListAllBookingsResponse(response, w); err != nil {
+	if err := encodeSetTeamQuotaOverrideResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1194,13 +4703,13 @@ func (s *Server) handleListAllBookingsRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleListMyBookingsRequest handles listMyBookings operation.
+// handleSetUserQuotaOverrideRequest handles setUserQuotaOverride operation.
 //
-// Возвращает список всех бронирований, созданных
-// текущим пользователем.
+// Неуказанные лимиты наследуются от квот команд, роли и
+// значений по умолчанию.
 //
-// GET /bookings/my
-func (s *Server) handleListMyBookingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /quotas/overrides/users/{userId}
+func (s *Server) handleSetUserQuotaOverrideRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -1208,15 +4717,15 @@ func (s *Server) handleListMyBookingsRequest(args [0]string, argsEscaped bool, w
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListMyBookingsOperation,
-			ID:   "listMyBookings",
+			Name: SetUserQuotaOverrideOperation,
+			ID:   "setUserQuotaOverride",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListMyBookingsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, SetUserQuotaOverrideOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1256,23 +4765,53 @@ func (s *Server) handleListMyBookingsRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
+	params, err := decodeSetUserQuotaOverrideParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeSetUserQuotaOverrideRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response ListMyBookingsRes
+	var response SetUserQuotaOverrideRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListMyBookingsOperation,
-			OperationSummary: "Получить список моих бронирований",
-			OperationID:      "listMyBookings",
-			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    SetUserQuotaOverrideOperation,
+			OperationSummary: "Переопределить квоту пользователя (только для админа)",
+			OperationID:      "setUserQuotaOverride",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "userId",
+					In:   "path",
+				}: params.UserId,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = struct{}
-			Response = ListMyBookingsRes
+			Request  = *QuotaOverrideUpdate
+			Params   = SetUserQuotaOverrideParams
+			Response = SetUserQuotaOverrideRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1281,14 +4820,14 @@ func (s *Server) handleListMyBookingsRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackSetUserQuotaOverrideParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListMyBookings(ctx)
+				response, err = s.h.SetUserQuotaOverride(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListMyBookings(ctx)
+		response, err = s.h.SetUserQuotaOverride(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1296,7 +4835,7 @@ func (s *Server) handleListMyBookingsRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeListMyBookingsResponse(response, w); err != nil {
+	if err := encodeSetUserQuotaOverrideResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1305,13 +4844,25 @@ func (s *Server) handleListMyBookingsRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleListOrdersRequest handles listOrders operation.
+// handleUpdateBookingRequest handles updateBooking operation.
 //
-// Возвращает список всех заказов для указанного
-// бронирования.
+// Обновляет время начала и/или окончания бронирования.
+// Время должно быть кратно 15 минутам в часовом поясе
+// здания и попадать в часы его работы.
+// Если указан on_behalf_of, бронирование переназначается на
+// этого пользователя.
+// Время бронирования, входящего в группу, меняется
+// только вместе с группой.
+// Бронирование может изменить его владелец, тот, кто
+// его создал,
+// пользователь с правом бронировать за владельца или
+// администратор.
+// Если передан If-Match, бронирование обновляется, только
+// если не изменилось с тех пор.
+// В случае успеха возвращает обновленное бронирование.
 //
-// GET /bookings/{bookingId}/orders
-func (s *Server) handleListOrdersRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PATCH /bookings/{bookingId}
+func (s *Server) handleUpdateBookingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -1319,15 +4870,15 @@ func (s *Server) handleListOrdersRequest(args [1]string, argsEscaped bool, w htt
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListOrdersOperation,
-			ID:   "listOrders",
+			Name: UpdateBookingOperation,
+			ID:   "updateBooking",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListOrdersOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateBookingOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1367,7 +4918,7 @@ func (s *Server) handleListOrdersRequest(args [1]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	params, err := decodeListOrdersParams(args, argsEscaped, r)
+	params, err := decodeUpdateBookingParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1377,16 +4928,35 @@ func (s *Server) handleListOrdersRequest(args [1]string, argsEscaped bool, w htt
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeUpdateBookingRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response ListOrdersRes
+	var response UpdateBookingRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListOrdersOperation,
-			OperationSummary: "Получить список заказов",
-			OperationID:      "listOrders",
-			Body:             nil,
+			OperationName:    UpdateBookingOperation,
+			OperationSummary: "Обновить бронирование по ID",
+			OperationID:      "updateBooking",
+			Body:             request,
 			Params: middleware.Parameters{
+				{
+					Name: "If-Match",
+					In:   "header",
+				}: params.IfMatch,
 				{
 					Name: "bookingId",
 					In:   "path",
@@ -1396,9 +4966,9 @@ func (s *Server) handleListOrdersRequest(args [1]string, argsEscaped bool, w htt
 		}
 
 		type (
-			Request  = struct{}
-			Params   = ListOrdersParams
-			Response = ListOrdersRes
+			Request  = *BookingUpdate
+			Params   = UpdateBookingParams
+			Response = UpdateBookingRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1407,14 +4977,14 @@ func (s *Server) handleListOrdersRequest(args [1]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackListOrdersParams,
+			unpackUpdateBookingParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListOrders(ctx, params)
+				response, err = s.h.UpdateBooking(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListOrders(ctx, params)
+		response, err = s.h.UpdateBooking(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1422,7 +4992,7 @@ func (s *Server) handleListOrdersRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeListOrdersResponse(response, w); err != nil {
+	if err := encodeUpdateBookingResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1431,13 +5001,13 @@ func (s *Server) handleListOrdersRequest(args [1]string, argsEscaped bool, w htt
 	}
 }
 
-// handleUpdateBookingRequest handles updateBooking operation.
+// handleUpdateBookingGroupRequest handles updateBookingGroup operation.
 //
-// Обновляет время начала и/или окончания бронирования.
-// В случае успеха возвращает обновленное бронирование.
+// Изменяет время начала и/или окончания всех
+// бронирований группы одной транзакцией.
 //
-// PATCH /bookings/{bookingId}
-func (s *Server) handleUpdateBookingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PATCH /booking-groups/{groupId}
+func (s *Server) handleUpdateBookingGroupRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -1445,15 +5015,15 @@ func (s *Server) handleUpdateBookingRequest(args [1]string, argsEscaped bool, w
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateBookingOperation,
-			ID:   "updateBooking",
+			Name: UpdateBookingGroupOperation,
+			ID:   "updateBookingGroup",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UpdateBookingOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateBookingGroupOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1493,7 +5063,7 @@ func (s *Server) handleUpdateBookingRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeUpdateBookingParams(args, argsEscaped, r)
+	params, err := decodeUpdateBookingGroupParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1503,7 +5073,7 @@ func (s *Server) handleUpdateBookingRequest(args [1]string, argsEscaped bool, w
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeUpdateBookingRequest(r)
+	request, close, err := s.decodeUpdateBookingGroupRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -1519,27 +5089,27 @@ func (s *Server) handleUpdateBookingRequest(args [1]string, argsEscaped bool, w
 		}
 	}()
 
-	var response UpdateBookingRes
+	var response UpdateBookingGroupRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateBookingOperation,
-			OperationSummary: "Обновить бронирование по ID",
-			OperationID:      "updateBooking",
+			OperationName:    UpdateBookingGroupOperation,
+			OperationSummary: "Перенести групповое бронирование",
+			OperationID:      "updateBookingGroup",
 			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "bookingId",
+					Name: "groupId",
 					In:   "path",
-				}: params.BookingId,
+				}: params.GroupId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *BookingUpdate
-			Params   = UpdateBookingParams
-			Response = UpdateBookingRes
+			Request  = *BookingGroupUpdate
+			Params   = UpdateBookingGroupParams
+			Response = UpdateBookingGroupRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1548,14 +5118,14 @@ func (s *Server) handleUpdateBookingRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackUpdateBookingParams,
+			unpackUpdateBookingGroupParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateBooking(ctx, request, params)
+				response, err = s.h.UpdateBookingGroup(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateBooking(ctx, request, params)
+		response, err = s.h.UpdateBookingGroup(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1563,7 +5133,7 @@ func (s *Server) handleUpdateBookingRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeUpdateBookingResponse(response, w); err != nil {
+	if err := encodeUpdateBookingGroupResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
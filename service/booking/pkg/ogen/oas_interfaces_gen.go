@@ -1,34 +1,102 @@
 // Code generated by ogen, DO NOT EDIT.
 package api
 
+type AcceptBookingTransferRes interface {
+	acceptBookingTransferRes()
+}
+
+type AddBookingGroupMemberRes interface {
+	addBookingGroupMemberRes()
+}
+
+type AllocateSeatsRes interface {
+	allocateSeatsRes()
+}
+
 type CreateBookingForAdminRes interface {
 	createBookingForAdminRes()
 }
 
+type CreateBookingGroupRes interface {
+	createBookingGroupRes()
+}
+
 type CreateBookingRes interface {
 	createBookingRes()
 }
 
+type CreateBookingTransferRes interface {
+	createBookingTransferRes()
+}
+
+type CreateDelegationRes interface {
+	createDelegationRes()
+}
+
 type CreateOrderRes interface {
 	createOrderRes()
 }
 
+type DeleteBookingGroupRes interface {
+	deleteBookingGroupRes()
+}
+
 type DeleteBookingRes interface {
 	deleteBookingRes()
 }
 
+type DeleteBookingTransferRes interface {
+	deleteBookingTransferRes()
+}
+
+type DeleteDelegationRes interface {
+	deleteDelegationRes()
+}
+
 type DeleteOrdersRes interface {
 	deleteOrdersRes()
 }
 
+type DeleteRoleQuotaOverrideRes interface {
+	deleteRoleQuotaOverrideRes()
+}
+
+type DeleteTeamQuotaOverrideRes interface {
+	deleteTeamQuotaOverrideRes()
+}
+
+type DeleteUserQuotaOverrideRes interface {
+	deleteUserQuotaOverrideRes()
+}
+
+type ExtendBookingRes interface {
+	extendBookingRes()
+}
+
 type GetBookingByIdRes interface {
 	getBookingByIdRes()
 }
 
+type GetBookingGroupRes interface {
+	getBookingGroupRes()
+}
+
+type GetBookingHistoryRes interface {
+	getBookingHistoryRes()
+}
+
+type GetBuildingWorkloadRes interface {
+	getBuildingWorkloadRes()
+}
+
 type GetFloorWorkloadRes interface {
 	getFloorWorkloadRes()
 }
 
+type GetMyQuotaRes interface {
+	getMyQuotaRes()
+}
+
 type GetWorkloadRes interface {
 	getWorkloadRes()
 }
@@ -37,6 +105,14 @@ type ListAllBookingsRes interface {
 	listAllBookingsRes()
 }
 
+type ListBookingTransfersRes interface {
+	listBookingTransfersRes()
+}
+
+type ListDelegationsRes interface {
+	listDelegationsRes()
+}
+
 type ListMyBookingsRes interface {
 	listMyBookingsRes()
 }
@@ -45,6 +121,34 @@ type ListOrdersRes interface {
 	listOrdersRes()
 }
 
+type ListQuotaOverridesRes interface {
+	listQuotaOverridesRes()
+}
+
+type ReleaseBookingRes interface {
+	releaseBookingRes()
+}
+
+type RemoveBookingGroupMemberRes interface {
+	removeBookingGroupMemberRes()
+}
+
+type SetRoleQuotaOverrideRes interface {
+	setRoleQuotaOverrideRes()
+}
+
+type SetTeamQuotaOverrideRes interface {
+	setTeamQuotaOverrideRes()
+}
+
+type SetUserQuotaOverrideRes interface {
+	setUserQuotaOverrideRes()
+}
+
+type UpdateBookingGroupRes interface {
+	updateBookingGroupRes()
+}
+
 type UpdateBookingRes interface {
 	updateBookingRes()
 }
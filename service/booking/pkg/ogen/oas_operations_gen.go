@@ -6,16 +6,42 @@ package api
 type OperationName = string
 
 const (
-	CreateBookingOperation         OperationName = "CreateBooking"
-	CreateBookingForAdminOperation OperationName = "CreateBookingForAdmin"
-	CreateOrderOperation           OperationName = "CreateOrder"
-	DeleteBookingOperation         OperationName = "DeleteBooking"
-	DeleteOrdersOperation          OperationName = "DeleteOrders"
-	GetBookingByIdOperation        OperationName = "GetBookingById"
-	GetFloorWorkloadOperation      OperationName = "GetFloorWorkload"
-	GetWorkloadOperation           OperationName = "GetWorkload"
-	ListAllBookingsOperation       OperationName = "ListAllBookings"
-	ListMyBookingsOperation        OperationName = "ListMyBookings"
-	ListOrdersOperation            OperationName = "ListOrders"
-	UpdateBookingOperation         OperationName = "UpdateBooking"
+	AcceptBookingTransferOperation    OperationName = "AcceptBookingTransfer"
+	AddBookingGroupMemberOperation    OperationName = "AddBookingGroupMember"
+	AllocateSeatsOperation            OperationName = "AllocateSeats"
+	CreateBookingOperation            OperationName = "CreateBooking"
+	CreateBookingForAdminOperation    OperationName = "CreateBookingForAdmin"
+	CreateBookingGroupOperation       OperationName = "CreateBookingGroup"
+	CreateBookingTransferOperation    OperationName = "CreateBookingTransfer"
+	CreateDelegationOperation         OperationName = "CreateDelegation"
+	CreateOrderOperation              OperationName = "CreateOrder"
+	DeleteBookingOperation            OperationName = "DeleteBooking"
+	DeleteBookingGroupOperation       OperationName = "DeleteBookingGroup"
+	DeleteBookingTransferOperation    OperationName = "DeleteBookingTransfer"
+	DeleteDelegationOperation         OperationName = "DeleteDelegation"
+	DeleteOrdersOperation             OperationName = "DeleteOrders"
+	DeleteRoleQuotaOverrideOperation  OperationName = "DeleteRoleQuotaOverride"
+	DeleteTeamQuotaOverrideOperation  OperationName = "DeleteTeamQuotaOverride"
+	DeleteUserQuotaOverrideOperation  OperationName = "DeleteUserQuotaOverride"
+	ExtendBookingOperation            OperationName = "ExtendBooking"
+	GetBookingByIdOperation           OperationName = "GetBookingById"
+	GetBookingGroupOperation          OperationName = "GetBookingGroup"
+	GetBookingHistoryOperation        OperationName = "GetBookingHistory"
+	GetBuildingWorkloadOperation      OperationName = "GetBuildingWorkload"
+	GetFloorWorkloadOperation         OperationName = "GetFloorWorkload"
+	GetMyQuotaOperation               OperationName = "GetMyQuota"
+	GetWorkloadOperation              OperationName = "GetWorkload"
+	ListAllBookingsOperation          OperationName = "ListAllBookings"
+	ListBookingTransfersOperation     OperationName = "ListBookingTransfers"
+	ListDelegationsOperation          OperationName = "ListDelegations"
+	ListMyBookingsOperation           OperationName = "ListMyBookings"
+	ListOrdersOperation               OperationName = "ListOrders"
+	ListQuotaOverridesOperation       OperationName = "ListQuotaOverrides"
+	ReleaseBookingOperation           OperationName = "ReleaseBooking"
+	RemoveBookingGroupMemberOperation OperationName = "RemoveBookingGroupMember"
+	SetRoleQuotaOverrideOperation     OperationName = "SetRoleQuotaOverride"
+	SetTeamQuotaOverrideOperation     OperationName = "SetTeamQuotaOverride"
+	SetUserQuotaOverrideOperation     OperationName = "SetUserQuotaOverride"
+	UpdateBookingOperation            OperationName = "UpdateBooking"
+	UpdateBookingGroupOperation       OperationName = "UpdateBookingGroup"
 )
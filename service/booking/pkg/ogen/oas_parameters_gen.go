@@ -16,6 +16,138 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
+// AcceptBookingTransferParams is parameters of acceptBookingTransfer operation.
+type AcceptBookingTransferParams struct {
+	// ID предложения передачи.
+	TransferId uuid.UUID
+}
+
+func unpackAcceptBookingTransferParams(packed middleware.Parameters) (params AcceptBookingTransferParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "transferId",
+			In:   "path",
+		}
+		params.TransferId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeAcceptBookingTransferParams(args [1]string, argsEscaped bool, r *http.Request) (params AcceptBookingTransferParams, _ error) {
+	// Decode path: transferId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "transferId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.TransferId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "transferId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// AddBookingGroupMemberParams is parameters of addBookingGroupMember operation.
+type AddBookingGroupMemberParams struct {
+	// ID группового бронирования.
+	GroupId uuid.UUID
+}
+
+func unpackAddBookingGroupMemberParams(packed middleware.Parameters) (params AddBookingGroupMemberParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "groupId",
+			In:   "path",
+		}
+		params.GroupId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeAddBookingGroupMemberParams(args [1]string, argsEscaped bool, r *http.Request) (params AddBookingGroupMemberParams, _ error) {
+	// Decode path: groupId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "groupId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.GroupId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "groupId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // CreateBookingForAdminParams is parameters of createBookingForAdmin operation.
 type CreateBookingForAdminParams struct {
 	// ID юзера.
@@ -82,13 +214,13 @@ func decodeCreateBookingForAdminParams(args [1]string, argsEscaped bool, r *http
 	return params, nil
 }
 
-// CreateOrderParams is parameters of createOrder operation.
-type CreateOrderParams struct {
+// CreateBookingTransferParams is parameters of createBookingTransfer operation.
+type CreateBookingTransferParams struct {
 	// ID бронирования.
 	BookingId uuid.UUID
 }
 
-func unpackCreateOrderParams(packed middleware.Parameters) (params CreateOrderParams) {
+func unpackCreateBookingTransferParams(packed middleware.Parameters) (params CreateBookingTransferParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "bookingId",
@@ -99,7 +231,7 @@ func unpackCreateOrderParams(packed middleware.Parameters) (params CreateOrderPa
 	return params
 }
 
-func decodeCreateOrderParams(args [1]string, argsEscaped bool, r *http.Request) (params CreateOrderParams, _ error) {
+func decodeCreateBookingTransferParams(args [1]string, argsEscaped bool, r *http.Request) (params CreateBookingTransferParams, _ error) {
 	// Decode path: bookingId.
 	if err := func() error {
 		param := args[0]
@@ -148,13 +280,13 @@ func decodeCreateOrderParams(args [1]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// DeleteBookingParams is parameters of deleteBooking operation.
-type DeleteBookingParams struct {
+// CreateOrderParams is parameters of createOrder operation.
+type CreateOrderParams struct {
 	// ID бронирования.
 	BookingId uuid.UUID
 }
 
-func unpackDeleteBookingParams(packed middleware.Parameters) (params DeleteBookingParams) {
+func unpackCreateOrderParams(packed middleware.Parameters) (params CreateOrderParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "bookingId",
@@ -165,7 +297,7 @@ func unpackDeleteBookingParams(packed middleware.Parameters) (params DeleteBooki
 	return params
 }
 
-func decodeDeleteBookingParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteBookingParams, _ error) {
+func decodeCreateOrderParams(args [1]string, argsEscaped bool, r *http.Request) (params CreateOrderParams, _ error) {
 	// Decode path: bookingId.
 	if err := func() error {
 		param := args[0]
@@ -214,33 +346,75 @@ func decodeDeleteBookingParams(args [1]string, argsEscaped bool, r *http.Request
 	return params, nil
 }
 
-// DeleteOrdersParams is parameters of deleteOrders operation.
-type DeleteOrdersParams struct {
+// DeleteBookingParams is parameters of deleteBooking operation.
+type DeleteBookingParams struct {
+	// ETag, полученный при чтении ресурса.
+	IfMatch OptString
 	// ID бронирования.
 	BookingId uuid.UUID
-	// ID заказа.
-	OrderId uuid.UUID
 }
 
-func unpackDeleteOrdersParams(packed middleware.Parameters) (params DeleteOrdersParams) {
+func unpackDeleteBookingParams(packed middleware.Parameters) (params DeleteBookingParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "bookingId",
-			In:   "path",
+			Name: "If-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfMatch = v.(OptString)
 		}
-		params.BookingId = packed[key].(uuid.UUID)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "orderId",
+			Name: "bookingId",
 			In:   "path",
 		}
-		params.OrderId = packed[key].(uuid.UUID)
+		params.BookingId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeDeleteOrdersParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteOrdersParams, _ error) {
+func decodeDeleteBookingParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteBookingParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: If-Match.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfMatchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIfMatchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IfMatch.SetTo(paramsDotIfMatchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "If-Match",
+			In:   "header",
+			Err:  err,
+		}
+	}
 	// Decode path: bookingId.
 	if err := func() error {
 		param := args[0]
@@ -286,11 +460,32 @@ func decodeDeleteOrdersParams(args [2]string, argsEscaped bool, r *http.Request)
 			Err:  err,
 		}
 	}
-	// Decode path: orderId.
+	return params, nil
+}
+
+// DeleteBookingGroupParams is parameters of deleteBookingGroup operation.
+type DeleteBookingGroupParams struct {
+	// ID группового бронирования.
+	GroupId uuid.UUID
+}
+
+func unpackDeleteBookingGroupParams(packed middleware.Parameters) (params DeleteBookingGroupParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "groupId",
+			In:   "path",
+		}
+		params.GroupId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeDeleteBookingGroupParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteBookingGroupParams, _ error) {
+	// Decode path: groupId.
 	if err := func() error {
-		param := args[1]
+		param := args[0]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
+			unescaped, err := url.PathUnescape(args[0])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -298,7 +493,7 @@ func decodeDeleteOrdersParams(args [2]string, argsEscaped bool, r *http.Request)
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "orderId",
+				Param:   "groupId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -315,7 +510,7 @@ func decodeDeleteOrdersParams(args [2]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.OrderId = c
+				params.GroupId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -326,7 +521,7 @@ func decodeDeleteOrdersParams(args [2]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "orderId",
+			Name: "groupId",
 			In:   "path",
 			Err:  err,
 		}
@@ -334,25 +529,25 @@ func decodeDeleteOrdersParams(args [2]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// GetBookingByIdParams is parameters of getBookingById operation.
-type GetBookingByIdParams struct {
-	// ID бронирования.
-	BookingId uuid.UUID
+// DeleteBookingTransferParams is parameters of deleteBookingTransfer operation.
+type DeleteBookingTransferParams struct {
+	// ID предложения передачи.
+	TransferId uuid.UUID
 }
 
-func unpackGetBookingByIdParams(packed middleware.Parameters) (params GetBookingByIdParams) {
+func unpackDeleteBookingTransferParams(packed middleware.Parameters) (params DeleteBookingTransferParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "bookingId",
+			Name: "transferId",
 			In:   "path",
 		}
-		params.BookingId = packed[key].(uuid.UUID)
+		params.TransferId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetBookingByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBookingByIdParams, _ error) {
-	// Decode path: bookingId.
+func decodeDeleteBookingTransferParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteBookingTransferParams, _ error) {
+	// Decode path: transferId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -364,7 +559,7 @@ func decodeGetBookingByIdParams(args [1]string, argsEscaped bool, r *http.Reques
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "bookingId",
+				Param:   "transferId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -381,7 +576,7 @@ func decodeGetBookingByIdParams(args [1]string, argsEscaped bool, r *http.Reques
 					return err
 				}
 
-				params.BookingId = c
+				params.TransferId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -392,7 +587,7 @@ func decodeGetBookingByIdParams(args [1]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "bookingId",
+			Name: "transferId",
 			In:   "path",
 			Err:  err,
 		}
@@ -400,44 +595,25 @@ func decodeGetBookingByIdParams(args [1]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// GetFloorWorkloadParams is parameters of getFloorWorkload operation.
-type GetFloorWorkloadParams struct {
-	// ID этажа.
-	FloorId uuid.UUID
-	// Время начала периода (в секундах, Unix timestamp).
-	TimeFrom Time
-	// Время окончания периода (в секундах, Unix timestamp).
-	TimeTo Time
+// DeleteDelegationParams is parameters of deleteDelegation operation.
+type DeleteDelegationParams struct {
+	// ID пользователя, которому выдано право.
+	DelegateId uuid.UUID
 }
 
-func unpackGetFloorWorkloadParams(packed middleware.Parameters) (params GetFloorWorkloadParams) {
+func unpackDeleteDelegationParams(packed middleware.Parameters) (params DeleteDelegationParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "floorId",
+			Name: "delegateId",
 			In:   "path",
 		}
-		params.FloorId = packed[key].(uuid.UUID)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "timeFrom",
-			In:   "query",
-		}
-		params.TimeFrom = packed[key].(Time)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "timeTo",
-			In:   "query",
-		}
-		params.TimeTo = packed[key].(Time)
+		params.DelegateId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetFloorWorkloadParams(args [1]string, argsEscaped bool, r *http.Request) (params GetFloorWorkloadParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: floorId.
+func decodeDeleteDelegationParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteDelegationParams, _ error) {
+	// Decode path: delegateId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -449,7 +625,7 @@ func decodeGetFloorWorkloadParams(args [1]string, argsEscaped bool, r *http.Requ
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "floorId",
+				Param:   "delegateId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -466,7 +642,7 @@ func decodeGetFloorWorkloadParams(args [1]string, argsEscaped bool, r *http.Requ
 					return err
 				}
 
-				params.FloorId = c
+				params.DelegateId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -477,41 +653,2812 @@ func decodeGetFloorWorkloadParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "floorId",
+			Name: "delegateId",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: timeFrom.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "timeFrom",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	return params, nil
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotTimeFromVal int64
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+// DeleteOrdersParams is parameters of deleteOrders operation.
+type DeleteOrdersParams struct {
+	// ID бронирования.
+	BookingId uuid.UUID
+	// ID заказа.
+	OrderId uuid.UUID
+}
 
-					c, err := conv.ToInt64(val)
-					if err != nil {
-						return err
+func unpackDeleteOrdersParams(packed middleware.Parameters) (params DeleteOrdersParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "bookingId",
+			In:   "path",
+		}
+		params.BookingId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "orderId",
+			In:   "path",
+		}
+		params.OrderId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeDeleteOrdersParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteOrdersParams, _ error) {
+	// Decode path: bookingId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "bookingId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.BookingId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "bookingId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: orderId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "orderId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.OrderId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "orderId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeleteRoleQuotaOverrideParams is parameters of deleteRoleQuotaOverride operation.
+type DeleteRoleQuotaOverrideParams struct {
+	// Роль.
+	Role Role
+}
+
+func unpackDeleteRoleQuotaOverrideParams(packed middleware.Parameters) (params DeleteRoleQuotaOverrideParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "role",
+			In:   "path",
+		}
+		params.Role = packed[key].(Role)
+	}
+	return params
+}
+
+func decodeDeleteRoleQuotaOverrideParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteRoleQuotaOverrideParams, _ error) {
+	// Decode path: role.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "role",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Role = Role(c)
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := params.Role.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "role",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeleteTeamQuotaOverrideParams is parameters of deleteTeamQuotaOverride operation.
+type DeleteTeamQuotaOverrideParams struct {
+	// ID команды.
+	TeamId uuid.UUID
+}
+
+func unpackDeleteTeamQuotaOverrideParams(packed middleware.Parameters) (params DeleteTeamQuotaOverrideParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "teamId",
+			In:   "path",
+		}
+		params.TeamId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeDeleteTeamQuotaOverrideParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteTeamQuotaOverrideParams, _ error) {
+	// Decode path: teamId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "teamId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.TeamId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "teamId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeleteUserQuotaOverrideParams is parameters of deleteUserQuotaOverride operation.
+type DeleteUserQuotaOverrideParams struct {
+	// ID пользователя.
+	UserId uuid.UUID
+}
+
+func unpackDeleteUserQuotaOverrideParams(packed middleware.Parameters) (params DeleteUserQuotaOverrideParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "userId",
+			In:   "path",
+		}
+		params.UserId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeDeleteUserQuotaOverrideParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteUserQuotaOverrideParams, _ error) {
+	// Decode path: userId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.UserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "userId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ExtendBookingParams is parameters of extendBooking operation.
+type ExtendBookingParams struct {
+	// ETag, полученный при чтении ресурса.
+	IfMatch OptString
+	// ID бронирования.
+	BookingId uuid.UUID
+}
+
+func unpackExtendBookingParams(packed middleware.Parameters) (params ExtendBookingParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "If-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfMatch = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "bookingId",
+			In:   "path",
+		}
+		params.BookingId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeExtendBookingParams(args [1]string, argsEscaped bool, r *http.Request) (params ExtendBookingParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: If-Match.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfMatchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIfMatchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IfMatch.SetTo(paramsDotIfMatchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "If-Match",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Decode path: bookingId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "bookingId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.BookingId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "bookingId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetBookingByIdParams is parameters of getBookingById operation.
+type GetBookingByIdParams struct {
+	// ID бронирования.
+	BookingId uuid.UUID
+}
+
+func unpackGetBookingByIdParams(packed middleware.Parameters) (params GetBookingByIdParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "bookingId",
+			In:   "path",
+		}
+		params.BookingId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetBookingByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBookingByIdParams, _ error) {
+	// Decode path: bookingId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "bookingId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.BookingId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "bookingId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetBookingGroupParams is parameters of getBookingGroup operation.
+type GetBookingGroupParams struct {
+	// ID группового бронирования.
+	GroupId uuid.UUID
+}
+
+func unpackGetBookingGroupParams(packed middleware.Parameters) (params GetBookingGroupParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "groupId",
+			In:   "path",
+		}
+		params.GroupId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetBookingGroupParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBookingGroupParams, _ error) {
+	// Decode path: groupId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "groupId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.GroupId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "groupId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetBookingHistoryParams is parameters of getBookingHistory operation.
+type GetBookingHistoryParams struct {
+	// ID бронирования.
+	BookingId uuid.UUID
+}
+
+func unpackGetBookingHistoryParams(packed middleware.Parameters) (params GetBookingHistoryParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "bookingId",
+			In:   "path",
+		}
+		params.BookingId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetBookingHistoryParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBookingHistoryParams, _ error) {
+	// Decode path: bookingId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "bookingId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.BookingId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "bookingId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetBuildingWorkloadParams is parameters of getBuildingWorkload operation.
+type GetBuildingWorkloadParams struct {
+	// ID здания.
+	BuildingId uuid.UUID
+	// Время начала периода (в секундах, Unix timestamp).
+	TimeFrom Time
+	// Время окончания периода (в секундах, Unix timestamp).
+	TimeTo Time
+	// Вернуть только рабочие места, у которых есть все
+	// указанные удобства.
+	Amenity []uuid.UUID
+}
+
+func unpackGetBuildingWorkloadParams(packed middleware.Parameters) (params GetBuildingWorkloadParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "buildingId",
+			In:   "path",
+		}
+		params.BuildingId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "timeFrom",
+			In:   "query",
+		}
+		params.TimeFrom = packed[key].(Time)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "timeTo",
+			In:   "query",
+		}
+		params.TimeTo = packed[key].(Time)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "amenity",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Amenity = v.([]uuid.UUID)
+		}
+	}
+	return params
+}
+
+func decodeGetBuildingWorkloadParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBuildingWorkloadParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: buildingId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "buildingId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.BuildingId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "buildingId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: timeFrom.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "timeFrom",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotTimeFromVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTimeFromVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.TimeFrom = Time(paramsDotTimeFromVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "timeFrom",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: timeTo.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "timeTo",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotTimeToVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTimeToVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.TimeTo = Time(paramsDotTimeToVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "timeTo",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: amenity.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "amenity",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotAmenityVal uuid.UUID
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToUUID(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotAmenityVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.Amenity = append(params.Amenity, paramsDotAmenityVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "amenity",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetFloorWorkloadParams is parameters of getFloorWorkload operation.
+type GetFloorWorkloadParams struct {
+	// ID этажа.
+	FloorId uuid.UUID
+	// Время начала периода (в секундах, Unix timestamp).
+	TimeFrom Time
+	// Время окончания периода (в секундах, Unix timestamp).
+	TimeTo Time
+	// Вернуть только рабочие места, у которых есть все
+	// указанные удобства.
+	Amenity []uuid.UUID
+}
+
+func unpackGetFloorWorkloadParams(packed middleware.Parameters) (params GetFloorWorkloadParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "floorId",
+			In:   "path",
+		}
+		params.FloorId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "timeFrom",
+			In:   "query",
+		}
+		params.TimeFrom = packed[key].(Time)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "timeTo",
+			In:   "query",
+		}
+		params.TimeTo = packed[key].(Time)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "amenity",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Amenity = v.([]uuid.UUID)
+		}
+	}
+	return params
+}
+
+func decodeGetFloorWorkloadParams(args [1]string, argsEscaped bool, r *http.Request) (params GetFloorWorkloadParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: floorId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "floorId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.FloorId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "floorId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: timeFrom.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "timeFrom",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotTimeFromVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTimeFromVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.TimeFrom = Time(paramsDotTimeFromVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "timeFrom",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: timeTo.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "timeTo",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotTimeToVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTimeToVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.TimeTo = Time(paramsDotTimeToVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "timeTo",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: amenity.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "amenity",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotAmenityVal uuid.UUID
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToUUID(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotAmenityVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.Amenity = append(params.Amenity, paramsDotAmenityVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "amenity",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetMyQuotaParams is parameters of getMyQuota operation.
+type GetMyQuotaParams struct {
+	// Здание, по местному времени которого считается неделя.
+	BuildingId OptUUID
+}
+
+func unpackGetMyQuotaParams(packed middleware.Parameters) (params GetMyQuotaParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "buildingId",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.BuildingId = v.(OptUUID)
+		}
+	}
+	return params
+}
+
+func decodeGetMyQuotaParams(args [0]string, argsEscaped bool, r *http.Request) (params GetMyQuotaParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: buildingId.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "buildingId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBuildingIdVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotBuildingIdVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.BuildingId.SetTo(paramsDotBuildingIdVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "buildingId",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetWorkloadParams is parameters of getWorkload operation.
+type GetWorkloadParams struct {
+	// ID рабочего места.
+	EntityId uuid.UUID
+	// Время начала периода (в секундах, Unix timestamp).
+	TimeFrom Time
+	// Время окончания периода (в секундах, Unix timestamp).
+	TimeTo Time
+}
+
+func unpackGetWorkloadParams(packed middleware.Parameters) (params GetWorkloadParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "entityId",
+			In:   "path",
+		}
+		params.EntityId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "timeFrom",
+			In:   "query",
+		}
+		params.TimeFrom = packed[key].(Time)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "timeTo",
+			In:   "query",
+		}
+		params.TimeTo = packed[key].(Time)
+	}
+	return params
+}
+
+func decodeGetWorkloadParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWorkloadParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: entityId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "entityId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.EntityId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "entityId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: timeFrom.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "timeFrom",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotTimeFromVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTimeFromVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.TimeFrom = Time(paramsDotTimeFromVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "timeFrom",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: timeTo.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "timeTo",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotTimeToVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTimeToVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.TimeTo = Time(paramsDotTimeToVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "timeTo",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListAllBookingsParams is parameters of listAllBookings operation.
+type ListAllBookingsParams struct {
+	// Вернуть только бронирования рабочих мест указанного
+	// здания.
+	BuildingId OptUUID
+	// Вернуть только бронирования рабочих мест указанного
+	// этажа.
+	FloorId OptUUID
+	// Вернуть только бронирования указанного рабочего
+	// места.
+	EntityId OptUUID
+	// Вернуть только бронирования указанного пользователя.
+	UserId OptUUID
+	// Вернуть только бронирования, которые заканчиваются
+	// позже указанного времени.
+	From OptTime
+	// Вернуть только бронирования, которые начинаются
+	// раньше указанного времени.
+	To OptTime
+	// Вернуть только бронирования с указанным статусом.
+	Status OptBookingStatus
+	// Поле сортировки, минус означает сортировку по
+	// убыванию.
+	Sort OptBookingSort
+	// Максимальное количество элементов на странице.
+	Limit OptInt
+	// Курсор следующей страницы из предыдущего ответа.
+	Cursor OptString
+}
+
+func unpackListAllBookingsParams(packed middleware.Parameters) (params ListAllBookingsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "buildingId",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.BuildingId = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "floorId",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.FloorId = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "entityId",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.EntityId = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "userId",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UserId = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "from",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.From = v.(OptTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "to",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.To = v.(OptTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.(OptBookingStatus)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sort",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sort = v.(OptBookingSort)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeListAllBookingsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListAllBookingsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: buildingId.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "buildingId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBuildingIdVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotBuildingIdVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.BuildingId.SetTo(paramsDotBuildingIdVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "buildingId",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: floorId.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "floorId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFloorIdVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFloorIdVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.FloorId.SetTo(paramsDotFloorIdVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "floorId",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: entityId.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "entityId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEntityIdVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEntityIdVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.EntityId.SetTo(paramsDotEntityIdVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "entityId",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: userId.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "userId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUserIdVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotUserIdVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.UserId.SetTo(paramsDotUserIdVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "userId",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: from.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFromVal Time
+				if err := func() error {
+					var paramsDotFromValVal int64
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToInt64(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotFromValVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					paramsDotFromVal = Time(paramsDotFromValVal)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.From.SetTo(paramsDotFromVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "from",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: to.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotToVal Time
+				if err := func() error {
+					var paramsDotToValVal int64
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToInt64(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotToValVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					paramsDotToVal = Time(paramsDotToValVal)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.To.SetTo(paramsDotToVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "to",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStatusVal BookingStatus
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStatusVal = BookingStatus(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Status.SetTo(paramsDotStatusVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Status.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: sort.
+	{
+		val := BookingSort("-created_at")
+		params.Sort.SetTo(val)
+	}
+	// Decode query: sort.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortVal BookingSort
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortVal = BookingSort(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Sort.SetTo(paramsDotSortVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Sort.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sort",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListMyBookingsParams is parameters of listMyBookings operation.
+type ListMyBookingsParams struct {
+	// Вернуть только бронирования рабочих мест указанного
+	// здания.
+	BuildingId OptUUID
+	// Вернуть только бронирования рабочих мест указанного
+	// этажа.
+	FloorId OptUUID
+	// Вернуть только бронирования указанного рабочего
+	// места.
+	EntityId OptUUID
+	// Вернуть только бронирования, которые заканчиваются
+	// позже указанного времени.
+	From OptTime
+	// Вернуть только бронирования, которые начинаются
+	// раньше указанного времени.
+	To OptTime
+	// Вернуть только бронирования с указанным статусом.
+	Status OptBookingStatus
+	// Поле сортировки, минус означает сортировку по
+	// убыванию.
+	Sort OptBookingSort
+	// Максимальное количество элементов на странице.
+	Limit OptInt
+	// Курсор следующей страницы из предыдущего ответа.
+	Cursor OptString
+}
+
+func unpackListMyBookingsParams(packed middleware.Parameters) (params ListMyBookingsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "buildingId",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.BuildingId = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "floorId",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.FloorId = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "entityId",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.EntityId = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "from",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.From = v.(OptTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "to",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.To = v.(OptTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.(OptBookingStatus)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sort",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sort = v.(OptBookingSort)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeListMyBookingsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListMyBookingsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: buildingId.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "buildingId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBuildingIdVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotBuildingIdVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.BuildingId.SetTo(paramsDotBuildingIdVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "buildingId",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: floorId.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "floorId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFloorIdVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFloorIdVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.FloorId.SetTo(paramsDotFloorIdVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "floorId",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: entityId.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "entityId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEntityIdVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEntityIdVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.EntityId.SetTo(paramsDotEntityIdVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "entityId",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: from.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFromVal Time
+				if err := func() error {
+					var paramsDotFromValVal int64
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToInt64(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotFromValVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					paramsDotFromVal = Time(paramsDotFromValVal)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.From.SetTo(paramsDotFromVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "from",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: to.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotToVal Time
+				if err := func() error {
+					var paramsDotToValVal int64
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToInt64(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotToValVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					paramsDotToVal = Time(paramsDotToValVal)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.To.SetTo(paramsDotToVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "to",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStatusVal BookingStatus
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStatusVal = BookingStatus(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Status.SetTo(paramsDotStatusVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Status.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: sort.
+	{
+		val := BookingSort("-created_at")
+		params.Sort.SetTo(val)
+	}
+	// Decode query: sort.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortVal BookingSort
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortVal = BookingSort(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Sort.SetTo(paramsDotSortVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Sort.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sort",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListOrdersParams is parameters of listOrders operation.
+type ListOrdersParams struct {
+	// ID бронирования.
+	BookingId uuid.UUID
+}
+
+func unpackListOrdersParams(packed middleware.Parameters) (params ListOrdersParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "bookingId",
+			In:   "path",
+		}
+		params.BookingId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeListOrdersParams(args [1]string, argsEscaped bool, r *http.Request) (params ListOrdersParams, _ error) {
+	// Decode path: bookingId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "bookingId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.BookingId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "bookingId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ReleaseBookingParams is parameters of releaseBooking operation.
+type ReleaseBookingParams struct {
+	// ETag, полученный при чтении ресурса.
+	IfMatch OptString
+	// ID бронирования.
+	BookingId uuid.UUID
+}
+
+func unpackReleaseBookingParams(packed middleware.Parameters) (params ReleaseBookingParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "If-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfMatch = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "bookingId",
+			In:   "path",
+		}
+		params.BookingId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeReleaseBookingParams(args [1]string, argsEscaped bool, r *http.Request) (params ReleaseBookingParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: If-Match.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfMatchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
 					}
 
-					paramsDotTimeFromVal = c
-					return nil
-				}(); err != nil {
+					paramsDotIfMatchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IfMatch.SetTo(paramsDotIfMatchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "If-Match",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Decode path: bookingId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "bookingId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.BookingId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "bookingId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RemoveBookingGroupMemberParams is parameters of removeBookingGroupMember operation.
+type RemoveBookingGroupMemberParams struct {
+	// ID группового бронирования.
+	GroupId uuid.UUID
+	// ID участника.
+	UserId uuid.UUID
+}
+
+func unpackRemoveBookingGroupMemberParams(packed middleware.Parameters) (params RemoveBookingGroupMemberParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "groupId",
+			In:   "path",
+		}
+		params.GroupId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "userId",
+			In:   "path",
+		}
+		params.UserId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeRemoveBookingGroupMemberParams(args [2]string, argsEscaped bool, r *http.Request) (params RemoveBookingGroupMemberParams, _ error) {
+	// Decode path: groupId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "groupId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.GroupId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "groupId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: userId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
 					return err
 				}
-				params.TimeFrom = Time(paramsDotTimeFromVal)
+
+				params.UserId = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
@@ -520,41 +3467,72 @@ func decodeGetFloorWorkloadParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "timeFrom",
-			In:   "query",
+			Name: "userId",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: timeTo.
+	return params, nil
+}
+
+// SetRoleQuotaOverrideParams is parameters of setRoleQuotaOverride operation.
+type SetRoleQuotaOverrideParams struct {
+	// Роль.
+	Role Role
+}
+
+func unpackSetRoleQuotaOverrideParams(packed middleware.Parameters) (params SetRoleQuotaOverrideParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "role",
+			In:   "path",
+		}
+		params.Role = packed[key].(Role)
+	}
+	return params
+}
+
+func decodeSetRoleQuotaOverrideParams(args [1]string, argsEscaped bool, r *http.Request) (params SetRoleQuotaOverrideParams, _ error) {
+	// Decode path: role.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "timeTo",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "role",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotTimeToVal int64
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					c, err := conv.ToInt64(val)
-					if err != nil {
-						return err
-					}
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
 
-					paramsDotTimeToVal = c
-					return nil
-				}(); err != nil {
+				params.Role = Role(c)
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := params.Role.Validate(); err != nil {
 					return err
 				}
-				params.TimeTo = Time(paramsDotTimeToVal)
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
@@ -563,52 +3541,33 @@ func decodeGetFloorWorkloadParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "timeTo",
-			In:   "query",
+			Name: "role",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetWorkloadParams is parameters of getWorkload operation.
-type GetWorkloadParams struct {
-	// ID рабочего места.
-	EntityId uuid.UUID
-	// Время начала периода (в секундах, Unix timestamp).
-	TimeFrom Time
-	// Время окончания периода (в секундах, Unix timestamp).
-	TimeTo Time
+// SetTeamQuotaOverrideParams is parameters of setTeamQuotaOverride operation.
+type SetTeamQuotaOverrideParams struct {
+	// ID команды.
+	TeamId uuid.UUID
 }
 
-func unpackGetWorkloadParams(packed middleware.Parameters) (params GetWorkloadParams) {
+func unpackSetTeamQuotaOverrideParams(packed middleware.Parameters) (params SetTeamQuotaOverrideParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "entityId",
+			Name: "teamId",
 			In:   "path",
 		}
-		params.EntityId = packed[key].(uuid.UUID)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "timeFrom",
-			In:   "query",
-		}
-		params.TimeFrom = packed[key].(Time)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "timeTo",
-			In:   "query",
-		}
-		params.TimeTo = packed[key].(Time)
+		params.TeamId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetWorkloadParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWorkloadParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: entityId.
+func decodeSetTeamQuotaOverrideParams(args [1]string, argsEscaped bool, r *http.Request) (params SetTeamQuotaOverrideParams, _ error) {
+	// Decode path: teamId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -620,7 +3579,7 @@ func decodeGetWorkloadParams(args [1]string, argsEscaped bool, r *http.Request)
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "entityId",
+				Param:   "teamId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -637,7 +3596,7 @@ func decodeGetWorkloadParams(args [1]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.EntityId = c
+				params.TeamId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -648,41 +3607,64 @@ func decodeGetWorkloadParams(args [1]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "entityId",
+			Name: "teamId",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: timeFrom.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "timeFrom",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	return params, nil
+}
+
+// SetUserQuotaOverrideParams is parameters of setUserQuotaOverride operation.
+type SetUserQuotaOverrideParams struct {
+	// ID пользователя.
+	UserId uuid.UUID
+}
+
+func unpackSetUserQuotaOverrideParams(packed middleware.Parameters) (params SetUserQuotaOverrideParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "userId",
+			In:   "path",
 		}
+		params.UserId = packed[key].(uuid.UUID)
+	}
+	return params
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotTimeFromVal int64
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+func decodeSetUserQuotaOverrideParams(args [1]string, argsEscaped bool, r *http.Request) (params SetUserQuotaOverrideParams, _ error) {
+	// Decode path: userId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-					c, err := conv.ToInt64(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotTimeFromVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToUUID(val)
+				if err != nil {
 					return err
 				}
-				params.TimeFrom = Time(paramsDotTimeFromVal)
+
+				params.UserId = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
@@ -691,75 +3673,83 @@ func decodeGetWorkloadParams(args [1]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "timeFrom",
-			In:   "query",
+			Name: "userId",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: timeTo.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "timeTo",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	return params, nil
+}
+
+// UpdateBookingParams is parameters of updateBooking operation.
+type UpdateBookingParams struct {
+	// ETag, полученный при чтении ресурса.
+	IfMatch OptString
+	// ID бронирования.
+	BookingId uuid.UUID
+}
+
+func unpackUpdateBookingParams(packed middleware.Parameters) (params UpdateBookingParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "If-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfMatch = v.(OptString)
 		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "bookingId",
+			In:   "path",
+		}
+		params.BookingId = packed[key].(uuid.UUID)
+	}
+	return params
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotTimeToVal int64
+func decodeUpdateBookingParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateBookingParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: If-Match.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfMatchVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt64(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotTimeToVal = c
+					paramsDotIfMatchVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.TimeTo = Time(paramsDotTimeToVal)
+				params.IfMatch.SetTo(paramsDotIfMatchVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "timeTo",
-			In:   "query",
+			Name: "If-Match",
+			In:   "header",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// ListOrdersParams is parameters of listOrders operation.
-type ListOrdersParams struct {
-	// ID бронирования.
-	BookingId uuid.UUID
-}
-
-func unpackListOrdersParams(packed middleware.Parameters) (params ListOrdersParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "bookingId",
-			In:   "path",
-		}
-		params.BookingId = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodeListOrdersParams(args [1]string, argsEscaped bool, r *http.Request) (params ListOrdersParams, _ error) {
 	// Decode path: bookingId.
 	if err := func() error {
 		param := args[0]
@@ -808,25 +3798,25 @@ func decodeListOrdersParams(args [1]string, argsEscaped bool, r *http.Request) (
 	return params, nil
 }
 
-// UpdateBookingParams is parameters of updateBooking operation.
-type UpdateBookingParams struct {
-	// ID бронирования.
-	BookingId uuid.UUID
+// UpdateBookingGroupParams is parameters of updateBookingGroup operation.
+type UpdateBookingGroupParams struct {
+	// ID группового бронирования.
+	GroupId uuid.UUID
 }
 
-func unpackUpdateBookingParams(packed middleware.Parameters) (params UpdateBookingParams) {
+func unpackUpdateBookingGroupParams(packed middleware.Parameters) (params UpdateBookingGroupParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "bookingId",
+			Name: "groupId",
 			In:   "path",
 		}
-		params.BookingId = packed[key].(uuid.UUID)
+		params.GroupId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeUpdateBookingParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateBookingParams, _ error) {
-	// Decode path: bookingId.
+func decodeUpdateBookingGroupParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateBookingGroupParams, _ error) {
+	// Decode path: groupId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -838,7 +3828,7 @@ func decodeUpdateBookingParams(args [1]string, argsEscaped bool, r *http.Request
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "bookingId",
+				Param:   "groupId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -855,7 +3845,7 @@ func decodeUpdateBookingParams(args [1]string, argsEscaped bool, r *http.Request
 					return err
 				}
 
-				params.BookingId = c
+				params.GroupId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -866,7 +3856,7 @@ func decodeUpdateBookingParams(args [1]string, argsEscaped bool, r *http.Request
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "bookingId",
+			Name: "groupId",
 			In:   "path",
 			Err:  err,
 		}
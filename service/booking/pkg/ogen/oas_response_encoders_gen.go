@@ -7,11 +7,22 @@ import (
 
 	"github.com/go-faster/errors"
 	"github.com/go-faster/jx"
+
+	"github.com/ogen-go/ogen/conv"
+	"github.com/ogen-go/ogen/uri"
 )
 
-func encodeCreateBookingResponse(response CreateBookingRes, w http.ResponseWriter) error {
+func encodeAcceptBookingTransferResponse(response AcceptBookingTransferRes, w http.ResponseWriter) error {
 	switch response := response.(type) {
-	case *Booking:
+	case *AcceptBookingTransferOKApplicationJSON:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 
@@ -40,7 +51,7 @@ func encodeCreateBookingResponse(response CreateBookingRes, w http.ResponseWrite
 
 		return nil
 
-	case *CreateBookingForbidden:
+	case *AcceptBookingTransferForbidden:
 		w.WriteHeader(403)
 
 		return nil
@@ -65,7 +76,7 @@ func encodeCreateBookingResponse(response CreateBookingRes, w http.ResponseWrite
 
 		return nil
 
-	case *CreateBookingConflict:
+	case *AcceptBookingTransferConflict:
 		w.WriteHeader(409)
 
 		return nil
@@ -75,9 +86,17 @@ func encodeCreateBookingResponse(response CreateBookingRes, w http.ResponseWrite
 	}
 }
 
-func encodeCreateBookingForAdminResponse(response CreateBookingForAdminRes, w http.ResponseWriter) error {
+func encodeAddBookingGroupMemberResponse(response AddBookingGroupMemberRes, w http.ResponseWriter) error {
 	switch response := response.(type) {
-	case *Booking:
+	case *BookingGroup:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 
@@ -106,7 +125,7 @@ func encodeCreateBookingForAdminResponse(response CreateBookingForAdminRes, w ht
 
 		return nil
 
-	case *CreateBookingForAdminForbidden:
+	case *AddBookingGroupMemberForbidden:
 		w.WriteHeader(403)
 
 		return nil
@@ -131,7 +150,7 @@ func encodeCreateBookingForAdminResponse(response CreateBookingForAdminRes, w ht
 
 		return nil
 
-	case *CreateBookingForAdminConflict:
+	case *AddBookingGroupMemberConflict:
 		w.WriteHeader(409)
 
 		return nil
@@ -141,9 +160,9 @@ func encodeCreateBookingForAdminResponse(response CreateBookingForAdminRes, w ht
 	}
 }
 
-func encodeCreateOrderResponse(response CreateOrderRes, w http.ResponseWriter) error {
+func encodeAllocateSeatsResponse(response AllocateSeatsRes, w http.ResponseWriter) error {
 	switch response := response.(type) {
-	case *Order:
+	case *Allocation:
 		if err := func() error {
 			if err := response.Validate(); err != nil {
 				return err
@@ -180,6 +199,11 @@ func encodeCreateOrderResponse(response CreateOrderRes, w http.ResponseWriter) e
 
 		return nil
 
+	case *AllocateSeatsForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
 	case *Response404:
 		if err := func() error {
 			if err := response.Validate(); err != nil {
@@ -200,15 +224,27 @@ func encodeCreateOrderResponse(response CreateOrderRes, w http.ResponseWriter) e
 
 		return nil
 
+	case *AllocateSeatsConflict:
+		w.WriteHeader(409)
+
+		return nil
+
 	default:
 		return errors.Errorf("unexpected response type: %T", response)
 	}
 }
 
-func encodeDeleteBookingResponse(response DeleteBookingRes, w http.ResponseWriter) error {
+func encodeCreateBookingResponse(response CreateBookingRes, w http.ResponseWriter) error {
 	switch response := response.(type) {
-	case *DeleteBookingNoContent:
-		w.WriteHeader(204)
+	case *Booking:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
 
 		return nil
 
@@ -229,6 +265,11 @@ func encodeDeleteBookingResponse(response DeleteBookingRes, w http.ResponseWrite
 
 		return nil
 
+	case *CreateBookingForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
 	case *Response404:
 		if err := func() error {
 			if err := response.Validate(); err != nil {
@@ -249,15 +290,27 @@ func encodeDeleteBookingResponse(response DeleteBookingRes, w http.ResponseWrite
 
 		return nil
 
+	case *CreateBookingConflict:
+		w.WriteHeader(409)
+
+		return nil
+
 	default:
 		return errors.Errorf("unexpected response type: %T", response)
 	}
 }
 
-func encodeDeleteOrdersResponse(response DeleteOrdersRes, w http.ResponseWriter) error {
+func encodeCreateBookingForAdminResponse(response CreateBookingForAdminRes, w http.ResponseWriter) error {
 	switch response := response.(type) {
-	case *DeleteOrdersNoContent:
-		w.WriteHeader(204)
+	case *Booking:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
 
 		return nil
 
@@ -278,6 +331,11 @@ func encodeDeleteOrdersResponse(response DeleteOrdersRes, w http.ResponseWriter)
 
 		return nil
 
+	case *CreateBookingForAdminForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
 	case *Response404:
 		if err := func() error {
 			if err := response.Validate(); err != nil {
@@ -298,14 +356,19 @@ func encodeDeleteOrdersResponse(response DeleteOrdersRes, w http.ResponseWriter)
 
 		return nil
 
+	case *CreateBookingForAdminConflict:
+		w.WriteHeader(409)
+
+		return nil
+
 	default:
 		return errors.Errorf("unexpected response type: %T", response)
 	}
 }
 
-func encodeGetBookingByIdResponse(response GetBookingByIdRes, w http.ResponseWriter) error {
+func encodeCreateBookingGroupResponse(response CreateBookingGroupRes, w http.ResponseWriter) error {
 	switch response := response.(type) {
-	case *BookingInfo:
+	case *BookingGroup:
 		if err := func() error {
 			if err := response.Validate(); err != nil {
 				return err
@@ -342,6 +405,11 @@ func encodeGetBookingByIdResponse(response GetBookingByIdRes, w http.ResponseWri
 
 		return nil
 
+	case *CreateBookingGroupForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
 	case *Response404:
 		if err := func() error {
 			if err := response.Validate(); err != nil {
@@ -362,14 +430,48 @@ func encodeGetBookingByIdResponse(response GetBookingByIdRes, w http.ResponseWri
 
 		return nil
 
+	case *CreateBookingGroupConflict:
+		w.WriteHeader(409)
+
+		return nil
+
 	default:
 		return errors.Errorf("unexpected response type: %T", response)
 	}
 }
 
-func encodeGetFloorWorkloadResponse(response GetFloorWorkloadRes, w http.ResponseWriter) error {
+func encodeCreateBookingTransferResponse(response CreateBookingTransferRes, w http.ResponseWriter) error {
 	switch response := response.(type) {
-	case *FloorWorkload:
+	case *BookingTransfer:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response400:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *Response404:
 		if err := func() error {
 			if err := response.Validate(); err != nil {
 				return err
@@ -378,6 +480,30 @@ func encodeGetFloorWorkloadResponse(response GetFloorWorkloadRes, w http.Respons
 		}(); err != nil {
 			return errors.Wrap(err, "validate")
 		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *CreateBookingTransferConflict:
+		w.WriteHeader(409)
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeCreateDelegationResponse(response CreateDelegationRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *Delegation:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 
@@ -426,14 +552,19 @@ func encodeGetFloorWorkloadResponse(response GetFloorWorkloadRes, w http.Respons
 
 		return nil
 
+	case *CreateDelegationConflict:
+		w.WriteHeader(409)
+
+		return nil
+
 	default:
 		return errors.Errorf("unexpected response type: %T", response)
 	}
 }
 
-func encodeGetWorkloadResponse(response GetWorkloadRes, w http.ResponseWriter) error {
+func encodeCreateOrderResponse(response CreateOrderRes, w http.ResponseWriter) error {
 	switch response := response.(type) {
-	case *Workload:
+	case *Order:
 		if err := func() error {
 			if err := response.Validate(); err != nil {
 				return err
@@ -495,9 +626,31 @@ func encodeGetWorkloadResponse(response GetWorkloadRes, w http.ResponseWriter) e
 	}
 }
 
-func encodeListAllBookingsResponse(response ListAllBookingsRes, w http.ResponseWriter) error {
+func encodeDeleteBookingResponse(response DeleteBookingRes, w http.ResponseWriter) error {
 	switch response := response.(type) {
-	case *ListAllBookingsOKApplicationJSON:
+	case *DeleteBookingNoContent:
+		w.WriteHeader(204)
+
+		return nil
+
+	case *Response400:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *Response404:
 		if err := func() error {
 			if err := response.Validate(); err != nil {
 				return err
@@ -507,7 +660,7 @@ func encodeListAllBookingsResponse(response ListAllBookingsRes, w http.ResponseW
 			return errors.Wrap(err, "validate")
 		}
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(200)
+		w.WriteHeader(404)
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -517,13 +670,15 @@ func encodeListAllBookingsResponse(response ListAllBookingsRes, w http.ResponseW
 
 		return nil
 
-	case *Response401:
-		w.WriteHeader(401)
-
-		return nil
+	case *Response412:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(412)
 
-	case *ListAllBookingsForbidden:
-		w.WriteHeader(403)
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
 
 		return nil
 
@@ -532,9 +687,19 @@ func encodeListAllBookingsResponse(response ListAllBookingsRes, w http.ResponseW
 	}
 }
 
-func encodeListMyBookingsResponse(response ListMyBookingsRes, w http.ResponseWriter) error {
+func encodeDeleteBookingGroupResponse(response DeleteBookingGroupRes, w http.ResponseWriter) error {
 	switch response := response.(type) {
-	case *ListMyBookingsOKApplicationJSON:
+	case *DeleteBookingGroupNoContent:
+		w.WriteHeader(204)
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *Response404:
 		if err := func() error {
 			if err := response.Validate(); err != nil {
 				return err
@@ -544,7 +709,7 @@ func encodeListMyBookingsResponse(response ListMyBookingsRes, w http.ResponseWri
 			return errors.Wrap(err, "validate")
 		}
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(200)
+		w.WriteHeader(404)
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -554,19 +719,24 @@ func encodeListMyBookingsResponse(response ListMyBookingsRes, w http.ResponseWri
 
 		return nil
 
-	case *Response401:
-		w.WriteHeader(401)
-
-		return nil
-
 	default:
 		return errors.Errorf("unexpected response type: %T", response)
 	}
 }
 
-func encodeListOrdersResponse(response ListOrdersRes, w http.ResponseWriter) error {
+func encodeDeleteBookingTransferResponse(response DeleteBookingTransferRes, w http.ResponseWriter) error {
 	switch response := response.(type) {
-	case *ListOrdersOKApplicationJSON:
+	case *DeleteBookingTransferNoContent:
+		w.WriteHeader(204)
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *Response404:
 		if err := func() error {
 			if err := response.Validate(); err != nil {
 				return err
@@ -576,7 +746,7 @@ func encodeListOrdersResponse(response ListOrdersRes, w http.ResponseWriter) err
 			return errors.Wrap(err, "validate")
 		}
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(200)
+		w.WriteHeader(404)
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -586,15 +756,15 @@ func encodeListOrdersResponse(response ListOrdersRes, w http.ResponseWriter) err
 
 		return nil
 
-	case *Response400:
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(400)
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
 
-		e := new(jx.Encoder)
-		response.Encode(e)
-		if _, err := e.WriteTo(w); err != nil {
-			return errors.Wrap(err, "write")
-		}
+func encodeDeleteDelegationResponse(response DeleteDelegationRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *DeleteDelegationNoContent:
+		w.WriteHeader(204)
 
 		return nil
 
@@ -628,17 +798,10 @@ func encodeListOrdersResponse(response ListOrdersRes, w http.ResponseWriter) err
 	}
 }
 
-func encodeUpdateBookingResponse(response UpdateBookingRes, w http.ResponseWriter) error {
+func encodeDeleteOrdersResponse(response DeleteOrdersRes, w http.ResponseWriter) error {
 	switch response := response.(type) {
-	case *Booking:
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(200)
-
-		e := new(jx.Encoder)
-		response.Encode(e)
-		if _, err := e.WriteTo(w); err != nil {
-			return errors.Wrap(err, "write")
-		}
+	case *DeleteOrdersNoContent:
+		w.WriteHeader(204)
 
 		return nil
 
@@ -659,11 +822,6 @@ func encodeUpdateBookingResponse(response UpdateBookingRes, w http.ResponseWrite
 
 		return nil
 
-	case *UpdateBookingForbidden:
-		w.WriteHeader(403)
-
-		return nil
-
 	case *Response404:
 		if err := func() error {
 			if err := response.Validate(); err != nil {
@@ -684,7 +842,1332 @@ func encodeUpdateBookingResponse(response UpdateBookingRes, w http.ResponseWrite
 
 		return nil
 
-	case *UpdateBookingConflict:
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeDeleteRoleQuotaOverrideResponse(response DeleteRoleQuotaOverrideRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *DeleteRoleQuotaOverrideNoContent:
+		w.WriteHeader(204)
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *DeleteRoleQuotaOverrideForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *Response404:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeDeleteTeamQuotaOverrideResponse(response DeleteTeamQuotaOverrideRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *DeleteTeamQuotaOverrideNoContent:
+		w.WriteHeader(204)
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *DeleteTeamQuotaOverrideForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *Response404:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeDeleteUserQuotaOverrideResponse(response DeleteUserQuotaOverrideRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *DeleteUserQuotaOverrideNoContent:
+		w.WriteHeader(204)
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *DeleteUserQuotaOverrideForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *Response404:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeExtendBookingResponse(response ExtendBookingRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *BookingHeaders:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "ETag" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ETag.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode ETag header")
+				}
+			}
+		}
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response400:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *ExtendBookingForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *Response404:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *ExtendBookingConflict:
+		w.WriteHeader(409)
+
+		return nil
+
+	case *Response412:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(412)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetBookingByIdResponse(response GetBookingByIdRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *BookingInfoHeaders:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "ETag" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ETag.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode ETag header")
+				}
+			}
+		}
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response400:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *Response404:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetBookingGroupResponse(response GetBookingGroupRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *BookingGroup:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *Response404:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetBookingHistoryResponse(response GetBookingHistoryRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *GetBookingHistoryOKApplicationJSON:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response400:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *Response404:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetBuildingWorkloadResponse(response GetBuildingWorkloadRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *FloorWorkload:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response400:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *Response404:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetFloorWorkloadResponse(response GetFloorWorkloadRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *FloorWorkload:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response400:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *Response404:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetMyQuotaResponse(response GetMyQuotaRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *QuotaStatus:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *Response404:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetWorkloadResponse(response GetWorkloadRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *Workload:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response400:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *Response404:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeListAllBookingsResponse(response ListAllBookingsRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *BookingInfoPage:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response400:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *ListAllBookingsForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeListBookingTransfersResponse(response ListBookingTransfersRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *BookingTransferList:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeListDelegationsResponse(response ListDelegationsRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *DelegationList:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeListMyBookingsResponse(response ListMyBookingsRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *BookingInfoPage:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response400:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeListOrdersResponse(response ListOrdersRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *ListOrdersOKApplicationJSON:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response400:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *Response404:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeListQuotaOverridesResponse(response ListQuotaOverridesRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *ListQuotaOverridesOKApplicationJSON:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *ListQuotaOverridesForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeReleaseBookingResponse(response ReleaseBookingRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *BookingHeaders:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "ETag" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ETag.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode ETag header")
+				}
+			}
+		}
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response400:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *Response404:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response412:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(412)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeRemoveBookingGroupMemberResponse(response RemoveBookingGroupMemberRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *RemoveBookingGroupMemberNoContent:
+		w.WriteHeader(204)
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *Response404:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeSetRoleQuotaOverrideResponse(response SetRoleQuotaOverrideRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *QuotaOverride:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *SetRoleQuotaOverrideForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeSetTeamQuotaOverrideResponse(response SetTeamQuotaOverrideRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *QuotaOverride:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *SetTeamQuotaOverrideForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeSetUserQuotaOverrideResponse(response SetUserQuotaOverrideRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *QuotaOverride:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *SetUserQuotaOverrideForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeUpdateBookingResponse(response UpdateBookingRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *BookingHeaders:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "ETag" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ETag.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode ETag header")
+				}
+			}
+		}
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response400:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *UpdateBookingForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *Response404:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *UpdateBookingConflict:
+		w.WriteHeader(409)
+
+		return nil
+
+	case *Response412:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(412)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeUpdateBookingGroupResponse(response UpdateBookingGroupRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *BookingGroup:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response400:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Response401:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *UpdateBookingGroupForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *Response404:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *UpdateBookingGroupConflict:
 		w.WriteHeader(409)
 
 		return nil
@@ -61,139 +61,263 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				break
 			}
 			switch elem[0] {
-			case 'b': // Prefix: "bookings"
+			case 'a': // Prefix: "allocations"
 				origElem := elem
-				if l := len("bookings"); len(elem) >= l && elem[0:l] == "bookings" {
+				if l := len("allocations"); len(elem) >= l && elem[0:l] == "allocations" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
+					// Leaf node.
 					switch r.Method {
-					case "GET":
-						s.handleListAllBookingsRequest([0]string{}, elemIsEscaped, w, r)
 					case "POST":
-						s.handleCreateBookingRequest([0]string{}, elemIsEscaped, w, r)
+						s.handleAllocateSeatsRequest([0]string{}, elemIsEscaped, w, r)
 					default:
-						s.notAllowed(w, r, "GET,POST")
+						s.notAllowed(w, r, "POST")
 					}
 
 					return
 				}
+
+				elem = origElem
+			case 'b': // Prefix: "booking"
+				origElem := elem
+				if l := len("booking"); len(elem) >= l && elem[0:l] == "booking" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
 				switch elem[0] {
-				case '/': // Prefix: "/"
+				case '-': // Prefix: "-groups"
 					origElem := elem
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+					if l := len("-groups"); len(elem) >= l && elem[0:l] == "-groups" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						break
+						switch r.Method {
+						case "POST":
+							s.handleCreateBookingGroupRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
 					}
 					switch elem[0] {
-					case 'a': // Prefix: "admin/"
+					case '/': // Prefix: "/"
 						origElem := elem
-						if l := len("admin/"); len(elem) >= l && elem[0:l] == "admin/" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						// Param: "userId"
-						// Leaf parameter
-						args[0] = elem
-						elem = ""
+						// Param: "groupId"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
 
 						if len(elem) == 0 {
-							// Leaf node.
 							switch r.Method {
-							case "POST":
-								s.handleCreateBookingForAdminRequest([1]string{
+							case "DELETE":
+								s.handleDeleteBookingGroupRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleGetBookingGroupRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PATCH":
+								s.handleUpdateBookingGroupRequest([1]string{
 									args[0],
 								}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "POST")
+								s.notAllowed(w, r, "DELETE,GET,PATCH")
 							}
 
 							return
 						}
+						switch elem[0] {
+						case '/': // Prefix: "/members"
+							origElem := elem
+							if l := len("/members"); len(elem) >= l && elem[0:l] == "/members" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-						elem = origElem
-					case 'm': // Prefix: "my"
-						origElem := elem
-						if l := len("my"); len(elem) >= l && elem[0:l] == "my" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+							if len(elem) == 0 {
+								switch r.Method {
+								case "POST":
+									s.handleAddBookingGroupMemberRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "GET":
-								s.handleListMyBookingsRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET")
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+								origElem := elem
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "userId"
+								// Leaf parameter
+								args[1] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "DELETE":
+										s.handleRemoveBookingGroupMemberRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE")
+									}
+
+									return
+								}
+
+								elem = origElem
 							}
 
-							return
+							elem = origElem
 						}
 
 						elem = origElem
 					}
-					// Param: "bookingId"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
+
+					elem = origElem
+				case 's': // Prefix: "s"
+					origElem := elem
+					if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+						elem = elem[l:]
+					} else {
+						break
 					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
 
 					if len(elem) == 0 {
 						switch r.Method {
-						case "DELETE":
-							s.handleDeleteBookingRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
 						case "GET":
-							s.handleGetBookingByIdRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						case "PATCH":
-							s.handleUpdateBookingRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
+							s.handleListAllBookingsRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleCreateBookingRequest([0]string{}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "DELETE,GET,PATCH")
+							s.notAllowed(w, r, "GET,POST")
 						}
 
 						return
 					}
 					switch elem[0] {
-					case '/': // Prefix: "/orders"
+					case '/': // Prefix: "/"
 						origElem := elem
-						if l := len("/orders"); len(elem) >= l && elem[0:l] == "/orders" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'a': // Prefix: "admin/"
+							origElem := elem
+							if l := len("admin/"); len(elem) >= l && elem[0:l] == "admin/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "userId"
+							// Leaf parameter
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleCreateBookingForAdminRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+							elem = origElem
+						case 'm': // Prefix: "my"
+							origElem := elem
+							if l := len("my"); len(elem) >= l && elem[0:l] == "my" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleListMyBookingsRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+							elem = origElem
+						}
+						// Param: "bookingId"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
 						if len(elem) == 0 {
 							switch r.Method {
+							case "DELETE":
+								s.handleDeleteBookingRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
 							case "GET":
-								s.handleListOrdersRequest([1]string{
+								s.handleGetBookingByIdRequest([1]string{
 									args[0],
 								}, elemIsEscaped, w, r)
-							case "POST":
-								s.handleCreateOrderRequest([1]string{
+							case "PATCH":
+								s.handleUpdateBookingRequest([1]string{
 									args[0],
 								}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "GET,POST")
+								s.notAllowed(w, r, "DELETE,GET,PATCH")
 							}
 
 							return
@@ -207,24 +331,159 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								break
 							}
 
-							// Param: "orderId"
-							// Leaf parameter
-							args[1] = elem
-							elem = ""
-
 							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "DELETE":
-									s.handleDeleteOrdersRequest([2]string{
-										args[0],
-										args[1],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "DELETE")
+								break
+							}
+							switch elem[0] {
+							case 'e': // Prefix: "extend"
+								origElem := elem
+								if l := len("extend"); len(elem) >= l && elem[0:l] == "extend" {
+									elem = elem[l:]
+								} else {
+									break
 								}
 
-								return
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleExtendBookingRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+								elem = origElem
+							case 'h': // Prefix: "history"
+								origElem := elem
+								if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetBookingHistoryRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+								elem = origElem
+							case 'o': // Prefix: "orders"
+								origElem := elem
+								if l := len("orders"); len(elem) >= l && elem[0:l] == "orders" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleListOrdersRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "POST":
+										s.handleCreateOrderRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,POST")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+									origElem := elem
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "orderId"
+									// Leaf parameter
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "DELETE":
+											s.handleDeleteOrdersRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE")
+										}
+
+										return
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
+							case 'r': // Prefix: "release"
+								origElem := elem
+								if l := len("release"); len(elem) >= l && elem[0:l] == "release" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleReleaseBookingRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+								elem = origElem
+							case 't': // Prefix: "transfers"
+								origElem := elem
+								if l := len("transfers"); len(elem) >= l && elem[0:l] == "transfers" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleCreateBookingTransferRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+								elem = origElem
 							}
 
 							elem = origElem
@@ -237,27 +496,36 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				}
 
 				elem = origElem
-			case 'w': // Prefix: "workloads/"
+			case 'd': // Prefix: "delegations"
 				origElem := elem
-				if l := len("workloads/"); len(elem) >= l && elem[0:l] == "workloads/" {
+				if l := len("delegations"); len(elem) >= l && elem[0:l] == "delegations" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					break
+					switch r.Method {
+					case "GET":
+						s.handleListDelegationsRequest([0]string{}, elemIsEscaped, w, r)
+					case "POST":
+						s.handleCreateDelegationRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET,POST")
+					}
+
+					return
 				}
 				switch elem[0] {
-				case 'f': // Prefix: "floors/"
+				case '/': // Prefix: "/"
 					origElem := elem
-					if l := len("floors/"); len(elem) >= l && elem[0:l] == "floors/" {
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
-					// Param: "floorId"
+					// Param: "delegateId"
 					// Leaf parameter
 					args[0] = elem
 					elem = ""
@@ -265,12 +533,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					if len(elem) == 0 {
 						// Leaf node.
 						switch r.Method {
-						case "GET":
-							s.handleGetFloorWorkloadRequest([1]string{
+						case "DELETE":
+							s.handleDeleteDelegationRequest([1]string{
 								args[0],
 							}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "GET")
+							s.notAllowed(w, r, "DELETE")
 						}
 
 						return
@@ -278,43 +546,361 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 					elem = origElem
 				}
-				// Param: "entityId"
-				// Leaf parameter
-				args[0] = elem
-				elem = ""
+
+				elem = origElem
+			case 'q': // Prefix: "quotas/"
+				origElem := elem
+				if l := len("quotas/"); len(elem) >= l && elem[0:l] == "quotas/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
 
 				if len(elem) == 0 {
-					// Leaf node.
-					switch r.Method {
-					case "GET":
-						s.handleGetWorkloadRequest([1]string{
-							args[0],
-						}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "GET")
+					break
+				}
+				switch elem[0] {
+				case 'm': // Prefix: "my"
+					origElem := elem
+					if l := len("my"); len(elem) >= l && elem[0:l] == "my" {
+						elem = elem[l:]
+					} else {
+						break
 					}
 
-					return
-				}
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleGetMyQuotaRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
 
-				elem = origElem
-			}
+						return
+					}
 
-			elem = origElem
-		}
-	}
-	s.notFound(w, r)
-}
+					elem = origElem
+				case 'o': // Prefix: "overrides"
+					origElem := elem
+					if l := len("overrides"); len(elem) >= l && elem[0:l] == "overrides" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-// Route is route object.
-type Route struct {
-	name        string
-	summary     string
-	operationID string
-	pathPattern string
-	count       int
-	args        [2]string
-}
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleListQuotaOverridesRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'r': // Prefix: "roles/"
+							origElem := elem
+							if l := len("roles/"); len(elem) >= l && elem[0:l] == "roles/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "role"
+							// Leaf parameter
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handleDeleteRoleQuotaOverrideRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "PUT":
+									s.handleSetRoleQuotaOverrideRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,PUT")
+								}
+
+								return
+							}
+
+							elem = origElem
+						case 't': // Prefix: "teams/"
+							origElem := elem
+							if l := len("teams/"); len(elem) >= l && elem[0:l] == "teams/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "teamId"
+							// Leaf parameter
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handleDeleteTeamQuotaOverrideRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "PUT":
+									s.handleSetTeamQuotaOverrideRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,PUT")
+								}
+
+								return
+							}
+
+							elem = origElem
+						case 'u': // Prefix: "users/"
+							origElem := elem
+							if l := len("users/"); len(elem) >= l && elem[0:l] == "users/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "userId"
+							// Leaf parameter
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handleDeleteUserQuotaOverrideRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "PUT":
+									s.handleSetUserQuotaOverrideRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,PUT")
+								}
+
+								return
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 't': // Prefix: "transfers"
+				origElem := elem
+				if l := len("transfers"); len(elem) >= l && elem[0:l] == "transfers" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "GET":
+						s.handleListBookingTransfersRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+					origElem := elem
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "transferId"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "DELETE":
+							s.handleDeleteBookingTransferRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "DELETE")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/accept"
+						origElem := elem
+						if l := len("/accept"); len(elem) >= l && elem[0:l] == "/accept" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleAcceptBookingTransferRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 'w': // Prefix: "workloads/"
+				origElem := elem
+				if l := len("workloads/"); len(elem) >= l && elem[0:l] == "workloads/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'b': // Prefix: "buildings/"
+					origElem := elem
+					if l := len("buildings/"); len(elem) >= l && elem[0:l] == "buildings/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "buildingId"
+					// Leaf parameter
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleGetBuildingWorkloadRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+					elem = origElem
+				case 'f': // Prefix: "floors/"
+					origElem := elem
+					if l := len("floors/"); len(elem) >= l && elem[0:l] == "floors/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "floorId"
+					// Leaf parameter
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleGetFloorWorkloadRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+					elem = origElem
+				}
+				// Param: "entityId"
+				// Leaf parameter
+				args[0] = elem
+				elem = ""
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "GET":
+						s.handleGetWorkloadRequest([1]string{
+							args[0],
+						}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
+				}
+
+				elem = origElem
+			}
+
+			elem = origElem
+		}
+	}
+	s.notFound(w, r)
+}
+
+// Route is route object.
+type Route struct {
+	name        string
+	summary     string
+	operationID string
+	pathPattern string
+	count       int
+	args        [2]string
+}
 
 // Name returns ogen operation name.
 //
@@ -389,13 +975,510 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				break
 			}
 
-			if len(elem) == 0 {
-				break
-			}
-			switch elem[0] {
-			case 'b': // Prefix: "bookings"
+			if len(elem) == 0 {
+				break
+			}
+			switch elem[0] {
+			case 'a': // Prefix: "allocations"
+				origElem := elem
+				if l := len("allocations"); len(elem) >= l && elem[0:l] == "allocations" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch method {
+					case "POST":
+						r.name = AllocateSeatsOperation
+						r.summary = "Подобрать свободные места рядом друг с другом"
+						r.operationID = "allocateSeats"
+						r.pathPattern = "/allocations"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+
+				elem = origElem
+			case 'b': // Prefix: "booking"
+				origElem := elem
+				if l := len("booking"); len(elem) >= l && elem[0:l] == "booking" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '-': // Prefix: "-groups"
+					origElem := elem
+					if l := len("-groups"); len(elem) >= l && elem[0:l] == "-groups" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "POST":
+							r.name = CreateBookingGroupOperation
+							r.summary = "Создать групповое бронирование"
+							r.operationID = "createBookingGroup"
+							r.pathPattern = "/booking-groups"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "groupId"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch method {
+							case "DELETE":
+								r.name = DeleteBookingGroupOperation
+								r.summary = "Отменить групповое бронирование"
+								r.operationID = "deleteBookingGroup"
+								r.pathPattern = "/booking-groups/{groupId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								r.name = GetBookingGroupOperation
+								r.summary = "Получить групповое бронирование по ID"
+								r.operationID = "getBookingGroup"
+								r.pathPattern = "/booking-groups/{groupId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PATCH":
+								r.name = UpdateBookingGroupOperation
+								r.summary = "Перенести групповое бронирование"
+								r.operationID = "updateBookingGroup"
+								r.pathPattern = "/booking-groups/{groupId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/members"
+							origElem := elem
+							if l := len("/members"); len(elem) >= l && elem[0:l] == "/members" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									r.name = AddBookingGroupMemberOperation
+									r.summary = "Добавить участника в групповое бронирование"
+									r.operationID = "addBookingGroupMember"
+									r.pathPattern = "/booking-groups/{groupId}/members"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+								origElem := elem
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "userId"
+								// Leaf parameter
+								args[1] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "DELETE":
+										r.name = RemoveBookingGroupMemberOperation
+										r.summary = "Удалить участника из группового бронирования"
+										r.operationID = "removeBookingGroupMember"
+										r.pathPattern = "/booking-groups/{groupId}/members/{userId}"
+										r.args = args
+										r.count = 2
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				case 's': // Prefix: "s"
+					origElem := elem
+					if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = ListAllBookingsOperation
+							r.summary = "Получить список всех бронирований (только для админа)"
+							r.operationID = "listAllBookings"
+							r.pathPattern = "/bookings"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = CreateBookingOperation
+							r.summary = "Создать бронирование"
+							r.operationID = "createBooking"
+							r.pathPattern = "/bookings"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'a': // Prefix: "admin/"
+							origElem := elem
+							if l := len("admin/"); len(elem) >= l && elem[0:l] == "admin/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "userId"
+							// Leaf parameter
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = CreateBookingForAdminOperation
+									r.summary = "Создать бронирование"
+									r.operationID = "createBookingForAdmin"
+									r.pathPattern = "/bookings/admin/{userId}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 'm': // Prefix: "my"
+							origElem := elem
+							if l := len("my"); len(elem) >= l && elem[0:l] == "my" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = ListMyBookingsOperation
+									r.summary = "Получить список моих бронирований"
+									r.operationID = "listMyBookings"
+									r.pathPattern = "/bookings/my"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						}
+						// Param: "bookingId"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch method {
+							case "DELETE":
+								r.name = DeleteBookingOperation
+								r.summary = "Удалить бронирование по ID"
+								r.operationID = "deleteBooking"
+								r.pathPattern = "/bookings/{bookingId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								r.name = GetBookingByIdOperation
+								r.summary = "Получить бронирование по ID"
+								r.operationID = "getBookingById"
+								r.pathPattern = "/bookings/{bookingId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PATCH":
+								r.name = UpdateBookingOperation
+								r.summary = "Обновить бронирование по ID"
+								r.operationID = "updateBooking"
+								r.pathPattern = "/bookings/{bookingId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+							origElem := elem
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'e': // Prefix: "extend"
+								origElem := elem
+								if l := len("extend"); len(elem) >= l && elem[0:l] == "extend" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = ExtendBookingOperation
+										r.summary = "Продлить активное бронирование"
+										r.operationID = "extendBooking"
+										r.pathPattern = "/bookings/{bookingId}/extend"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							case 'h': // Prefix: "history"
+								origElem := elem
+								if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetBookingHistoryOperation
+										r.summary = "Получить историю бронирования"
+										r.operationID = "getBookingHistory"
+										r.pathPattern = "/bookings/{bookingId}/history"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							case 'o': // Prefix: "orders"
+								origElem := elem
+								if l := len("orders"); len(elem) >= l && elem[0:l] == "orders" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = ListOrdersOperation
+										r.summary = "Получить список заказов"
+										r.operationID = "listOrders"
+										r.pathPattern = "/bookings/{bookingId}/orders"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "POST":
+										r.name = CreateOrderOperation
+										r.summary = "Создать заказ"
+										r.operationID = "createOrder"
+										r.pathPattern = "/bookings/{bookingId}/orders"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+									origElem := elem
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "orderId"
+									// Leaf parameter
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "DELETE":
+											r.name = DeleteOrdersOperation
+											r.summary = "Удалить заказ"
+											r.operationID = "deleteOrders"
+											r.pathPattern = "/bookings/{bookingId}/orders/{orderId}"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
+							case 'r': // Prefix: "release"
+								origElem := elem
+								if l := len("release"); len(elem) >= l && elem[0:l] == "release" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = ReleaseBookingOperation
+										r.summary = "Досрочно завершить активное бронирование"
+										r.operationID = "releaseBooking"
+										r.pathPattern = "/bookings/{bookingId}/release"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							case 't': // Prefix: "transfers"
+								origElem := elem
+								if l := len("transfers"); len(elem) >= l && elem[0:l] == "transfers" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = CreateBookingTransferOperation
+										r.summary = "Предложить передачу бронирования"
+										r.operationID = "createBookingTransfer"
+										r.pathPattern = "/bookings/{bookingId}/transfers"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 'd': // Prefix: "delegations"
 				origElem := elem
-				if l := len("bookings"); len(elem) >= l && elem[0:l] == "bookings" {
+				if l := len("delegations"); len(elem) >= l && elem[0:l] == "delegations" {
 					elem = elem[l:]
 				} else {
 					break
@@ -404,18 +1487,18 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				if len(elem) == 0 {
 					switch method {
 					case "GET":
-						r.name = ListAllBookingsOperation
-						r.summary = "Получить список всех бронирований (только для админа)"
-						r.operationID = "listAllBookings"
-						r.pathPattern = "/bookings"
+						r.name = ListDelegationsOperation
+						r.summary = "Получить список делегирований"
+						r.operationID = "listDelegations"
+						r.pathPattern = "/delegations"
 						r.args = args
 						r.count = 0
 						return r, true
 					case "POST":
-						r.name = CreateBookingOperation
-						r.summary = "Создать бронирование"
-						r.operationID = "createBooking"
-						r.pathPattern = "/bookings"
+						r.name = CreateDelegationOperation
+						r.summary = "Выдать право бронировать от своего имени"
+						r.operationID = "createDelegation"
+						r.pathPattern = "/delegations"
 						r.args = args
 						r.count = 0
 						return r, true
@@ -432,67 +1515,258 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						break
 					}
 
+					// Param: "delegateId"
+					// Leaf parameter
+					args[0] = elem
+					elem = ""
+
 					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "DELETE":
+							r.name = DeleteDelegationOperation
+							r.summary = "Отозвать право бронировать от своего имени"
+							r.operationID = "deleteDelegation"
+							r.pathPattern = "/delegations/{delegateId}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 'q': // Prefix: "quotas/"
+				origElem := elem
+				if l := len("quotas/"); len(elem) >= l && elem[0:l] == "quotas/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'm': // Prefix: "my"
+					origElem := elem
+					if l := len("my"); len(elem) >= l && elem[0:l] == "my" {
+						elem = elem[l:]
+					} else {
 						break
 					}
-					switch elem[0] {
-					case 'a': // Prefix: "admin/"
-						origElem := elem
-						if l := len("admin/"); len(elem) >= l && elem[0:l] == "admin/" {
-							elem = elem[l:]
-						} else {
-							break
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = GetMyQuotaOperation
+							r.summary = "Получить остаток своей квоты"
+							r.operationID = "getMyQuota"
+							r.pathPattern = "/quotas/my"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
 						}
+					}
 
-						// Param: "userId"
-						// Leaf parameter
-						args[0] = elem
-						elem = ""
+					elem = origElem
+				case 'o': // Prefix: "overrides"
+					origElem := elem
+					if l := len("overrides"); len(elem) >= l && elem[0:l] == "overrides" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "POST":
-								r.name = CreateBookingForAdminOperation
-								r.summary = "Создать бронирование"
-								r.operationID = "createBookingForAdmin"
-								r.pathPattern = "/bookings/admin/{userId}"
-								r.args = args
-								r.count = 1
-								return r, true
-							default:
-								return
-							}
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = ListQuotaOverridesOperation
+							r.summary = "Получить переопределения квот (только для админа)"
+							r.operationID = "listQuotaOverrides"
+							r.pathPattern = "/quotas/overrides"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
 						}
-
-						elem = origElem
-					case 'm': // Prefix: "my"
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
 						origElem := elem
-						if l := len("my"); len(elem) >= l && elem[0:l] == "my" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "GET":
-								r.name = ListMyBookingsOperation
-								r.summary = "Получить список моих бронирований"
-								r.operationID = "listMyBookings"
-								r.pathPattern = "/bookings/my"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
+							break
+						}
+						switch elem[0] {
+						case 'r': // Prefix: "roles/"
+							origElem := elem
+							if l := len("roles/"); len(elem) >= l && elem[0:l] == "roles/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "role"
+							// Leaf parameter
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = DeleteRoleQuotaOverrideOperation
+									r.summary = "Удалить переопределение квоты роли (только для админа)"
+									r.operationID = "deleteRoleQuotaOverride"
+									r.pathPattern = "/quotas/overrides/roles/{role}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PUT":
+									r.name = SetRoleQuotaOverrideOperation
+									r.summary = "Переопределить квоту роли (только для админа)"
+									r.operationID = "setRoleQuotaOverride"
+									r.pathPattern = "/quotas/overrides/roles/{role}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 't': // Prefix: "teams/"
+							origElem := elem
+							if l := len("teams/"); len(elem) >= l && elem[0:l] == "teams/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "teamId"
+							// Leaf parameter
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = DeleteTeamQuotaOverrideOperation
+									r.summary = "Удалить переопределение квоты команды (только для админа)"
+									r.operationID = "deleteTeamQuotaOverride"
+									r.pathPattern = "/quotas/overrides/teams/{teamId}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PUT":
+									r.name = SetTeamQuotaOverrideOperation
+									r.summary = "Переопределить квоту команды (только для админа)"
+									r.operationID = "setTeamQuotaOverride"
+									r.pathPattern = "/quotas/overrides/teams/{teamId}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 'u': // Prefix: "users/"
+							origElem := elem
+							if l := len("users/"); len(elem) >= l && elem[0:l] == "users/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "userId"
+							// Leaf parameter
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = DeleteUserQuotaOverrideOperation
+									r.summary = "Удалить переопределение квоты пользователя (только для админа)"
+									r.operationID = "deleteUserQuotaOverride"
+									r.pathPattern = "/quotas/overrides/users/{userId}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PUT":
+									r.name = SetUserQuotaOverrideOperation
+									r.summary = "Переопределить квоту пользователя (только для админа)"
+									r.operationID = "setUserQuotaOverride"
+									r.pathPattern = "/quotas/overrides/users/{userId}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
 							}
+
+							elem = origElem
 						}
 
 						elem = origElem
 					}
-					// Param: "bookingId"
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 't': // Prefix: "transfers"
+				origElem := elem
+				if l := len("transfers"); len(elem) >= l && elem[0:l] == "transfers" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = ListBookingTransfersOperation
+						r.summary = "Получить список предложений передачи"
+						r.operationID = "listBookingTransfers"
+						r.pathPattern = "/transfers"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+					origElem := elem
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "transferId"
 					// Match until "/"
 					idx := strings.IndexByte(elem, '/')
 					if idx < 0 {
@@ -504,26 +1778,10 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					if len(elem) == 0 {
 						switch method {
 						case "DELETE":
-							r.name = DeleteBookingOperation
-							r.summary = "Удалить бронирование по ID"
-							r.operationID = "deleteBooking"
-							r.pathPattern = "/bookings/{bookingId}"
-							r.args = args
-							r.count = 1
-							return r, true
-						case "GET":
-							r.name = GetBookingByIdOperation
-							r.summary = "Получить бронирование по ID"
-							r.operationID = "getBookingById"
-							r.pathPattern = "/bookings/{bookingId}"
-							r.args = args
-							r.count = 1
-							return r, true
-						case "PATCH":
-							r.name = UpdateBookingOperation
-							r.summary = "Обновить бронирование по ID"
-							r.operationID = "updateBooking"
-							r.pathPattern = "/bookings/{bookingId}"
+							r.name = DeleteBookingTransferOperation
+							r.summary = "Отозвать или отклонить предложение передачи"
+							r.operationID = "deleteBookingTransfer"
+							r.pathPattern = "/transfers/{transferId}"
 							r.args = args
 							r.count = 1
 							return r, true
@@ -532,29 +1790,22 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 					}
 					switch elem[0] {
-					case '/': // Prefix: "/orders"
+					case '/': // Prefix: "/accept"
 						origElem := elem
-						if l := len("/orders"); len(elem) >= l && elem[0:l] == "/orders" {
+						if l := len("/accept"); len(elem) >= l && elem[0:l] == "/accept" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
+							// Leaf node.
 							switch method {
-							case "GET":
-								r.name = ListOrdersOperation
-								r.summary = "Получить список заказов"
-								r.operationID = "listOrders"
-								r.pathPattern = "/bookings/{bookingId}/orders"
-								r.args = args
-								r.count = 1
-								return r, true
 							case "POST":
-								r.name = CreateOrderOperation
-								r.summary = "Создать заказ"
-								r.operationID = "createOrder"
-								r.pathPattern = "/bookings/{bookingId}/orders"
+								r.name = AcceptBookingTransferOperation
+								r.summary = "Принять предложение передачи"
+								r.operationID = "acceptBookingTransfer"
+								r.pathPattern = "/transfers/{transferId}/accept"
 								r.args = args
 								r.count = 1
 								return r, true
@@ -562,38 +1813,6 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								return
 							}
 						}
-						switch elem[0] {
-						case '/': // Prefix: "/"
-							origElem := elem
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							// Param: "orderId"
-							// Leaf parameter
-							args[1] = elem
-							elem = ""
-
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "DELETE":
-									r.name = DeleteOrdersOperation
-									r.summary = "Удалить заказ"
-									r.operationID = "deleteOrders"
-									r.pathPattern = "/bookings/{bookingId}/orders/{orderId}"
-									r.args = args
-									r.count = 2
-									return r, true
-								default:
-									return
-								}
-							}
-
-							elem = origElem
-						}
 
 						elem = origElem
 					}
@@ -614,6 +1833,36 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					break
 				}
 				switch elem[0] {
+				case 'b': // Prefix: "buildings/"
+					origElem := elem
+					if l := len("buildings/"); len(elem) >= l && elem[0:l] == "buildings/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "buildingId"
+					// Leaf parameter
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = GetBuildingWorkloadOperation
+							r.summary = "Получить нагрузку на здание"
+							r.operationID = "getBuildingWorkload"
+							r.pathPattern = "/workloads/buildings/{buildingId}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
 				case 'f': // Prefix: "floors/"
 					origElem := elem
 					if l := len("floors/"); len(elem) >= l && elem[0:l] == "floors/" {
@@ -7,6 +7,217 @@ import (
 	"github.com/google/uuid"
 )
 
+// AcceptBookingTransferConflict is response for AcceptBookingTransfer operation.
+type AcceptBookingTransferConflict struct{}
+
+func (*AcceptBookingTransferConflict) acceptBookingTransferRes() {}
+
+// AcceptBookingTransferForbidden is response for AcceptBookingTransfer operation.
+type AcceptBookingTransferForbidden struct{}
+
+func (*AcceptBookingTransferForbidden) acceptBookingTransferRes() {}
+
+type AcceptBookingTransferOKApplicationJSON []Booking
+
+func (*AcceptBookingTransferOKApplicationJSON) acceptBookingTransferRes() {}
+
+// AddBookingGroupMemberConflict is response for AddBookingGroupMember operation.
+type AddBookingGroupMemberConflict struct{}
+
+func (*AddBookingGroupMemberConflict) addBookingGroupMemberRes() {}
+
+// AddBookingGroupMemberForbidden is response for AddBookingGroupMember operation.
+type AddBookingGroupMemberForbidden struct{}
+
+func (*AddBookingGroupMemberForbidden) addBookingGroupMemberRes() {}
+
+// AllocateSeatsConflict is response for AllocateSeats operation.
+type AllocateSeatsConflict struct{}
+
+func (*AllocateSeatsConflict) allocateSeatsRes() {}
+
+// AllocateSeatsForbidden is response for AllocateSeats operation.
+type AllocateSeatsForbidden struct{}
+
+func (*AllocateSeatsForbidden) allocateSeatsRes() {}
+
+// Ref: #/components/schemas/AllocatedSeats
+type AllocatedSeats struct {
+	Entity BookingEntity `json:"entity"`
+	// Количество мест, подобранных в этом открытом
+	// пространстве.
+	Places int `json:"places"`
+}
+
+// GetEntity returns the value of Entity.
+func (s *AllocatedSeats) GetEntity() BookingEntity {
+	return s.Entity
+}
+
+// GetPlaces returns the value of Places.
+func (s *AllocatedSeats) GetPlaces() int {
+	return s.Places
+}
+
+// SetEntity sets the value of Entity.
+func (s *AllocatedSeats) SetEntity(val BookingEntity) {
+	s.Entity = val
+}
+
+// SetPlaces sets the value of Places.
+func (s *AllocatedSeats) SetPlaces(val int) {
+	s.Places = val
+}
+
+// Ref: #/components/schemas/Allocation
+type Allocation struct {
+	// Подобранные места.
+	Seats []AllocatedSeats `json:"seats"`
+	// Групповое бронирование, если места были
+	// забронированы.
+	Group OptBookingGroup `json:"group"`
+}
+
+// GetSeats returns the value of Seats.
+func (s *Allocation) GetSeats() []AllocatedSeats {
+	return s.Seats
+}
+
+// GetGroup returns the value of Group.
+func (s *Allocation) GetGroup() OptBookingGroup {
+	return s.Group
+}
+
+// SetSeats sets the value of Seats.
+func (s *Allocation) SetSeats(val []AllocatedSeats) {
+	s.Seats = val
+}
+
+// SetGroup sets the value of Group.
+func (s *Allocation) SetGroup(val OptBookingGroup) {
+	s.Group = val
+}
+
+func (*Allocation) allocateSeatsRes() {}
+
+// Ref: #/components/schemas/AllocationRequest
+type AllocationRequest struct {
+	// Уникальный идентификатор этажа.
+	FloorID uuid.UUID `json:"floor_id"`
+	// Время начала бронирования (в секундах, Unix timestamp).
+	TimeFrom Time `json:"time_from"`
+	// Время окончания бронирования (в секундах, Unix timestamp).
+	TimeTo Time `json:"time_to"`
+	// Количество мест.
+	Seats int `json:"seats"`
+	// Подбирать только места, у которых есть все указанные
+	// удобства.
+	Amenities []uuid.UUID `json:"amenities"`
+	// Участники, для которых бронируются места; количество
+	// должно совпадать с seats.
+	Attendees []uuid.UUID `json:"attendees"`
+	// Сразу забронировать подобранные места.
+	Book OptBool `json:"book"`
+}
+
+// GetFloorID returns the value of FloorID.
+func (s *AllocationRequest) GetFloorID() uuid.UUID {
+	return s.FloorID
+}
+
+// GetTimeFrom returns the value of TimeFrom.
+func (s *AllocationRequest) GetTimeFrom() Time {
+	return s.TimeFrom
+}
+
+// GetTimeTo returns the value of TimeTo.
+func (s *AllocationRequest) GetTimeTo() Time {
+	return s.TimeTo
+}
+
+// GetSeats returns the value of Seats.
+func (s *AllocationRequest) GetSeats() int {
+	return s.Seats
+}
+
+// GetAmenities returns the value of Amenities.
+func (s *AllocationRequest) GetAmenities() []uuid.UUID {
+	return s.Amenities
+}
+
+// GetAttendees returns the value of Attendees.
+func (s *AllocationRequest) GetAttendees() []uuid.UUID {
+	return s.Attendees
+}
+
+// GetBook returns the value of Book.
+func (s *AllocationRequest) GetBook() OptBool {
+	return s.Book
+}
+
+// SetFloorID sets the value of FloorID.
+func (s *AllocationRequest) SetFloorID(val uuid.UUID) {
+	s.FloorID = val
+}
+
+// SetTimeFrom sets the value of TimeFrom.
+func (s *AllocationRequest) SetTimeFrom(val Time) {
+	s.TimeFrom = val
+}
+
+// SetTimeTo sets the value of TimeTo.
+func (s *AllocationRequest) SetTimeTo(val Time) {
+	s.TimeTo = val
+}
+
+// SetSeats sets the value of Seats.
+func (s *AllocationRequest) SetSeats(val int) {
+	s.Seats = val
+}
+
+// SetAmenities sets the value of Amenities.
+func (s *AllocationRequest) SetAmenities(val []uuid.UUID) {
+	s.Amenities = val
+}
+
+// SetAttendees sets the value of Attendees.
+func (s *AllocationRequest) SetAttendees(val []uuid.UUID) {
+	s.Attendees = val
+}
+
+// SetBook sets the value of Book.
+func (s *AllocationRequest) SetBook(val OptBool) {
+	s.Book = val
+}
+
+// Ref: #/components/schemas/Amenity
+type Amenity struct {
+	// Уникальный идентификатор удобства.
+	ID uuid.UUID `json:"id"`
+	// Название удобства.
+	Name string `json:"name"`
+}
+
+// GetID returns the value of ID.
+func (s *Amenity) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Amenity) GetName() string {
+	return s.Name
+}
+
+// SetID sets the value of ID.
+func (s *Amenity) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Amenity) SetName(val string) {
+	s.Name = val
+}
+
 type BearerAuth struct {
 	Token string
 }
@@ -27,9 +238,15 @@ type Booking struct {
 	ID uuid.UUID `json:"id"`
 	// Уникальный идентификатор рабочего места.
 	EntityID uuid.UUID `json:"entity_id"`
+	// Уникальный идентификатор пользователя, для которого
+	// создано бронирование.
+	UserID uuid.UUID `json:"user_id"`
 	// Уникальный идентификатор пользователя, создавшего
 	// бронирование.
-	UserID uuid.UUID `json:"user_id"`
+	BookedBy uuid.UUID `json:"booked_by"`
+	// Уникальный идентификатор группового бронирования,
+	// если бронирование входит в группу.
+	GroupID OptUUID `json:"group_id"`
 	// Время начала бронирования (в секундах, Unix timestamp).
 	TimeFrom Time `json:"time_from"`
 	// Время окончания бронирования (в секундах, Unix timestamp).
@@ -39,6 +256,9 @@ type Booking struct {
 	// Время последнего обновления бронирования (в секундах,
 	//  Unix timestamp).
 	UpdatedAt Time `json:"updated_at"`
+	// Версия бронирования, увеличивается при каждом
+	// изменении.
+	Version int `json:"version"`
 }
 
 // GetID returns the value of ID.
@@ -56,6 +276,16 @@ func (s *Booking) GetUserID() uuid.UUID {
 	return s.UserID
 }
 
+// GetBookedBy returns the value of BookedBy.
+func (s *Booking) GetBookedBy() uuid.UUID {
+	return s.BookedBy
+}
+
+// GetGroupID returns the value of GroupID.
+func (s *Booking) GetGroupID() OptUUID {
+	return s.GroupID
+}
+
 // GetTimeFrom returns the value of TimeFrom.
 func (s *Booking) GetTimeFrom() Time {
 	return s.TimeFrom
@@ -76,6 +306,11 @@ func (s *Booking) GetUpdatedAt() Time {
 	return s.UpdatedAt
 }
 
+// GetVersion returns the value of Version.
+func (s *Booking) GetVersion() int {
+	return s.Version
+}
+
 // SetID sets the value of ID.
 func (s *Booking) SetID(val uuid.UUID) {
 	s.ID = val
@@ -91,6 +326,16 @@ func (s *Booking) SetUserID(val uuid.UUID) {
 	s.UserID = val
 }
 
+// SetBookedBy sets the value of BookedBy.
+func (s *Booking) SetBookedBy(val uuid.UUID) {
+	s.BookedBy = val
+}
+
+// SetGroupID sets the value of GroupID.
+func (s *Booking) SetGroupID(val OptUUID) {
+	s.GroupID = val
+}
+
 // SetTimeFrom sets the value of TimeFrom.
 func (s *Booking) SetTimeFrom(val Time) {
 	s.TimeFrom = val
@@ -111,9 +356,13 @@ func (s *Booking) SetUpdatedAt(val Time) {
 	s.UpdatedAt = val
 }
 
+// SetVersion sets the value of Version.
+func (s *Booking) SetVersion(val int) {
+	s.Version = val
+}
+
 func (*Booking) createBookingForAdminRes() {}
 func (*Booking) createBookingRes()         {}
-func (*Booking) updateBookingRes()         {}
 
 // Ref: #/components/schemas/BookingCreate
 type BookingCreate struct {
@@ -123,6 +372,9 @@ type BookingCreate struct {
 	TimeFrom Time `json:"time_from"`
 	// Время окончания бронирования (в секундах, Unix timestamp).
 	TimeTo Time `json:"time_to"`
+	// Уникальный идентификатор пользователя, для которого
+	// создается бронирование.
+	OnBehalfOf OptUUID `json:"on_behalf_of"`
 }
 
 // GetEntityID returns the value of EntityID.
@@ -140,6 +392,11 @@ func (s *BookingCreate) GetTimeTo() Time {
 	return s.TimeTo
 }
 
+// GetOnBehalfOf returns the value of OnBehalfOf.
+func (s *BookingCreate) GetOnBehalfOf() OptUUID {
+	return s.OnBehalfOf
+}
+
 // SetEntityID sets the value of EntityID.
 func (s *BookingCreate) SetEntityID(val uuid.UUID) {
 	s.EntityID = val
@@ -155,19 +412,29 @@ func (s *BookingCreate) SetTimeTo(val Time) {
 	s.TimeTo = val
 }
 
+// SetOnBehalfOf sets the value of OnBehalfOf.
+func (s *BookingCreate) SetOnBehalfOf(val OptUUID) {
+	s.OnBehalfOf = val
+}
+
 // Ref: #/components/schemas/BookingEntity
 type BookingEntity struct {
-	ID        uuid.UUID         `json:"id"`
-	Type      BookingEntityType `json:"type"`
-	Title     string            `json:"title"`
-	X         int               `json:"x"`
-	Y         int               `json:"y"`
-	FloorID   uuid.UUID         `json:"floor_id"`
-	Width     int               `json:"width"`
-	Height    int               `json:"height"`
-	Capacity  int               `json:"capacity"`
-	CreatedAt Time              `json:"created_at"`
-	UpdatedAt Time              `json:"updated_at"`
+	ID       uuid.UUID         `json:"id"`
+	Type     BookingEntityType `json:"type"`
+	Title    string            `json:"title"`
+	X        int               `json:"x"`
+	Y        int               `json:"y"`
+	FloorID  uuid.UUID         `json:"floor_id"`
+	Width    int               `json:"width"`
+	Height   int               `json:"height"`
+	Capacity int               `json:"capacity"`
+	// Премиальное рабочее место, бронирование которого
+	// ограничено отдельной квотой.
+	IsPremium bool `json:"is_premium"`
+	// Удобства рабочего места.
+	Amenities []Amenity `json:"amenities"`
+	CreatedAt Time      `json:"created_at"`
+	UpdatedAt Time      `json:"updated_at"`
 }
 
 // GetID returns the value of ID.
@@ -215,6 +482,16 @@ func (s *BookingEntity) GetCapacity() int {
 	return s.Capacity
 }
 
+// GetIsPremium returns the value of IsPremium.
+func (s *BookingEntity) GetIsPremium() bool {
+	return s.IsPremium
+}
+
+// GetAmenities returns the value of Amenities.
+func (s *BookingEntity) GetAmenities() []Amenity {
+	return s.Amenities
+}
+
 // GetCreatedAt returns the value of CreatedAt.
 func (s *BookingEntity) GetCreatedAt() Time {
 	return s.CreatedAt
@@ -270,6 +547,16 @@ func (s *BookingEntity) SetCapacity(val int) {
 	s.Capacity = val
 }
 
+// SetIsPremium sets the value of IsPremium.
+func (s *BookingEntity) SetIsPremium(val bool) {
+	s.IsPremium = val
+}
+
+// SetAmenities sets the value of Amenities.
+func (s *BookingEntity) SetAmenities(val []Amenity) {
+	s.Amenities = val
+}
+
 // SetCreatedAt sets the value of CreatedAt.
 func (s *BookingEntity) SetCreatedAt(val Time) {
 	s.CreatedAt = val
@@ -321,110 +608,186 @@ func (s *BookingEntityType) UnmarshalText(data []byte) error {
 	}
 }
 
-// Ref: #/components/schemas/BookingInfo
-type BookingInfo struct {
-	// Уникальный идентификатор бронирования.
-	ID     uuid.UUID     `json:"id"`
-	Entity BookingEntity `json:"entity"`
-	// Информация о пользователе, создавшем бронирование.
-	User User `json:"user"`
+// Ref: #/components/schemas/BookingExtend
+type BookingExtend struct {
+	// Количество 15-минутных слотов, на которое
+	// продлевается бронирование.
+	Slots int `json:"slots"`
+}
+
+// GetSlots returns the value of Slots.
+func (s *BookingExtend) GetSlots() int {
+	return s.Slots
+}
+
+// SetSlots sets the value of Slots.
+func (s *BookingExtend) SetSlots(val int) {
+	s.Slots = val
+}
+
+// Ref: #/components/schemas/BookingGroup
+type BookingGroup struct {
+	// Уникальный идентификатор группового бронирования.
+	ID uuid.UUID `json:"id"`
+	// Уникальный идентификатор пользователя, создавшего
+	// групповое бронирование.
+	OwnerID uuid.UUID `json:"owner_id"`
 	// Время начала бронирования (в секундах, Unix timestamp).
 	TimeFrom Time `json:"time_from"`
 	// Время окончания бронирования (в секундах, Unix timestamp).
 	TimeTo Time `json:"time_to"`
-	// Список заказов, связанных с бронированием.
-	Orders []Order `json:"orders"`
-	// Время создания бронирования (в секундах, Unix timestamp).
-	CreatedAt Time `json:"created_at"`
-	// Время последнего обновления бронирования (в секундах,
-	//  Unix timestamp).
-	UpdatedAt Time `json:"updated_at"`
+	// Бронирования группы.
+	Bookings  []Booking `json:"bookings"`
+	CreatedAt Time      `json:"created_at"`
+	UpdatedAt Time      `json:"updated_at"`
 }
 
 // GetID returns the value of ID.
-func (s *BookingInfo) GetID() uuid.UUID {
+func (s *BookingGroup) GetID() uuid.UUID {
 	return s.ID
 }
 
-// GetEntity returns the value of Entity.
-func (s *BookingInfo) GetEntity() BookingEntity {
-	return s.Entity
-}
-
-// GetUser returns the value of User.
-func (s *BookingInfo) GetUser() User {
-	return s.User
+// GetOwnerID returns the value of OwnerID.
+func (s *BookingGroup) GetOwnerID() uuid.UUID {
+	return s.OwnerID
 }
 
 // GetTimeFrom returns the value of TimeFrom.
-func (s *BookingInfo) GetTimeFrom() Time {
+func (s *BookingGroup) GetTimeFrom() Time {
 	return s.TimeFrom
 }
 
 // GetTimeTo returns the value of TimeTo.
-func (s *BookingInfo) GetTimeTo() Time {
+func (s *BookingGroup) GetTimeTo() Time {
 	return s.TimeTo
 }
 
-// GetOrders returns the value of Orders.
-func (s *BookingInfo) GetOrders() []Order {
-	return s.Orders
+// GetBookings returns the value of Bookings.
+func (s *BookingGroup) GetBookings() []Booking {
+	return s.Bookings
 }
 
 // GetCreatedAt returns the value of CreatedAt.
-func (s *BookingInfo) GetCreatedAt() Time {
+func (s *BookingGroup) GetCreatedAt() Time {
 	return s.CreatedAt
 }
 
 // GetUpdatedAt returns the value of UpdatedAt.
-func (s *BookingInfo) GetUpdatedAt() Time {
+func (s *BookingGroup) GetUpdatedAt() Time {
 	return s.UpdatedAt
 }
 
 // SetID sets the value of ID.
-func (s *BookingInfo) SetID(val uuid.UUID) {
+func (s *BookingGroup) SetID(val uuid.UUID) {
 	s.ID = val
 }
 
-// SetEntity sets the value of Entity.
-func (s *BookingInfo) SetEntity(val BookingEntity) {
-	s.Entity = val
-}
-
-// SetUser sets the value of User.
-func (s *BookingInfo) SetUser(val User) {
-	s.User = val
+// SetOwnerID sets the value of OwnerID.
+func (s *BookingGroup) SetOwnerID(val uuid.UUID) {
+	s.OwnerID = val
 }
 
 // SetTimeFrom sets the value of TimeFrom.
-func (s *BookingInfo) SetTimeFrom(val Time) {
+func (s *BookingGroup) SetTimeFrom(val Time) {
 	s.TimeFrom = val
 }
 
 // SetTimeTo sets the value of TimeTo.
-func (s *BookingInfo) SetTimeTo(val Time) {
+func (s *BookingGroup) SetTimeTo(val Time) {
 	s.TimeTo = val
 }
 
-// SetOrders sets the value of Orders.
-func (s *BookingInfo) SetOrders(val []Order) {
-	s.Orders = val
+// SetBookings sets the value of Bookings.
+func (s *BookingGroup) SetBookings(val []Booking) {
+	s.Bookings = val
 }
 
 // SetCreatedAt sets the value of CreatedAt.
-func (s *BookingInfo) SetCreatedAt(val Time) {
+func (s *BookingGroup) SetCreatedAt(val Time) {
 	s.CreatedAt = val
 }
 
 // SetUpdatedAt sets the value of UpdatedAt.
-func (s *BookingInfo) SetUpdatedAt(val Time) {
+func (s *BookingGroup) SetUpdatedAt(val Time) {
 	s.UpdatedAt = val
 }
 
-func (*BookingInfo) getBookingByIdRes() {}
+func (*BookingGroup) addBookingGroupMemberRes() {}
+func (*BookingGroup) createBookingGroupRes()    {}
+func (*BookingGroup) getBookingGroupRes()       {}
+func (*BookingGroup) updateBookingGroupRes()    {}
 
-// Ref: #/components/schemas/BookingUpdate
-type BookingUpdate struct {
+// Ref: #/components/schemas/BookingGroupCreate
+type BookingGroupCreate struct {
+	// Время начала бронирования (в секундах, Unix timestamp).
+	TimeFrom Time `json:"time_from"`
+	// Время окончания бронирования (в секундах, Unix timestamp).
+	TimeTo Time `json:"time_to"`
+	// Бронируемые рабочие места.
+	Items []BookingGroupItem `json:"items"`
+}
+
+// GetTimeFrom returns the value of TimeFrom.
+func (s *BookingGroupCreate) GetTimeFrom() Time {
+	return s.TimeFrom
+}
+
+// GetTimeTo returns the value of TimeTo.
+func (s *BookingGroupCreate) GetTimeTo() Time {
+	return s.TimeTo
+}
+
+// GetItems returns the value of Items.
+func (s *BookingGroupCreate) GetItems() []BookingGroupItem {
+	return s.Items
+}
+
+// SetTimeFrom sets the value of TimeFrom.
+func (s *BookingGroupCreate) SetTimeFrom(val Time) {
+	s.TimeFrom = val
+}
+
+// SetTimeTo sets the value of TimeTo.
+func (s *BookingGroupCreate) SetTimeTo(val Time) {
+	s.TimeTo = val
+}
+
+// SetItems sets the value of Items.
+func (s *BookingGroupCreate) SetItems(val []BookingGroupItem) {
+	s.Items = val
+}
+
+// Ref: #/components/schemas/BookingGroupItem
+type BookingGroupItem struct {
+	// Уникальный идентификатор рабочего места.
+	EntityID uuid.UUID `json:"entity_id"`
+	// Уникальный идентификатор участника, для которого
+	// бронируется место.
+	UserID OptUUID `json:"user_id"`
+}
+
+// GetEntityID returns the value of EntityID.
+func (s *BookingGroupItem) GetEntityID() uuid.UUID {
+	return s.EntityID
+}
+
+// GetUserID returns the value of UserID.
+func (s *BookingGroupItem) GetUserID() OptUUID {
+	return s.UserID
+}
+
+// SetEntityID sets the value of EntityID.
+func (s *BookingGroupItem) SetEntityID(val uuid.UUID) {
+	s.EntityID = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *BookingGroupItem) SetUserID(val OptUUID) {
+	s.UserID = val
+}
+
+// Ref: #/components/schemas/BookingGroupUpdate
+type BookingGroupUpdate struct {
 	// Новое время начала бронирования (в секундах, Unix timestamp).
 	TimeFrom OptTime `json:"time_from"`
 	// Новое время окончания бронирования (в секундах, Unix
@@ -433,135 +796,1359 @@ type BookingUpdate struct {
 }
 
 // GetTimeFrom returns the value of TimeFrom.
-func (s *BookingUpdate) GetTimeFrom() OptTime {
+func (s *BookingGroupUpdate) GetTimeFrom() OptTime {
 	return s.TimeFrom
 }
 
 // GetTimeTo returns the value of TimeTo.
-func (s *BookingUpdate) GetTimeTo() OptTime {
+func (s *BookingGroupUpdate) GetTimeTo() OptTime {
 	return s.TimeTo
 }
 
 // SetTimeFrom sets the value of TimeFrom.
-func (s *BookingUpdate) SetTimeFrom(val OptTime) {
+func (s *BookingGroupUpdate) SetTimeFrom(val OptTime) {
 	s.TimeFrom = val
 }
 
 // SetTimeTo sets the value of TimeTo.
-func (s *BookingUpdate) SetTimeTo(val OptTime) {
+func (s *BookingGroupUpdate) SetTimeTo(val OptTime) {
 	s.TimeTo = val
 }
 
-// CreateBookingConflict is response for CreateBooking operation.
-type CreateBookingConflict struct{}
+// BookingHeaders wraps Booking with response headers.
+type BookingHeaders struct {
+	ETag     OptString
+	Response Booking
+}
 
-func (*CreateBookingConflict) createBookingRes() {}
+// GetETag returns the value of ETag.
+func (s *BookingHeaders) GetETag() OptString {
+	return s.ETag
+}
 
-// CreateBookingForAdminConflict is response for CreateBookingForAdmin operation.
-type CreateBookingForAdminConflict struct{}
+// GetResponse returns the value of Response.
+func (s *BookingHeaders) GetResponse() Booking {
+	return s.Response
+}
 
-func (*CreateBookingForAdminConflict) createBookingForAdminRes() {}
+// SetETag sets the value of ETag.
+func (s *BookingHeaders) SetETag(val OptString) {
+	s.ETag = val
+}
 
-// CreateBookingForAdminForbidden is response for CreateBookingForAdmin operation.
-type CreateBookingForAdminForbidden struct{}
+// SetResponse sets the value of Response.
+func (s *BookingHeaders) SetResponse(val Booking) {
+	s.Response = val
+}
 
-func (*CreateBookingForAdminForbidden) createBookingForAdminRes() {}
+func (*BookingHeaders) extendBookingRes()  {}
+func (*BookingHeaders) releaseBookingRes() {}
+func (*BookingHeaders) updateBookingRes()  {}
 
-// CreateBookingForbidden is response for CreateBooking operation.
-type CreateBookingForbidden struct{}
+// Действие с бронированием.
+// Ref: #/components/schemas/BookingHistoryAction
+type BookingHistoryAction string
 
-func (*CreateBookingForbidden) createBookingRes() {}
+const (
+	BookingHistoryActionExtend  BookingHistoryAction = "extend"
+	BookingHistoryActionRelease BookingHistoryAction = "release"
+)
 
-// DeleteBookingNoContent is response for DeleteBooking operation.
-type DeleteBookingNoContent struct{}
+// AllValues returns all BookingHistoryAction values.
+func (BookingHistoryAction) AllValues() []BookingHistoryAction {
+	return []BookingHistoryAction{
+		BookingHistoryActionExtend,
+		BookingHistoryActionRelease,
+	}
+}
 
-func (*DeleteBookingNoContent) deleteBookingRes() {}
+// MarshalText implements encoding.TextMarshaler.
+func (s BookingHistoryAction) MarshalText() ([]byte, error) {
+	switch s {
+	case BookingHistoryActionExtend:
+		return []byte(s), nil
+	case BookingHistoryActionRelease:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
 
-// DeleteOrdersNoContent is response for DeleteOrders operation.
-type DeleteOrdersNoContent struct{}
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *BookingHistoryAction) UnmarshalText(data []byte) error {
+	switch BookingHistoryAction(data) {
+	case BookingHistoryActionExtend:
+		*s = BookingHistoryActionExtend
+		return nil
+	case BookingHistoryActionRelease:
+		*s = BookingHistoryActionRelease
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
 
-func (*DeleteOrdersNoContent) deleteOrdersRes() {}
+// Ref: #/components/schemas/BookingHistoryItem
+type BookingHistoryItem struct {
+	ID     uuid.UUID            `json:"id"`
+	Action BookingHistoryAction `json:"action"`
+	// Пользователь, совершивший действие.
+	ActorID uuid.UUID `json:"actor_id"`
+	// Время окончания бронирования до действия.
+	PrevTimeTo Time `json:"prev_time_to"`
+	// Время окончания бронирования после действия.
+	TimeTo    Time `json:"time_to"`
+	CreatedAt Time `json:"created_at"`
+}
 
-type FloorWorkload []FloorWorkloadItem
+// GetID returns the value of ID.
+func (s *BookingHistoryItem) GetID() uuid.UUID {
+	return s.ID
+}
 
-func (*FloorWorkload) getFloorWorkloadRes() {}
+// GetAction returns the value of Action.
+func (s *BookingHistoryItem) GetAction() BookingHistoryAction {
+	return s.Action
+}
 
-type FloorWorkloadItem struct {
+// GetActorID returns the value of ActorID.
+func (s *BookingHistoryItem) GetActorID() uuid.UUID {
+	return s.ActorID
+}
+
+// GetPrevTimeTo returns the value of PrevTimeTo.
+func (s *BookingHistoryItem) GetPrevTimeTo() Time {
+	return s.PrevTimeTo
+}
+
+// GetTimeTo returns the value of TimeTo.
+func (s *BookingHistoryItem) GetTimeTo() Time {
+	return s.TimeTo
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *BookingHistoryItem) GetCreatedAt() Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *BookingHistoryItem) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetAction sets the value of Action.
+func (s *BookingHistoryItem) SetAction(val BookingHistoryAction) {
+	s.Action = val
+}
+
+// SetActorID sets the value of ActorID.
+func (s *BookingHistoryItem) SetActorID(val uuid.UUID) {
+	s.ActorID = val
+}
+
+// SetPrevTimeTo sets the value of PrevTimeTo.
+func (s *BookingHistoryItem) SetPrevTimeTo(val Time) {
+	s.PrevTimeTo = val
+}
+
+// SetTimeTo sets the value of TimeTo.
+func (s *BookingHistoryItem) SetTimeTo(val Time) {
+	s.TimeTo = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *BookingHistoryItem) SetCreatedAt(val Time) {
+	s.CreatedAt = val
+}
+
+// Ref: #/components/schemas/BookingInfo
+type BookingInfo struct {
+	// Уникальный идентификатор бронирования.
+	ID     uuid.UUID     `json:"id"`
+	Status BookingStatus `json:"status"`
 	Entity BookingEntity `json:"entity"`
-	// Флаг, указывающий, свободно ли рабочее место в
-	// указанное время.
-	IsFree bool `json:"is_free"`
+	// Этаж рабочего места.
+	Floor OptFloor `json:"floor"`
+	// Информация о пользователе, для которого создано
+	// бронирование.
+	User User `json:"user"`
+	// Уникальный идентификатор пользователя, создавшего
+	// бронирование.
+	BookedBy uuid.UUID `json:"booked_by"`
+	// Время начала бронирования (в секундах, Unix timestamp).
+	TimeFrom Time `json:"time_from"`
+	// Время окончания бронирования (в секундах, Unix timestamp).
+	TimeTo Time `json:"time_to"`
+	// Список заказов, связанных с бронированием.
+	Orders []Order `json:"orders"`
+	// Время создания бронирования (в секундах, Unix timestamp).
+	CreatedAt Time `json:"created_at"`
+	// Время последнего обновления бронирования (в секундах,
+	//  Unix timestamp).
+	UpdatedAt Time `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *BookingInfo) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetStatus returns the value of Status.
+func (s *BookingInfo) GetStatus() BookingStatus {
+	return s.Status
 }
 
 // GetEntity returns the value of Entity.
-func (s *FloorWorkloadItem) GetEntity() BookingEntity {
+func (s *BookingInfo) GetEntity() BookingEntity {
 	return s.Entity
 }
 
-// GetIsFree returns the value of IsFree.
-func (s *FloorWorkloadItem) GetIsFree() bool {
-	return s.IsFree
+// GetFloor returns the value of Floor.
+func (s *BookingInfo) GetFloor() OptFloor {
+	return s.Floor
+}
+
+// GetUser returns the value of User.
+func (s *BookingInfo) GetUser() User {
+	return s.User
+}
+
+// GetBookedBy returns the value of BookedBy.
+func (s *BookingInfo) GetBookedBy() uuid.UUID {
+	return s.BookedBy
+}
+
+// GetTimeFrom returns the value of TimeFrom.
+func (s *BookingInfo) GetTimeFrom() Time {
+	return s.TimeFrom
+}
+
+// GetTimeTo returns the value of TimeTo.
+func (s *BookingInfo) GetTimeTo() Time {
+	return s.TimeTo
+}
+
+// GetOrders returns the value of Orders.
+func (s *BookingInfo) GetOrders() []Order {
+	return s.Orders
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *BookingInfo) GetCreatedAt() Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *BookingInfo) GetUpdatedAt() Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *BookingInfo) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetStatus sets the value of Status.
+func (s *BookingInfo) SetStatus(val BookingStatus) {
+	s.Status = val
 }
 
 // SetEntity sets the value of Entity.
-func (s *FloorWorkloadItem) SetEntity(val BookingEntity) {
+func (s *BookingInfo) SetEntity(val BookingEntity) {
 	s.Entity = val
 }
 
-// SetIsFree sets the value of IsFree.
-func (s *FloorWorkloadItem) SetIsFree(val bool) {
-	s.IsFree = val
+// SetFloor sets the value of Floor.
+func (s *BookingInfo) SetFloor(val OptFloor) {
+	s.Floor = val
 }
 
-// ListAllBookingsForbidden is response for ListAllBookings operation.
-type ListAllBookingsForbidden struct{}
+// SetUser sets the value of User.
+func (s *BookingInfo) SetUser(val User) {
+	s.User = val
+}
 
-func (*ListAllBookingsForbidden) listAllBookingsRes() {}
+// SetBookedBy sets the value of BookedBy.
+func (s *BookingInfo) SetBookedBy(val uuid.UUID) {
+	s.BookedBy = val
+}
+
+// SetTimeFrom sets the value of TimeFrom.
+func (s *BookingInfo) SetTimeFrom(val Time) {
+	s.TimeFrom = val
+}
+
+// SetTimeTo sets the value of TimeTo.
+func (s *BookingInfo) SetTimeTo(val Time) {
+	s.TimeTo = val
+}
+
+// SetOrders sets the value of Orders.
+func (s *BookingInfo) SetOrders(val []Order) {
+	s.Orders = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *BookingInfo) SetCreatedAt(val Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *BookingInfo) SetUpdatedAt(val Time) {
+	s.UpdatedAt = val
+}
+
+// BookingInfoHeaders wraps BookingInfo with response headers.
+type BookingInfoHeaders struct {
+	ETag     OptString
+	Response BookingInfo
+}
+
+// GetETag returns the value of ETag.
+func (s *BookingInfoHeaders) GetETag() OptString {
+	return s.ETag
+}
+
+// GetResponse returns the value of Response.
+func (s *BookingInfoHeaders) GetResponse() BookingInfo {
+	return s.Response
+}
+
+// SetETag sets the value of ETag.
+func (s *BookingInfoHeaders) SetETag(val OptString) {
+	s.ETag = val
+}
+
+// SetResponse sets the value of Response.
+func (s *BookingInfoHeaders) SetResponse(val BookingInfo) {
+	s.Response = val
+}
+
+func (*BookingInfoHeaders) getBookingByIdRes() {}
+
+// Ref: #/components/schemas/BookingInfoPage
+type BookingInfoPage struct {
+	Items []BookingInfo `json:"items"`
+	// Курсор следующей страницы, отсутствует на последней
+	// странице.
+	NextCursor OptString `json:"next_cursor"`
+}
+
+// GetItems returns the value of Items.
+func (s *BookingInfoPage) GetItems() []BookingInfo {
+	return s.Items
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *BookingInfoPage) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetItems sets the value of Items.
+func (s *BookingInfoPage) SetItems(val []BookingInfo) {
+	s.Items = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *BookingInfoPage) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+func (*BookingInfoPage) listAllBookingsRes() {}
+func (*BookingInfoPage) listMyBookingsRes()  {}
+
+type BookingSort string
+
+const (
+	BookingSortCreatedAt      BookingSort = "created_at"
+	BookingSortMinusCreatedAt BookingSort = "-created_at"
+	BookingSortTimeFrom       BookingSort = "time_from"
+	BookingSortMinusTimeFrom  BookingSort = "-time_from"
+)
+
+// AllValues returns all BookingSort values.
+func (BookingSort) AllValues() []BookingSort {
+	return []BookingSort{
+		BookingSortCreatedAt,
+		BookingSortMinusCreatedAt,
+		BookingSortTimeFrom,
+		BookingSortMinusTimeFrom,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s BookingSort) MarshalText() ([]byte, error) {
+	switch s {
+	case BookingSortCreatedAt:
+		return []byte(s), nil
+	case BookingSortMinusCreatedAt:
+		return []byte(s), nil
+	case BookingSortTimeFrom:
+		return []byte(s), nil
+	case BookingSortMinusTimeFrom:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *BookingSort) UnmarshalText(data []byte) error {
+	switch BookingSort(data) {
+	case BookingSortCreatedAt:
+		*s = BookingSortCreatedAt
+		return nil
+	case BookingSortMinusCreatedAt:
+		*s = BookingSortMinusCreatedAt
+		return nil
+	case BookingSortTimeFrom:
+		*s = BookingSortTimeFrom
+		return nil
+	case BookingSortMinusTimeFrom:
+		*s = BookingSortMinusTimeFrom
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Статус бронирования по его времени.
+// Ref: #/components/schemas/BookingStatus
+type BookingStatus string
+
+const (
+	BookingStatusUpcoming BookingStatus = "upcoming"
+	BookingStatusActive   BookingStatus = "active"
+	BookingStatusFinished BookingStatus = "finished"
+)
+
+// AllValues returns all BookingStatus values.
+func (BookingStatus) AllValues() []BookingStatus {
+	return []BookingStatus{
+		BookingStatusUpcoming,
+		BookingStatusActive,
+		BookingStatusFinished,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s BookingStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case BookingStatusUpcoming:
+		return []byte(s), nil
+	case BookingStatusActive:
+		return []byte(s), nil
+	case BookingStatusFinished:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
 
-type ListAllBookingsOKApplicationJSON []BookingInfo
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *BookingStatus) UnmarshalText(data []byte) error {
+	switch BookingStatus(data) {
+	case BookingStatusUpcoming:
+		*s = BookingStatusUpcoming
+		return nil
+	case BookingStatusActive:
+		*s = BookingStatusActive
+		return nil
+	case BookingStatusFinished:
+		*s = BookingStatusFinished
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
 
-func (*ListAllBookingsOKApplicationJSON) listAllBookingsRes() {}
+// Ref: #/components/schemas/BookingTransfer
+type BookingTransfer struct {
+	ID uuid.UUID `json:"id"`
+	// Передаваемое бронирование.
+	BookingID uuid.UUID `json:"booking_id"`
+	// Владелец бронирования, предложивший передачу.
+	FromUserID uuid.UUID `json:"from_user_id"`
+	// Почта получателя бронирования.
+	Email string `json:"email"`
+	// Бронирование получателя, которое отдается взамен при
+	// обмене.
+	SwapBookingID OptUUID `json:"swap_booking_id"`
+	CreatedAt     Time    `json:"created_at"`
+}
 
-type ListMyBookingsOKApplicationJSON []BookingInfo
+// GetID returns the value of ID.
+func (s *BookingTransfer) GetID() uuid.UUID {
+	return s.ID
+}
 
-func (*ListMyBookingsOKApplicationJSON) listMyBookingsRes() {}
+// GetBookingID returns the value of BookingID.
+func (s *BookingTransfer) GetBookingID() uuid.UUID {
+	return s.BookingID
+}
+
+// GetFromUserID returns the value of FromUserID.
+func (s *BookingTransfer) GetFromUserID() uuid.UUID {
+	return s.FromUserID
+}
+
+// GetEmail returns the value of Email.
+func (s *BookingTransfer) GetEmail() string {
+	return s.Email
+}
+
+// GetSwapBookingID returns the value of SwapBookingID.
+func (s *BookingTransfer) GetSwapBookingID() OptUUID {
+	return s.SwapBookingID
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *BookingTransfer) GetCreatedAt() Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *BookingTransfer) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetBookingID sets the value of BookingID.
+func (s *BookingTransfer) SetBookingID(val uuid.UUID) {
+	s.BookingID = val
+}
+
+// SetFromUserID sets the value of FromUserID.
+func (s *BookingTransfer) SetFromUserID(val uuid.UUID) {
+	s.FromUserID = val
+}
+
+// SetEmail sets the value of Email.
+func (s *BookingTransfer) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetSwapBookingID sets the value of SwapBookingID.
+func (s *BookingTransfer) SetSwapBookingID(val OptUUID) {
+	s.SwapBookingID = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *BookingTransfer) SetCreatedAt(val Time) {
+	s.CreatedAt = val
+}
+
+func (*BookingTransfer) createBookingTransferRes() {}
+
+// Ref: #/components/schemas/BookingTransferCreate
+type BookingTransferCreate struct {
+	// Почта получателя.
+	Email string `json:"email"`
+	// Бронирование получателя на то же время для обмена
+	// местами.
+	SwapBookingID OptUUID `json:"swap_booking_id"`
+}
+
+// GetEmail returns the value of Email.
+func (s *BookingTransferCreate) GetEmail() string {
+	return s.Email
+}
+
+// GetSwapBookingID returns the value of SwapBookingID.
+func (s *BookingTransferCreate) GetSwapBookingID() OptUUID {
+	return s.SwapBookingID
+}
+
+// SetEmail sets the value of Email.
+func (s *BookingTransferCreate) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetSwapBookingID sets the value of SwapBookingID.
+func (s *BookingTransferCreate) SetSwapBookingID(val OptUUID) {
+	s.SwapBookingID = val
+}
+
+// Ref: #/components/schemas/BookingTransferList
+type BookingTransferList struct {
+	// Предложения, отправленные текущим пользователем.
+	Outgoing []BookingTransfer `json:"outgoing"`
+	// Предложения, адресованные текущему пользователю.
+	Incoming []BookingTransfer `json:"incoming"`
+}
+
+// GetOutgoing returns the value of Outgoing.
+func (s *BookingTransferList) GetOutgoing() []BookingTransfer {
+	return s.Outgoing
+}
+
+// GetIncoming returns the value of Incoming.
+func (s *BookingTransferList) GetIncoming() []BookingTransfer {
+	return s.Incoming
+}
+
+// SetOutgoing sets the value of Outgoing.
+func (s *BookingTransferList) SetOutgoing(val []BookingTransfer) {
+	s.Outgoing = val
+}
+
+// SetIncoming sets the value of Incoming.
+func (s *BookingTransferList) SetIncoming(val []BookingTransfer) {
+	s.Incoming = val
+}
+
+func (*BookingTransferList) listBookingTransfersRes() {}
+
+// Ref: #/components/schemas/BookingUpdate
+type BookingUpdate struct {
+	// Новое время начала бронирования (в секундах, Unix timestamp).
+	TimeFrom OptTime `json:"time_from"`
+	// Новое время окончания бронирования (в секундах, Unix
+	// timestamp).
+	TimeTo OptTime `json:"time_to"`
+	// Уникальный идентификатор пользователя, на которого
+	// переназначается бронирование.
+	OnBehalfOf OptUUID `json:"on_behalf_of"`
+}
+
+// GetTimeFrom returns the value of TimeFrom.
+func (s *BookingUpdate) GetTimeFrom() OptTime {
+	return s.TimeFrom
+}
+
+// GetTimeTo returns the value of TimeTo.
+func (s *BookingUpdate) GetTimeTo() OptTime {
+	return s.TimeTo
+}
+
+// GetOnBehalfOf returns the value of OnBehalfOf.
+func (s *BookingUpdate) GetOnBehalfOf() OptUUID {
+	return s.OnBehalfOf
+}
+
+// SetTimeFrom sets the value of TimeFrom.
+func (s *BookingUpdate) SetTimeFrom(val OptTime) {
+	s.TimeFrom = val
+}
+
+// SetTimeTo sets the value of TimeTo.
+func (s *BookingUpdate) SetTimeTo(val OptTime) {
+	s.TimeTo = val
+}
+
+// SetOnBehalfOf sets the value of OnBehalfOf.
+func (s *BookingUpdate) SetOnBehalfOf(val OptUUID) {
+	s.OnBehalfOf = val
+}
+
+// CreateBookingConflict is response for CreateBooking operation.
+type CreateBookingConflict struct{}
+
+func (*CreateBookingConflict) createBookingRes() {}
+
+// CreateBookingForAdminConflict is response for CreateBookingForAdmin operation.
+type CreateBookingForAdminConflict struct{}
+
+func (*CreateBookingForAdminConflict) createBookingForAdminRes() {}
+
+// CreateBookingForAdminForbidden is response for CreateBookingForAdmin operation.
+type CreateBookingForAdminForbidden struct{}
+
+func (*CreateBookingForAdminForbidden) createBookingForAdminRes() {}
+
+// CreateBookingForbidden is response for CreateBooking operation.
+type CreateBookingForbidden struct{}
+
+func (*CreateBookingForbidden) createBookingRes() {}
+
+// CreateBookingGroupConflict is response for CreateBookingGroup operation.
+type CreateBookingGroupConflict struct{}
+
+func (*CreateBookingGroupConflict) createBookingGroupRes() {}
+
+// CreateBookingGroupForbidden is response for CreateBookingGroup operation.
+type CreateBookingGroupForbidden struct{}
+
+func (*CreateBookingGroupForbidden) createBookingGroupRes() {}
+
+// CreateBookingTransferConflict is response for CreateBookingTransfer operation.
+type CreateBookingTransferConflict struct{}
+
+func (*CreateBookingTransferConflict) createBookingTransferRes() {}
+
+// CreateDelegationConflict is response for CreateDelegation operation.
+type CreateDelegationConflict struct{}
+
+func (*CreateDelegationConflict) createDelegationRes() {}
+
+// Ref: #/components/schemas/Delegation
+type Delegation struct {
+	ID uuid.UUID `json:"id"`
+	// Пользователь, выдавший право бронировать от своего
+	// имени.
+	UserID uuid.UUID `json:"user_id"`
+	// Пользователь, получивший право.
+	DelegateID uuid.UUID `json:"delegate_id"`
+	CreatedAt  Time      `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Delegation) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetUserID returns the value of UserID.
+func (s *Delegation) GetUserID() uuid.UUID {
+	return s.UserID
+}
+
+// GetDelegateID returns the value of DelegateID.
+func (s *Delegation) GetDelegateID() uuid.UUID {
+	return s.DelegateID
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Delegation) GetCreatedAt() Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Delegation) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *Delegation) SetUserID(val uuid.UUID) {
+	s.UserID = val
+}
+
+// SetDelegateID sets the value of DelegateID.
+func (s *Delegation) SetDelegateID(val uuid.UUID) {
+	s.DelegateID = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Delegation) SetCreatedAt(val Time) {
+	s.CreatedAt = val
+}
+
+func (*Delegation) createDelegationRes() {}
+
+// Ref: #/components/schemas/DelegationCreate
+type DelegationCreate struct {
+	// Пользователь, которому выдается право бронировать от
+	// имени текущего.
+	DelegateID uuid.UUID `json:"delegate_id"`
+}
+
+// GetDelegateID returns the value of DelegateID.
+func (s *DelegationCreate) GetDelegateID() uuid.UUID {
+	return s.DelegateID
+}
+
+// SetDelegateID sets the value of DelegateID.
+func (s *DelegationCreate) SetDelegateID(val uuid.UUID) {
+	s.DelegateID = val
+}
+
+// Ref: #/components/schemas/DelegationList
+type DelegationList struct {
+	// Права, выданные текущим пользователем.
+	Granted []Delegation `json:"granted"`
+	// Права, полученные текущим пользователем.
+	Received []Delegation `json:"received"`
+}
+
+// GetGranted returns the value of Granted.
+func (s *DelegationList) GetGranted() []Delegation {
+	return s.Granted
+}
+
+// GetReceived returns the value of Received.
+func (s *DelegationList) GetReceived() []Delegation {
+	return s.Received
+}
+
+// SetGranted sets the value of Granted.
+func (s *DelegationList) SetGranted(val []Delegation) {
+	s.Granted = val
+}
+
+// SetReceived sets the value of Received.
+func (s *DelegationList) SetReceived(val []Delegation) {
+	s.Received = val
+}
+
+func (*DelegationList) listDelegationsRes() {}
+
+// DeleteBookingGroupNoContent is response for DeleteBookingGroup operation.
+type DeleteBookingGroupNoContent struct{}
+
+func (*DeleteBookingGroupNoContent) deleteBookingGroupRes() {}
+
+// DeleteBookingNoContent is response for DeleteBooking operation.
+type DeleteBookingNoContent struct{}
+
+func (*DeleteBookingNoContent) deleteBookingRes() {}
+
+// DeleteBookingTransferNoContent is response for DeleteBookingTransfer operation.
+type DeleteBookingTransferNoContent struct{}
+
+func (*DeleteBookingTransferNoContent) deleteBookingTransferRes() {}
+
+// DeleteDelegationNoContent is response for DeleteDelegation operation.
+type DeleteDelegationNoContent struct{}
+
+func (*DeleteDelegationNoContent) deleteDelegationRes() {}
+
+// DeleteOrdersNoContent is response for DeleteOrders operation.
+type DeleteOrdersNoContent struct{}
+
+func (*DeleteOrdersNoContent) deleteOrdersRes() {}
+
+// DeleteRoleQuotaOverrideForbidden is response for DeleteRoleQuotaOverride operation.
+type DeleteRoleQuotaOverrideForbidden struct{}
+
+func (*DeleteRoleQuotaOverrideForbidden) deleteRoleQuotaOverrideRes() {}
+
+// DeleteRoleQuotaOverrideNoContent is response for DeleteRoleQuotaOverride operation.
+type DeleteRoleQuotaOverrideNoContent struct{}
+
+func (*DeleteRoleQuotaOverrideNoContent) deleteRoleQuotaOverrideRes() {}
+
+// DeleteTeamQuotaOverrideForbidden is response for DeleteTeamQuotaOverride operation.
+type DeleteTeamQuotaOverrideForbidden struct{}
+
+func (*DeleteTeamQuotaOverrideForbidden) deleteTeamQuotaOverrideRes() {}
+
+// DeleteTeamQuotaOverrideNoContent is response for DeleteTeamQuotaOverride operation.
+type DeleteTeamQuotaOverrideNoContent struct{}
+
+func (*DeleteTeamQuotaOverrideNoContent) deleteTeamQuotaOverrideRes() {}
+
+// DeleteUserQuotaOverrideForbidden is response for DeleteUserQuotaOverride operation.
+type DeleteUserQuotaOverrideForbidden struct{}
+
+func (*DeleteUserQuotaOverrideForbidden) deleteUserQuotaOverrideRes() {}
+
+// DeleteUserQuotaOverrideNoContent is response for DeleteUserQuotaOverride operation.
+type DeleteUserQuotaOverrideNoContent struct{}
+
+func (*DeleteUserQuotaOverrideNoContent) deleteUserQuotaOverrideRes() {}
+
+// ExtendBookingConflict is response for ExtendBooking operation.
+type ExtendBookingConflict struct{}
+
+func (*ExtendBookingConflict) extendBookingRes() {}
+
+// ExtendBookingForbidden is response for ExtendBooking operation.
+type ExtendBookingForbidden struct{}
+
+func (*ExtendBookingForbidden) extendBookingRes() {}
+
+// Ref: #/components/schemas/Floor
+type Floor struct {
+	ID         uuid.UUID `json:"id"`
+	Name       string    `json:"name"`
+	BuildingID OptUUID   `json:"building_id"`
+}
+
+// GetID returns the value of ID.
+func (s *Floor) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Floor) GetName() string {
+	return s.Name
+}
+
+// GetBuildingID returns the value of BuildingID.
+func (s *Floor) GetBuildingID() OptUUID {
+	return s.BuildingID
+}
+
+// SetID sets the value of ID.
+func (s *Floor) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Floor) SetName(val string) {
+	s.Name = val
+}
+
+// SetBuildingID sets the value of BuildingID.
+func (s *Floor) SetBuildingID(val OptUUID) {
+	s.BuildingID = val
+}
+
+type FloorWorkload []FloorWorkloadItem
+
+func (*FloorWorkload) getBuildingWorkloadRes() {}
+func (*FloorWorkload) getFloorWorkloadRes()    {}
+
+type FloorWorkloadItem struct {
+	Entity BookingEntity `json:"entity"`
+	// Флаг, указывающий, свободно ли рабочее место в
+	// указанное время.
+	IsFree bool `json:"is_free"`
+	// Флаг, указывающий, что место закреплено за командой
+	// или сотрудником и недоступно пользователю.
+	IsRestricted bool `json:"is_restricted"`
+}
+
+// GetEntity returns the value of Entity.
+func (s *FloorWorkloadItem) GetEntity() BookingEntity {
+	return s.Entity
+}
+
+// GetIsFree returns the value of IsFree.
+func (s *FloorWorkloadItem) GetIsFree() bool {
+	return s.IsFree
+}
+
+// GetIsRestricted returns the value of IsRestricted.
+func (s *FloorWorkloadItem) GetIsRestricted() bool {
+	return s.IsRestricted
+}
+
+// SetEntity sets the value of Entity.
+func (s *FloorWorkloadItem) SetEntity(val BookingEntity) {
+	s.Entity = val
+}
+
+// SetIsFree sets the value of IsFree.
+func (s *FloorWorkloadItem) SetIsFree(val bool) {
+	s.IsFree = val
+}
+
+// SetIsRestricted sets the value of IsRestricted.
+func (s *FloorWorkloadItem) SetIsRestricted(val bool) {
+	s.IsRestricted = val
+}
+
+type GetBookingHistoryOKApplicationJSON []BookingHistoryItem
+
+func (*GetBookingHistoryOKApplicationJSON) getBookingHistoryRes() {}
+
+// ListAllBookingsForbidden is response for ListAllBookings operation.
+type ListAllBookingsForbidden struct{}
+
+func (*ListAllBookingsForbidden) listAllBookingsRes() {}
+
+type ListOrdersOKApplicationJSON []Order
+
+func (*ListOrdersOKApplicationJSON) listOrdersRes() {}
+
+// ListQuotaOverridesForbidden is response for ListQuotaOverrides operation.
+type ListQuotaOverridesForbidden struct{}
+
+func (*ListQuotaOverridesForbidden) listQuotaOverridesRes() {}
+
+type ListQuotaOverridesOKApplicationJSON []QuotaOverride
+
+func (*ListQuotaOverridesOKApplicationJSON) listQuotaOverridesRes() {}
+
+// NewOptBookingGroup returns new OptBookingGroup with value set to v.
+func NewOptBookingGroup(v BookingGroup) OptBookingGroup {
+	return OptBookingGroup{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBookingGroup is optional BookingGroup.
+type OptBookingGroup struct {
+	Value BookingGroup
+	Set   bool
+}
+
+// IsSet returns true if OptBookingGroup was set.
+func (o OptBookingGroup) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBookingGroup) Reset() {
+	var v BookingGroup
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBookingGroup) SetTo(v BookingGroup) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBookingGroup) Get() (v BookingGroup, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBookingGroup) Or(d BookingGroup) BookingGroup {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBookingSort returns new OptBookingSort with value set to v.
+func NewOptBookingSort(v BookingSort) OptBookingSort {
+	return OptBookingSort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBookingSort is optional BookingSort.
+type OptBookingSort struct {
+	Value BookingSort
+	Set   bool
+}
+
+// IsSet returns true if OptBookingSort was set.
+func (o OptBookingSort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBookingSort) Reset() {
+	var v BookingSort
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBookingSort) SetTo(v BookingSort) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBookingSort) Get() (v BookingSort, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBookingSort) Or(d BookingSort) BookingSort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBookingStatus returns new OptBookingStatus with value set to v.
+func NewOptBookingStatus(v BookingStatus) OptBookingStatus {
+	return OptBookingStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBookingStatus is optional BookingStatus.
+type OptBookingStatus struct {
+	Value BookingStatus
+	Set   bool
+}
+
+// IsSet returns true if OptBookingStatus was set.
+func (o OptBookingStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBookingStatus) Reset() {
+	var v BookingStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBookingStatus) SetTo(v BookingStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBookingStatus) Get() (v BookingStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBookingStatus) Or(d BookingStatus) BookingStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloor returns new OptFloor with value set to v.
+func NewOptFloor(v Floor) OptFloor {
+	return OptFloor{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloor is optional Floor.
+type OptFloor struct {
+	Value Floor
+	Set   bool
+}
+
+// IsSet returns true if OptFloor was set.
+func (o OptFloor) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloor) Reset() {
+	var v Floor
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloor) SetTo(v Floor) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloor) Get() (v Floor, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloor) Or(d Floor) Floor {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptResponse404Resource returns new OptResponse404Resource with value set to v.
+func NewOptResponse404Resource(v Response404Resource) OptResponse404Resource {
+	return OptResponse404Resource{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptResponse404Resource is optional Response404Resource.
+type OptResponse404Resource struct {
+	Value Response404Resource
+	Set   bool
+}
+
+// IsSet returns true if OptResponse404Resource was set.
+func (o OptResponse404Resource) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptResponse404Resource) Reset() {
+	var v Response404Resource
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptResponse404Resource) SetTo(v Response404Resource) {
+	o.Set = true
+	o.Value = v
+}
 
-type ListOrdersOKApplicationJSON []Order
+// Get returns value and boolean that denotes whether value was set.
+func (o OptResponse404Resource) Get() (v Response404Resource, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
 
-func (*ListOrdersOKApplicationJSON) listOrdersRes() {}
+// Or returns value if set, or given parameter if does not.
+func (o OptResponse404Resource) Or(d Response404Resource) Response404Resource {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
 
-// NewOptResponse404Resource returns new OptResponse404Resource with value set to v.
-func NewOptResponse404Resource(v Response404Resource) OptResponse404Resource {
-	return OptResponse404Resource{
+// NewOptRole returns new OptRole with value set to v.
+func NewOptRole(v Role) OptRole {
+	return OptRole{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptResponse404Resource is optional Response404Resource.
-type OptResponse404Resource struct {
-	Value Response404Resource
+// OptRole is optional Role.
+type OptRole struct {
+	Value Role
 	Set   bool
 }
 
-// IsSet returns true if OptResponse404Resource was set.
-func (o OptResponse404Resource) IsSet() bool { return o.Set }
+// IsSet returns true if OptRole was set.
+func (o OptRole) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptResponse404Resource) Reset() {
-	var v Response404Resource
+func (o *OptRole) Reset() {
+	var v Role
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptResponse404Resource) SetTo(v Response404Resource) {
+func (o *OptRole) SetTo(v Role) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptResponse404Resource) Get() (v Response404Resource, ok bool) {
+func (o OptRole) Get() (v Role, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -569,7 +2156,7 @@ func (o OptResponse404Resource) Get() (v Response404Resource, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptResponse404Resource) Or(d Response404Resource) Response404Resource {
+func (o OptRole) Or(d Role) Role {
 	if v, ok := o.Get(); ok {
 		return v
 	}
@@ -668,6 +2255,52 @@ func (o OptTime) Or(d Time) Time {
 	return d
 }
 
+// NewOptUUID returns new OptUUID with value set to v.
+func NewOptUUID(v uuid.UUID) OptUUID {
+	return OptUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUUID is optional uuid.UUID.
+type OptUUID struct {
+	Value uuid.UUID
+	Set   bool
+}
+
+// IsSet returns true if OptUUID was set.
+func (o OptUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUUID) Get() (v uuid.UUID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // Ref: #/components/schemas/Order
 type Order struct {
 	// Уникальный идентификатор заказа.
@@ -814,6 +2447,315 @@ func (s *OrderThingEnum) UnmarshalText(data []byte) error {
 	}
 }
 
+// Лимиты квоты; 0 означает отсутствие ограничения.
+// Ref: #/components/schemas/QuotaLimits
+type QuotaLimits struct {
+	// Часов бронирования в неделю.
+	HoursPerWeek int `json:"hours_per_week"`
+	// Одновременных будущих бронирований.
+	ActiveBookings int `json:"active_bookings"`
+	// Часов бронирования премиальных мест в неделю.
+	PremiumHoursPerWeek int `json:"premium_hours_per_week"`
+}
+
+// GetHoursPerWeek returns the value of HoursPerWeek.
+func (s *QuotaLimits) GetHoursPerWeek() int {
+	return s.HoursPerWeek
+}
+
+// GetActiveBookings returns the value of ActiveBookings.
+func (s *QuotaLimits) GetActiveBookings() int {
+	return s.ActiveBookings
+}
+
+// GetPremiumHoursPerWeek returns the value of PremiumHoursPerWeek.
+func (s *QuotaLimits) GetPremiumHoursPerWeek() int {
+	return s.PremiumHoursPerWeek
+}
+
+// SetHoursPerWeek sets the value of HoursPerWeek.
+func (s *QuotaLimits) SetHoursPerWeek(val int) {
+	s.HoursPerWeek = val
+}
+
+// SetActiveBookings sets the value of ActiveBookings.
+func (s *QuotaLimits) SetActiveBookings(val int) {
+	s.ActiveBookings = val
+}
+
+// SetPremiumHoursPerWeek sets the value of PremiumHoursPerWeek.
+func (s *QuotaLimits) SetPremiumHoursPerWeek(val int) {
+	s.PremiumHoursPerWeek = val
+}
+
+// Ref: #/components/schemas/QuotaOverride
+type QuotaOverride struct {
+	ID                  uuid.UUID `json:"id"`
+	UserID              OptUUID   `json:"user_id"`
+	TeamID              OptUUID   `json:"team_id"`
+	Role                OptRole   `json:"role"`
+	HoursPerWeek        OptInt    `json:"hours_per_week"`
+	ActiveBookings      OptInt    `json:"active_bookings"`
+	PremiumHoursPerWeek OptInt    `json:"premium_hours_per_week"`
+	CreatedAt           Time      `json:"created_at"`
+	UpdatedAt           Time      `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *QuotaOverride) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetUserID returns the value of UserID.
+func (s *QuotaOverride) GetUserID() OptUUID {
+	return s.UserID
+}
+
+// GetTeamID returns the value of TeamID.
+func (s *QuotaOverride) GetTeamID() OptUUID {
+	return s.TeamID
+}
+
+// GetRole returns the value of Role.
+func (s *QuotaOverride) GetRole() OptRole {
+	return s.Role
+}
+
+// GetHoursPerWeek returns the value of HoursPerWeek.
+func (s *QuotaOverride) GetHoursPerWeek() OptInt {
+	return s.HoursPerWeek
+}
+
+// GetActiveBookings returns the value of ActiveBookings.
+func (s *QuotaOverride) GetActiveBookings() OptInt {
+	return s.ActiveBookings
+}
+
+// GetPremiumHoursPerWeek returns the value of PremiumHoursPerWeek.
+func (s *QuotaOverride) GetPremiumHoursPerWeek() OptInt {
+	return s.PremiumHoursPerWeek
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *QuotaOverride) GetCreatedAt() Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *QuotaOverride) GetUpdatedAt() Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *QuotaOverride) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *QuotaOverride) SetUserID(val OptUUID) {
+	s.UserID = val
+}
+
+// SetTeamID sets the value of TeamID.
+func (s *QuotaOverride) SetTeamID(val OptUUID) {
+	s.TeamID = val
+}
+
+// SetRole sets the value of Role.
+func (s *QuotaOverride) SetRole(val OptRole) {
+	s.Role = val
+}
+
+// SetHoursPerWeek sets the value of HoursPerWeek.
+func (s *QuotaOverride) SetHoursPerWeek(val OptInt) {
+	s.HoursPerWeek = val
+}
+
+// SetActiveBookings sets the value of ActiveBookings.
+func (s *QuotaOverride) SetActiveBookings(val OptInt) {
+	s.ActiveBookings = val
+}
+
+// SetPremiumHoursPerWeek sets the value of PremiumHoursPerWeek.
+func (s *QuotaOverride) SetPremiumHoursPerWeek(val OptInt) {
+	s.PremiumHoursPerWeek = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *QuotaOverride) SetCreatedAt(val Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *QuotaOverride) SetUpdatedAt(val Time) {
+	s.UpdatedAt = val
+}
+
+func (*QuotaOverride) setRoleQuotaOverrideRes() {}
+func (*QuotaOverride) setTeamQuotaOverrideRes() {}
+func (*QuotaOverride) setUserQuotaOverrideRes() {}
+
+// Ref: #/components/schemas/QuotaOverrideUpdate
+type QuotaOverrideUpdate struct {
+	HoursPerWeek        OptInt `json:"hours_per_week"`
+	ActiveBookings      OptInt `json:"active_bookings"`
+	PremiumHoursPerWeek OptInt `json:"premium_hours_per_week"`
+}
+
+// GetHoursPerWeek returns the value of HoursPerWeek.
+func (s *QuotaOverrideUpdate) GetHoursPerWeek() OptInt {
+	return s.HoursPerWeek
+}
+
+// GetActiveBookings returns the value of ActiveBookings.
+func (s *QuotaOverrideUpdate) GetActiveBookings() OptInt {
+	return s.ActiveBookings
+}
+
+// GetPremiumHoursPerWeek returns the value of PremiumHoursPerWeek.
+func (s *QuotaOverrideUpdate) GetPremiumHoursPerWeek() OptInt {
+	return s.PremiumHoursPerWeek
+}
+
+// SetHoursPerWeek sets the value of HoursPerWeek.
+func (s *QuotaOverrideUpdate) SetHoursPerWeek(val OptInt) {
+	s.HoursPerWeek = val
+}
+
+// SetActiveBookings sets the value of ActiveBookings.
+func (s *QuotaOverrideUpdate) SetActiveBookings(val OptInt) {
+	s.ActiveBookings = val
+}
+
+// SetPremiumHoursPerWeek sets the value of PremiumHoursPerWeek.
+func (s *QuotaOverrideUpdate) SetPremiumHoursPerWeek(val OptInt) {
+	s.PremiumHoursPerWeek = val
+}
+
+// Остаток квоты; не возвращается для лимитов без
+// ограничения.
+// Ref: #/components/schemas/QuotaRemaining
+type QuotaRemaining struct {
+	HoursPerWeek        OptFloat64 `json:"hours_per_week"`
+	ActiveBookings      OptInt     `json:"active_bookings"`
+	PremiumHoursPerWeek OptFloat64 `json:"premium_hours_per_week"`
+}
+
+// GetHoursPerWeek returns the value of HoursPerWeek.
+func (s *QuotaRemaining) GetHoursPerWeek() OptFloat64 {
+	return s.HoursPerWeek
+}
+
+// GetActiveBookings returns the value of ActiveBookings.
+func (s *QuotaRemaining) GetActiveBookings() OptInt {
+	return s.ActiveBookings
+}
+
+// GetPremiumHoursPerWeek returns the value of PremiumHoursPerWeek.
+func (s *QuotaRemaining) GetPremiumHoursPerWeek() OptFloat64 {
+	return s.PremiumHoursPerWeek
+}
+
+// SetHoursPerWeek sets the value of HoursPerWeek.
+func (s *QuotaRemaining) SetHoursPerWeek(val OptFloat64) {
+	s.HoursPerWeek = val
+}
+
+// SetActiveBookings sets the value of ActiveBookings.
+func (s *QuotaRemaining) SetActiveBookings(val OptInt) {
+	s.ActiveBookings = val
+}
+
+// SetPremiumHoursPerWeek sets the value of PremiumHoursPerWeek.
+func (s *QuotaRemaining) SetPremiumHoursPerWeek(val OptFloat64) {
+	s.PremiumHoursPerWeek = val
+}
+
+// Ref: #/components/schemas/QuotaStatus
+type QuotaStatus struct {
+	Limits    QuotaLimits    `json:"limits"`
+	Used      QuotaUsage     `json:"used"`
+	Remaining QuotaRemaining `json:"remaining"`
+}
+
+// GetLimits returns the value of Limits.
+func (s *QuotaStatus) GetLimits() QuotaLimits {
+	return s.Limits
+}
+
+// GetUsed returns the value of Used.
+func (s *QuotaStatus) GetUsed() QuotaUsage {
+	return s.Used
+}
+
+// GetRemaining returns the value of Remaining.
+func (s *QuotaStatus) GetRemaining() QuotaRemaining {
+	return s.Remaining
+}
+
+// SetLimits sets the value of Limits.
+func (s *QuotaStatus) SetLimits(val QuotaLimits) {
+	s.Limits = val
+}
+
+// SetUsed sets the value of Used.
+func (s *QuotaStatus) SetUsed(val QuotaUsage) {
+	s.Used = val
+}
+
+// SetRemaining sets the value of Remaining.
+func (s *QuotaStatus) SetRemaining(val QuotaRemaining) {
+	s.Remaining = val
+}
+
+func (*QuotaStatus) getMyQuotaRes() {}
+
+// Ref: #/components/schemas/QuotaUsage
+type QuotaUsage struct {
+	// Часов бронирования на текущей неделе.
+	HoursPerWeek float64 `json:"hours_per_week"`
+	// Одновременных будущих бронирований.
+	ActiveBookings int `json:"active_bookings"`
+	// Часов бронирования премиальных мест на текущей
+	// неделе.
+	PremiumHoursPerWeek float64 `json:"premium_hours_per_week"`
+}
+
+// GetHoursPerWeek returns the value of HoursPerWeek.
+func (s *QuotaUsage) GetHoursPerWeek() float64 {
+	return s.HoursPerWeek
+}
+
+// GetActiveBookings returns the value of ActiveBookings.
+func (s *QuotaUsage) GetActiveBookings() int {
+	return s.ActiveBookings
+}
+
+// GetPremiumHoursPerWeek returns the value of PremiumHoursPerWeek.
+func (s *QuotaUsage) GetPremiumHoursPerWeek() float64 {
+	return s.PremiumHoursPerWeek
+}
+
+// SetHoursPerWeek sets the value of HoursPerWeek.
+func (s *QuotaUsage) SetHoursPerWeek(val float64) {
+	s.HoursPerWeek = val
+}
+
+// SetActiveBookings sets the value of ActiveBookings.
+func (s *QuotaUsage) SetActiveBookings(val int) {
+	s.ActiveBookings = val
+}
+
+// SetPremiumHoursPerWeek sets the value of PremiumHoursPerWeek.
+func (s *QuotaUsage) SetPremiumHoursPerWeek(val float64) {
+	s.PremiumHoursPerWeek = val
+}
+
+// RemoveBookingGroupMemberNoContent is response for RemoveBookingGroupMember operation.
+type RemoveBookingGroupMemberNoContent struct{}
+
+func (*RemoveBookingGroupMemberNoContent) removeBookingGroupMemberRes() {}
+
 type Response400 struct {
 	// Сообщение об ошибке.
 	Message OptString `json:"message"`
@@ -829,32 +2771,71 @@ func (s *Response400) SetMessage(val OptString) {
 	s.Message = val
 }
 
+func (*Response400) acceptBookingTransferRes() {}
+func (*Response400) addBookingGroupMemberRes() {}
+func (*Response400) allocateSeatsRes()         {}
 func (*Response400) createBookingForAdminRes() {}
+func (*Response400) createBookingGroupRes()    {}
 func (*Response400) createBookingRes()         {}
+func (*Response400) createBookingTransferRes() {}
+func (*Response400) createDelegationRes()      {}
 func (*Response400) createOrderRes()           {}
 func (*Response400) deleteBookingRes()         {}
 func (*Response400) deleteOrdersRes()          {}
+func (*Response400) extendBookingRes()         {}
 func (*Response400) getBookingByIdRes()        {}
+func (*Response400) getBookingHistoryRes()     {}
+func (*Response400) getBuildingWorkloadRes()   {}
 func (*Response400) getFloorWorkloadRes()      {}
 func (*Response400) getWorkloadRes()           {}
+func (*Response400) listAllBookingsRes()       {}
+func (*Response400) listMyBookingsRes()        {}
 func (*Response400) listOrdersRes()            {}
+func (*Response400) releaseBookingRes()        {}
+func (*Response400) updateBookingGroupRes()    {}
 func (*Response400) updateBookingRes()         {}
 
 // Ref: #/components/responses/Response401
 type Response401 struct{}
 
-func (*Response401) createBookingForAdminRes() {}
-func (*Response401) createBookingRes()         {}
-func (*Response401) createOrderRes()           {}
-func (*Response401) deleteBookingRes()         {}
-func (*Response401) deleteOrdersRes()          {}
-func (*Response401) getBookingByIdRes()        {}
-func (*Response401) getFloorWorkloadRes()      {}
-func (*Response401) getWorkloadRes()           {}
-func (*Response401) listAllBookingsRes()       {}
-func (*Response401) listMyBookingsRes()        {}
-func (*Response401) listOrdersRes()            {}
-func (*Response401) updateBookingRes()         {}
+func (*Response401) acceptBookingTransferRes()    {}
+func (*Response401) addBookingGroupMemberRes()    {}
+func (*Response401) allocateSeatsRes()            {}
+func (*Response401) createBookingForAdminRes()    {}
+func (*Response401) createBookingGroupRes()       {}
+func (*Response401) createBookingRes()            {}
+func (*Response401) createBookingTransferRes()    {}
+func (*Response401) createDelegationRes()         {}
+func (*Response401) createOrderRes()              {}
+func (*Response401) deleteBookingGroupRes()       {}
+func (*Response401) deleteBookingRes()            {}
+func (*Response401) deleteBookingTransferRes()    {}
+func (*Response401) deleteDelegationRes()         {}
+func (*Response401) deleteOrdersRes()             {}
+func (*Response401) deleteRoleQuotaOverrideRes()  {}
+func (*Response401) deleteTeamQuotaOverrideRes()  {}
+func (*Response401) deleteUserQuotaOverrideRes()  {}
+func (*Response401) extendBookingRes()            {}
+func (*Response401) getBookingByIdRes()           {}
+func (*Response401) getBookingGroupRes()          {}
+func (*Response401) getBookingHistoryRes()        {}
+func (*Response401) getBuildingWorkloadRes()      {}
+func (*Response401) getFloorWorkloadRes()         {}
+func (*Response401) getMyQuotaRes()               {}
+func (*Response401) getWorkloadRes()              {}
+func (*Response401) listAllBookingsRes()          {}
+func (*Response401) listBookingTransfersRes()     {}
+func (*Response401) listDelegationsRes()          {}
+func (*Response401) listMyBookingsRes()           {}
+func (*Response401) listOrdersRes()               {}
+func (*Response401) listQuotaOverridesRes()       {}
+func (*Response401) releaseBookingRes()           {}
+func (*Response401) removeBookingGroupMemberRes() {}
+func (*Response401) setRoleQuotaOverrideRes()     {}
+func (*Response401) setTeamQuotaOverrideRes()     {}
+func (*Response401) setUserQuotaOverrideRes()     {}
+func (*Response401) updateBookingGroupRes()       {}
+func (*Response401) updateBookingRes()            {}
 
 type Response404 struct {
 	// Тип ресурса, который не был найден.
@@ -871,42 +2852,76 @@ func (s *Response404) SetResource(val OptResponse404Resource) {
 	s.Resource = val
 }
 
-func (*Response404) createBookingForAdminRes() {}
-func (*Response404) createBookingRes()         {}
-func (*Response404) createOrderRes()           {}
-func (*Response404) deleteBookingRes()         {}
-func (*Response404) deleteOrdersRes()          {}
-func (*Response404) getBookingByIdRes()        {}
-func (*Response404) getFloorWorkloadRes()      {}
-func (*Response404) getWorkloadRes()           {}
-func (*Response404) listOrdersRes()            {}
-func (*Response404) updateBookingRes()         {}
+func (*Response404) acceptBookingTransferRes()    {}
+func (*Response404) addBookingGroupMemberRes()    {}
+func (*Response404) allocateSeatsRes()            {}
+func (*Response404) createBookingForAdminRes()    {}
+func (*Response404) createBookingGroupRes()       {}
+func (*Response404) createBookingRes()            {}
+func (*Response404) createBookingTransferRes()    {}
+func (*Response404) createDelegationRes()         {}
+func (*Response404) createOrderRes()              {}
+func (*Response404) deleteBookingGroupRes()       {}
+func (*Response404) deleteBookingRes()            {}
+func (*Response404) deleteBookingTransferRes()    {}
+func (*Response404) deleteDelegationRes()         {}
+func (*Response404) deleteOrdersRes()             {}
+func (*Response404) deleteRoleQuotaOverrideRes()  {}
+func (*Response404) deleteTeamQuotaOverrideRes()  {}
+func (*Response404) deleteUserQuotaOverrideRes()  {}
+func (*Response404) extendBookingRes()            {}
+func (*Response404) getBookingByIdRes()           {}
+func (*Response404) getBookingGroupRes()          {}
+func (*Response404) getBookingHistoryRes()        {}
+func (*Response404) getBuildingWorkloadRes()      {}
+func (*Response404) getFloorWorkloadRes()         {}
+func (*Response404) getMyQuotaRes()               {}
+func (*Response404) getWorkloadRes()              {}
+func (*Response404) listOrdersRes()               {}
+func (*Response404) releaseBookingRes()           {}
+func (*Response404) removeBookingGroupMemberRes() {}
+func (*Response404) updateBookingGroupRes()       {}
+func (*Response404) updateBookingRes()            {}
 
 // Тип ресурса, который не был найден.
 type Response404Resource string
 
 const (
+	Response404ResourceBuilding      Response404Resource = "Building"
 	Response404ResourceFloor         Response404Resource = "Floor"
 	Response404ResourceBookingEntity Response404Resource = "BookingEntity"
 	Response404ResourceBooking       Response404Resource = "Booking"
 	Response404ResourceOrder         Response404Resource = "Order"
 	Response404ResourceGuest         Response404Resource = "Guest"
+	Response404ResourceUser          Response404Resource = "User"
+	Response404ResourceDelegation    Response404Resource = "Delegation"
+	Response404ResourceBookingGroup  Response404Resource = "BookingGroup"
+	Response404ResourceQuotaOverride Response404Resource = "QuotaOverride"
+	Response404ResourceTransfer      Response404Resource = "Transfer"
 )
 
 // AllValues returns all Response404Resource values.
 func (Response404Resource) AllValues() []Response404Resource {
 	return []Response404Resource{
+		Response404ResourceBuilding,
 		Response404ResourceFloor,
 		Response404ResourceBookingEntity,
 		Response404ResourceBooking,
 		Response404ResourceOrder,
 		Response404ResourceGuest,
+		Response404ResourceUser,
+		Response404ResourceDelegation,
+		Response404ResourceBookingGroup,
+		Response404ResourceQuotaOverride,
+		Response404ResourceTransfer,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
 func (s Response404Resource) MarshalText() ([]byte, error) {
 	switch s {
+	case Response404ResourceBuilding:
+		return []byte(s), nil
 	case Response404ResourceFloor:
 		return []byte(s), nil
 	case Response404ResourceBookingEntity:
@@ -917,6 +2932,16 @@ func (s Response404Resource) MarshalText() ([]byte, error) {
 		return []byte(s), nil
 	case Response404ResourceGuest:
 		return []byte(s), nil
+	case Response404ResourceUser:
+		return []byte(s), nil
+	case Response404ResourceDelegation:
+		return []byte(s), nil
+	case Response404ResourceBookingGroup:
+		return []byte(s), nil
+	case Response404ResourceQuotaOverride:
+		return []byte(s), nil
+	case Response404ResourceTransfer:
+		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
 	}
@@ -925,6 +2950,9 @@ func (s Response404Resource) MarshalText() ([]byte, error) {
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (s *Response404Resource) UnmarshalText(data []byte) error {
 	switch Response404Resource(data) {
+	case Response404ResourceBuilding:
+		*s = Response404ResourceBuilding
+		return nil
 	case Response404ResourceFloor:
 		*s = Response404ResourceFloor
 		return nil
@@ -940,11 +2968,117 @@ func (s *Response404Resource) UnmarshalText(data []byte) error {
 	case Response404ResourceGuest:
 		*s = Response404ResourceGuest
 		return nil
+	case Response404ResourceUser:
+		*s = Response404ResourceUser
+		return nil
+	case Response404ResourceDelegation:
+		*s = Response404ResourceDelegation
+		return nil
+	case Response404ResourceBookingGroup:
+		*s = Response404ResourceBookingGroup
+		return nil
+	case Response404ResourceQuotaOverride:
+		*s = Response404ResourceQuotaOverride
+		return nil
+	case Response404ResourceTransfer:
+		*s = Response404ResourceTransfer
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type Response412 struct {
+	// Сообщение об ошибке.
+	Message OptString `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *Response412) GetMessage() OptString {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *Response412) SetMessage(val OptString) {
+	s.Message = val
+}
+
+func (*Response412) deleteBookingRes()  {}
+func (*Response412) extendBookingRes()  {}
+func (*Response412) releaseBookingRes() {}
+func (*Response412) updateBookingRes()  {}
+
+// Ref: #/components/schemas/Role
+type Role string
+
+const (
+	RoleUSER       Role = "USER"
+	RoleADMIN      Role = "ADMIN"
+	RoleSUPERADMIN Role = "SUPER_ADMIN"
+	RoleSUPPORT    Role = "SUPPORT"
+)
+
+// AllValues returns all Role values.
+func (Role) AllValues() []Role {
+	return []Role{
+		RoleUSER,
+		RoleADMIN,
+		RoleSUPERADMIN,
+		RoleSUPPORT,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Role) MarshalText() ([]byte, error) {
+	switch s {
+	case RoleUSER:
+		return []byte(s), nil
+	case RoleADMIN:
+		return []byte(s), nil
+	case RoleSUPERADMIN:
+		return []byte(s), nil
+	case RoleSUPPORT:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Role) UnmarshalText(data []byte) error {
+	switch Role(data) {
+	case RoleUSER:
+		*s = RoleUSER
+		return nil
+	case RoleADMIN:
+		*s = RoleADMIN
+		return nil
+	case RoleSUPERADMIN:
+		*s = RoleSUPERADMIN
+		return nil
+	case RoleSUPPORT:
+		*s = RoleSUPPORT
+		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
+// SetRoleQuotaOverrideForbidden is response for SetRoleQuotaOverride operation.
+type SetRoleQuotaOverrideForbidden struct{}
+
+func (*SetRoleQuotaOverrideForbidden) setRoleQuotaOverrideRes() {}
+
+// SetTeamQuotaOverrideForbidden is response for SetTeamQuotaOverride operation.
+type SetTeamQuotaOverrideForbidden struct{}
+
+func (*SetTeamQuotaOverrideForbidden) setTeamQuotaOverrideRes() {}
+
+// SetUserQuotaOverrideForbidden is response for SetUserQuotaOverride operation.
+type SetUserQuotaOverrideForbidden struct{}
+
+func (*SetUserQuotaOverrideForbidden) setUserQuotaOverrideRes() {}
+
 type Time int64
 
 // UpdateBookingConflict is response for UpdateBooking operation.
@@ -957,6 +3091,16 @@ type UpdateBookingForbidden struct{}
 
 func (*UpdateBookingForbidden) updateBookingRes() {}
 
+// UpdateBookingGroupConflict is response for UpdateBookingGroup operation.
+type UpdateBookingGroupConflict struct{}
+
+func (*UpdateBookingGroupConflict) updateBookingGroupRes() {}
+
+// UpdateBookingGroupForbidden is response for UpdateBookingGroup operation.
+type UpdateBookingGroupForbidden struct{}
+
+func (*UpdateBookingGroupForbidden) updateBookingGroupRes() {}
+
 // Ref: #/components/schemas/User
 type User struct {
 	// Уникальный идентификатор пользователя.
@@ -8,11 +8,98 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	AllocationsHandler
+	BookingGroupsHandler
 	BookingsHandler
+	DelegationsHandler
 	OrdersHandler
+	QuotasHandler
+	TransfersHandler
 	WorkloadsHandler
 }
 
+// AllocationsHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: Allocations
+type AllocationsHandler interface {
+	// AllocateSeats implements allocateSeats operation.
+	//
+	// Подбирает указанное количество свободных мест в
+	// открытых пространствах этажа
+	// на заданный период времени так, чтобы они находились
+	// как можно ближе друг к другу.
+	// Близость определяется по расстоянию между
+	// прямоугольниками рабочих мест на плане этажа.
+	// Места, закрепленные за другими командами или
+	// сотрудниками, не предлагаются.
+	// Если указан book, подобранные места сразу бронируются
+	// одним групповым бронированием;
+	// места распределяются между участниками из attendees в
+	// порядке перечисления,
+	// а если участники не указаны, бронируются для текущего
+	// пользователя.
+	//
+	// POST /allocations
+	AllocateSeats(ctx context.Context, req *AllocationRequest) (AllocateSeatsRes, error)
+}
+
+// BookingGroupsHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: BookingGroups
+type BookingGroupsHandler interface {
+	// AddBookingGroupMember implements addBookingGroupMember operation.
+	//
+	// Бронирует рабочее место для участника на время
+	// группового бронирования.
+	//
+	// POST /booking-groups/{groupId}/members
+	AddBookingGroupMember(ctx context.Context, req *BookingGroupItem, params AddBookingGroupMemberParams) (AddBookingGroupMemberRes, error)
+	// CreateBookingGroup implements createBookingGroup operation.
+	//
+	// Бронирует несколько рабочих мест на один и тот же
+	// период времени одной операцией.
+	// Для каждого места можно указать участника, для
+	// которого оно бронируется;
+	// если участник не указан, место бронируется для
+	// текущего пользователя.
+	// Проверки те же, что и при создании обычного
+	// бронирования.
+	// Бронирования создаются в одной транзакции: если хотя
+	// бы одно место недоступно,
+	// не создается ни одно бронирование.
+	//
+	// POST /booking-groups
+	CreateBookingGroup(ctx context.Context, req *BookingGroupCreate) (CreateBookingGroupRes, error)
+	// DeleteBookingGroup implements deleteBookingGroup operation.
+	//
+	// Удаляет групповое бронирование вместе со всеми его
+	// бронированиями.
+	//
+	// DELETE /booking-groups/{groupId}
+	DeleteBookingGroup(ctx context.Context, params DeleteBookingGroupParams) (DeleteBookingGroupRes, error)
+	// GetBookingGroup implements getBookingGroup operation.
+	//
+	// Групповое бронирование доступно его создателю и
+	// администратору.
+	//
+	// GET /booking-groups/{groupId}
+	GetBookingGroup(ctx context.Context, params GetBookingGroupParams) (GetBookingGroupRes, error)
+	// RemoveBookingGroupMember implements removeBookingGroupMember operation.
+	//
+	// Удаляет все бронирования участника в группе.
+	// Если в группе не осталось бронирований, она удаляется.
+	//
+	// DELETE /booking-groups/{groupId}/members/{userId}
+	RemoveBookingGroupMember(ctx context.Context, params RemoveBookingGroupMemberParams) (RemoveBookingGroupMemberRes, error)
+	// UpdateBookingGroup implements updateBookingGroup operation.
+	//
+	// Изменяет время начала и/или окончания всех
+	// бронирований группы одной транзакцией.
+	//
+	// PATCH /booking-groups/{groupId}
+	UpdateBookingGroup(ctx context.Context, req *BookingGroupUpdate, params UpdateBookingGroupParams) (UpdateBookingGroupRes, error)
+}
+
 // BookingsHandler handles operations described by OpenAPI v3 specification.
 //
 // x-ogen-operation-group: Bookings
@@ -21,6 +108,14 @@ type BookingsHandler interface {
 	//
 	// Создает новое бронирование для указанного рабочего
 	// места на заданный период времени.
+	// Время должно быть кратно 15 минутам в часовом поясе
+	// здания и попадать в часы его работы.
+	// Если указан on_behalf_of, бронирование создается для этого
+	// пользователя;
+	// для этого он должен выдать текущему пользователю
+	// право бронировать за него,
+	// либо текущий пользователь должен быть
+	// администратором.
 	// В случае успеха возвращает созданное бронирование.
 	//
 	// POST /bookings
@@ -37,9 +132,27 @@ type BookingsHandler interface {
 	//
 	// Удаляет бронирование по его уникальному
 	// идентификатору.
+	// Если передан If-Match, бронирование удаляется, только
+	// если не изменилось с тех пор.
 	//
 	// DELETE /bookings/{bookingId}
 	DeleteBooking(ctx context.Context, params DeleteBookingParams) (DeleteBookingRes, error)
+	// ExtendBooking implements extendBooking operation.
+	//
+	// Продлевает идущее сейчас бронирование на указанное
+	// число 15-минутных слотов.
+	// Проверяются только добавленные слоты: место должно
+	// быть свободно,
+	// а слоты должны попадать в часы работы здания и не
+	// превышать квоту.
+	// Бронирование, входящее в группу, продлевается только
+	// вместе с группой.
+	// Продление записывается в историю бронирования.
+	// Если передан If-Match, бронирование продлевается, только
+	// если не изменилось с тех пор.
+	//
+	// POST /bookings/{bookingId}/extend
+	ExtendBooking(ctx context.Context, req *BookingExtend, params ExtendBookingParams) (ExtendBookingRes, error)
 	// GetBookingById implements getBookingById operation.
 	//
 	// Возвращает информацию о бронировании по его
@@ -47,28 +160,97 @@ type BookingsHandler interface {
 	//
 	// GET /bookings/{bookingId}
 	GetBookingById(ctx context.Context, params GetBookingByIdParams) (GetBookingByIdRes, error)
+	// GetBookingHistory implements getBookingHistory operation.
+	//
+	// Возвращает продления и досрочные завершения
+	// бронирования в порядке их совершения.
+	//
+	// GET /bookings/{bookingId}/history
+	GetBookingHistory(ctx context.Context, params GetBookingHistoryParams) (GetBookingHistoryRes, error)
 	// ListAllBookings implements listAllBookings operation.
 	//
-	// Возвращает список всех бронирований.
+	// Возвращает страницу бронирований вместе с рабочими
+	// местами, этажами и заказами.
+	// Следующая страница запрашивается с курсором next_cursor
+	// из ответа и теми же сортировкой и фильтрами.
 	//
 	// GET /bookings
-	ListAllBookings(ctx context.Context) (ListAllBookingsRes, error)
+	ListAllBookings(ctx context.Context, params ListAllBookingsParams) (ListAllBookingsRes, error)
 	// ListMyBookings implements listMyBookings operation.
 	//
-	// Возвращает список всех бронирований, созданных
-	// текущим пользователем.
+	// Возвращает страницу бронирований текущего
+	// пользователя вместе с рабочими местами, этажами и
+	// заказами.
+	// Следующая страница запрашивается с курсором next_cursor
+	// из ответа и теми же сортировкой и фильтрами.
 	//
 	// GET /bookings/my
-	ListMyBookings(ctx context.Context) (ListMyBookingsRes, error)
+	ListMyBookings(ctx context.Context, params ListMyBookingsParams) (ListMyBookingsRes, error)
+	// ReleaseBooking implements releaseBooking operation.
+	//
+	// Завершает идущее сейчас бронирование: время
+	// окончания переносится
+	// на ближайшую следующую границу 15-минутного слота, и
+	// оставшееся время
+	// сразу становится доступно для других.
+	// Бронирование, входящее в группу, завершается только
+	// вместе с группой.
+	// Завершение записывается в историю бронирования.
+	// Если передан If-Match, бронирование завершается, только
+	// если не изменилось с тех пор.
+	//
+	// POST /bookings/{bookingId}/release
+	ReleaseBooking(ctx context.Context, params ReleaseBookingParams) (ReleaseBookingRes, error)
 	// UpdateBooking implements updateBooking operation.
 	//
 	// Обновляет время начала и/или окончания бронирования.
+	// Время должно быть кратно 15 минутам в часовом поясе
+	// здания и попадать в часы его работы.
+	// Если указан on_behalf_of, бронирование переназначается на
+	// этого пользователя.
+	// Время бронирования, входящего в группу, меняется
+	// только вместе с группой.
+	// Бронирование может изменить его владелец, тот, кто
+	// его создал,
+	// пользователь с правом бронировать за владельца или
+	// администратор.
+	// Если передан If-Match, бронирование обновляется, только
+	// если не изменилось с тех пор.
 	// В случае успеха возвращает обновленное бронирование.
 	//
 	// PATCH /bookings/{bookingId}
 	UpdateBooking(ctx context.Context, req *BookingUpdate, params UpdateBookingParams) (UpdateBookingRes, error)
 }
 
+// DelegationsHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: Delegations
+type DelegationsHandler interface {
+	// CreateDelegation implements createDelegation operation.
+	//
+	// Разрешает указанному пользователю создавать и
+	// изменять бронирования от имени текущего
+	// пользователя.
+	//
+	// POST /delegations
+	CreateDelegation(ctx context.Context, req *DelegationCreate) (CreateDelegationRes, error)
+	// DeleteDelegation implements deleteDelegation operation.
+	//
+	// Отозвать право бронировать от своего имени.
+	//
+	// DELETE /delegations/{delegateId}
+	DeleteDelegation(ctx context.Context, params DeleteDelegationParams) (DeleteDelegationRes, error)
+	// ListDelegations implements listDelegations operation.
+	//
+	// Возвращает пользователей, которым текущий
+	// пользователь выдал право бронировать за него,
+	// и пользователей, которые выдали такое право текущему
+	// пользователю.
+	//
+	// GET /delegations
+	ListDelegations(ctx context.Context) (ListDelegationsRes, error)
+}
+
 // OrdersHandler handles operations described by OpenAPI v3 specification.
 //
 // x-ogen-operation-group: Orders
@@ -95,10 +277,143 @@ type OrdersHandler interface {
 	ListOrders(ctx context.Context, params ListOrdersParams) (ListOrdersRes, error)
 }
 
+// QuotasHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: Quotas
+type QuotasHandler interface {
+	// DeleteRoleQuotaOverride implements deleteRoleQuotaOverride operation.
+	//
+	// Удалить переопределение квоты роли (только для
+	// админа).
+	//
+	// DELETE /quotas/overrides/roles/{role}
+	DeleteRoleQuotaOverride(ctx context.Context, params DeleteRoleQuotaOverrideParams) (DeleteRoleQuotaOverrideRes, error)
+	// DeleteTeamQuotaOverride implements deleteTeamQuotaOverride operation.
+	//
+	// Удалить переопределение квоты команды (только для
+	// админа).
+	//
+	// DELETE /quotas/overrides/teams/{teamId}
+	DeleteTeamQuotaOverride(ctx context.Context, params DeleteTeamQuotaOverrideParams) (DeleteTeamQuotaOverrideRes, error)
+	// DeleteUserQuotaOverride implements deleteUserQuotaOverride operation.
+	//
+	// Удалить переопределение квоты пользователя (только
+	// для админа).
+	//
+	// DELETE /quotas/overrides/users/{userId}
+	DeleteUserQuotaOverride(ctx context.Context, params DeleteUserQuotaOverrideParams) (DeleteUserQuotaOverrideRes, error)
+	// GetMyQuota implements getMyQuota operation.
+	//
+	// Возвращает действующие лимиты текущего пользователя,
+	//  их использование и остаток.
+	// Часы считаются за текущую неделю, которая начинается
+	// в понедельник по местному
+	// времени здания. Без здания неделя считается по UTC.
+	// При бронировании неделя считается по часовому поясу
+	// здания бронируемого места.
+	// Лимит, равный 0, означает отсутствие ограничения;
+	// остаток для такого лимита не возвращается.
+	//
+	// GET /quotas/my
+	GetMyQuota(ctx context.Context, params GetMyQuotaParams) (GetMyQuotaRes, error)
+	// ListQuotaOverrides implements listQuotaOverrides operation.
+	//
+	// Получить переопределения квот (только для админа).
+	//
+	// GET /quotas/overrides
+	ListQuotaOverrides(ctx context.Context) (ListQuotaOverridesRes, error)
+	// SetRoleQuotaOverride implements setRoleQuotaOverride operation.
+	//
+	// Неуказанные лимиты наследуются от значений по
+	// умолчанию.
+	//
+	// PUT /quotas/overrides/roles/{role}
+	SetRoleQuotaOverride(ctx context.Context, req *QuotaOverrideUpdate, params SetRoleQuotaOverrideParams) (SetRoleQuotaOverrideRes, error)
+	// SetTeamQuotaOverride implements setTeamQuotaOverride operation.
+	//
+	// Квота действует для всех участников команды.
+	// Неуказанные лимиты наследуются от квоты роли и
+	// значений по умолчанию.
+	// Если пользователь состоит в нескольких командах, для
+	// каждого лимита
+	// действует наибольшее значение из квот его команд.
+	//
+	// PUT /quotas/overrides/teams/{teamId}
+	SetTeamQuotaOverride(ctx context.Context, req *QuotaOverrideUpdate, params SetTeamQuotaOverrideParams) (SetTeamQuotaOverrideRes, error)
+	// SetUserQuotaOverride implements setUserQuotaOverride operation.
+	//
+	// Неуказанные лимиты наследуются от квот команд, роли и
+	// значений по умолчанию.
+	//
+	// PUT /quotas/overrides/users/{userId}
+	SetUserQuotaOverride(ctx context.Context, req *QuotaOverrideUpdate, params SetUserQuotaOverrideParams) (SetUserQuotaOverrideRes, error)
+}
+
+// TransfersHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: Transfers
+type TransfersHandler interface {
+	// AcceptBookingTransfer implements acceptBookingTransfer operation.
+	//
+	// Получатель принимает предложение. Бронирование
+	// переходит к получателю,
+	// если он может бронировать это место, не превышает
+	// квоту и не имеет других бронирований на это время.
+	// При обмене бронирования обмениваются местами в одной
+	// транзакции,
+	// и каждый из участников должен иметь возможность
+	// бронировать полученное место.
+	// Возвращает измененные бронирования.
+	//
+	// POST /transfers/{transferId}/accept
+	AcceptBookingTransfer(ctx context.Context, params AcceptBookingTransferParams) (AcceptBookingTransferRes, error)
+	// CreateBookingTransfer implements createBookingTransfer operation.
+	//
+	// Предлагает передать бронирование текущего
+	// пользователя сотруднику с указанной почтой.
+	// Бронирование переходит к получателю, только когда он
+	// примет предложение.
+	// Если передан swap_booking_id, получатель взамен отдает свое
+	// бронирование на то же время,
+	// и бронирования обмениваются местами.
+	// Завершенные бронирования и бронирования, входящие в
+	// группу, передать нельзя.
+	// На одно бронирование может быть только одно
+	// предложение.
+	// Если сотрудника с указанной почтой нет, ответ такой
+	// же, как для существующего,
+	// чтобы по нему нельзя было проверить почту, но принять
+	// такое предложение некому.
+	//
+	// POST /bookings/{bookingId}/transfers
+	CreateBookingTransfer(ctx context.Context, req *BookingTransferCreate, params CreateBookingTransferParams) (CreateBookingTransferRes, error)
+	// DeleteBookingTransfer implements deleteBookingTransfer operation.
+	//
+	// Отправитель отзывает предложение, получатель
+	// отклоняет его.
+	//
+	// DELETE /transfers/{transferId}
+	DeleteBookingTransfer(ctx context.Context, params DeleteBookingTransferParams) (DeleteBookingTransferRes, error)
+	// ListBookingTransfers implements listBookingTransfers operation.
+	//
+	// Возвращает предложения передачи, отправленные
+	// текущим пользователем и адресованные ему.
+	//
+	// GET /transfers
+	ListBookingTransfers(ctx context.Context) (ListBookingTransfersRes, error)
+}
+
 // WorkloadsHandler handles operations described by OpenAPI v3 specification.
 //
 // x-ogen-operation-group: Workloads
 type WorkloadsHandler interface {
+	// GetBuildingWorkload implements getBuildingWorkload operation.
+	//
+	// Возвращает информацию о нагрузке на все рабочие
+	// места здания за указанный период времени.
+	//
+	// GET /workloads/buildings/{buildingId}
+	GetBuildingWorkload(ctx context.Context, params GetBuildingWorkloadParams) (GetBuildingWorkloadRes, error)
 	// GetFloorWorkload implements getFloorWorkload operation.
 	//
 	// Возвращает информацию о нагрузке на указанный этаж
@@ -0,0 +1,9 @@
+package tracing
+
+type Config struct {
+	ServiceName  string  `env:"TRACING_SERVICE_NAME" env-default:"booking"`
+	Exporter     string  `env:"TRACING_EXPORTER" env-default:"none"`
+	OTLPEndpoint string  `env:"TRACING_OTLP_ENDPOINT" env-default:"localhost:4318"`
+	OTLPInsecure bool    `env:"TRACING_OTLP_INSECURE" env-default:"true"`
+	SampleRatio  float64 `env:"TRACING_SAMPLE_RATIO" env-default:"1"`
+}
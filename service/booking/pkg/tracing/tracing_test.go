@@ -0,0 +1,39 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+func TestInit(t *testing.T) {
+	ctx := context.Background()
+
+	shutdown, err := Init(ctx, Config{ServiceName: "booking", Exporter: ExporterNone, SampleRatio: 1})
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(ctx))
+
+	_, err = Init(ctx, Config{ServiceName: "booking", Exporter: "jaeger"})
+	assert.Error(t, err)
+}
+
+func TestPropagation(t *testing.T) {
+	ctx := context.Background()
+
+	shutdown, err := Init(ctx, Config{ServiceName: "booking", Exporter: ExporterStdout, SampleRatio: 1})
+	require.NoError(t, err)
+	defer shutdown(ctx)
+
+	ctx, span := otel.Tracer("test").Start(ctx, "outgoing")
+	defer span.End()
+
+	header := http.Header{}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+
+	assert.Contains(t, header.Get("traceparent"), span.SpanContext().TraceID().String())
+}
@@ -1,11 +1,14 @@
 package app
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/nikitaSstepanov/coffee-id/internal/controller"
 	"github.com/nikitaSstepanov/coffee-id/internal/usecase"
 	"github.com/nikitaSstepanov/coffee-id/internal/usecase/storage"
+	"github.com/nikitaSstepanov/coffee-id/pkg/health"
+	"github.com/nikitaSstepanov/coffee-id/pkg/tracing"
 	"github.com/nikitaSstepanov/tools"
 	"github.com/nikitaSstepanov/tools/ctx"
 	e "github.com/nikitaSstepanov/tools/error"
@@ -18,7 +21,9 @@ type App struct {
 	usecase    *usecase.UseCase
 	storage    *storage.Storage
 	server     *httper.Server
+	health     *health.Checker
 	ctx        ctx.Context
+	tracing    func(context.Context) error
 }
 
 func New() *App {
@@ -39,11 +44,21 @@ func New() *App {
 
 	app.ctx = ctx
 
+	app.tracing, err = tracing.Init(context.Background(), &cfg.Tracing)
+	if err != nil {
+		log.Error("Can`t init tracing", sl.ErrAttr(err))
+		panic("App start error.")
+	}
+
 	app.storage = storage.New(ctx)
 
 	app.usecase = usecase.New(app.storage, &cfg.UseCase)
 
-	app.controller = controller.New(app.usecase, &cfg.Controller)
+	app.health = health.New(&cfg.Health)
+	app.health.Add("postgres", app.storage.PingPostgres)
+	app.health.Add("redis", app.storage.PingRedis)
+
+	app.controller = controller.New(app.usecase, app.health, &cfg.Controller)
 
 	handler := app.controller.InitRoutes(ctx)
 
@@ -68,11 +83,19 @@ func (a *App) Run() {
 func (a *App) shutdown() e.Error {
 	log := a.ctx.Logger()
 
+	a.health.Drain()
+
 	err := e.E(a.server.Shutdown(a.ctx))
 	if err != nil {
 		log.Error("Failed to stop http server", err.SlErr())
 		return err
 	}
 
+	err = e.E(a.tracing(a.ctx))
+	if err != nil {
+		log.Error("Failed to stop tracing", err.SlErr())
+		return err
+	}
+
 	return nil
 }
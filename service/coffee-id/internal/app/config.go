@@ -3,12 +3,16 @@ package app
 import (
 	"github.com/nikitaSstepanov/coffee-id/internal/controller"
 	"github.com/nikitaSstepanov/coffee-id/internal/usecase"
+	"github.com/nikitaSstepanov/coffee-id/pkg/health"
+	"github.com/nikitaSstepanov/coffee-id/pkg/tracing"
 	config "github.com/nikitaSstepanov/tools/configurator"
 )
 
 type Config struct {
 	Controller controller.Config `yaml:"controller"`
 	UseCase    usecase.Config    `yaml:"usecase"`
+	Tracing    tracing.Config    `yaml:"tracing"`
+	Health     health.Config     `yaml:"health"`
 }
 
 func getConfig() (*Config, error) {
@@ -7,44 +7,69 @@ import (
 	"github.com/gin-gonic/gin"
 	v1 "github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1"
 	"github.com/nikitaSstepanov/coffee-id/internal/usecase"
+	"github.com/nikitaSstepanov/coffee-id/pkg/health"
+	"github.com/nikitaSstepanov/coffee-id/pkg/metrics"
 	"github.com/nikitaSstepanov/tools/ctx"
 	"github.com/nikitaSstepanov/tools/httper"
+	"github.com/nikitaSstepanov/tools/sl"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 )
 
+const serviceName = "coffee-id"
+
 type Config struct {
 	V1   v1.Config `yaml:"v1"`
 	Mode string    `yaml:"mode" env:"MODE" env-default:"DEBUG"`
+
+	// TrustedProxies may set X-Forwarded-For. Without them client ip, which
+	// login and signup limits are keyed on, is the address of connection.
+	TrustedProxies []string `yaml:"trusted_proxies" env:"TRUSTED_PROXIES" env-separator:","`
 }
 
 type Controller struct {
-	v1  *v1.Router
-	cfg *Config
+	v1     *v1.Router
+	health *health.Checker
+	cfg    *Config
 }
 
-func New(uc *usecase.UseCase, cfg *Config) *Controller {
+func New(uc *usecase.UseCase, health *health.Checker, cfg *Config) *Controller {
 
 	return &Controller{
-		v1:  v1.New(uc, &cfg.V1),
-		cfg: cfg,
+		v1:     v1.New(uc, &cfg.V1),
+		health: health,
+		cfg:    cfg,
 	}
 }
 
 func (c *Controller) InitRoutes(ctx ctx.Context) *gin.Engine {
 	setGinMode(c.cfg.Mode)
 
-	router := gin.New()
+	router, err := newEngine(c.cfg)
+	if err != nil {
+		ctx.Logger().Error("Can`t set trusted proxies", sl.ErrAttr(err))
+		panic("App start error.")
+	}
+
 	router.Use(cors.New(cors.Config{
 		AllowOriginFunc: func (origin string) bool {return true},
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS", "HEAD", "TRACE", "CONNECT"},
-		AllowHeaders:     []string{"Origin", "Content-Length", "Content-Type", "Authorization", "Yandex-Token"},
+		AllowHeaders:     []string{"Origin", "Content-Length", "Content-Type", "Authorization"},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}))
 
+	router.GET("/metrics", gin.WrapH(metrics.Handler()))
+	router.GET("/healthz", gin.WrapF(c.health.Live))
+	router.GET("/readyz", gin.WrapF(c.health.Ready))
+
+	router.Use(otelgin.Middleware(serviceName), metrics.Gin())
+
 	router.GET("/ping", func(ctx *gin.Context) {
 		ctx.JSON(httper.StatusOK, "pong")
 	})
 
+	c.v1.InitWellKnownRoutes(router)
+
 	api := router.Group("/api")
 	{
 		c.v1.InitRoutes(ctx, api)
@@ -53,6 +78,16 @@ func (c *Controller) InitRoutes(ctx ctx.Context) *gin.Engine {
 	return router
 }
 
+func newEngine(cfg *Config) (*gin.Engine, error) {
+	router := gin.New()
+
+	if err := router.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		return nil, err
+	}
+
+	return router, nil
+}
+
 func setGinMode(mode string) {
 	switch mode {
 
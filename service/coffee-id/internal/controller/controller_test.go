@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/assert/v2"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	"github.com/nikitaSstepanov/coffee-id/internal/usecase/pkg/security"
+	storage "github.com/nikitaSstepanov/coffee-id/internal/usecase/storage/security"
+	"github.com/nikitaSstepanov/tools/ctx"
+	"github.com/nikitaSstepanov/tools/sl"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestSpoofedForwardedFor(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	server, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("Can`t run redis: %v", err)
+	}
+	defer server.Close()
+
+	rs := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	limiter := security.New(&security.Storages{Security: storage.New(nil, *rs)})
+
+	router, err := newEngine(&Config{})
+	if err != nil {
+		t.Fatalf("Can`t create engine: %v", err)
+	}
+
+	c := ctx.New(sl.Default())
+	router.POST("/login", func(g *gin.Context) {
+		if err := limiter.LimitIp(c, types.LOGIN, g.ClientIP()); err != nil {
+			assert.Equal(t, err.GetCode(), types.TooManyRequests)
+			g.Status(http.StatusTooManyRequests)
+			return
+		}
+
+		g.Status(http.StatusNoContent)
+	})
+
+	login := func(forwardedFor string) int {
+		req := httptest.NewRequest(http.MethodPost, "/login", nil)
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		return w.Code
+	}
+
+	// Every request claims another ip, all of them are counted for the connection.
+	for i := range 20 {
+		assert.Equal(t, login(fmt.Sprintf("203.0.113.%d", i)), http.StatusNoContent)
+	}
+
+	assert.Equal(t, login("203.0.113.20"), http.StatusTooManyRequests)
+}
+
+func TestTrustedProxies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router, err := newEngine(&Config{TrustedProxies: []string{"192.0.2.0/24"}})
+	if err != nil {
+		t.Fatalf("Can`t create engine: %v", err)
+	}
+
+	router.GET("/ip", func(g *gin.Context) {
+		g.String(http.StatusOK, g.ClientIP())
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/ip", nil)
+	req.Header.Set("X-Forwarded-For", "203.0.113.7")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, w.Body.String(), "203.0.113.7")
+
+	if _, err := newEngine(&Config{TrustedProxies: []string{"not an ip"}}); err == nil {
+		t.Errorf("Can`t reject invalid proxy")
+	}
+}
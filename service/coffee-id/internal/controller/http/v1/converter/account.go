@@ -14,39 +14,40 @@ func DtoUser(user *entity.User) *dto.Account {
 	}
 }
 
-func DtoAnswer(user *entity.User, token string) *dto.AccountAnswer {
-	return &dto.AccountAnswer{
-		Id:    user.Id,
-		Email: user.Email,
-		Name:  user.Name,
-		Token: token,
+func DtoUsers(users []*entity.User) []*dto.Account {
+	result := make([]*dto.Account, len(users))
+
+	for i, user := range users {
+		result[i] = DtoUser(user)
 	}
-}
 
-func DtoUserForOAuth(user *entity.User, opts *entity.FieldOptions) map[string]interface{} {
-	data := make(map[string]interface{})
+	return result
+}
 
-	if user.Id != "" {
-		data["id"] = user.Id
+func DtoServiceUser(user *entity.User) *dto.ServiceAccount {
+	return &dto.ServiceAccount{
+		Account: *DtoUser(user),
+		Role:    string(user.Role),
 	}
+}
 
-	if user.Email != "" {
-		data["email"] = user.Email
-	}
+func DtoServiceUsers(users []*entity.User) []*dto.ServiceAccount {
+	result := make([]*dto.ServiceAccount, len(users))
 
-	if user.Name != "" {
-		data["name"] = user.Name
+	for i, user := range users {
+		result[i] = DtoServiceUser(user)
 	}
 
-	if len(user.Role) != 0 {
-		data["role"] = user.Role
-	}
+	return result
+}
 
-	if opts.HasVerified {
-		data["verified"] = user.Verified
+func DtoAnswer(user *entity.User, token string) *dto.AccountAnswer {
+	return &dto.AccountAnswer{
+		Id:    user.Id,
+		Email: user.Email,
+		Name:  user.Name,
+		Token: token,
 	}
-
-	return data
 }
 
 func EntityCreate(create dto.CreateUser) *entity.User {
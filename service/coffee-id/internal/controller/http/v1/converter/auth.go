@@ -17,3 +17,72 @@ func DtoToken(tokens *entity.Tokens) dto.Token {
 		Token: tokens.Access,
 	}
 }
+
+func EntityClient(userAgent, ip string) *entity.Session {
+	return &entity.Session{
+		Device: userAgent,
+		Ip:     ip,
+	}
+}
+
+func DtoSessions(sessions []*entity.Session, currentId string) []*dto.Session {
+	result := make([]*dto.Session, 0, len(sessions))
+
+	for _, session := range sessions {
+		result = append(result, &dto.Session{
+			Id:         session.Id,
+			Device:     session.Device,
+			Ip:         session.Ip,
+			CreatedAt:  session.CreatedAt,
+			LastUsedAt: session.LastUsedAt,
+			Current:    session.Id == currentId,
+		})
+	}
+
+	return result
+}
+
+func DtoAuthUrl(url string) dto.AuthUrl {
+	return dto.AuthUrl{
+		Url: url,
+	}
+}
+
+func DtoIdentities(identities []*entity.Identity) []*dto.Identity {
+	result := make([]*dto.Identity, 0, len(identities))
+
+	for _, identity := range identities {
+		result = append(result, &dto.Identity{
+			Provider:  identity.Provider,
+			Email:     identity.Email,
+			CreatedAt: identity.CreatedAt,
+		})
+	}
+
+	return result
+}
+
+func DtoTwoFactorSetup(setup *entity.TwoFactorSetup) *dto.TwoFactorSetup {
+	if setup == nil {
+		return nil
+	}
+
+	return &dto.TwoFactorSetup{
+		Secret: setup.Secret,
+		Uri:    setup.Uri,
+	}
+}
+
+func DtoChallenge(challenge *entity.LoginChallenge) *dto.TwoFactorChallenge {
+	return &dto.TwoFactorChallenge{
+		Challenge: challenge.Id,
+		Setup:     DtoTwoFactorSetup(challenge.Setup),
+	}
+}
+
+func DtoTwoFactorAnswer(user *entity.User, token string, recovery []string) *dto.TwoFactorAnswer {
+	return &dto.TwoFactorAnswer{
+		AccountAnswer: *DtoAnswer(user, token),
+		RecoveryCodes: recovery,
+	}
+}
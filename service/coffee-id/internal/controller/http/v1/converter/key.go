@@ -0,0 +1,42 @@
+package converter
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+
+	"github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/dto"
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+)
+
+func DtoJWKS(keys []*entity.PublicKey) *dto.JWKS {
+	result := make([]*dto.JWK, 0, len(keys))
+
+	for _, key := range keys {
+		jwk := &dto.JWK{
+			Kid: key.Kid,
+			Use: "sig",
+			Alg: key.Alg,
+		}
+
+		switch pub := key.Key.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+		default:
+			continue
+		}
+
+		result = append(result, jwk)
+	}
+
+	return &dto.JWKS{
+		Keys: result,
+	}
+}
@@ -0,0 +1,128 @@
+package converter
+
+import (
+	"strings"
+	"time"
+
+	"github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/dto"
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+)
+
+func DtoClient(client *entity.Client) *dto.Client {
+	return &dto.Client{
+		Id:          client.Id,
+		Name:        client.Name,
+		ClientId:    client.ClientId,
+		RedirectUri: client.RedirectUri,
+		Fields:      fieldsOrEmpty(client.Fields),
+		CreatedAt:   client.CreatedAt,
+	}
+}
+
+func DtoClients(clients []*entity.Client) []*dto.Client {
+	result := make([]*dto.Client, 0, len(clients))
+
+	for _, client := range clients {
+		result = append(result, DtoClient(client))
+	}
+
+	return result
+}
+
+func DtoClientCredentials(client *entity.Client, secret string) *dto.ClientCredentials {
+	return &dto.ClientCredentials{
+		Id:           client.Id,
+		Name:         client.Name,
+		ClientId:     client.ClientId,
+		ClientSecret: secret,
+		RedirectUri:  client.RedirectUri,
+		Fields:       fieldsOrEmpty(client.Fields),
+		CreatedAt:    client.CreatedAt,
+	}
+}
+
+func EntityOAuthClient(create dto.CreateClient, userId string) *entity.Client {
+	return &entity.Client{
+		Name:        create.Name,
+		RedirectUri: create.RedirectUri,
+		Fields:      create.Fields,
+		UserId:      userId,
+	}
+}
+
+func EntityOAuthSession(query dto.Authorize, userId string) *entity.OAuthSession {
+	return &entity.OAuthSession{
+		UserId:      userId,
+		ClientId:    query.ClientId,
+		RedirectUri: query.RedirectUri,
+		Fields:      types.ParseScope(query.Scope),
+		State:       query.State,
+		Nonce:       query.Nonce,
+		Challenge:   query.CodeChallenge,
+	}
+}
+
+func DtoConsent(session *entity.OAuthSession, client *entity.Client) *dto.Consent {
+	return &dto.Consent{
+		RequestId:  session.Id,
+		ClientName: client.Name,
+		ClientId:   client.ClientId,
+		Fields:     fieldsOrEmpty(session.Fields),
+	}
+}
+
+func EntityOAuthExchange(body dto.TokenRequest) *entity.OAuthExchange {
+	return &entity.OAuthExchange{
+		ClientId:    body.ClientId,
+		Secret:      body.ClientSecret,
+		Code:        body.Code,
+		RedirectUri: body.RedirectUri,
+		Verifier:    body.CodeVerifier,
+	}
+}
+
+func DtoTokenResponse(tokens *entity.OAuthTokens) *dto.TokenResponse {
+	return &dto.TokenResponse{
+		AccessToken: tokens.Access,
+		TokenType:   "Bearer",
+		ExpiresIn:   int(tokens.Expires.Seconds()),
+		IdToken:     tokens.IdToken,
+		Scope:       tokens.Scope,
+	}
+}
+
+// DtoUserInfo returns standard claims of user limited to fields granted to client.
+func DtoUserInfo(user *entity.User, fields []types.Field) *dto.UserInfo {
+	info := &dto.UserInfo{
+		Sub: user.Id,
+	}
+
+	for _, field := range fields {
+		switch field {
+		case types.EMAIL:
+			info.Email = user.Email
+		case types.VERIFIED:
+			verified := user.Verified
+			info.EmailVerified = &verified
+		case types.NAME:
+			info.Name = user.Name
+		case types.BIRTHDAY:
+			if !user.Birthday.IsZero() {
+				info.Birthdate = user.Birthday.Format(time.DateOnly)
+			}
+		case types.ROLES:
+			info.Role = strings.ToLower(string(user.Role))
+		}
+	}
+
+	return info
+}
+
+func fieldsOrEmpty(fields []types.Field) []types.Field {
+	if fields == nil {
+		return make([]types.Field, 0)
+	}
+
+	return fields
+}
@@ -0,0 +1,43 @@
+package converter
+
+import (
+	"github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/dto"
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+)
+
+func DtoRole(role *entity.RolePermissions) *dto.Role {
+	permissions := make([]string, 0, len(role.Permissions))
+
+	for _, permission := range role.Permissions {
+		permissions = append(permissions, string(permission))
+	}
+
+	return &dto.Role{
+		Role:        string(role.Role),
+		Permissions: permissions,
+	}
+}
+
+func DtoRoles(roles []*entity.RolePermissions) []*dto.Role {
+	result := make([]*dto.Role, 0, len(roles))
+
+	for _, role := range roles {
+		result = append(result, DtoRole(role))
+	}
+
+	return result
+}
+
+func EntityRolePermissions(role string, body dto.SetPermissions) *entity.RolePermissions {
+	permissions := make([]types.Permission, 0, len(body.Permissions))
+
+	for _, permission := range body.Permissions {
+		permissions = append(permissions, types.Permission(permission))
+	}
+
+	return &entity.RolePermissions{
+		Role:        types.Role(role),
+		Permissions: permissions,
+	}
+}
@@ -0,0 +1,30 @@
+package converter
+
+import (
+	"github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/dto"
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+)
+
+func DtoSecurityEvents(events []*entity.SecurityEvent) []*dto.SecurityEvent {
+	result := make([]*dto.SecurityEvent, 0, len(events))
+
+	for _, event := range events {
+		item := &dto.SecurityEvent{
+			Id:        event.Id,
+			UserId:    event.UserId,
+			Type:      string(event.Type),
+			Ip:        event.Ip,
+			CreatedAt: event.CreatedAt,
+			ExpiresAt: event.ExpiresAt,
+			ClearedBy: event.ClearedBy,
+		}
+
+		if !event.ClearedAt.IsZero() {
+			item.ClearedAt = &event.ClearedAt
+		}
+
+		result = append(result, item)
+	}
+
+	return result
+}
@@ -0,0 +1,42 @@
+package converter
+
+import (
+	"github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/dto"
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+)
+
+func DtoTeam(team *entity.Team) *dto.Team {
+	members := team.Members
+	if members == nil {
+		members = make([]string, 0)
+	}
+
+	return &dto.Team{
+		Id:      team.Id,
+		Name:    team.Name,
+		Members: members,
+	}
+}
+
+func DtoTeamShort(team *entity.Team) *dto.TeamShort {
+	return &dto.TeamShort{
+		Id:   team.Id,
+		Name: team.Name,
+	}
+}
+
+func DtoTeams(teams []*entity.Team) []*dto.TeamShort {
+	result := make([]*dto.TeamShort, 0, len(teams))
+
+	for _, team := range teams {
+		result = append(result, DtoTeamShort(team))
+	}
+
+	return result
+}
+
+func EntityTeam(upsert dto.UpsertTeam) *entity.Team {
+	return &entity.Team{
+		Name: upsert.Name,
+	}
+}
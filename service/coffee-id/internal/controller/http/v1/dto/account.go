@@ -6,6 +6,17 @@ type Account struct {
 	Name  string `json:"name"`
 }
 
+// ServiceAccount is returned to other services by internal endpoints,
+// unlike Account it also has role of user.
+type ServiceAccount struct {
+	Account
+	Role string `json:"role"`
+}
+
+type UsersBatch struct {
+	Ids []string `json:"ids" validate:"required,min=1,max=100,dive,uuid"`
+}
+
 type CreateUser struct {
 	Email    string `json:"email"    validate:"required,email"`
 	Name     string `json:"name"     validate:"required,min=2,max=30"`
@@ -46,3 +57,17 @@ type AccountAnswer struct {
 	Name  string `json:"name"`
 	Token string `json:"token"`
 }
+
+type VerifyEmail struct {
+	Code string `json:"code" validate:"required,len=6,numeric"`
+}
+
+type ForgotPassword struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type ResetPassword struct {
+	Email    string `json:"email"    validate:"required,email"`
+	Code     string `json:"code"     validate:"required,len=6,numeric"`
+	Password string `json:"password" validate:"required,min=8,max=50,password"`
+}
@@ -1,5 +1,7 @@
 package dto
 
+import "time"
+
 type Login struct {
 	Email    string `json:"email"    validate:"required,email"`
 	Password string `json:"password" validate:"required,min=8,max=50,password"`
@@ -12,3 +14,68 @@ type Token struct {
 type AuthUrl struct {
 	Url string `json:"auth_url"`
 }
+
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type JWKS struct {
+	Keys []*JWK `json:"keys"`
+}
+
+type Session struct {
+	Id         string    `json:"id"`
+	Device     string    `json:"device"`
+	Ip         string    `json:"ip"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	Current    bool      `json:"current"`
+}
+
+type ExternalCallback struct {
+	Code  string `json:"code"  validate:"required"`
+	State string `json:"state" validate:"required"`
+}
+
+type Identity struct {
+	Provider  string    `json:"provider"`
+	Email     string    `json:"email,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type TwoFactorSetup struct {
+	Secret string `json:"secret"`
+	Uri    string `json:"uri"`
+}
+
+// TwoFactorChallenge is returned by login instead of token, when the second factor
+// is required. Setup is present if user has to enroll first.
+type TwoFactorChallenge struct {
+	Challenge string          `json:"challenge"`
+	Setup     *TwoFactorSetup `json:"setup,omitempty"`
+}
+
+type TwoFactorVerify struct {
+	Challenge string `json:"challenge" validate:"required"`
+	Code      string `json:"code"      validate:"required,min=6,max=11"`
+}
+
+type TwoFactorCode struct {
+	Code string `json:"code" validate:"required,min=6,max=11"`
+}
+
+type RecoveryCodes struct {
+	Codes []string `json:"recovery_codes"`
+}
+
+type TwoFactorAnswer struct {
+	AccountAnswer
+	RecoveryCodes []string `json:"recovery_codes,omitempty"`
+}
@@ -0,0 +1,107 @@
+package dto
+
+import (
+	"time"
+
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+)
+
+type Client struct {
+	Id          string        `json:"id"`
+	Name        string        `json:"name"`
+	ClientId    string        `json:"client_id"`
+	RedirectUri string        `json:"redirect_uri"`
+	Fields      []types.Field `json:"fields"`
+	CreatedAt   time.Time     `json:"created_at"`
+}
+
+type ClientCredentials struct {
+	Id           string        `json:"id"`
+	Name         string        `json:"name"`
+	ClientId     string        `json:"client_id"`
+	ClientSecret string        `json:"client_secret"`
+	RedirectUri  string        `json:"redirect_uri"`
+	Fields       []types.Field `json:"fields"`
+	CreatedAt    time.Time     `json:"created_at"`
+}
+
+type CreateClient struct {
+	Name        string        `json:"name"         validate:"required,min=2,max=100"`
+	RedirectUri string        `json:"redirect_uri" validate:"required,url,max=2048"`
+	Fields      []types.Field `json:"fields"       validate:"fields"`
+}
+
+type Authorize struct {
+	ResponseType        string `form:"response_type"         validate:"required,eq=code"`
+	ClientId            string `form:"client_id"             validate:"required"`
+	RedirectUri         string `form:"redirect_uri"          validate:"required,url"`
+	Scope               string `form:"scope"                 validate:"required"`
+	State               string `form:"state"`
+	Nonce               string `form:"nonce"`
+	CodeChallenge       string `form:"code_challenge"        validate:"required,min=43,max=128"`
+	CodeChallengeMethod string `form:"code_challenge_method" validate:"required,eq=S256"`
+}
+
+type Consent struct {
+	RequestId  string        `json:"request_id"`
+	ClientName string        `json:"client_name"`
+	ClientId   string        `json:"client_id"`
+	Fields     []types.Field `json:"fields"`
+}
+
+type ConsentAnswer struct {
+	Approve bool `json:"approve"`
+}
+
+type OAuthRedirect struct {
+	RedirectUri string `json:"redirect_uri"`
+}
+
+type TokenRequest struct {
+	GrantType    string `form:"grant_type"`
+	Code         string `form:"code"`
+	RedirectUri  string `form:"redirect_uri"`
+	CodeVerifier string `form:"code_verifier"`
+	Scope        string `form:"scope"`
+	ClientId     string `form:"client_id"`
+	ClientSecret string `form:"client_secret"`
+}
+
+type TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+	IdToken     string `json:"id_token,omitempty"`
+	Scope       string `json:"scope"`
+}
+
+// OAuthError is error of token and userinfo endpoints in format of RFC 6749.
+type OAuthError struct {
+	Error       string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+type UserInfo struct {
+	Sub           string `json:"sub"`
+	Email         string `json:"email,omitempty"`
+	EmailVerified *bool  `json:"email_verified,omitempty"`
+	Name          string `json:"name,omitempty"`
+	Birthdate     string `json:"birthdate,omitempty"`
+	Role          string `json:"role,omitempty"`
+}
+
+type OpenIdConfiguration struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserinfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JwksUri                           string   `json:"jwks_uri"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IdTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+}
@@ -0,0 +1,10 @@
+package dto
+
+type Role struct {
+	Role        string   `json:"role"`
+	Permissions []string `json:"permissions"`
+}
+
+type SetPermissions struct {
+	Permissions []string `json:"permissions" validate:"required,dive,required"`
+}
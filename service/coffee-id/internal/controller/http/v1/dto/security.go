@@ -0,0 +1,14 @@
+package dto
+
+import "time"
+
+type SecurityEvent struct {
+	Id        string     `json:"id"`
+	UserId    string     `json:"user_id"`
+	Type      string     `json:"type"       example:"LOCKOUT"`
+	Ip        string     `json:"ip"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	ClearedAt *time.Time `json:"cleared_at,omitempty"`
+	ClearedBy string     `json:"cleared_by,omitempty"`
+}
@@ -0,0 +1,20 @@
+package dto
+
+type Team struct {
+	Id      string   `json:"id"`
+	Name    string   `json:"name"`
+	Members []string `json:"members"`
+}
+
+type TeamShort struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type UpsertTeam struct {
+	Name string `json:"name" validate:"required,min=2,max=100"`
+}
+
+type TeamMember struct {
+	UserId string `json:"user_id" validate:"required,uuid"`
+}
@@ -1,58 +1,87 @@
 package middleware
 
 import (
-	"slices"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	resp "github.com/nikitaSstepanov/coffee-id/internal/controller/response"
 	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	ct "github.com/nikitaSstepanov/coffee-id/pkg/utils/controller"
+	e "github.com/nikitaSstepanov/tools/error"
 )
 
-func (m *Middleware) CheckAccess(roles ...types.Role) gin.HandlerFunc {
+// CheckAccess authenticates request and requires token owner to have all of permissions.
+func (m *Middleware) CheckAccess(permissions ...types.Permission) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		header := ctx.GetHeader("Authorization")
-
-		if header == "" {
-			resp.AbortErrMsg(ctx, foundErr)
+		token, err := bearerToken(ctx)
+		if err != nil {
+			resp.AbortErrMsg(ctx, err)
 			return
 		}
 
-		parts := strings.Split(header, " ")
-		if len(parts) < 2 {
-			resp.AbortErrMsg(ctx, bearerErr)
+		claims, err := m.auth.ValidateToken(ct.GetCtx(ctx), token)
+		if err != nil {
+			resp.AbortErrMsg(ctx, unauthErr)
 			return
 		}
 
-		bearer := parts[0]
-		token := parts[1]
+		for _, permission := range permissions {
+			if !claims.Can(permission) {
+				resp.AbortErrMsg(ctx, forbiddenErr)
+				return
+			}
+		}
 
-		if bearer != bearerType {
-			resp.AbortErrMsg(ctx, bearerErr)
+		ctx.Set("userId", claims.Id)
+		ctx.Set("sessionId", claims.SessionId)
+
+		ctx.Next()
+	}
+}
+
+// CheckService authenticates internal request of other service and requires its token to have all of scopes.
+func (m *Middleware) CheckService(scopes ...types.ServiceScope) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		token, err := bearerToken(ctx)
+		if err != nil {
+			resp.AbortErrMsg(ctx, err)
 			return
 		}
 
-		claims, err := m.auth.ValidateToken(token, false)
+		claims, err := m.auth.ValidateServiceToken(ct.GetCtx(ctx), token)
 		if err != nil {
 			resp.AbortErrMsg(ctx, unauthErr)
 			return
 		}
 
-		if len(roles) != 0 {
-			access := false
-
-			if slices.Contains(roles, claims.Role) {
-				access = true
-			}
-
-			if !access {
+		for _, scope := range scopes {
+			if !claims.Allows(scope) {
 				resp.AbortErrMsg(ctx, forbiddenErr)
 				return
 			}
 		}
 
-		ctx.Set("userId", claims.Id)
+		ctx.Set("clientId", claims.ClientId)
 
 		ctx.Next()
 	}
 }
+
+func bearerToken(ctx *gin.Context) (string, e.Error) {
+	header := ctx.GetHeader("Authorization")
+
+	if header == "" {
+		return "", foundErr
+	}
+
+	parts := strings.Split(header, " ")
+	if len(parts) < 2 {
+		return "", bearerErr
+	}
+
+	if parts[0] != bearerType {
+		return "", bearerErr
+	}
+
+	return parts[1], nil
+}
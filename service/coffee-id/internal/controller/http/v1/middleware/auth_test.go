@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/assert/v2"
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	"github.com/nikitaSstepanov/coffee-id/internal/usecase/pkg/auth"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+// sessions knows the only session, other methods aren`t used by CheckAccess.
+type sessions struct {
+	auth.SessionStorage
+	session *entity.Session
+}
+
+func (s sessions) Get(c ctx.Context, id string) (*entity.Session, e.Error) {
+	if id != s.session.Id {
+		return nil, e.New("Session wasn`t found", e.NotFound)
+	}
+
+	return s.session, nil
+}
+
+func TestCheckAccess(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	jwt, err := auth.NewJwt(&auth.JwtOptions{
+		Audience: []string{"coffee-id-frontend"},
+		Issuer:   "coffee-id-backend",
+	})
+	if err != nil {
+		t.Fatalf("Can`t create jwt: %v", err)
+	}
+
+	user := &entity.User{Id: "id", Role: types.SUPPORT}
+	session := &entity.Session{Id: "sid", UserId: user.Id, TokenId: "jti"}
+
+	m := New(auth.New(&auth.Storages{Session: sessions{session: session}}, &auth.UseCases{Jwt: jwt}))
+
+	router := gin.New()
+	router.GET("/verify", m.CheckAccess(types.BOOKING_READ_ANY, types.BOOKING_VERIFY), func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+	router.GET("/layout", m.CheckAccess(types.LAYOUT_EDIT), func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	token, verr := jwt.GenerateToken(user, session, []types.Permission{types.BOOKING_READ_ANY, types.BOOKING_VERIFY}, false)
+	if verr != nil {
+		t.Fatalf("Can`t sign token: %v", verr)
+	}
+
+	revoked, verr := jwt.GenerateToken(user, &entity.Session{Id: "revoked", TokenId: "jti"}, []types.Permission{types.LAYOUT_EDIT}, false)
+	if verr != nil {
+		t.Fatalf("Can`t sign token: %v", verr)
+	}
+
+	cases := []struct {
+		path   string
+		token  string
+		status int
+	}{
+		{"/verify", token, http.StatusNoContent},
+		{"/layout", token, http.StatusForbidden},
+		{"/layout", revoked, http.StatusUnauthorized},
+		{"/verify", "", http.StatusUnauthorized},
+	}
+
+	for _, tc := range cases {
+		req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+		if tc.token != "" {
+			req.Header.Set("Authorization", "Bearer "+tc.token)
+		}
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, w.Code, tc.status)
+	}
+}
@@ -52,7 +52,7 @@ func (a *Account) Get(c *gin.Context) {
 }
 
 // @Summary Retrieve user by ID
-// @Description Returns user information based on their ID.
+// @Description Returns user information based on their ID. Internal endpoint also returns role of user.
 // @Tags Account
 // @Accept json
 // @Produce json
@@ -60,9 +60,12 @@ func (a *Account) Get(c *gin.Context) {
 // @Param        id    path     string  true  "user id"  Format(uuid)
 // @Success 200 {object} dto.Account "Successful response"
 // @Failure 400 {object} resp.JsonError "ID must be integer"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 403 {object} resp.JsonError "This resource is forbidden"
 // @Failure 404 {object} resp.JsonError "This user wasn`t found."
 // @Failure 500 {object} resp.JsonError "Something going wrong..."
 // @Router /id/account/{id} [get]
+// @Router /id/internal/users/{id} [get]
 func (a *Account) GetById(c *gin.Context) {
 	ctx := ct.GetCtx(c)
 
@@ -79,13 +82,18 @@ func (a *Account) GetById(c *gin.Context) {
 		return
 	}
 
+	if isService(c) {
+		c.JSON(okStatus, conv.DtoServiceUser(user))
+		return
+	}
+
 	result := conv.DtoUser(user)
 
 	c.JSON(okStatus, result)
 }
 
 // @Summary Retrieve user by Email
-// @Description Returns user information based on their Email.
+// @Description Returns user information based on their Email. Requires account.read_any permission or users.read scope of service, services also get role of user
 // @Tags Account
 // @Accept json
 // @Produce json
@@ -93,9 +101,12 @@ func (a *Account) GetById(c *gin.Context) {
 // @Param        email    path     string  true  "user email"  Format(email)
 // @Success 200 {object} dto.Account "Successful response"
 // @Failure 400 {object} resp.JsonError "Invalid email"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 403 {object} resp.JsonError "This resource is forbidden"
 // @Failure 404 {object} resp.JsonError "This user wasn`t found."
 // @Failure 500 {object} resp.JsonError "Something going wrong..."
 // @Router /id/account/email/{email} [get]
+// @Router /id/internal/users/email/{email} [get]
 func (a *Account) GetByEmail(c *gin.Context) {
 	ctx := ct.GetCtx(c)
 
@@ -112,11 +123,59 @@ func (a *Account) GetByEmail(c *gin.Context) {
 		return
 	}
 
+	if isService(c) {
+		c.JSON(httper.StatusOK, conv.DtoServiceUser(user))
+		return
+	}
+
 	result := conv.DtoUser(user)
 
 	c.JSON(httper.StatusOK, result)
 }
 
+// @Summary Retrieve users by IDs
+// @Description Returns users by up to 100 ids in one request, unknown ids are skipped. Requires account.read_any permission or users.read scope of service, services also get roles of users
+// @Tags Account
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param body body dto.UsersBatch true "User ids"
+// @Success 200 {array} dto.Account "Successful response"
+// @Failure 400 {object} resp.JsonError "Incorrect data"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 403 {object} resp.JsonError "This resource is forbidden"
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/account/batch [post]
+// @Router /id/internal/users/batch [post]
+func (a *Account) GetBatch(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	var body dto.UsersBatch
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		resp.AbortErrMsg(c, badReqErr.WithErr(err))
+		return
+	}
+
+	if err := validator.Struct(body); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	users, err := a.usecase.GetBatch(ctx, body.Ids)
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	if isService(c) {
+		c.JSON(okStatus, conv.DtoServiceUsers(users))
+		return
+	}
+
+	c.JSON(okStatus, conv.DtoUsers(users))
+}
+
 // @Summary Create User
 // @Description Creates a new user and returns access tokens.
 // @Tags Account
@@ -126,6 +185,7 @@ func (a *Account) GetByEmail(c *gin.Context) {
 // @Success 201 {object} dto.AccountAnswer "Successful response with token"
 // @Failure 400 {object} resp.JsonError "Incorrect data"
 // @Failure 409 {object} resp.JsonError "User with this email already exist"
+// @Failure 429 {object} resp.JsonError "Too many requests, try again later."
 // @Failure 500 {object} resp.JsonError "Something going wrong..."
 // @Router /id/account/new [post]
 func (a *Account) Create(c *gin.Context) {
@@ -145,7 +205,9 @@ func (a *Account) Create(c *gin.Context) {
 
 	user := conv.EntityCreate(body)
 
-	tokens, err := a.usecase.Create(ctx, user)
+	client := conv.EntityClient(c.Request.UserAgent(), c.ClientIP())
+
+	tokens, err := a.usecase.Create(ctx, user, client)
 	if err != nil {
 		resp.AbortErrMsg(c, err)
 		return
@@ -229,7 +291,7 @@ func (a *Account) SetRole(c *gin.Context) {
 }
 
 // @Summary Update user information
-// @Description Updates the user's information including password. For admin
+// @Description Updates the user's information including password. Requires account.write.any permission
 // @Tags Account
 // @Accept json
 // @Produce json
@@ -308,7 +370,7 @@ func (a *Account) Delete(c *gin.Context) {
 }
 
 // @Summary Get list of users
-// @Description List of users with pagination. Only for ADMIN
+// @Description List of users with pagination. Requires account.read.any permission
 // @Tags Account
 // @Produce json
 // @Security Bearer
@@ -324,8 +386,6 @@ func (a *Account) Delete(c *gin.Context) {
 func (a *Account) GetList(c *gin.Context) {
 	ctx := ct.GetCtx(c)
 
-	token := c.GetHeader("Authorization")
-
 	page, parseErr := strconv.ParseInt(c.DefaultQuery("page", "0"), 10, 64)
 	if parseErr != nil || page < 0 {
 		resp.AbortErrMsg(c, e.New("Page must be integer", e.BadInput))
@@ -338,7 +398,7 @@ func (a *Account) GetList(c *gin.Context) {
 		return
 	}
 
-	list, count, err := a.usecase.GetList(ctx, int(page), int(size), token)
+	list, count, err := a.usecase.GetList(ctx, int(page), int(size))
 	if err != nil {
 		resp.AbortErrMsg(c, err)
 		return
@@ -351,3 +411,9 @@ func (a *Account) GetList(c *gin.Context) {
 
 	c.JSON(okStatus, result)
 }
+
+// isService reports whether request came to internal endpoint from
+// another service, CheckService sets clientId of the caller.
+func isService(c *gin.Context) bool {
+	return c.GetString("clientId") != ""
+}
@@ -0,0 +1,139 @@
+package account
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/dto"
+	"github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/validator"
+	resp "github.com/nikitaSstepanov/coffee-id/internal/controller/response"
+	ct "github.com/nikitaSstepanov/coffee-id/pkg/utils/controller"
+)
+
+// @Summary Resend verification code
+// @Description Sends new code confirming the user's email. Codes are sent at most once a minute
+// @Tags Account
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} resp.Message "Ok."
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 409 {object} resp.JsonError "Email is already verified."
+// @Failure 429 {object} resp.JsonError "Code was sent recently, try again later."
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/account/verify/resend [post]
+func (a *Account) SendVerification(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	userId := c.GetString("userId")
+
+	if err := a.usecase.SendVerification(ctx, userId); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, okMsg)
+}
+
+// @Summary Verify email
+// @Description Confirms the user's email with code sent on signup or email change
+// @Tags Account
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param body body dto.VerifyEmail true "Code from email"
+// @Success 200 {object} resp.Message "Ok."
+// @Failure 400 {object} resp.JsonError "Incorrect data, Code is incorrect or expired."
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 409 {object} resp.JsonError "Email is already verified."
+// @Failure 429 {object} resp.JsonError "Too many wrong attempts, request new code."
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/account/verify [post]
+func (a *Account) Verify(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	userId := c.GetString("userId")
+
+	var body dto.VerifyEmail
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		resp.AbortErrMsg(c, badReqErr.WithErr(err))
+		return
+	}
+
+	if err := validator.Struct(body); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	if err := a.usecase.Verify(ctx, userId, body.Code); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, okMsg)
+}
+
+// @Summary Forgot password
+// @Description Sends password reset code to email. Answer is the same whether user exists or not
+// @Tags Account
+// @Accept json
+// @Produce json
+// @Param body body dto.ForgotPassword true "Email of user"
+// @Success 200 {object} resp.Message "Ok."
+// @Failure 400 {object} resp.JsonError "Incorrect data"
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/account/password/forgot [post]
+func (a *Account) ForgotPassword(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	var body dto.ForgotPassword
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		resp.AbortErrMsg(c, badReqErr.WithErr(err))
+		return
+	}
+
+	if err := validator.Struct(body); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	if err := a.usecase.ForgotPassword(ctx, body.Email); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, okMsg)
+}
+
+// @Summary Reset password
+// @Description Sets new password with code from email and revokes all sessions of the user
+// @Tags Account
+// @Accept json
+// @Produce json
+// @Param body body dto.ResetPassword true "Code and new password"
+// @Success 200 {object} resp.Message "Ok."
+// @Failure 400 {object} resp.JsonError "Incorrect data, Code is incorrect or expired."
+// @Failure 429 {object} resp.JsonError "Too many wrong attempts, request new code."
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/account/password/reset [post]
+func (a *Account) ResetPassword(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	var body dto.ResetPassword
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		resp.AbortErrMsg(c, badReqErr.WithErr(err))
+		return
+	}
+
+	if err := validator.Struct(body, validator.Password); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	if err := a.usecase.ResetPassword(ctx, body.Email, body.Code, body.Password); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, okMsg)
+}
@@ -8,11 +8,16 @@ import (
 )
 
 type AccountUseCase interface {
-	GetList(c ctx.Context, page, size int, token string) ([]*dto.AP, int, e.Error)
+	GetList(c ctx.Context, page, size int) ([]*dto.AP, int, e.Error)
 	Get(ctx ctx.Context, userId string) (*entity.User, e.Error)
-	Create(ctx ctx.Context, user *entity.User) (*entity.Tokens, e.Error)
+	Create(ctx ctx.Context, user *entity.User, client *entity.Session) (*entity.Tokens, e.Error)
 	Update(ctx ctx.Context, user *entity.User, pass string) (*entity.User, e.Error)
 	AddRole(ctx ctx.Context, user *entity.User) e.Error
 	Delete(ctx ctx.Context, user *entity.User) e.Error
 	GetByEmail(c ctx.Context, email string) (*entity.User, e.Error)
+	GetBatch(c ctx.Context, ids []string) ([]*entity.User, e.Error)
+	SendVerification(c ctx.Context, userId string) e.Error
+	Verify(c ctx.Context, userId, code string) e.Error
+	ForgotPassword(c ctx.Context, email string) e.Error
+	ResetPassword(c ctx.Context, email, code, password string) e.Error
 }
@@ -25,15 +25,17 @@ func New(uc AuthUseCase, cookie *httper.CookieCfg, host string) *Auth {
 }
 
 // @Summary Log in a user
-// @Description Logs in a user with email and password
+// @Description Logs in a user with email and password. Repeated failures delay next logins and lock the account for a while. If the second factor is required, challenge is returned instead of token, it is passed to /auth/2fa/verify with code
 // @Tags Auth
 // @Accept json
 // @Produce json
 // @Param body body dto.Login true "Login information"
-// @Success 200 {object} dto.Token "Access token"
+// @Success 200 {object} dto.AccountAnswer "Access token"
+// @Success 202 {object} dto.TwoFactorChallenge "The second factor is required"
 // @Failure 400 {object} resp.JsonError "Incorrect data"
 // @Failure 401 {object} resp.JsonError "Incorrect email or password"
 // @Failure 404 {object} resp.JsonError "This user wasn't found."
+// @Failure 429 {object} resp.JsonError "Too many requests, too many failed logins or account is temporarily locked"
 // @Failure 500 {object} resp.JsonError "Something going wrong..."
 // @Router /id/auth/login [post]
 func (a *Auth) Login(c *gin.Context) {
@@ -53,12 +55,19 @@ func (a *Auth) Login(c *gin.Context) {
 
 	user := conv.EntityLogin(body)
 
-	tokens, user, err := a.usecase.Login(ctx, user)
+	client := conv.EntityClient(c.Request.UserAgent(), c.ClientIP())
+
+	tokens, user, challenge, err := a.usecase.Login(ctx, user, client)
 	if err != nil {
 		resp.AbortErrMsg(c, err)
 		return
 	}
 
+	if challenge != nil {
+		c.JSON(acceptedStatus, conv.DtoChallenge(challenge))
+		return
+	}
+
 	c.SetCookie(
 		a.cookie.Name, tokens.Refresh, a.cookie.Age,
 		a.cookie.Path, a.cookie.Host,
@@ -71,15 +80,26 @@ func (a *Auth) Login(c *gin.Context) {
 }
 
 // @Summary Log out a user
-// @Description Logs out a user by invalidating the session
+// @Description Logs out a user by revoking the current session. Its refresh token can't be used anymore
 // @Tags Auth
 // @Accept json
 // @Produce json
 // @Security Bearer
 // @Success 200 {object} resp.Message "Logout success."
+// @Failure 401 {object} resp.JsonError "Unauth"
 // @Failure 500 {object} resp.JsonError "Something going wrong..."
 // @Router  /id/auth/logout [post]
 func (a *Auth) Logout(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	userId := c.GetString("userId")
+	sessionId := c.GetString("sessionId")
+
+	if err := a.usecase.Logout(ctx, userId, sessionId); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
 	c.SetCookie(
 		a.cookie.Name, "", -1,
 		a.cookie.Path, a.cookie.Host,
@@ -90,13 +110,14 @@ func (a *Auth) Logout(c *gin.Context) {
 }
 
 // @Summary Refresh user tokens
-// @Description Refreshes the user's tokens using the refresh token from the cookie
+// @Description Refreshes the user's tokens using the refresh token from the cookie. Every refresh token can be used once, reuse revokes the session
 // @Tags Auth
 // @Accept json
 // @Produce json
 // @Success 200 {object} dto.Token "Refresh token"
-// @Failure 401 {object} resp.JsonError "Token is invalid"
+// @Failure 401 {object} resp.JsonError "Token is invalid, Refresh token was already used, session is revoked."
 // @Failure 404 {object} resp.JsonError "Your token wasn't found., This user wasn't found."
+// @Failure 429 {object} resp.JsonError "Too many requests, try again later."
 // @Failure 500 {object} resp.JsonError "Something going wrong..."
 // @Router /id/auth/refresh [get]
 func (a *Auth) Refresh(c *gin.Context) {
@@ -108,7 +129,9 @@ func (a *Auth) Refresh(c *gin.Context) {
 		return
 	}
 
-	tokens, err := a.usecase.Refresh(ctx, refresh)
+	client := conv.EntityClient(c.Request.UserAgent(), c.ClientIP())
+
+	tokens, err := a.usecase.Refresh(ctx, refresh, client)
 	if err != nil {
 		resp.AbortErrMsg(c, err)
 		return
@@ -124,3 +147,92 @@ func (a *Auth) Refresh(c *gin.Context) {
 
 	c.JSON(okStatus, result)
 }
+
+// @Summary Get sessions
+// @Description Returns active sessions of the user
+// @Tags Auth
+// @Produce json
+// @Security Bearer
+// @Success 200 {array} dto.Session "Successful response"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/auth/sessions [get]
+func (a *Auth) GetSessions(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	userId := c.GetString("userId")
+
+	sessions, err := a.usecase.GetSessions(ctx, userId)
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, conv.DtoSessions(sessions, c.GetString("sessionId")))
+}
+
+// @Summary Revoke session
+// @Description Logs out the user from one of the devices
+// @Tags Auth
+// @Produce json
+// @Security Bearer
+// @Param        id    path     string  true  "session id"
+// @Success 200 {object} resp.Message "Ok."
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 404 {object} resp.JsonError "This session wasn`t found."
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/auth/sessions/{id} [delete]
+func (a *Auth) RevokeSession(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	userId := c.GetString("userId")
+
+	if err := a.usecase.RevokeSession(ctx, userId, c.Param("id")); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, okMsg)
+}
+
+// @Summary Force logout
+// @Description Revokes all sessions of the user. Requires account.write.any permission
+// @Tags Auth
+// @Produce json
+// @Security Bearer
+// @Param        id    path     string  true  "user id"  Format(uuid)
+// @Success 200 {object} resp.Message "Ok."
+// @Failure 400 {object} resp.JsonError "Id must be uuid"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 403 {object} resp.JsonError "This resource is forbidden"
+// @Failure 404 {object} resp.JsonError "This user wasn`t found."
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/account/{id}/sessions [delete]
+func (a *Auth) RevokeAll(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	id := c.Param("id")
+
+	if err := validator.UUID(id); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	if err := a.usecase.RevokeAll(ctx, id); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, okMsg)
+}
+
+// @Summary JSON Web Key Set
+// @Description Returns public keys for verifying tokens. Tokens reference key by kid header, keys of previous rotation are kept until their tokens expire.
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} dto.JWKS "Key set"
+// @Router /.well-known/jwks.json [get]
+func (a *Auth) JWKS(c *gin.Context) {
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(okStatus, conv.DtoJWKS(a.usecase.PublicKeys()))
+}
@@ -0,0 +1,167 @@
+package auth
+
+import (
+	"github.com/gin-gonic/gin"
+	conv "github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/converter"
+	"github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/dto"
+	"github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/validator"
+	resp "github.com/nikitaSstepanov/coffee-id/internal/controller/response"
+	ct "github.com/nikitaSstepanov/coffee-id/pkg/utils/controller"
+)
+
+// @Summary Get identity providers
+// @Description Returns names of external identity providers users can sign in with
+// @Tags Auth
+// @Produce json
+// @Success 200 {array} string "Provider names"
+// @Router /id/auth/external [get]
+func (a *Auth) GetProviders(c *gin.Context) {
+	c.JSON(okStatus, a.usecase.Providers())
+}
+
+// @Summary Log in with provider
+// @Description Returns url of provider's login page. Provider redirects user back to frontend with code and state, which must be passed to callback
+// @Tags Auth
+// @Produce json
+// @Param        provider    path     string  true  "provider name"
+// @Success 200 {object} dto.AuthUrl "Login page"
+// @Failure 404 {object} resp.JsonError "This identity provider wasn`t found."
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/auth/external/{provider} [get]
+func (a *Auth) ExternalUrl(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	url, err := a.usecase.ExternalUrl(ctx, c.Param("provider"), "")
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, conv.DtoAuthUrl(url))
+}
+
+// @Summary Link provider
+// @Description Returns url of provider's login page. On callback provider's account is linked to the user
+// @Tags Auth
+// @Produce json
+// @Security Bearer
+// @Param        provider    path     string  true  "provider name"
+// @Success 200 {object} dto.AuthUrl "Login page"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 404 {object} resp.JsonError "This identity provider wasn`t found."
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/auth/external/{provider}/link [get]
+func (a *Auth) LinkUrl(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	userId := c.GetString("userId")
+
+	url, err := a.usecase.ExternalUrl(ctx, c.Param("provider"), userId)
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, conv.DtoAuthUrl(url))
+}
+
+// @Summary Provider callback
+// @Description Exchanges code of provider and logs in the user. New user is created, if provider's account isn't linked and its email isn't taken
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param        provider    path     string  true  "provider name"
+// @Param body body dto.ExternalCallback true "Code and state returned by provider"
+// @Success 200 {object} dto.AccountAnswer "Access token"
+// @Success 202 {object} dto.TwoFactorChallenge "The second factor is required"
+// @Failure 400 {object} resp.JsonError "Incorrect data, Login request is expired or invalid."
+// @Failure 404 {object} resp.JsonError "This identity provider wasn`t found."
+// @Failure 409 {object} resp.JsonError "User with this email already exists., This identity is linked to another account."
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/auth/external/{provider}/callback [post]
+func (a *Auth) ExternalCallback(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	var body dto.ExternalCallback
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		resp.AbortErrMsg(c, badReqErr.WithErr(err))
+		return
+	}
+
+	if err := validator.Struct(body); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	client := conv.EntityClient(c.Request.UserAgent(), c.ClientIP())
+
+	tokens, user, challenge, err := a.usecase.ExternalCallback(ctx, c.Param("provider"), body.Code, body.State, client)
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	if challenge != nil {
+		c.JSON(acceptedStatus, conv.DtoChallenge(challenge))
+		return
+	}
+
+	c.SetCookie(
+		a.cookie.Name, tokens.Refresh, a.cookie.Age,
+		a.cookie.Path, a.cookie.Host,
+		a.cookie.Secure, a.cookie.HttpOnly,
+	)
+
+	result := conv.DtoAnswer(user, tokens.Access)
+
+	c.JSON(okStatus, result)
+}
+
+// @Summary Get linked providers
+// @Description Returns accounts of external providers linked to the user
+// @Tags Auth
+// @Produce json
+// @Security Bearer
+// @Success 200 {array} dto.Identity "Successful response"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/auth/identities [get]
+func (a *Auth) GetIdentities(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	userId := c.GetString("userId")
+
+	identities, err := a.usecase.GetIdentities(ctx, userId)
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, conv.DtoIdentities(identities))
+}
+
+// @Summary Unlink provider
+// @Description Unlinks provider's account from the user. User created by provider can't unlink the last one
+// @Tags Auth
+// @Produce json
+// @Security Bearer
+// @Param        provider    path     string  true  "provider name"
+// @Success 200 {object} resp.Message "Ok."
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 404 {object} resp.JsonError "This provider isn`t linked to account."
+// @Failure 409 {object} resp.JsonError "Account has no password, so the last provider can`t be unlinked."
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/auth/external/{provider} [delete]
+func (a *Auth) Unlink(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	userId := c.GetString("userId")
+
+	if err := a.usecase.Unlink(ctx, userId, c.Param("provider")); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, okMsg)
+}
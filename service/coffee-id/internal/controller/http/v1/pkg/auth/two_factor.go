@@ -0,0 +1,228 @@
+package auth
+
+import (
+	"github.com/gin-gonic/gin"
+	conv "github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/converter"
+	"github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/dto"
+	"github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/validator"
+	resp "github.com/nikitaSstepanov/coffee-id/internal/controller/response"
+	ct "github.com/nikitaSstepanov/coffee-id/pkg/utils/controller"
+)
+
+// @Summary Pass the second factor
+// @Description Finishes login with TOTP or recovery code. If user enrolled on login, 2FA is enabled and recovery codes are returned once
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body dto.TwoFactorVerify true "Challenge of login and code"
+// @Success 200 {object} dto.TwoFactorAnswer "Access token"
+// @Failure 400 {object} resp.JsonError "Incorrect data"
+// @Failure 401 {object} resp.JsonError "Code is incorrect."
+// @Failure 404 {object} resp.JsonError "Login request is expired or invalid."
+// @Failure 429 {object} resp.JsonError "Too many wrong codes, log in again."
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/auth/2fa/verify [post]
+func (a *Auth) VerifyTwoFactor(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	var body dto.TwoFactorVerify
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		resp.AbortErrMsg(c, badReqErr.WithErr(err))
+		return
+	}
+
+	if err := validator.Struct(body); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	tokens, user, recovery, err := a.usecase.VerifyTwoFactor(ctx, body.Challenge, body.Code)
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.SetCookie(
+		a.cookie.Name, tokens.Refresh, a.cookie.Age,
+		a.cookie.Path, a.cookie.Host,
+		a.cookie.Secure, a.cookie.HttpOnly,
+	)
+
+	result := conv.DtoTwoFactorAnswer(user, tokens.Access, recovery)
+
+	c.JSON(okStatus, result)
+}
+
+// @Summary Enroll in 2FA
+// @Description Creates TOTP secret. Uri is shown as QR code to add secret to authenticator app, 2FA works after it is enabled with the first code
+// @Tags Auth
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} dto.TwoFactorSetup "Secret"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 409 {object} resp.JsonError "Two-factor authentication is already enabled."
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/auth/2fa [post]
+func (a *Auth) EnrollTwoFactor(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	userId := c.GetString("userId")
+
+	setup, err := a.usecase.EnrollTwoFactor(ctx, userId)
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, conv.DtoTwoFactorSetup(setup))
+}
+
+// @Summary Enable 2FA
+// @Description Enables 2FA with the first code from authenticator app and returns recovery codes. They are shown once
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param body body dto.TwoFactorCode true "Code from authenticator app"
+// @Success 200 {object} dto.RecoveryCodes "Recovery codes"
+// @Failure 400 {object} resp.JsonError "Incorrect data"
+// @Failure 401 {object} resp.JsonError "Unauth, Code is incorrect."
+// @Failure 404 {object} resp.JsonError "Two-factor authentication isn`t set up."
+// @Failure 409 {object} resp.JsonError "Two-factor authentication is already enabled."
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/auth/2fa/enable [post]
+func (a *Auth) EnableTwoFactor(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	userId := c.GetString("userId")
+
+	var body dto.TwoFactorCode
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		resp.AbortErrMsg(c, badReqErr.WithErr(err))
+		return
+	}
+
+	if err := validator.Struct(body); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	codes, err := a.usecase.EnableTwoFactor(ctx, userId, body.Code)
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, dto.RecoveryCodes{Codes: codes})
+}
+
+// @Summary Disable 2FA
+// @Description Disables 2FA. Not allowed for roles requiring it
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param body body dto.TwoFactorCode true "TOTP or recovery code"
+// @Success 200 {object} resp.Message "Ok."
+// @Failure 400 {object} resp.JsonError "Incorrect data"
+// @Failure 401 {object} resp.JsonError "Unauth, Code is incorrect."
+// @Failure 403 {object} resp.JsonError "Two-factor authentication is required for your role."
+// @Failure 409 {object} resp.JsonError "Two-factor authentication isn`t enabled."
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/auth/2fa [delete]
+func (a *Auth) DisableTwoFactor(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	userId := c.GetString("userId")
+
+	var body dto.TwoFactorCode
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		resp.AbortErrMsg(c, badReqErr.WithErr(err))
+		return
+	}
+
+	if err := validator.Struct(body); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	if err := a.usecase.DisableTwoFactor(ctx, userId, body.Code); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, okMsg)
+}
+
+// @Summary Regenerate recovery codes
+// @Description Replaces recovery codes, old ones stop working
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param body body dto.TwoFactorCode true "TOTP or recovery code"
+// @Success 200 {object} dto.RecoveryCodes "Recovery codes"
+// @Failure 400 {object} resp.JsonError "Incorrect data"
+// @Failure 401 {object} resp.JsonError "Unauth, Code is incorrect."
+// @Failure 409 {object} resp.JsonError "Two-factor authentication isn`t enabled."
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/auth/2fa/recovery [post]
+func (a *Auth) RegenerateRecoveryCodes(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	userId := c.GetString("userId")
+
+	var body dto.TwoFactorCode
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		resp.AbortErrMsg(c, badReqErr.WithErr(err))
+		return
+	}
+
+	if err := validator.Struct(body); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	codes, err := a.usecase.RegenerateRecoveryCodes(ctx, userId, body.Code)
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, dto.RecoveryCodes{Codes: codes})
+}
+
+// @Summary Reset 2FA of user
+// @Description Removes 2FA of user who lost their device and revokes their sessions. Requires account.write.any permission
+// @Tags Auth
+// @Produce json
+// @Security Bearer
+// @Param        id    path     string  true  "user id"  Format(uuid)
+// @Success 200 {object} resp.Message "Ok."
+// @Failure 400 {object} resp.JsonError "Id must be uuid"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 403 {object} resp.JsonError "This resource is forbidden"
+// @Failure 404 {object} resp.JsonError "This user wasn`t found."
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/account/{id}/2fa [delete]
+func (a *Auth) ResetTwoFactor(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	id := c.Param("id")
+
+	if err := validator.UUID(id); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	if err := a.usecase.ResetTwoFactor(ctx, id); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, okMsg)
+}
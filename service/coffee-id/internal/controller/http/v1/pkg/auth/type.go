@@ -7,6 +7,22 @@ import (
 )
 
 type AuthUseCase interface {
-	Login(c ctx.Context, user *entity.User) (*entity.Tokens, *entity.User, e.Error)
-	Refresh(ctx ctx.Context, refresh string) (*entity.Tokens, e.Error)
+	Login(c ctx.Context, user *entity.User, client *entity.Session) (*entity.Tokens, *entity.User, *entity.LoginChallenge, e.Error)
+	Refresh(c ctx.Context, refresh string, client *entity.Session) (*entity.Tokens, e.Error)
+	Logout(c ctx.Context, userId, sessionId string) e.Error
+	GetSessions(c ctx.Context, userId string) ([]*entity.Session, e.Error)
+	RevokeSession(c ctx.Context, userId, sessionId string) e.Error
+	RevokeAll(c ctx.Context, userId string) e.Error
+	PublicKeys() []*entity.PublicKey
+	Providers() []string
+	ExternalUrl(c ctx.Context, name, userId string) (string, e.Error)
+	ExternalCallback(c ctx.Context, name, code, state string, client *entity.Session) (*entity.Tokens, *entity.User, *entity.LoginChallenge, e.Error)
+	GetIdentities(c ctx.Context, userId string) ([]*entity.Identity, e.Error)
+	Unlink(c ctx.Context, userId, name string) e.Error
+	VerifyTwoFactor(c ctx.Context, challengeId, code string) (*entity.Tokens, *entity.User, []string, e.Error)
+	EnrollTwoFactor(c ctx.Context, userId string) (*entity.TwoFactorSetup, e.Error)
+	EnableTwoFactor(c ctx.Context, userId, code string) ([]string, e.Error)
+	DisableTwoFactor(c ctx.Context, userId, code string) e.Error
+	RegenerateRecoveryCodes(c ctx.Context, userId, code string) ([]string, e.Error)
+	ResetTwoFactor(c ctx.Context, userId string) e.Error
 }
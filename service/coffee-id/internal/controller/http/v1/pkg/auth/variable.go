@@ -7,7 +7,8 @@ import (
 )
 
 const (
-	okStatus        = httper.StatusOK
+	okStatus       = httper.StatusOK
+	acceptedStatus = httper.StatusAccepted
 )
 
 var (
@@ -16,4 +17,5 @@ var (
 
 var (
 	logoutMsg = resp.NewMessage("Logout success.")
+	okMsg     = resp.NewMessage("Ok.")
 )
@@ -0,0 +1,405 @@
+package oauth
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	conv "github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/converter"
+	"github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/dto"
+	"github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/validator"
+	resp "github.com/nikitaSstepanov/coffee-id/internal/controller/response"
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	ct "github.com/nikitaSstepanov/coffee-id/pkg/utils/controller"
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/nikitaSstepanov/tools/sl"
+)
+
+type OAuth struct {
+	usecase OAuthUseCase
+	cfg     *Config
+}
+
+func New(uc OAuthUseCase, cfg *Config) *OAuth {
+	return &OAuth{
+		usecase: uc,
+		cfg:     cfg,
+	}
+}
+
+// @Summary Get OAuth clients
+// @Description Returns registered OAuth clients. Requires client.manage permission
+// @Tags OAuth
+// @Produce json
+// @Security Bearer
+// @Success 200 {array} dto.Client "Successful response"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 403 {object} resp.JsonError "This resource is forbidden"
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/oauth/clients [get]
+func (o *OAuth) GetClients(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	clients, err := o.usecase.GetClients(ctx)
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, conv.DtoClients(clients))
+}
+
+// @Summary Get OAuth client
+// @Description Returns OAuth client by id. Requires client.manage permission
+// @Tags OAuth
+// @Produce json
+// @Security Bearer
+// @Param        id    path     string  true  "client id"  Format(uuid)
+// @Success 200 {object} dto.Client "Successful response"
+// @Failure 400 {object} resp.JsonError "Id must be uuid"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 403 {object} resp.JsonError "This resource is forbidden"
+// @Failure 404 {object} resp.JsonError "This client wasn`t found."
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/oauth/clients/{id} [get]
+func (o *OAuth) GetClient(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	id := c.Param("id")
+
+	if err := validator.UUID(id); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	client, err := o.usecase.GetClient(ctx, id)
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, conv.DtoClient(client))
+}
+
+// @Summary Register OAuth client
+// @Description Registers application which may sign users in with Coffee ID. Client secret is returned only once. Requires client.manage permission
+// @Tags OAuth
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param body body dto.CreateClient true "Client data"
+// @Success 201 {object} dto.ClientCredentials "Successful response"
+// @Failure 400 {object} resp.JsonError "Incorrect data"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 403 {object} resp.JsonError "This resource is forbidden"
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/oauth/clients [post]
+func (o *OAuth) CreateClient(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	var body dto.CreateClient
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		resp.AbortErrMsg(c, badReqErr.WithErr(err))
+		return
+	}
+
+	if err := validator.Struct(body, validator.Fields); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	client := conv.EntityOAuthClient(body, c.GetString("userId"))
+
+	secret, err := o.usecase.CreateClient(ctx, client)
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(createdStatus, conv.DtoClientCredentials(client, secret))
+}
+
+// @Summary Delete OAuth client
+// @Description Deletes OAuth client, its tokens stop working on expiration. Requires client.manage permission
+// @Tags OAuth
+// @Produce json
+// @Security Bearer
+// @Param        id    path     string  true  "client id"  Format(uuid)
+// @Success 200 {object} resp.Message "Ok."
+// @Failure 400 {object} resp.JsonError "Id must be uuid"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 403 {object} resp.JsonError "This resource is forbidden"
+// @Failure 404 {object} resp.JsonError "This client wasn`t found."
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/oauth/clients/{id} [delete]
+func (o *OAuth) DeleteClient(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	id := c.Param("id")
+
+	if err := validator.UUID(id); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	if err := o.usecase.DeleteClient(ctx, id); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, okMsg)
+}
+
+// @Summary Start authorization
+// @Description Checks authorization request of client for signed in user and returns data for consent screen. Only code flow with PKCE (S256) is supported, scope must contain openid and may contain account fields the client is registered with
+// @Tags OAuth
+// @Produce json
+// @Security Bearer
+// @Param response_type query string true "Must be code"
+// @Param client_id query string true "Client id"
+// @Param redirect_uri query string true "Registered redirect uri"
+// @Param scope query string true "Space-delimited scopes, e.g. openid email name"
+// @Param state query string false "Opaque value returned to client"
+// @Param nonce query string false "Value added to ID token"
+// @Param code_challenge query string true "PKCE challenge"
+// @Param code_challenge_method query string true "Must be S256"
+// @Success 200 {object} dto.Consent "Successful response"
+// @Failure 400 {object} resp.JsonError "Incorrect data, Unknown client., Redirect uri doesn`t match registered one., Requested scope isn`t allowed for this client."
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/oauth/authorize [get]
+func (o *OAuth) Authorize(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	var query dto.Authorize
+
+	if err := c.ShouldBindQuery(&query); err != nil {
+		resp.AbortErrMsg(c, badReqErr.WithErr(err))
+		return
+	}
+
+	if err := validator.Struct(query); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	if !slices.Contains(strings.Fields(query.Scope), types.OPENID) {
+		resp.AbortErrMsg(c, openIdErr)
+		return
+	}
+
+	request := conv.EntityOAuthSession(query, c.GetString("userId"))
+
+	client, err := o.usecase.Authorize(ctx, request)
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, conv.DtoConsent(request, client))
+}
+
+// @Summary Answer consent
+// @Description Approves or denies authorization request. Returns uri the user must be redirected to, with code on approval
+// @Tags OAuth
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param        id    path     string  true  "authorization request id"
+// @Param body body dto.ConsentAnswer true "User answer"
+// @Success 200 {object} dto.OAuthRedirect "Successful response"
+// @Failure 400 {object} resp.JsonError "Incorrect data"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 404 {object} resp.JsonError "This authorization request wasn`t found."
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/oauth/authorize/{id} [post]
+func (o *OAuth) Consent(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	var body dto.ConsentAnswer
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		resp.AbortErrMsg(c, badReqErr.WithErr(err))
+		return
+	}
+
+	redirect, err := o.usecase.Consent(ctx, c.GetString("userId"), c.Param("id"), body.Approve)
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, &dto.OAuthRedirect{RedirectUri: redirect})
+}
+
+// @Summary Token endpoint
+// @Description Exchanges authorization code for access and ID tokens. Internal services get tokens with client_credentials grant. Client authenticates with HTTP Basic or client_id and client_secret in body
+// @Tags OAuth
+// @Accept x-www-form-urlencoded
+// @Produce json
+// @Param grant_type formData string true "authorization_code or client_credentials"
+// @Param code formData string false "Authorization code"
+// @Param redirect_uri formData string false "Redirect uri of authorization request"
+// @Param code_verifier formData string false "PKCE verifier"
+// @Param scope formData string false "Scopes of service, all granted scopes by default"
+// @Param client_id formData string false "Client id"
+// @Param client_secret formData string false "Client secret"
+// @Success 200 {object} dto.TokenResponse "Successful response"
+// @Failure 400 {object} dto.OAuthError "invalid_request, invalid_grant, invalid_scope, unsupported_grant_type"
+// @Failure 401 {object} dto.OAuthError "invalid_client"
+// @Failure 500 {object} dto.OAuthError "server_error"
+// @Router /id/oauth/token [post]
+func (o *OAuth) Token(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	c.Header("Cache-Control", "no-store")
+
+	var body dto.TokenRequest
+
+	if err := c.ShouldBind(&body); err != nil {
+		abortOAuth(c, badRequestStatus, "invalid_request", err.Error())
+		return
+	}
+
+	if id, secret, ok := c.Request.BasicAuth(); ok {
+		body.ClientId, _ = url.QueryUnescape(id)
+		body.ClientSecret, _ = url.QueryUnescape(secret)
+	}
+
+	var tokens *entity.OAuthTokens
+	var err e.Error
+
+	switch body.GrantType {
+	case authorizationCodeGrant:
+		if body.Code == "" || body.ClientId == "" || body.CodeVerifier == "" {
+			abortOAuth(c, badRequestStatus, "invalid_request", "code, client_id and code_verifier are required")
+			return
+		}
+
+		tokens, err = o.usecase.Exchange(ctx, conv.EntityOAuthExchange(body))
+	case clientCredentialsGrant:
+		if body.ClientId == "" || body.ClientSecret == "" {
+			abortOAuth(c, badRequestStatus, "invalid_request", "client_id and client_secret are required")
+			return
+		}
+
+		tokens, err = o.usecase.ClientCredentials(ctx, body.ClientId, body.ClientSecret, body.Scope)
+	default:
+		abortOAuth(c, badRequestStatus, "unsupported_grant_type", "")
+		return
+	}
+
+	if err != nil {
+		switch err.GetCode() {
+		case e.Unauthorize:
+			c.Header("WWW-Authenticate", "Basic")
+			abortOAuth(c, unauthorizedStatus, "invalid_client", err.GetMessage())
+		case e.BadInput, e.NotFound:
+			code := "invalid_grant"
+			if body.GrantType == clientCredentialsGrant {
+				code = "invalid_scope"
+			}
+
+			abortOAuth(c, badRequestStatus, code, err.GetMessage())
+		default:
+			ctx.Logger().Error("Something going wrong...", err.SlErr())
+			abortOAuth(c, internalStatus, "server_error", "")
+		}
+
+		return
+	}
+
+	c.JSON(okStatus, conv.DtoTokenResponse(tokens))
+}
+
+// @Summary UserInfo endpoint
+// @Description Returns claims of user limited to fields granted to client. Requires access token issued to client
+// @Tags OAuth
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} dto.UserInfo "Successful response"
+// @Failure 401 {object} dto.OAuthError "invalid_token"
+// @Failure 500 {object} dto.OAuthError "server_error"
+// @Router /id/oauth/userinfo [get]
+func (o *OAuth) UserInfo(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	token, err := bearerToken(c)
+	if err == nil {
+		user, fields, uerr := o.usecase.UserInfo(ctx, token)
+		if uerr == nil {
+			c.JSON(okStatus, conv.DtoUserInfo(user, fields))
+			return
+		}
+
+		err = uerr
+	}
+
+	if err.GetCode() != e.Unauthorize {
+		ctx.Logger().Error("Something going wrong...", err.SlErr())
+		abortOAuth(c, internalStatus, "server_error", "")
+		return
+	}
+
+	c.Header("WWW-Authenticate", `Bearer error="invalid_token"`)
+	abortOAuth(c, unauthorizedStatus, "invalid_token", err.GetMessage())
+}
+
+// @Summary OpenID Connect discovery
+// @Description Returns OpenID Provider metadata
+// @Tags OAuth
+// @Produce json
+// @Success 200 {object} dto.OpenIdConfiguration "Successful response"
+// @Router /.well-known/openid-configuration [get]
+func (o *OAuth) Discovery(c *gin.Context) {
+	scopes := []string{types.OPENID}
+	for _, field := range types.Fields {
+		scopes = append(scopes, field.Scope())
+	}
+
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(okStatus, &dto.OpenIdConfiguration{
+		Issuer:                            o.usecase.Issuer(),
+		AuthorizationEndpoint:             o.cfg.ConsentUrl,
+		TokenEndpoint:                     o.cfg.PublicUrl + "/api/v1/oauth/token",
+		UserinfoEndpoint:                  o.cfg.PublicUrl + "/api/v1/oauth/userinfo",
+		JwksUri:                           o.cfg.PublicUrl + "/.well-known/jwks.json",
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               []string{authorizationCodeGrant, clientCredentialsGrant},
+		SubjectTypesSupported:             []string{"public"},
+		IdTokenSigningAlgValuesSupported:  o.usecase.Algorithms(),
+		ScopesSupported:                   scopes,
+		ClaimsSupported:                   []string{"sub", "email", "email_verified", "name", "birthdate", "role"},
+		CodeChallengeMethodsSupported:     []string{"S256"},
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post"},
+	})
+}
+
+func bearerToken(c *gin.Context) (string, e.Error) {
+	header := c.GetHeader("Authorization")
+
+	if header == "" {
+		return "", tokenNotSent
+	}
+
+	parts := strings.Split(header, " ")
+	if len(parts) < 2 || parts[0] != bearerType {
+		return "", bearerErr
+	}
+
+	return parts[1], nil
+}
+
+func abortOAuth(c *gin.Context, status int, code, description string) {
+	ctx := ct.GetCtx(c)
+
+	ctx.Logger().Info("OAuth request failed", sl.StringAttr("error", code))
+
+	c.AbortWithStatusJSON(status, &dto.OAuthError{
+		Error:       code,
+		Description: description,
+	})
+}
@@ -0,0 +1,403 @@
+package oauth
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/assert/v2"
+	"github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/dto"
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	"github.com/nikitaSstepanov/coffee-id/internal/usecase/pkg/auth"
+	usecase "github.com/nikitaSstepanov/coffee-id/internal/usecase/pkg/oauth"
+	storage "github.com/nikitaSstepanov/coffee-id/internal/usecase/storage/oauth"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/nikitaSstepanov/tools/sl"
+	"github.com/nikitaSstepanov/tools/utils/coder"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	testUserId      = "6f1c3d1e-2a4b-4c5d-8e9f-0a1b2c3d4e5f"
+	testRedirectUri = "https://app.example.com/callback"
+	testVerifier    = "dBjftJeZ4CVP-mJ92K3hqlWyaL8L3kj1X7sPBmaTLr0Hb"
+)
+
+func TestCodeFlow(t *testing.T) {
+	env := setupEnv(t)
+
+	code := env.authorize(t, "openid email", "xyz", true)
+
+	tokens, status := env.token(t, code, testVerifier)
+	assert.Equal(t, status, http.StatusOK)
+	assert.Equal(t, tokens.TokenType, "Bearer")
+	assert.Equal(t, tokens.Scope, "openid email")
+
+	var idClaims usecase.IdClaims
+	if err := env.jwt.Parse(tokens.IdToken, &idClaims); err != nil {
+		t.Fatalf("Invalid ID token: %v", err)
+	}
+
+	assert.Equal(t, idClaims.Subject, testUserId)
+	assert.Equal(t, idClaims.Nonce, "nonce")
+	assert.Equal(t, []string(idClaims.Audience), []string{env.client.ClientId})
+
+	info, status := env.userInfo(t, tokens.AccessToken)
+	assert.Equal(t, status, http.StatusOK)
+	assert.Equal(t, info.Sub, testUserId)
+	assert.Equal(t, info.Email, "user@coffee.id")
+	// Name wasn't requested, though client may read it.
+	assert.Equal(t, info.Name, "")
+
+	// Code is exchanged only once.
+	_, status = env.token(t, code, testVerifier)
+	assert.Equal(t, status, http.StatusBadRequest)
+}
+
+func TestCodeFlowErrors(t *testing.T) {
+	env := setupEnv(t)
+
+	t.Run("Wrong verifier", func(t *testing.T) {
+		code := env.authorize(t, "openid email", "", true)
+
+		_, status := env.token(t, code, strings.Repeat("a", 43))
+		assert.Equal(t, status, http.StatusBadRequest)
+	})
+
+	t.Run("Scope isn`t allowed", func(t *testing.T) {
+		res := env.get(t, "/oauth/authorize?"+authorizeQuery(env.client.ClientId, "openid birthday", "").Encode(), "")
+		assert.Equal(t, res.StatusCode, http.StatusBadRequest)
+	})
+
+	t.Run("Consent denied", func(t *testing.T) {
+		redirect := env.consent(t, "openid", "state", false)
+
+		assert.Equal(t, redirect.Query().Get("error"), "access_denied")
+		assert.Equal(t, redirect.Query().Get("state"), "state")
+		assert.Equal(t, redirect.Query().Get("code"), "")
+	})
+
+	t.Run("Token of Coffee ID at userinfo", func(t *testing.T) {
+		user := &entity.User{Id: testUserId, Role: types.USER}
+		session := &entity.Session{Id: "sid", TokenId: "jti"}
+
+		token, err := env.jwt.GenerateToken(user, session, nil, false)
+		if err != nil {
+			t.Fatalf("Can`t sign token: %v", err)
+		}
+
+		_, status := env.userInfo(t, token)
+		assert.Equal(t, status, http.StatusUnauthorized)
+	})
+}
+
+func TestClientCredentials(t *testing.T) {
+	env := setupEnv(t)
+
+	tokens, status := env.serviceToken(t, "booking", "booking-secret", "")
+	assert.Equal(t, status, http.StatusOK)
+	assert.Equal(t, tokens.Scope, "users.read")
+	assert.Equal(t, tokens.IdToken, "")
+
+	claims, err := env.jwt.ValidateServiceToken(tokens.AccessToken)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, claims.ClientId, "booking")
+	assert.Equal(t, claims.Allows(types.USERS_READ), true)
+
+	_, status = env.serviceToken(t, "booking", "wrong", "")
+	assert.Equal(t, status, http.StatusUnauthorized)
+
+	// Service can`t ask for scope, which wasn`t granted to it.
+	res, status := env.serviceToken(t, "booking", "booking-secret", "verification.read")
+	assert.Equal(t, status, http.StatusBadRequest)
+	assert.Equal(t, res.AccessToken, "")
+}
+
+// testEnv is Coffee ID served in process and a client of it.
+type testEnv struct {
+	server *httptest.Server
+	jwt    *auth.Jwt
+	client *entity.Client
+	secret string
+}
+
+func setupEnv(t *testing.T) *testEnv {
+	gin.SetMode(gin.TestMode)
+
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("Can`t run redis: %v", err)
+	}
+	t.Cleanup(mr.Close)
+
+	rs := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	jwt, err := auth.NewJwt(&auth.JwtOptions{
+		Audience:        []string{"coffee-id-frontend"},
+		ServiceAudience: "coffee-services",
+		Issuer:          "coffee-id-backend",
+	})
+	if err != nil {
+		t.Fatalf("Can`t create jwt: %v", err)
+	}
+
+	clients := &clientStorage{clients: make(map[string]*entity.Client)}
+
+	uc := usecase.New(
+		&usecase.Storages{
+			Client: clients,
+			OAuth:  storage.New(*rs),
+			User:   &userStorage{},
+		},
+		&usecase.UseCases{
+			Services: map[string]usecase.Service{
+				"booking": {ClientId: "booking", Secret: "booking-secret", Scopes: []types.ServiceScope{types.USERS_READ}},
+			},
+			Jwt:   jwt,
+			Coder: coder.New(&coder.Config{HashCost: 4}),
+		},
+	)
+
+	client := &entity.Client{
+		Name:        "App",
+		RedirectUri: testRedirectUri,
+		Fields:      []types.Field{types.EMAIL, types.NAME},
+	}
+
+	secret, cerr := uc.CreateClient(ctx.New(sl.Default()), client)
+	if cerr != nil {
+		t.Fatalf("Can`t create client: %v", cerr)
+	}
+
+	handler := New(uc, &Config{PublicUrl: "http://id.example.com"})
+
+	signedIn := func(c *gin.Context) {
+		c.Set("userId", testUserId)
+	}
+
+	router := gin.New()
+	router.GET("/oauth/authorize", signedIn, handler.Authorize)
+	router.POST("/oauth/authorize/:id", signedIn, handler.Consent)
+	router.POST("/oauth/token", handler.Token)
+	router.GET("/oauth/userinfo", handler.UserInfo)
+
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	return &testEnv{
+		server: server,
+		jwt:    jwt,
+		client: client,
+		secret: secret,
+	}
+}
+
+// authorize passes consent screen and returns authorization code.
+func (env *testEnv) authorize(t *testing.T, scope, state string, approve bool) string {
+	redirect := env.consent(t, scope, state, approve)
+
+	assert.Equal(t, redirect.Query().Get("state"), state)
+
+	code := redirect.Query().Get("code")
+	if code == "" {
+		t.Fatalf("Code wasn`t returned: %s", redirect)
+	}
+
+	return code
+}
+
+func (env *testEnv) consent(t *testing.T, scope, state string, approve bool) *url.URL {
+	res := env.get(t, "/oauth/authorize?"+authorizeQuery(env.client.ClientId, scope, state).Encode(), "")
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("Authorization request failed with %d", res.StatusCode)
+	}
+
+	var consent dto.Consent
+	decode(t, res, &consent)
+
+	assert.Equal(t, consent.ClientName, "App")
+
+	body := `{"approve": false}`
+	if approve {
+		body = `{"approve": true}`
+	}
+
+	res, err := http.Post(env.server.URL+"/oauth/authorize/"+consent.RequestId, "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("Consent failed: %v", err)
+	}
+
+	var answer dto.OAuthRedirect
+	decode(t, res, &answer)
+
+	redirect, err := url.Parse(answer.RedirectUri)
+	if err != nil {
+		t.Fatalf("Invalid redirect: %v", err)
+	}
+
+	assert.Equal(t, redirect.Host, "app.example.com")
+
+	return redirect
+}
+
+func (env *testEnv) token(t *testing.T, code, verifier string) (*dto.TokenResponse, int) {
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {testRedirectUri},
+		"code_verifier": {verifier},
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, env.server.URL+"/oauth/token", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(env.client.ClientId, env.secret)
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Token request failed: %v", err)
+	}
+
+	var tokens dto.TokenResponse
+	decode(t, res, &tokens)
+
+	return &tokens, res.StatusCode
+}
+
+func (env *testEnv) serviceToken(t *testing.T, clientId, secret, scope string) (*dto.TokenResponse, int) {
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {clientId},
+		"client_secret": {secret},
+		"scope":         {scope},
+	}
+
+	res, err := http.PostForm(env.server.URL+"/oauth/token", form)
+	if err != nil {
+		t.Fatalf("Token request failed: %v", err)
+	}
+
+	var tokens dto.TokenResponse
+	decode(t, res, &tokens)
+
+	return &tokens, res.StatusCode
+}
+
+func (env *testEnv) userInfo(t *testing.T, token string) (*dto.UserInfo, int) {
+	res := env.get(t, "/oauth/userinfo", token)
+
+	var info dto.UserInfo
+	decode(t, res, &info)
+
+	return &info, res.StatusCode
+}
+
+func (env *testEnv) get(t *testing.T, path, token string) *http.Response {
+	req, _ := http.NewRequest(http.MethodGet, env.server.URL+path, nil)
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+
+	return res
+}
+
+func authorizeQuery(clientId, scope, state string) url.Values {
+	hash := sha256.Sum256([]byte(testVerifier))
+
+	return url.Values{
+		"response_type":         {"code"},
+		"client_id":             {clientId},
+		"redirect_uri":          {testRedirectUri},
+		"scope":                 {scope},
+		"state":                 {state},
+		"nonce":                 {"nonce"},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(hash[:])},
+		"code_challenge_method": {"S256"},
+	}
+}
+
+func decode(t *testing.T, res *http.Response, v any) {
+	defer res.Body.Close()
+
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		t.Fatalf("Can`t decode response: %v", err)
+	}
+}
+
+type clientStorage struct {
+	clients map[string]*entity.Client
+}
+
+func (s *clientStorage) GetById(c ctx.Context, id string) (*entity.Client, e.Error) {
+	for _, client := range s.clients {
+		if client.Id == id {
+			return client, nil
+		}
+	}
+
+	return nil, e.New("This client wasn`t found.", e.NotFound)
+}
+
+func (s *clientStorage) GetByClientId(c ctx.Context, clientId string) (*entity.Client, e.Error) {
+	if client, ok := s.clients[clientId]; ok {
+		return client, nil
+	}
+
+	return nil, e.New("This client wasn`t found.", e.NotFound)
+}
+
+func (s *clientStorage) GetAll(c ctx.Context) ([]*entity.Client, e.Error) {
+	clients := make([]*entity.Client, 0, len(s.clients))
+
+	for _, client := range s.clients {
+		clients = append(clients, client)
+	}
+
+	return clients, nil
+}
+
+func (s *clientStorage) Create(c ctx.Context, client *entity.Client) e.Error {
+	client.Id = "2b7e1f0a-9c3d-4e5f-8a1b-2c3d4e5f6a7b"
+	s.clients[client.ClientId] = client
+
+	return nil
+}
+
+func (s *clientStorage) Delete(c ctx.Context, id string) e.Error {
+	for clientId, client := range s.clients {
+		if client.Id == id {
+			delete(s.clients, clientId)
+		}
+	}
+
+	return nil
+}
+
+type userStorage struct{}
+
+func (s *userStorage) GetById(c ctx.Context, id string) (*entity.User, e.Error) {
+	if id != testUserId {
+		return nil, e.New("This user wasn`t found.", e.NotFound)
+	}
+
+	return &entity.User{
+		Id:    testUserId,
+		Email: "user@coffee.id",
+		Name:  "User",
+		Role:  types.USER,
+	}, nil
+}
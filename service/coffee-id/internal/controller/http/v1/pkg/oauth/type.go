@@ -0,0 +1,29 @@
+package oauth
+
+import (
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+type Config struct {
+	// PublicUrl is url Coffee ID is reachable by clients, e.g. "https://id.example.com".
+	PublicUrl string `yaml:"public_url" env:"OAUTH_PUBLIC_URL"`
+	// ConsentUrl is frontend page which shows consent screen and calls authorize API.
+	ConsentUrl string `yaml:"consent_url" env:"OAUTH_CONSENT_URL"`
+}
+
+type OAuthUseCase interface {
+	GetClients(c ctx.Context) ([]*entity.Client, e.Error)
+	GetClient(c ctx.Context, id string) (*entity.Client, e.Error)
+	CreateClient(c ctx.Context, client *entity.Client) (string, e.Error)
+	DeleteClient(c ctx.Context, id string) e.Error
+	Authorize(c ctx.Context, request *entity.OAuthSession) (*entity.Client, e.Error)
+	Consent(c ctx.Context, userId, id string, approve bool) (string, e.Error)
+	Exchange(c ctx.Context, exchange *entity.OAuthExchange) (*entity.OAuthTokens, e.Error)
+	ClientCredentials(c ctx.Context, clientId, secret, scope string) (*entity.OAuthTokens, e.Error)
+	UserInfo(c ctx.Context, token string) (*entity.User, []types.Field, e.Error)
+	Issuer() string
+	Algorithms() []string
+}
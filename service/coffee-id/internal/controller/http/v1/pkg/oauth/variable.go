@@ -0,0 +1,30 @@
+package oauth
+
+import (
+	resp "github.com/nikitaSstepanov/coffee-id/internal/controller/response"
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/nikitaSstepanov/tools/httper"
+)
+
+const (
+	okStatus           = httper.StatusOK
+	createdStatus      = httper.StatusCreated
+	badRequestStatus   = httper.StatusBadRequest
+	unauthorizedStatus = httper.StatusUnauthorized
+	internalStatus     = httper.StatusInternalServerError
+
+	authorizationCodeGrant = "authorization_code"
+	clientCredentialsGrant = "client_credentials"
+	bearerType             = "Bearer"
+)
+
+var (
+	badReqErr    = e.New("Incorrect data.", e.BadInput)
+	openIdErr    = e.New("Scope must contain openid.", e.BadInput)
+	bearerErr    = e.New("Token is not bearer", e.Unauthorize)
+	tokenNotSent = e.New("Authorization header wasn`t found", e.Unauthorize)
+)
+
+var (
+	okMsg = resp.NewMessage("Ok.")
+)
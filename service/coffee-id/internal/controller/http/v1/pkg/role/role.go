@@ -0,0 +1,104 @@
+package role
+
+import (
+	"github.com/gin-gonic/gin"
+	conv "github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/converter"
+	"github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/dto"
+	"github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/validator"
+	resp "github.com/nikitaSstepanov/coffee-id/internal/controller/response"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	ct "github.com/nikitaSstepanov/coffee-id/pkg/utils/controller"
+)
+
+type Role struct {
+	usecase RoleUseCase
+}
+
+func New(uc RoleUseCase) *Role {
+	return &Role{
+		usecase: uc,
+	}
+}
+
+// @Summary Get roles
+// @Description Returns all roles with their permissions.
+// @Tags Role
+// @Produce json
+// @Security Bearer
+// @Success 200 {array} dto.Role "Successful response"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/roles/ [get]
+func (r *Role) GetAll(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	roles, err := r.usecase.GetAll(ctx)
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, conv.DtoRoles(roles))
+}
+
+// @Summary Get role
+// @Description Returns permissions of the role.
+// @Tags Role
+// @Produce json
+// @Security Bearer
+// @Param        role    path     string  true  "role"  Enums(USER, ADMIN, SUPER_ADMIN, SUPPORT)
+// @Success 200 {object} dto.Role "Successful response"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 404 {object} resp.JsonError "This role doesn`t exist."
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/roles/{role} [get]
+func (r *Role) Get(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	role, err := r.usecase.Get(ctx, types.Role(c.Param("role")))
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, conv.DtoRole(role))
+}
+
+// @Summary Set role permissions
+// @Description Replaces permissions of the role. Users get new permissions with their next token. Requires role.manage permission
+// @Tags Role
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param        role    path     string  true  "role"  Enums(USER, ADMIN, SUPER_ADMIN, SUPPORT)
+// @Param body body dto.SetPermissions true "Permissions of the role"
+// @Success 200 {object} dto.Role "Successful response"
+// @Failure 400 {object} resp.JsonError "Incorrect data."
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 403 {object} resp.JsonError "This resource is forbidden"
+// @Failure 404 {object} resp.JsonError "This role doesn`t exist."
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/roles/{role} [put]
+func (r *Role) SetPermissions(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	var body dto.SetPermissions
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		resp.AbortErrMsg(c, badReqErr.WithErr(err))
+		return
+	}
+
+	if err := validator.Struct(body); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	role, err := r.usecase.SetPermissions(ctx, conv.EntityRolePermissions(c.Param("role"), body))
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, conv.DtoRole(role))
+}
@@ -0,0 +1,14 @@
+package role
+
+import (
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+type RoleUseCase interface {
+	GetAll(c ctx.Context) ([]*entity.RolePermissions, e.Error)
+	Get(c ctx.Context, role types.Role) (*entity.RolePermissions, e.Error)
+	SetPermissions(c ctx.Context, role *entity.RolePermissions) (*entity.RolePermissions, e.Error)
+}
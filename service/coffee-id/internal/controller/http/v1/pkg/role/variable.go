@@ -0,0 +1,14 @@
+package role
+
+import (
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/nikitaSstepanov/tools/httper"
+)
+
+const (
+	okStatus = httper.StatusOK
+)
+
+var (
+	badReqErr = e.New("Incorrect data.", e.BadInput)
+)
@@ -0,0 +1,119 @@
+package security
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	conv "github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/converter"
+	"github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/validator"
+	resp "github.com/nikitaSstepanov/coffee-id/internal/controller/response"
+	ct "github.com/nikitaSstepanov/coffee-id/pkg/utils/controller"
+)
+
+type Security struct {
+	usecase SecurityUseCase
+}
+
+func New(uc SecurityUseCase) *Security {
+	return &Security{
+		usecase: uc,
+	}
+}
+
+// @Summary Get security events
+// @Description Returns security events of the user, e.g. lockouts after failed logins, newest first. Requires account.read.any permission
+// @Tags Security
+// @Produce json
+// @Security Bearer
+// @Param        id    path     string  true  "user id"  Format(uuid)
+// @Param page      query int  false  "Page"
+// @Param size      query int  false  "Size"
+// @Success 200 {array} dto.SecurityEvent "Successful response"
+// @Failure 400 {object} resp.JsonError "Incorrect data."
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 403 {object} resp.JsonError "This resource is forbidden"
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/account/{id}/events [get]
+func (s *Security) GetEvents(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	id := c.Param("id")
+
+	if err := validator.UUID(id); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	page, parseErr := strconv.ParseInt(c.DefaultQuery("page", "0"), 10, 64)
+	if parseErr != nil || page < 0 {
+		resp.AbortErrMsg(c, pageErr)
+		return
+	}
+
+	size, parseErr := strconv.ParseInt(c.DefaultQuery("size", "20"), 10, 64)
+	if parseErr != nil || size < 0 {
+		resp.AbortErrMsg(c, sizeErr)
+		return
+	}
+
+	events, err := s.usecase.GetEvents(ctx, id, int(page), int(size))
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, conv.DtoSecurityEvents(events))
+}
+
+// @Summary Get lockouts
+// @Description Returns accounts which are locked now after failed logins. Requires account.read.any permission
+// @Tags Security
+// @Produce json
+// @Security Bearer
+// @Success 200 {array} dto.SecurityEvent "Successful response"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 403 {object} resp.JsonError "This resource is forbidden"
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/account/lockouts [get]
+func (s *Security) GetLockouts(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	events, err := s.usecase.GetLockouts(ctx)
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, conv.DtoSecurityEvents(events))
+}
+
+// @Summary Clear lockout
+// @Description Unlocks the account before lockout expires and forgets its failed logins. Requires account.write.any permission
+// @Tags Security
+// @Produce json
+// @Security Bearer
+// @Param        id    path     string  true  "user id"  Format(uuid)
+// @Success 200 {object} resp.Message "Ok."
+// @Failure 400 {object} resp.JsonError "Id must be uuid"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 403 {object} resp.JsonError "This resource is forbidden"
+// @Failure 404 {object} resp.JsonError "This account isn`t locked."
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/account/{id}/lockout [delete]
+func (s *Security) Unlock(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	id := c.Param("id")
+
+	if err := validator.UUID(id); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	if err := s.usecase.Unlock(ctx, id, c.GetString("userId")); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, okMsg)
+}
@@ -0,0 +1,13 @@
+package security
+
+import (
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+type SecurityUseCase interface {
+	GetEvents(c ctx.Context, userId string, page, size int) ([]*entity.SecurityEvent, e.Error)
+	GetLockouts(c ctx.Context) ([]*entity.SecurityEvent, e.Error)
+	Unlock(c ctx.Context, userId, adminId string) e.Error
+}
@@ -0,0 +1,20 @@
+package security
+
+import (
+	resp "github.com/nikitaSstepanov/coffee-id/internal/controller/response"
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/nikitaSstepanov/tools/httper"
+)
+
+const (
+	okStatus = httper.StatusOK
+)
+
+var (
+	pageErr = e.New("Page must be integer", e.BadInput)
+	sizeErr = e.New("Size must be integer", e.BadInput)
+)
+
+var (
+	okMsg = resp.NewMessage("Ok.")
+)
@@ -0,0 +1,309 @@
+package team
+
+import (
+	"github.com/gin-gonic/gin"
+	conv "github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/converter"
+	"github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/dto"
+	"github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/validator"
+	resp "github.com/nikitaSstepanov/coffee-id/internal/controller/response"
+	ct "github.com/nikitaSstepanov/coffee-id/pkg/utils/controller"
+)
+
+type Team struct {
+	usecase TeamUseCase
+}
+
+func New(uc TeamUseCase) *Team {
+	return &Team{
+		usecase: uc,
+	}
+}
+
+// @Summary Get list of teams
+// @Description Returns all teams.
+// @Tags Team
+// @Produce json
+// @Security Bearer
+// @Success 200 {array} dto.TeamShort "Successful response"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/teams/ [get]
+func (t *Team) GetAll(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	teams, err := t.usecase.GetAll(ctx)
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, conv.DtoTeams(teams))
+}
+
+// @Summary Retrieve team by ID
+// @Description Returns team with its members.
+// @Tags Team
+// @Produce json
+// @Security Bearer
+// @Param        id    path     string  true  "team id"  Format(uuid)
+// @Success 200 {object} dto.Team "Successful response"
+// @Failure 400 {object} resp.JsonError "Id must be uuid"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 404 {object} resp.JsonError "This team wasn`t found."
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/teams/{id} [get]
+func (t *Team) Get(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	id := c.Param("id")
+
+	if err := validator.UUID(id); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	team, err := t.usecase.Get(ctx, id)
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, conv.DtoTeam(team))
+}
+
+// @Summary Retrieve user teams
+// @Description Returns teams the user is a member of.
+// @Tags Team
+// @Produce json
+// @Security Bearer
+// @Param        id    path     string  true  "user id"  Format(uuid)
+// @Success 200 {array} dto.TeamShort "Successful response"
+// @Failure 400 {object} resp.JsonError "Id must be uuid"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 403 {object} resp.JsonError "This resource is forbidden"
+// @Failure 404 {object} resp.JsonError "This user wasn`t found."
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/account/{id}/teams [get]
+// @Router /id/internal/users/{id}/teams [get]
+func (t *Team) GetForUser(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	id := c.Param("id")
+
+	if err := validator.UUID(id); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	teams, err := t.usecase.GetForUser(ctx, id)
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, conv.DtoTeams(teams))
+}
+
+// @Summary Create team
+// @Description Creates a new team. Requires team.manage permission
+// @Tags Team
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param body body dto.UpsertTeam true "Data for creating a team"
+// @Success 201 {object} dto.Team "Successful response"
+// @Failure 400 {object} resp.JsonError "Incorrect data"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 403 {object} resp.JsonError "This resource is forbidden"
+// @Failure 409 {object} resp.JsonError "Team with this name already exist"
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/teams/new [post]
+func (t *Team) Create(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	var body dto.UpsertTeam
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		resp.AbortErrMsg(c, badReqErr.WithErr(err))
+		return
+	}
+
+	if err := validator.Struct(body); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	team := conv.EntityTeam(body)
+
+	if err := t.usecase.Create(ctx, team); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(createdStatus, conv.DtoTeam(team))
+}
+
+// @Summary Update team
+// @Description Renames the team. Requires team.manage permission
+// @Tags Team
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param        id    path     string  true  "team id"  Format(uuid)
+// @Param body body dto.UpsertTeam true "Team update data"
+// @Success 200 {object} dto.Team "Successful response"
+// @Failure 400 {object} resp.JsonError "Incorrect data"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 403 {object} resp.JsonError "This resource is forbidden"
+// @Failure 404 {object} resp.JsonError "This team wasn`t found."
+// @Failure 409 {object} resp.JsonError "Team with this name already exist"
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/teams/{id}/edit [patch]
+func (t *Team) Update(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	id := c.Param("id")
+
+	if err := validator.UUID(id); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	var body dto.UpsertTeam
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		resp.AbortErrMsg(c, badReqErr.WithErr(err))
+		return
+	}
+
+	if err := validator.Struct(body); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	team := conv.EntityTeam(body)
+	team.Id = id
+
+	team, err := t.usecase.Update(ctx, team)
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, conv.DtoTeam(team))
+}
+
+// @Summary Delete team
+// @Description Deletes the team with all its memberships. Requires team.manage permission
+// @Tags Team
+// @Security Bearer
+// @Param        id    path     string  true  "team id"  Format(uuid)
+// @Success 204 "No content"
+// @Failure 400 {object} resp.JsonError "Id must be uuid"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 403 {object} resp.JsonError "This resource is forbidden"
+// @Failure 404 {object} resp.JsonError "This team wasn`t found."
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/teams/{id} [delete]
+func (t *Team) Delete(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	id := c.Param("id")
+
+	if err := validator.UUID(id); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	if err := t.usecase.Delete(ctx, id); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(noContentStatus, nil)
+}
+
+// @Summary Add team member
+// @Description Adds the user to the team. Requires team.manage permission
+// @Tags Team
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param        id    path     string  true  "team id"  Format(uuid)
+// @Param body body dto.TeamMember true "Member to add"
+// @Success 200 {object} dto.Team "Successful response"
+// @Failure 400 {object} resp.JsonError "Incorrect data"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 403 {object} resp.JsonError "This resource is forbidden"
+// @Failure 404 {object} resp.JsonError "This team or user wasn`t found."
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/teams/{id}/members [post]
+func (t *Team) AddMember(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	id := c.Param("id")
+
+	if err := validator.UUID(id); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	var body dto.TeamMember
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		resp.AbortErrMsg(c, badReqErr.WithErr(err))
+		return
+	}
+
+	if err := validator.Struct(body); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	team, err := t.usecase.AddMember(ctx, id, body.UserId)
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, conv.DtoTeam(team))
+}
+
+// @Summary Remove team member
+// @Description Removes the user from the team. Requires team.manage permission
+// @Tags Team
+// @Produce json
+// @Security Bearer
+// @Param        id      path     string  true  "team id"  Format(uuid)
+// @Param        userId  path     string  true  "user id"  Format(uuid)
+// @Success 200 {object} dto.Team "Successful response"
+// @Failure 400 {object} resp.JsonError "Id must be uuid"
+// @Failure 401 {object} resp.JsonError "Unauth"
+// @Failure 403 {object} resp.JsonError "This resource is forbidden"
+// @Failure 404 {object} resp.JsonError "This team wasn`t found."
+// @Failure 500 {object} resp.JsonError "Something going wrong..."
+// @Router /id/teams/{id}/members/{userId} [delete]
+func (t *Team) RemoveMember(c *gin.Context) {
+	ctx := ct.GetCtx(c)
+
+	id := c.Param("id")
+	userId := c.Param("userId")
+
+	if err := validator.UUID(id); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	if err := validator.UUID(userId); err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	team, err := t.usecase.RemoveMember(ctx, id, userId)
+	if err != nil {
+		resp.AbortErrMsg(c, err)
+		return
+	}
+
+	c.JSON(okStatus, conv.DtoTeam(team))
+}
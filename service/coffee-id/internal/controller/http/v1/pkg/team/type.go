@@ -0,0 +1,18 @@
+package team
+
+import (
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+type TeamUseCase interface {
+	Get(c ctx.Context, id string) (*entity.Team, e.Error)
+	GetAll(c ctx.Context) ([]*entity.Team, e.Error)
+	GetForUser(c ctx.Context, userId string) ([]*entity.Team, e.Error)
+	Create(c ctx.Context, team *entity.Team) e.Error
+	Update(c ctx.Context, team *entity.Team) (*entity.Team, e.Error)
+	Delete(c ctx.Context, id string) e.Error
+	AddMember(c ctx.Context, teamId, userId string) (*entity.Team, e.Error)
+	RemoveMember(c ctx.Context, teamId, userId string) (*entity.Team, e.Error)
+}
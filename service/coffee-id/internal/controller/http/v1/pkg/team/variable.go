@@ -0,0 +1,16 @@
+package team
+
+import (
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/nikitaSstepanov/tools/httper"
+)
+
+const (
+	okStatus        = httper.StatusOK
+	createdStatus   = httper.StatusCreated
+	noContentStatus = httper.StatusNoContent
+)
+
+var (
+	badReqErr = e.New("Incorrect data.", e.BadInput)
+)
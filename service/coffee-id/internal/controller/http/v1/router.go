@@ -5,6 +5,11 @@ import (
 	"github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/middleware"
 	"github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/pkg/account"
 	"github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/pkg/auth"
+	"github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/pkg/oauth"
+	"github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/pkg/role"
+	"github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/pkg/security"
+	"github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/pkg/team"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
 	"github.com/nikitaSstepanov/coffee-id/internal/usecase"
 	"github.com/nikitaSstepanov/coffee-id/pkg/swagger"
 	"github.com/nikitaSstepanov/tools/ctx"
@@ -18,21 +23,30 @@ type Config struct {
 	FrontendHost   string              `env:"FRONTEND_HOST"`
 	Swagger        swagger.SwaggerSpec `yaml:"swagger"`
 	SessionsSecret string              `env:"SESSIONS_SECRET"`
+	OAuth          oauth.Config        `yaml:"oauth"`
 }
 
 type Router struct {
-	account AccountHandler
-	auth    AuthHandler
-	mid     Middleware
+	account  AccountHandler
+	auth     AuthHandler
+	team     TeamHandler
+	role     RoleHandler
+	oauth    OAuthHandler
+	security SecurityHandler
+	mid      Middleware
 }
 
 func New(uc *usecase.UseCase, cfg *Config) *Router {
 	swagger.SetSwaggerConfig(cfg.Swagger)
 
 	return &Router{
-		auth:    auth.New(uc.Auth, &cfg.Cookie, cfg.FrontendHost),
-		account: account.New(uc.Account, &cfg.Cookie),
-		mid:     middleware.New(uc.Auth),
+		auth:     auth.New(uc.Auth, &cfg.Cookie, cfg.FrontendHost),
+		account:  account.New(uc.Account, &cfg.Cookie),
+		team:     team.New(uc.Team),
+		role:     role.New(uc.Role),
+		oauth:    oauth.New(uc.OAuth, &cfg.OAuth),
+		security: security.New(uc.Security),
+		mid:      middleware.New(uc.Auth),
 	}
 }
 
@@ -44,23 +58,44 @@ func (r *Router) InitRoutes(ctx ctx.Context, h *gin.RouterGroup) *gin.RouterGrou
 		r.initSwaggerRoute(router)
 		r.initAccountRoutes(router)
 		r.initAuthRoutes(router)
+		r.initTeamRoutes(router)
+		r.initRoleRoutes(router)
+		r.initOAuthRoutes(router)
+		r.initInternalRoutes(router)
 	}
 
 	return router
 }
 
+// InitWellKnownRoutes registers discovery routes, which must be served from the root.
+func (r *Router) InitWellKnownRoutes(h gin.IRouter) {
+	h.GET("/.well-known/jwks.json", r.auth.JWKS)
+	h.GET("/.well-known/openid-configuration", r.oauth.Discovery)
+}
+
 func (r *Router) initAccountRoutes(h *gin.RouterGroup) *gin.RouterGroup {
 	router := h.Group("/account")
 	{
 		router.GET("/", r.mid.CheckAccess(), r.account.Get)
-		router.GET("/all", r.mid.CheckAccess("ADMIN"), r.account.GetList)
-		router.GET("/:id", r.account.GetById)
-		router.PATCH("/:id/edit", r.mid.CheckAccess("ADMIN"), r.account.Edit)
-		router.GET("/email/:email", r.account.GetByEmail)
+		router.GET("/all", r.mid.CheckAccess(types.ACCOUNT_READ_ANY), r.account.GetList)
+		router.GET("/lockouts", r.mid.CheckAccess(types.ACCOUNT_READ_ANY), r.security.GetLockouts)
+		router.GET("/:id", r.mid.CheckAccess(), r.account.GetById)
+		router.GET("/:id/teams", r.mid.CheckAccess(), r.team.GetForUser)
+		router.PATCH("/:id/edit", r.mid.CheckAccess(types.ACCOUNT_WRITE_ANY), r.account.Edit)
+		router.DELETE("/:id/sessions", r.mid.CheckAccess(types.ACCOUNT_WRITE_ANY), r.auth.RevokeAll)
+		router.DELETE("/:id/2fa", r.mid.CheckAccess(types.ACCOUNT_WRITE_ANY), r.auth.ResetTwoFactor)
+		router.GET("/:id/events", r.mid.CheckAccess(types.ACCOUNT_READ_ANY), r.security.GetEvents)
+		router.DELETE("/:id/lockout", r.mid.CheckAccess(types.ACCOUNT_WRITE_ANY), r.security.Unlock)
+		router.GET("/email/:email", r.mid.CheckAccess(types.ACCOUNT_READ_ANY), r.account.GetByEmail)
+		router.POST("/batch", r.mid.CheckAccess(types.ACCOUNT_READ_ANY), r.account.GetBatch)
 		router.POST("/new", r.account.Create)
 		router.PATCH("/edit", r.mid.CheckAccess(), r.account.Update)
-		router.PATCH("/role", r.mid.CheckAccess("ADMIN"), r.account.SetRole)
+		router.PATCH("/role", r.mid.CheckAccess(types.ROLE_ASSIGN), r.account.SetRole)
 		router.DELETE("/delete", r.mid.CheckAccess(), r.account.Delete)
+		router.POST("/verify", r.mid.CheckAccess(), r.account.Verify)
+		router.POST("/verify/resend", r.mid.CheckAccess(), r.account.SendVerification)
+		router.POST("/password/forgot", r.account.ForgotPassword)
+		router.POST("/password/reset", r.account.ResetPassword)
 	}
 
 	return router
@@ -72,6 +107,74 @@ func (r *Router) initAuthRoutes(h *gin.RouterGroup) *gin.RouterGroup {
 		router.POST("/login", r.auth.Login)
 		router.POST("/logout", r.mid.CheckAccess(), r.auth.Logout)
 		router.GET("/refresh", r.auth.Refresh)
+		router.GET("/sessions", r.mid.CheckAccess(), r.auth.GetSessions)
+		router.DELETE("/sessions/:id", r.mid.CheckAccess(), r.auth.RevokeSession)
+		router.GET("/external", r.auth.GetProviders)
+		router.GET("/external/:provider", r.auth.ExternalUrl)
+		router.GET("/external/:provider/link", r.mid.CheckAccess(), r.auth.LinkUrl)
+		router.POST("/external/:provider/callback", r.auth.ExternalCallback)
+		router.DELETE("/external/:provider", r.mid.CheckAccess(), r.auth.Unlink)
+		router.GET("/identities", r.mid.CheckAccess(), r.auth.GetIdentities)
+		router.POST("/2fa/verify", r.auth.VerifyTwoFactor)
+		router.POST("/2fa", r.mid.CheckAccess(), r.auth.EnrollTwoFactor)
+		router.POST("/2fa/enable", r.mid.CheckAccess(), r.auth.EnableTwoFactor)
+		router.DELETE("/2fa", r.mid.CheckAccess(), r.auth.DisableTwoFactor)
+		router.POST("/2fa/recovery", r.mid.CheckAccess(), r.auth.RegenerateRecoveryCodes)
+	}
+
+	return router
+}
+
+func (r *Router) initTeamRoutes(h *gin.RouterGroup) *gin.RouterGroup {
+	router := h.Group("/teams")
+	{
+		router.GET("/", r.mid.CheckAccess(), r.team.GetAll)
+		router.GET("/:id", r.mid.CheckAccess(), r.team.Get)
+		router.POST("/new", r.mid.CheckAccess(types.TEAM_MANAGE), r.team.Create)
+		router.PATCH("/:id/edit", r.mid.CheckAccess(types.TEAM_MANAGE), r.team.Update)
+		router.DELETE("/:id", r.mid.CheckAccess(types.TEAM_MANAGE), r.team.Delete)
+		router.POST("/:id/members", r.mid.CheckAccess(types.TEAM_MANAGE), r.team.AddMember)
+		router.DELETE("/:id/members/:userId", r.mid.CheckAccess(types.TEAM_MANAGE), r.team.RemoveMember)
+	}
+
+	return router
+}
+
+func (r *Router) initRoleRoutes(h *gin.RouterGroup) *gin.RouterGroup {
+	router := h.Group("/roles")
+	{
+		router.GET("/", r.mid.CheckAccess(), r.role.GetAll)
+		router.GET("/:role", r.mid.CheckAccess(), r.role.Get)
+		router.PUT("/:role", r.mid.CheckAccess(types.ROLE_MANAGE), r.role.SetPermissions)
+	}
+
+	return router
+}
+
+func (r *Router) initOAuthRoutes(h *gin.RouterGroup) *gin.RouterGroup {
+	router := h.Group("/oauth")
+	{
+		router.GET("/clients", r.mid.CheckAccess(types.CLIENT_MANAGE), r.oauth.GetClients)
+		router.GET("/clients/:id", r.mid.CheckAccess(types.CLIENT_MANAGE), r.oauth.GetClient)
+		router.POST("/clients", r.mid.CheckAccess(types.CLIENT_MANAGE), r.oauth.CreateClient)
+		router.DELETE("/clients/:id", r.mid.CheckAccess(types.CLIENT_MANAGE), r.oauth.DeleteClient)
+		router.GET("/authorize", r.mid.CheckAccess(), r.oauth.Authorize)
+		router.POST("/authorize/:id", r.mid.CheckAccess(), r.oauth.Consent)
+		router.POST("/token", r.oauth.Token)
+		router.GET("/userinfo", r.oauth.UserInfo)
+		router.POST("/userinfo", r.oauth.UserInfo)
+	}
+
+	return router
+}
+
+func (r *Router) initInternalRoutes(h *gin.RouterGroup) *gin.RouterGroup {
+	router := h.Group("/internal")
+	{
+		router.GET("/users/:id", r.mid.CheckService(types.USERS_READ), r.account.GetById)
+		router.GET("/users/:id/teams", r.mid.CheckService(types.USERS_READ), r.team.GetForUser)
+		router.GET("/users/email/:email", r.mid.CheckService(types.USERS_READ), r.account.GetByEmail)
+		router.POST("/users/batch", r.mid.CheckService(types.USERS_READ), r.account.GetBatch)
 	}
 
 	return router
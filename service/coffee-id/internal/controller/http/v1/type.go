@@ -14,17 +14,74 @@ type AccountHandler interface {
 	SetRole(c *gin.Context)
 	Delete(c *gin.Context)
 	GetByEmail(c *gin.Context)
+	GetBatch(c *gin.Context)
 	Edit(c *gin.Context)
 	GetList(c *gin.Context)
+	SendVerification(c *gin.Context)
+	Verify(c *gin.Context)
+	ForgotPassword(c *gin.Context)
+	ResetPassword(c *gin.Context)
 }
 
 type AuthHandler interface {
 	Login(c *gin.Context)
 	Logout(c *gin.Context)
 	Refresh(c *gin.Context)
+	GetSessions(c *gin.Context)
+	RevokeSession(c *gin.Context)
+	RevokeAll(c *gin.Context)
+	JWKS(c *gin.Context)
+	GetProviders(c *gin.Context)
+	ExternalUrl(c *gin.Context)
+	LinkUrl(c *gin.Context)
+	ExternalCallback(c *gin.Context)
+	GetIdentities(c *gin.Context)
+	Unlink(c *gin.Context)
+	VerifyTwoFactor(c *gin.Context)
+	EnrollTwoFactor(c *gin.Context)
+	EnableTwoFactor(c *gin.Context)
+	DisableTwoFactor(c *gin.Context)
+	RegenerateRecoveryCodes(c *gin.Context)
+	ResetTwoFactor(c *gin.Context)
+}
+
+type TeamHandler interface {
+	GetAll(c *gin.Context)
+	Get(c *gin.Context)
+	GetForUser(c *gin.Context)
+	Create(c *gin.Context)
+	Update(c *gin.Context)
+	Delete(c *gin.Context)
+	AddMember(c *gin.Context)
+	RemoveMember(c *gin.Context)
+}
+
+type RoleHandler interface {
+	GetAll(c *gin.Context)
+	Get(c *gin.Context)
+	SetPermissions(c *gin.Context)
+}
+
+type OAuthHandler interface {
+	GetClients(c *gin.Context)
+	GetClient(c *gin.Context)
+	CreateClient(c *gin.Context)
+	DeleteClient(c *gin.Context)
+	Authorize(c *gin.Context)
+	Consent(c *gin.Context)
+	Token(c *gin.Context)
+	UserInfo(c *gin.Context)
+	Discovery(c *gin.Context)
+}
+
+type SecurityHandler interface {
+	GetEvents(c *gin.Context)
+	GetLockouts(c *gin.Context)
+	Unlock(c *gin.Context)
 }
 
 type Middleware interface {
-	CheckAccess(roles ...types.Role) gin.HandlerFunc
+	CheckAccess(permissions ...types.Permission) gin.HandlerFunc
+	CheckService(scopes ...types.ServiceScope) gin.HandlerFunc
 	InitLogger(c ctx.Context) gin.HandlerFunc
 }
@@ -22,6 +22,8 @@ func Struct(s interface{}, args ...Arg) e.Error {
 				msg += "Field" + err.Field() + "is required. "
 			case "email":
 				msg += "Invalid email. "
+			case "uuid":
+				msg += err.Field() + " must be uuid. "
 			case "min":
 				msg += "Min length of " + err.Field() + " is " + err.Param() + ". "
 			case "max":
@@ -32,6 +34,14 @@ func Struct(s interface{}, args ...Arg) e.Error {
 				msg += "Minimal avaliable age is 8. "
 			case "fields":
 				msg += "Invalid fields. "
+			case "url":
+				msg += err.Field() + " must be url. "
+			case "eq":
+				msg += err.Field() + " must be " + err.Param() + ". "
+			case "len":
+				msg += "Length of " + err.Field() + " must be " + err.Param() + ". "
+			case "numeric":
+				msg += err.Field() + " must contain only digits. "
 			}
 		}
 
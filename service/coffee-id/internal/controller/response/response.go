@@ -1,7 +1,10 @@
 package resp
 
 import (
+	"net/http"
+
 	"github.com/gin-gonic/gin"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
 	ct "github.com/nikitaSstepanov/coffee-id/pkg/utils/controller"
 	e "github.com/nikitaSstepanov/tools/error"
 )
@@ -31,8 +34,13 @@ func AbortErrMsg(c *gin.Context, err e.Error) {
 		log.Info("Invalid input data")
 	}
 
+	status := err.ToHttpCode()
+	if err.GetCode() == types.TooManyRequests {
+		status = http.StatusTooManyRequests
+	}
+
 	c.AbortWithStatusJSON(
-		err.ToHttpCode(),
+		status,
 		err.ToJson(),
 	)
 }
@@ -2,19 +2,22 @@ package entity
 
 import (
 	"encoding/json"
+	"time"
 
 	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
 	"github.com/nikitaSstepanov/tools/client/pg"
 )
 
+// Client is an application registered to sign users in with Coffee ID.
 type Client struct {
-	Id          uint64        `redis:"id"`
+	Id          string        `redis:"id"`
 	Name        string        `redis:"name"`
 	ClientId    string        `redis:"client_id"`
 	Secret      string        `redis:"secret"`
 	RedirectUri string        `redis:"redirect_uri"`
 	Fields      []types.Field `redis:"fields"`
-	UserId      uint64        `redis:"user_id"`
+	UserId      string        `redis:"user_id"`
+	CreatedAt   time.Time     `redis:"created_at"`
 }
 
 func (c *Client) MarshalBinary() ([]byte, error) {
@@ -26,13 +29,25 @@ func (c *Client) UnmarshalBinary(data []byte) error {
 }
 
 func (c *Client) Scan(r pg.Row) error {
-	return r.Scan(
+	var userId *string
+
+	err := r.Scan(
 		&c.Id,
 		&c.Name,
 		&c.ClientId,
 		&c.Secret,
 		&c.RedirectUri,
 		&c.Fields,
-		&c.UserId,
+		&userId,
+		&c.CreatedAt,
 	)
+	if err != nil {
+		return err
+	}
+
+	if userId != nil {
+		c.UserId = *userId
+	}
+
+	return nil
 }
@@ -1,10 +1,18 @@
 package entity
 
-import "encoding/json"
+import (
+	"encoding/json"
 
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+)
+
+// ActivationCode is single-use code sent to email. Email is the address code
+// was sent to, so code can't confirm email changed after it was issued.
 type ActivationCode struct {
-	Code   string `redis:"code"`
-	UserId uint64 `redis:"userId"`
+	Code   string         `redis:"code"`
+	UserId string         `redis:"userId"`
+	Type   types.CodeType `redis:"type"`
+	Email  string         `redis:"email"`
 }
 
 func (c *ActivationCode) MarshalBinary() ([]byte, error) {
@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/nikitaSstepanov/tools/client/pg"
+)
+
+// Identity links account of external provider to user.
+type Identity struct {
+	Provider  string    `redis:"provider"`
+	Subject   string    `redis:"subject"`
+	UserId    string    `redis:"user_id"`
+	Email     string    `redis:"email"`
+	CreatedAt time.Time `redis:"created_at"`
+}
+
+// ExternalUser is profile of user returned by external provider.
+type ExternalUser struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Birthday      time.Time
+}
+
+// ExternalState is login request to external provider waiting for callback.
+// UserId is set when signed in user links provider to their account.
+type ExternalState struct {
+	State    string `redis:"state"`
+	Provider string `redis:"provider"`
+	Verifier string `redis:"verifier"`
+	Nonce    string `redis:"nonce"`
+	UserId   string `redis:"user_id"`
+}
+
+func (i *Identity) Scan(r pg.Row) error {
+	var email *string
+
+	err := r.Scan(
+		&i.Provider,
+		&i.Subject,
+		&i.UserId,
+		&email,
+		&i.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if email != nil {
+		i.Email = *email
+	}
+
+	return nil
+}
+
+func (s *ExternalState) MarshalBinary() ([]byte, error) {
+	return json.Marshal(s)
+}
+
+func (s *ExternalState) UnmarshalBinary(data []byte) error {
+	return json.Unmarshal(data, s)
+}
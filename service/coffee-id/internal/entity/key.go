@@ -0,0 +1,10 @@
+package entity
+
+import "crypto"
+
+// PublicKey is a token verification key published in JWKS.
+type PublicKey struct {
+	Kid string
+	Alg string
+	Key crypto.PublicKey
+}
@@ -1,25 +1,49 @@
 package entity
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"time"
 
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+)
+
+// OAuthSession is an authorization request waiting for user's consent.
 type OAuthSession struct {
-	Challenge string `redis:"challenge"`
-	ClientId  uint64 `redis:"client_id"`
+	Id          string        `redis:"id"`
+	UserId      string        `redis:"user_id"`
+	ClientId    string        `redis:"client_id"`
+	RedirectUri string        `redis:"redirect_uri"`
+	Fields      []types.Field `redis:"fields"`
+	State       string        `redis:"state"`
+	Nonce       string        `redis:"nonce"`
+	Challenge   string        `redis:"challenge"`
 }
 
+// OAuthCode is an authorization code, which client exchanges for tokens once.
 type OAuthCode struct {
-	UserId uint64 `redis:"id"`
+	Code        string        `redis:"code"`
+	UserId      string        `redis:"user_id"`
+	ClientId    string        `redis:"client_id"`
+	RedirectUri string        `redis:"redirect_uri"`
+	Fields      []types.Field `redis:"fields"`
+	Nonce       string        `redis:"nonce"`
+	Challenge   string        `redis:"challenge"`
 }
 
-type OAuthTokens struct {
-	Access        string
-	Refresh       string
-	ClientAccess  string
-	ClientRefresh string
+// OAuthExchange is a token request of client.
+type OAuthExchange struct {
+	ClientId    string
+	Secret      string
+	Code        string
+	RedirectUri string
+	Verifier    string
 }
 
-type FieldOptions struct {
-	HasVerified bool
+type OAuthTokens struct {
+	Access  string
+	IdToken string
+	Expires time.Duration
+	Scope   string
 }
 
 func (o *OAuthSession) MarshalBinary() ([]byte, error) {
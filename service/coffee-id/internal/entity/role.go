@@ -0,0 +1,8 @@
+package entity
+
+import types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+
+type RolePermissions struct {
+	Role        types.Role
+	Permissions []types.Permission
+}
@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"time"
+
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	"github.com/nikitaSstepanov/tools/client/pg"
+)
+
+// SecurityEvent is recorded when account is attacked, e.g. locked after failed
+// logins. Event is active until it expires or admin clears it.
+type SecurityEvent struct {
+	Id        string
+	UserId    string
+	Type      types.SecurityEventType
+	Ip        string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	ClearedAt time.Time
+	ClearedBy string
+}
+
+func (s *SecurityEvent) Scan(r pg.Row) error {
+	var clearedAt *time.Time
+	var clearedBy *string
+
+	err := r.Scan(
+		&s.Id,
+		&s.UserId,
+		&s.Type,
+		&s.Ip,
+		&s.CreatedAt,
+		&s.ExpiresAt,
+		&clearedAt,
+		&clearedBy,
+	)
+	if err != nil {
+		return err
+	}
+
+	if clearedAt != nil {
+		s.ClearedAt = *clearedAt
+	}
+
+	if clearedBy != nil {
+		s.ClearedBy = *clearedBy
+	}
+
+	return nil
+}
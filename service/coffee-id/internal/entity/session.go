@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Session is a family of refresh tokens issued by one login. Only the latest
+// refresh token of session, identified by TokenId, may be exchanged.
+type Session struct {
+	Id         string    `redis:"id"`
+	UserId     string    `redis:"user_id"`
+	TokenId    string    `redis:"token_id"`
+	Device     string    `redis:"device"`
+	Ip         string    `redis:"ip"`
+	CreatedAt  time.Time `redis:"created_at"`
+	LastUsedAt time.Time `redis:"last_used_at"`
+	ExpiresAt  time.Time `redis:"expires_at"`
+}
+
+func (s *Session) MarshalBinary() ([]byte, error) {
+	return json.Marshal(s)
+}
+
+func (s *Session) UnmarshalBinary(data []byte) error {
+	return json.Unmarshal(data, s)
+}
@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/nikitaSstepanov/tools/client/pg"
+)
+
+type Team struct {
+	Id        string    `redis:"id"`
+	Name      string    `redis:"name"`
+	CreatedAt time.Time `redis:"created_at"`
+	Members   []string  `redis:"members"`
+}
+
+func (t *Team) MarshalBinary() ([]byte, error) {
+	return json.Marshal(t)
+}
+
+func (t *Team) UnmarshalBinary(data []byte) error {
+	return json.Unmarshal(data, t)
+}
+
+func (t *Team) Scan(r pg.Row) error {
+	return r.Scan(
+		&t.Id,
+		&t.Name,
+		&t.CreatedAt,
+	)
+}
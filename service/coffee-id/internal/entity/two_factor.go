@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/nikitaSstepanov/tools/client/pg"
+)
+
+// TwoFactor is TOTP of user. It is enabled only after user entered the first
+// code. Recovery codes are stored as hashes, LastStep protects codes from replay.
+type TwoFactor struct {
+	UserId        string
+	Secret        string
+	Enabled       bool
+	RecoveryCodes []string
+	LastStep      int64
+	CreatedAt     time.Time
+}
+
+// TwoFactorSetup is shown to user once to add secret to authenticator app.
+type TwoFactorSetup struct {
+	Secret string
+	Uri    string
+}
+
+// LoginChallenge is login waiting for the second factor. Setup is set when
+// user has to enroll before getting tokens.
+type LoginChallenge struct {
+	Id     string          `redis:"id"`
+	UserId string          `redis:"user_id"`
+	Device string          `redis:"device"`
+	Ip     string          `redis:"ip"`
+	Setup  *TwoFactorSetup `redis:"-" json:"-"`
+}
+
+func (t *TwoFactor) Scan(r pg.Row) error {
+	return r.Scan(
+		&t.UserId,
+		&t.Secret,
+		&t.Enabled,
+		&t.RecoveryCodes,
+		&t.LastStep,
+		&t.CreatedAt,
+	)
+}
+
+func (c *LoginChallenge) MarshalBinary() ([]byte, error) {
+	return json.Marshal(c)
+}
+
+func (c *LoginChallenge) UnmarshalBinary(data []byte) error {
+	return json.Unmarshal(data, c)
+}
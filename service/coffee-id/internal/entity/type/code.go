@@ -0,0 +1,9 @@
+package types
+
+// CodeType is what activation code confirms.
+type CodeType string
+
+const (
+	VERIFICATION   CodeType = "VERIFICATION"
+	PASSWORD_RESET CodeType = "PASSWORD_RESET"
+)
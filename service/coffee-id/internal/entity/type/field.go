@@ -1,5 +1,12 @@
 package types
 
+import (
+	"slices"
+	"strings"
+)
+
+// Field is a part of account, which OAuth client may be allowed to read.
+// Fields are requested as lowercase scopes, e.g. "email".
 type Field string
 
 const (
@@ -10,3 +17,60 @@ const (
 	BIRTHDAY Field = "BIRTHDAY"
 	VERIFIED Field = "VERIFIED"
 )
+
+// OPENID is the scope every OpenID Connect request must contain.
+const OPENID = "openid"
+
+var (
+	Fields = []Field{
+		ID,
+		EMAIL,
+		NAME,
+		ROLES,
+		BIRTHDAY,
+		VERIFIED,
+	}
+)
+
+func (f Field) IsValid() bool {
+	return slices.Contains(Fields, f)
+}
+
+func (f Field) Scope() string {
+	return strings.ToLower(string(f))
+}
+
+func FieldFromScope(scope string) Field {
+	return Field(strings.ToUpper(scope))
+}
+
+// Scope formats fields as space-delimited scope of OpenID Connect request.
+func Scope(fields []Field) string {
+	scopes := []string{OPENID}
+
+	for _, field := range fields {
+		scopes = append(scopes, field.Scope())
+	}
+
+	return strings.Join(scopes, " ")
+}
+
+// ParseScope returns fields of space-delimited scope. Unknown scopes are kept,
+// so they can be rejected by caller.
+func ParseScope(scope string) []Field {
+	fields := make([]Field, 0)
+
+	for _, s := range strings.Fields(scope) {
+		if s == OPENID {
+			continue
+		}
+
+		field := FieldFromScope(s)
+
+		if !slices.Contains(fields, field) {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
@@ -5,4 +5,5 @@ type OAuth string
 const (
 	YANDEX OAuth = "YANDEX"
 	GOOGLE OAuth = "GOOGLE"
+	OIDC   OAuth = "OIDC"
 )
@@ -0,0 +1,59 @@
+package types
+
+import "slices"
+
+// Permission is a named action checked by every service instead of roles.
+type Permission string
+
+const (
+	BOOKING_READ_ANY  Permission = "booking.read.any"
+	BOOKING_WRITE_ANY Permission = "booking.write.any"
+	BOOKING_VERIFY    Permission = "booking.verify"
+	ORDER_READ_ANY    Permission = "order.read.any"
+	ORDER_WRITE_ANY   Permission = "order.write.any"
+	ORDER_FULFIL      Permission = "order.fulfil"
+	LAYOUT_EDIT       Permission = "layout.edit"
+	QUOTA_MANAGE      Permission = "quota.manage"
+	STATS_READ        Permission = "stats.read"
+	ACCOUNT_READ_ANY  Permission = "account.read.any"
+	ACCOUNT_WRITE_ANY Permission = "account.write.any"
+	ROLE_ASSIGN       Permission = "role.assign"
+	ROLE_MANAGE       Permission = "role.manage"
+	TEAM_MANAGE       Permission = "team.manage"
+	CLIENT_MANAGE     Permission = "client.manage"
+)
+
+var (
+	Permissions = []Permission{
+		BOOKING_READ_ANY,
+		BOOKING_WRITE_ANY,
+		BOOKING_VERIFY,
+		ORDER_READ_ANY,
+		ORDER_WRITE_ANY,
+		ORDER_FULFIL,
+		LAYOUT_EDIT,
+		QUOTA_MANAGE,
+		STATS_READ,
+		ACCOUNT_READ_ANY,
+		ACCOUNT_WRITE_ANY,
+		ROLE_ASSIGN,
+		ROLE_MANAGE,
+		TEAM_MANAGE,
+		CLIENT_MANAGE,
+	}
+
+	Roles = []Role{
+		USER,
+		ADMIN,
+		SUPER_ADMIN,
+		SUPPORT,
+	}
+)
+
+func (p Permission) IsValid() bool {
+	return slices.Contains(Permissions, p)
+}
+
+func (r Role) IsValid() bool {
+	return slices.Contains(Roles, r)
+}
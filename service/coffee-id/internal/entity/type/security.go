@@ -0,0 +1,17 @@
+package types
+
+// Action is request limited by rate per ip and per account.
+type Action string
+
+const (
+	LOGIN   Action = "LOGIN"
+	SIGNUP  Action = "SIGNUP"
+	REFRESH Action = "REFRESH"
+)
+
+// SecurityEventType is what happened with account.
+type SecurityEventType string
+
+const (
+	LOCKOUT SecurityEventType = "LOCKOUT"
+)
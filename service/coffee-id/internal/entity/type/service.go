@@ -0,0 +1,23 @@
+package types
+
+import "slices"
+
+// ServiceScope is what internal service may do with token it gets by client
+// credentials grant.
+type ServiceScope string
+
+const (
+	USERS_READ        ServiceScope = "users.read"
+	VERIFICATION_READ ServiceScope = "verification.read"
+)
+
+var (
+	ServiceScopes = []ServiceScope{
+		USERS_READ,
+		VERIFICATION_READ,
+	}
+)
+
+func (s ServiceScope) IsValid() bool {
+	return slices.Contains(ServiceScopes, s)
+}
@@ -0,0 +1,7 @@
+package types
+
+import e "github.com/nikitaSstepanov/tools/error"
+
+// TooManyRequests is status of errors caused by rate limits. tools/error has
+// no such status, so response maps it to 429 itself.
+const TooManyRequests e.StatusType = 429
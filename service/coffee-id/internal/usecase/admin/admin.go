@@ -1,18 +1,35 @@
 package admin
 
 import (
+	"time"
+
+	"github.com/nikitaSstepanov/coffee-id/pkg/tracing"
 	"github.com/nikitaSstepanov/tools/ctx"
 	e "github.com/nikitaSstepanov/tools/error"
 	"github.com/nikitaSstepanov/tools/httper"
 )
 
+const (
+	clientId     = "coffee-id"
+	scope        = "verification.read"
+	tokenExpires = 5 * time.Minute
+)
+
 type Admin struct {
 	client *httper.Client
+	jwt    Signer
 }
 
-func New(cfg *httper.ClientCfg) *Admin {
+// Signer issues tokens of Coffee ID itself. Coffee ID is issuer of service tokens,
+// so it doesn`t need client credentials grant to call admin service.
+type Signer interface {
+	ServiceToken(clientId, scope string, expires time.Duration) (string, e.Error)
+}
+
+func New(cfg *httper.ClientCfg, jwt Signer) *Admin {
 	return &Admin{
 		client: httper.NewClient(cfg),
+		jwt:    jwt,
 	}
 }
 
@@ -20,12 +37,17 @@ type User struct {
 	Verified bool `json:"verified"`
 }
 
-func (i *Admin) GetUser(c ctx.Context, email string, token string) (*User, e.Error) {
+func (i *Admin) GetUser(c ctx.Context, id string) (*User, e.Error) {
 	var user User
 
+	token, tokenErr := i.jwt.ServiceToken(clientId, scope, tokenExpires)
+	if tokenErr != nil {
+		return nil, tokenErr
+	}
+
 	req, err := httper.NewReq(&httper.Params{
 		Method:        httper.GetMethod,
-		Url:           "/verification/" + email + "/check",
+		Url:           "/internal/verification/" + id,
 		Unmarshal:     true,
 		UnmarshalTo:   &user,
 		UnmarshalType: httper.JsonType,
@@ -34,12 +56,21 @@ func (i *Admin) GetUser(c ctx.Context, email string, token string) (*User, e.Err
 		return nil, e.InternalErr.WithErr(err)
 	}
 
-	req.Header.Add("Authorization", token)
+	req.Header.Add("Authorization", "Bearer "+token)
+
+	span := tracing.Outgoing(c, req.Request)
 
-	_, err = i.client.Do(req)
+	response, err := i.client.Do(req)
 	if err != nil {
+		tracing.End(span, 0, err)
 		return nil, e.InternalErr.WithErr(err)
 	}
 
+	tracing.End(span, response.StatusCode, nil)
+
+	if response.StatusCode != 200 {
+		return nil, e.InternalErr
+	}
+
 	return &user, nil
 }
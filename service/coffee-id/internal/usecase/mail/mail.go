@@ -0,0 +1,93 @@
+package mail
+
+import (
+	"fmt"
+
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+// Mail sends letters to users through configured sender.
+type Mail struct {
+	sender Sender
+	from   string
+}
+
+// Sender delivers message. SMTP is used in production, file and log senders
+// let tests and local runs read letters without mail server.
+type Sender interface {
+	Send(c ctx.Context, msg *Message) error
+}
+
+type Message struct {
+	From    string
+	To      string
+	Subject string
+	Body    string
+}
+
+// Config selects sender by type: "smtp", "file" or "log".
+type Config struct {
+	Type string     `yaml:"type" env:"MAIL_TYPE"`
+	From string     `yaml:"from" env:"MAIL_FROM"`
+	Path string     `yaml:"path" env:"MAIL_PATH"`
+	Smtp SmtpConfig `yaml:"smtp"`
+}
+
+type SmtpConfig struct {
+	Host     string `yaml:"host"     env:"SMTP_HOST"`
+	Port     int    `yaml:"port"     env:"SMTP_PORT"`
+	Username string `yaml:"username" env:"SMTP_USERNAME"`
+	Password string `env:"SMTP_PASSWORD"`
+}
+
+func New(cfg *Config) (*Mail, error) {
+	var sender Sender
+
+	switch cfg.Type {
+	case smtpType:
+		if cfg.Smtp.Host == "" {
+			return nil, fmt.Errorf("smtp host is required")
+		}
+
+		sender = newSmtp(&cfg.Smtp)
+	case fileType:
+		if cfg.Path == "" {
+			return nil, fmt.Errorf("path of mail file is required")
+		}
+
+		sender = newFile(cfg.Path)
+	case logType, "":
+		sender = newLog()
+	default:
+		return nil, fmt.Errorf("unknown mail sender %q", cfg.Type)
+	}
+
+	return &Mail{
+		sender: sender,
+		from:   cfg.From,
+	}, nil
+}
+
+func (m *Mail) SendActivation(c ctx.Context, to string, code string) e.Error {
+	return m.send(c, to, activationSubject, fmt.Sprintf(activationBody, code))
+}
+
+func (m *Mail) SendPasswordReset(c ctx.Context, to string, code string) e.Error {
+	return m.send(c, to, resetSubject, fmt.Sprintf(resetBody, code))
+}
+
+func (m *Mail) send(c ctx.Context, to, subject, body string) e.Error {
+	msg := &Message{
+		From:    m.from,
+		To:      to,
+		Subject: subject,
+		Body:    body,
+	}
+
+	if err := m.sender.Send(c, msg); err != nil {
+		return sendErr.WithErr(err).WithCtx(c)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package mail
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/assert/v2"
+	"github.com/nikitaSstepanov/tools/ctx"
+	"github.com/nikitaSstepanov/tools/sl"
+)
+
+func TestFileSender(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mail.txt")
+
+	mail, err := New(&Config{Type: fileType, From: "id@coffee.id", Path: path})
+	if err != nil {
+		t.Fatalf("Can`t create mail: %v", err)
+	}
+
+	ctx := ctx.New(sl.Default())
+
+	if err := mail.SendActivation(ctx, "user@coffee.id", "123456"); err != nil {
+		t.Fatalf("Can`t send mail: %v", err)
+	}
+
+	if err := mail.SendPasswordReset(ctx, "user@coffee.id", "654321"); err != nil {
+		t.Fatalf("Can`t send mail: %v", err)
+	}
+
+	data, rerr := os.ReadFile(path)
+	if rerr != nil {
+		t.Fatalf("Can`t read mail: %v", rerr)
+	}
+
+	letters := string(data)
+
+	assert.Equal(t, strings.Count(letters, "To: user@coffee.id"), 2)
+	assert.Equal(t, strings.Contains(letters, "Subject: "+activationSubject), true)
+	assert.Equal(t, strings.Contains(letters, "123456"), true)
+	assert.Equal(t, strings.Contains(letters, "654321"), true)
+}
+
+func TestNew(t *testing.T) {
+	configs := []*Config{
+		{Type: "pigeon"},
+		{Type: smtpType},
+		{Type: fileType},
+	}
+
+	for _, cfg := range configs {
+		if _, err := New(cfg); err == nil {
+			t.Errorf("Test failing: expected not nil error for %v", cfg)
+		}
+	}
+}
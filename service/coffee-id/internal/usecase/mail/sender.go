@@ -0,0 +1,98 @@
+package mail
+
+import (
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/nikitaSstepanov/tools/ctx"
+	"github.com/nikitaSstepanov/tools/sl"
+)
+
+type smtpSender struct {
+	addr string
+	auth smtp.Auth
+}
+
+func newSmtp(cfg *SmtpConfig) *smtpSender {
+	sender := &smtpSender{
+		addr: net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)),
+	}
+
+	if cfg.Username != "" {
+		sender.auth = smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)
+	}
+
+	return sender
+}
+
+func (s *smtpSender) Send(c ctx.Context, msg *Message) error {
+	from, err := mail.ParseAddress(msg.From)
+	if err != nil {
+		return err
+	}
+
+	return smtp.SendMail(s.addr, s.auth, from.Address, []string{msg.To}, format(msg))
+}
+
+// fileSender appends letters to file.
+type fileSender struct {
+	mu   sync.Mutex
+	path string
+}
+
+func newFile(path string) *fileSender {
+	return &fileSender{
+		path: path,
+	}
+}
+
+func (s *fileSender) Send(c ctx.Context, msg *Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(append(format(msg), '\n'))
+
+	return err
+}
+
+// logSender writes letters to log.
+type logSender struct{}
+
+func newLog() *logSender {
+	return &logSender{}
+}
+
+func (s *logSender) Send(c ctx.Context, msg *Message) error {
+	c.Logger().Info(
+		"Mail",
+		sl.StringAttr("to", msg.To),
+		sl.StringAttr("subject", msg.Subject),
+		sl.StringAttr("body", msg.Body),
+	)
+
+	return nil
+}
+
+func format(msg *Message) []byte {
+	headers := []string{
+		fmt.Sprintf("From: %s", msg.From),
+		fmt.Sprintf("To: %s", msg.To),
+		fmt.Sprintf("Subject: %s", msg.Subject),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+	}
+
+	return []byte(strings.Join(headers, "\r\n") + "\r\n\r\n" + msg.Body + "\r\n")
+}
@@ -0,0 +1,20 @@
+package mail
+
+import e "github.com/nikitaSstepanov/tools/error"
+
+const (
+	smtpType = "smtp"
+	fileType = "file"
+	logType  = "log"
+)
+
+const (
+	activationSubject = "Confirm your email"
+	activationBody    = "Your Coffee ID confirmation code: %s"
+	resetSubject      = "Password reset"
+	resetBody         = "Your Coffee ID password reset code: %s\n\nIf you didn`t request reset, ignore this letter."
+)
+
+var (
+	sendErr = e.New("Can`t send email.", e.Internal)
+)
@@ -1,32 +1,39 @@
 package account
 
 import (
+	"slices"
+
 	"github.com/nikitaSstepanov/coffee-id/internal/controller/http/v1/dto"
 	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
 	"github.com/nikitaSstepanov/tools/ctx"
 	e "github.com/nikitaSstepanov/tools/error"
 	"github.com/nikitaSstepanov/tools/utils/coder"
 )
 
 type Account struct {
-	user  UserStorage
-	code  CodeStorage
-	jwt   JwtUseCase
-	adm   Admin
-	coder *coder.Coder
+	user     UserStorage
+	code     CodeStorage
+	session  SessionUseCase
+	security SecurityUseCase
+	mail     MailUseCase
+	adm      Admin
+	coder    *coder.Coder
 }
 
 func New(store *Storages, uc *UseCases) *Account {
 	return &Account{
-		user:  store.User,
-		code:  store.Code,
-		jwt:   uc.Jwt,
-		coder: uc.Coder,
-		adm:   uc.Admin,
+		user:     store.User,
+		code:     store.Code,
+		session:  uc.Session,
+		security: uc.Security,
+		mail:     uc.Mail,
+		coder:    uc.Coder,
+		adm:      uc.Admin,
 	}
 }
 
-func (a *Account) GetList(c ctx.Context, page, size int, token string) ([]*dto.AP, int, e.Error) {
+func (a *Account) GetList(c ctx.Context, page, size int) ([]*dto.AP, int, e.Error) {
 	o, err := a.user.Getc(c)
 	if err != nil {
 		return nil, 0, err
@@ -40,7 +47,7 @@ func (a *Account) GetList(c ctx.Context, page, size int, token string) ([]*dto.A
 	result := make([]*dto.AP, 0)
 
 	for _, acc := range res {
-		dd, err := a.adm.GetUser(c, acc.Id, token)
+		dd, err := a.adm.GetUser(c, acc.Id)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -62,6 +69,12 @@ func (a *Account) GetByEmail(c ctx.Context, email string) (*entity.User, e.Error
 	return a.user.GetByEmail(c, email)
 }
 
+// GetBatch returns users by ids in one query, so other services needn`t
+// request them one by one. Unknown ids are skipped.
+func (a *Account) GetBatch(c ctx.Context, ids []string) ([]*entity.User, e.Error) {
+	return a.user.GetByIds(c, slices.Compact(slices.Sorted(slices.Values(ids))))
+}
+
 func (a *Account) Get(ctx ctx.Context, userId string) (*entity.User, e.Error) {
 	user, err := a.user.GetById(ctx, userId)
 	if err != nil {
@@ -71,7 +84,15 @@ func (a *Account) Get(ctx ctx.Context, userId string) (*entity.User, e.Error) {
 	return user, nil
 }
 
-func (a *Account) Create(ctx ctx.Context, user *entity.User) (*entity.Tokens, e.Error) {
+func (a *Account) Create(ctx ctx.Context, user *entity.User, client *entity.Session) (*entity.Tokens, e.Error) {
+	if err := a.security.LimitIp(ctx, types.SIGNUP, client.Ip); err != nil {
+		return nil, err
+	}
+
+	if err := a.security.LimitAccount(ctx, types.SIGNUP, user.Email); err != nil {
+		return nil, err
+	}
+
 	candidate, err := a.user.GetByEmail(ctx, user.Email)
 	if err != nil && err.GetCode() != e.NotFound {
 		return nil, err
@@ -95,7 +116,12 @@ func (a *Account) Create(ctx ctx.Context, user *entity.User) (*entity.Tokens, e.
 
 	user.Role = defaultRole
 
-	return a.getTokens(user)
+	// User is already created, so they can request code again if mail failed.
+	if err := a.sendCode(ctx, types.VERIFICATION, user); err != nil {
+		ctx.Logger().Warn("Can`t send verification code", err.SlErr())
+	}
+
+	return a.session.Issue(ctx, user, client)
 }
 
 func (a *Account) Update(ctx ctx.Context, user *entity.User, pass string) (*entity.User, e.Error) {
@@ -135,11 +161,19 @@ func (a *Account) Update(ctx ctx.Context, user *entity.User, pass string) (*enti
 		user.Password = hash
 	}
 
+	emailChanged := user.Email != ""
+
 	user, err := a.user.Update(ctx, user)
 	if err != nil {
 		return nil, err
 	}
 
+	if emailChanged {
+		if err := a.sendCode(ctx, types.VERIFICATION, user); err != nil {
+			ctx.Logger().Warn("Can`t send verification code", err.SlErr())
+		}
+	}
+
 	return user, nil
 }
 
@@ -159,22 +193,3 @@ func (a *Account) Delete(ctx ctx.Context, user *entity.User) e.Error {
 
 	return a.user.Delete(ctx, user)
 }
-
-func (a *Account) getTokens(user *entity.User) (*entity.Tokens, e.Error) {
-	var tokens entity.Tokens
-
-	access, err := a.jwt.GenerateToken(user, false)
-	if err != nil {
-		return nil, err
-	}
-
-	refresh, err := a.jwt.GenerateToken(user, true)
-	if err != nil {
-		return nil, err
-	}
-
-	tokens.Access = access
-	tokens.Refresh = refresh
-
-	return &tokens, nil
-}
@@ -0,0 +1,157 @@
+package account
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"math/big"
+
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+// SendVerification sends new code confirming email of user.
+func (a *Account) SendVerification(c ctx.Context, userId string) e.Error {
+	user, err := a.user.GetById(c, userId)
+	if err != nil {
+		return err
+	}
+
+	if user.Verified {
+		return verifiedErr
+	}
+
+	return a.sendCode(c, types.VERIFICATION, user)
+}
+
+func (a *Account) Verify(c ctx.Context, userId, code string) e.Error {
+	user, err := a.user.GetById(c, userId)
+	if err != nil {
+		return err
+	}
+
+	if user.Verified {
+		return verifiedErr
+	}
+
+	if err := a.checkCode(c, types.VERIFICATION, user, code); err != nil {
+		return err
+	}
+
+	user.Verified = true
+
+	return a.user.Verify(c, user)
+}
+
+// ForgotPassword sends password reset code. Unknown email and rate limit aren't
+// reported, so the answer doesn't tell whether user exists.
+func (a *Account) ForgotPassword(c ctx.Context, email string) e.Error {
+	user, err := a.user.GetByEmail(c, email)
+	if err != nil {
+		if err.GetCode() == e.NotFound {
+			return nil
+		}
+
+		return err
+	}
+
+	if err := a.sendCode(c, types.PASSWORD_RESET, user); err != nil {
+		if err.GetCode() == types.TooManyRequests {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// ResetPassword sets new password and logs user out of every device. Code was
+// sent to email of user, so the email is verified too.
+func (a *Account) ResetPassword(c ctx.Context, email, code, password string) e.Error {
+	user, err := a.user.GetByEmail(c, email)
+	if err != nil {
+		if err.GetCode() == e.NotFound {
+			return codeErr.WithErr(err)
+		}
+
+		return err
+	}
+
+	if err := a.checkCode(c, types.PASSWORD_RESET, user, code); err != nil {
+		return err
+	}
+
+	hash, hashErr := a.coder.Hash(password)
+	if hashErr != nil {
+		return e.InternalErr.WithErr(hashErr)
+	}
+
+	if _, err := a.user.Update(c, &entity.User{Id: user.Id, Password: hash}); err != nil {
+		return err
+	}
+
+	if !user.Verified {
+		user.Verified = true
+
+		if err := a.user.Verify(c, user); err != nil {
+			return err
+		}
+	}
+
+	return a.session.RevokeAll(c, user.Id)
+}
+
+func (a *Account) sendCode(c ctx.Context, codeType types.CodeType, user *entity.User) e.Error {
+	if err := a.code.Throttle(c, codeType, user.Id); err != nil {
+		return err
+	}
+
+	code := &entity.ActivationCode{
+		Code:   newCode(),
+		UserId: user.Id,
+		Type:   codeType,
+		Email:  user.Email,
+	}
+
+	if err := a.code.Set(c, code); err != nil {
+		return err
+	}
+
+	if codeType == types.PASSWORD_RESET {
+		return a.mail.SendPasswordReset(c, user.Email, code.Code)
+	}
+
+	return a.mail.SendActivation(c, user.Email, code.Code)
+}
+
+// checkCode compares code with the one sent to current email of user and
+// deletes it, so every code is used once.
+func (a *Account) checkCode(c ctx.Context, codeType types.CodeType, user *entity.User, code string) e.Error {
+	if err := a.code.Attempt(c, codeType, user.Id); err != nil {
+		return err
+	}
+
+	saved, err := a.code.Get(c, codeType, user.Id)
+	if err != nil {
+		if err.GetCode() == e.NotFound {
+			return codeErr.WithErr(err)
+		}
+
+		return err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(saved.Code), []byte(code)) != 1 || saved.Email != user.Email {
+		return codeErr
+	}
+
+	return a.code.Del(c, codeType, user.Id)
+}
+
+func newCode() string {
+	n, _ := rand.Int(rand.Reader, big.NewInt(1_000_000))
+
+	return fmt.Sprintf("%06d", n.Int64())
+}
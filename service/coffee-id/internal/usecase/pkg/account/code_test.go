@@ -0,0 +1,167 @@
+package account
+
+import (
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-playground/assert/v2"
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	"github.com/nikitaSstepanov/coffee-id/internal/usecase/storage/activation_code"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/nikitaSstepanov/tools/sl"
+	"github.com/nikitaSstepanov/tools/utils/coder"
+	"github.com/redis/go-redis/v9"
+)
+
+const testEmail = "user@coffee.id"
+
+func TestVerify(t *testing.T) {
+	account, users, mail, _ := setupAccount(t)
+	ctx := ctx.New(sl.Default())
+
+	if err := account.SendVerification(ctx, "user"); err != nil {
+		t.Fatalf("Can`t send code: %v", err)
+	}
+
+	// Codes are throttled.
+	err := account.SendVerification(ctx, "user")
+	assert.Equal(t, err.GetCode(), types.TooManyRequests)
+
+	err = account.Verify(ctx, "user", "wrong")
+	assert.Equal(t, err.GetCode(), e.BadInput)
+
+	if err := account.Verify(ctx, "user", mail.codes[testEmail]); err != nil {
+		t.Fatalf("Can`t verify email: %v", err)
+	}
+
+	assert.Equal(t, users.user.Verified, true)
+
+	err = account.Verify(ctx, "user", mail.codes[testEmail])
+	assert.Equal(t, err.GetCode(), e.Conflict)
+}
+
+func TestResetPassword(t *testing.T) {
+	account, users, mail, sessions := setupAccount(t)
+	ctx := ctx.New(sl.Default())
+
+	// Unknown email isn`t reported.
+	if err := account.ForgotPassword(ctx, "unknown@coffee.id"); err != nil {
+		t.Fatalf("Test failing: %v", err)
+	}
+
+	if err := account.ForgotPassword(ctx, testEmail); err != nil {
+		t.Fatalf("Can`t send code: %v", err)
+	}
+
+	code := mail.codes[testEmail]
+
+	if err := account.ResetPassword(ctx, testEmail, code, "NewPass1!"); err != nil {
+		t.Fatalf("Can`t reset password: %v", err)
+	}
+
+	if err := account.coder.CompareHash(users.user.Password, "NewPass1!"); err != nil {
+		t.Errorf("Password wasn`t changed: %v", err)
+	}
+
+	assert.Equal(t, sessions.revoked, "user")
+
+	// Code is used once.
+	err := account.ResetPassword(ctx, testEmail, code, "OtherPass1!")
+	assert.Equal(t, err.GetCode(), e.BadInput)
+}
+
+func setupAccount(t *testing.T) (*Account, *userStorage, *mailSender, *sessionUseCase) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("Can`t run redis: %v", err)
+	}
+	t.Cleanup(mr.Close)
+
+	rs := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	users := &userStorage{user: &entity.User{Id: "user", Email: testEmail}}
+	mail := &mailSender{codes: make(map[string]string)}
+	sessions := &sessionUseCase{}
+
+	account := New(
+		&Storages{
+			User: users,
+			Code: activation_code.New(*rs),
+		},
+		&UseCases{
+			Session: sessions,
+			Mail:    mail,
+			Coder:   coder.New(&coder.Config{HashCost: 4}),
+		},
+	)
+
+	return account, users, mail, sessions
+}
+
+type mailSender struct {
+	codes map[string]string
+}
+
+func (m *mailSender) SendActivation(c ctx.Context, to string, code string) e.Error {
+	m.codes[to] = code
+	return nil
+}
+
+func (m *mailSender) SendPasswordReset(c ctx.Context, to string, code string) e.Error {
+	m.codes[to] = code
+	return nil
+}
+
+type sessionUseCase struct {
+	revoked string
+}
+
+func (s *sessionUseCase) Issue(c ctx.Context, user *entity.User, client *entity.Session) (*entity.Tokens, e.Error) {
+	return &entity.Tokens{}, nil
+}
+
+func (s *sessionUseCase) RevokeAll(c ctx.Context, userId string) e.Error {
+	s.revoked = userId
+	return nil
+}
+
+// userStorage keeps the only user.
+type userStorage struct {
+	UserStorage
+	user *entity.User
+}
+
+func (s *userStorage) GetById(c ctx.Context, id string) (*entity.User, e.Error) {
+	if id != s.user.Id {
+		return nil, e.New("This user wasn`t found.", e.NotFound)
+	}
+
+	user := *s.user
+
+	return &user, nil
+}
+
+func (s *userStorage) GetByEmail(c ctx.Context, email string) (*entity.User, e.Error) {
+	if email != s.user.Email {
+		return nil, e.New("This user wasn`t found.", e.NotFound)
+	}
+
+	user := *s.user
+
+	return &user, nil
+}
+
+func (s *userStorage) Update(c ctx.Context, user *entity.User) (*entity.User, e.Error) {
+	if user.Password != "" {
+		s.user.Password = user.Password
+	}
+
+	return s.GetById(c, user.Id)
+}
+
+func (s *userStorage) Verify(c ctx.Context, user *entity.User) e.Error {
+	s.user.Verified = user.Verified
+	return nil
+}
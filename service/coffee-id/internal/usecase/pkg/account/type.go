@@ -2,6 +2,7 @@ package account
 
 import (
 	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
 	"github.com/nikitaSstepanov/coffee-id/internal/usecase/admin"
 	"github.com/nikitaSstepanov/tools/ctx"
 	e "github.com/nikitaSstepanov/tools/error"
@@ -9,9 +10,10 @@ import (
 )
 
 type UseCases struct {
-	Jwt   JwtUseCase
-	Mail  MailUseCase
-	Coder *coder.Coder
+	Session  SessionUseCase
+	Security SecurityUseCase
+	Mail     MailUseCase
+	Coder    *coder.Coder
 	Admin
 }
 
@@ -20,12 +22,19 @@ type Storages struct {
 	Code CodeStorage
 }
 
-type JwtUseCase interface {
-	GenerateToken(user *entity.User, isRefresh bool) (string, e.Error)
+type SessionUseCase interface {
+	Issue(c ctx.Context, user *entity.User, client *entity.Session) (*entity.Tokens, e.Error)
+	RevokeAll(c ctx.Context, userId string) e.Error
+}
+
+type SecurityUseCase interface {
+	LimitIp(c ctx.Context, action types.Action, ip string) e.Error
+	LimitAccount(c ctx.Context, action types.Action, account string) e.Error
 }
 
 type MailUseCase interface {
 	SendActivation(c ctx.Context, to string, code string) e.Error
+	SendPasswordReset(c ctx.Context, to string, code string) e.Error
 }
 
 type UserStorage interface {
@@ -33,6 +42,7 @@ type UserStorage interface {
 	Getc(c ctx.Context) ([]*entity.User, e.Error)
 	GetById(c ctx.Context, id string) (*entity.User, e.Error)
 	GetByEmail(c ctx.Context, email string) (*entity.User, e.Error)
+	GetByIds(c ctx.Context, ids []string) ([]*entity.User, e.Error)
 	Create(c ctx.Context, user *entity.User) e.Error
 	Update(ctx ctx.Context, user *entity.User) (*entity.User, e.Error)
 	AddRole(c ctx.Context, user *entity.User) e.Error
@@ -41,15 +51,13 @@ type UserStorage interface {
 }
 
 type CodeStorage interface {
-	Get(c ctx.Context, userId uint64) (*entity.ActivationCode, e.Error)
+	Get(c ctx.Context, codeType types.CodeType, userId string) (*entity.ActivationCode, e.Error)
 	Set(c ctx.Context, code *entity.ActivationCode) e.Error
-	Del(c ctx.Context, userId uint64) e.Error
-}
-
-type ClientStorage interface {
-	GetById(c ctx.Context, id uint64) (*entity.Client, e.Error)
+	Del(c ctx.Context, codeType types.CodeType, userId string) e.Error
+	Attempt(c ctx.Context, codeType types.CodeType, userId string) e.Error
+	Throttle(c ctx.Context, codeType types.CodeType, userId string) e.Error
 }
 
 type Admin interface {
-	GetUser(c ctx.Context, email string, tokem string) (*admin.User, e.Error)
+	GetUser(c ctx.Context, id string) (*admin.User, e.Error)
 }
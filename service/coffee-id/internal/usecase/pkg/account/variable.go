@@ -8,6 +8,8 @@ import (
 var (
 	conflictErr = e.New("User with this email already exist", e.Conflict)
 	badPassErr  = e.New("Incorrect password", e.Forbidden)
+	verifiedErr = e.New("Email is already verified.", e.Conflict)
+	codeErr     = e.New("Code is incorrect or expired.", e.BadInput)
 
 	defaultRole = types.USER
 )
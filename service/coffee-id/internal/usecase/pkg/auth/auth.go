@@ -1,7 +1,12 @@
 package auth
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
 	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
 	"github.com/nikitaSstepanov/tools/ctx"
 	e "github.com/nikitaSstepanov/tools/error"
 	"github.com/nikitaSstepanov/tools/utils/coder"
@@ -9,8 +14,12 @@ import (
 
 type Auth struct {
 	user      UserStorage
-	oauth     OAuthStorage
-	yndxOAuth YandexOAuth
+	perm      PermissionStorage
+	session   SessionStorage
+	identity  IdentityStorage
+	twoFactor TwoFactorStorage
+	providers map[string]Provider
+	security  SecurityUseCase
 	coder     *coder.Coder
 	Jwt       *Jwt
 }
@@ -18,64 +27,222 @@ type Auth struct {
 func New(store *Storages, uc *UseCases) *Auth {
 	return &Auth{
 		user:      store.User,
-		yndxOAuth: uc.YandexOAuth,
-		oauth:     store.OAuth,
+		perm:      store.Permission,
+		session:   store.Session,
+		identity:  store.Identity,
+		twoFactor: store.TwoFactor,
+		providers: uc.Providers,
+		security:  uc.Security,
 		coder:     uc.Coder,
 		Jwt:       uc.Jwt,
 	}
 }
 
-func (a *Auth) Login(c ctx.Context, user *entity.User) (*entity.Tokens, *entity.User, e.Error) {
+// Login checks password of user. If user has to pass the second factor, challenge
+// is returned instead of tokens. Failed logins delay next ones and lock account.
+func (a *Auth) Login(c ctx.Context, user *entity.User, client *entity.Session) (*entity.Tokens, *entity.User, *entity.LoginChallenge, e.Error) {
+	if err := a.security.LimitIp(c, types.LOGIN, client.Ip); err != nil {
+		return nil, nil, nil, err
+	}
+
+	if err := a.security.LimitAccount(c, types.LOGIN, user.Email); err != nil {
+		return nil, nil, nil, err
+	}
+
 	candidate, err := a.user.GetByEmail(c, user.Email)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	if candidate.Id == "" {
-		return nil, nil, badDataErr
+		return nil, nil, nil, badDataErr
 	}
 
 	if candidate.OAuth != "" {
-		return nil, nil, notFoundErr
+		return nil, nil, nil, notFoundErr
+	}
+
+	if err := a.security.Check(c, candidate.Id); err != nil {
+		return nil, nil, nil, err
 	}
 
 	if err := a.coder.CompareHash(candidate.Password, user.Password); err != nil {
-		return nil, nil, badDataErr.WithErr(err)
+		if err := a.security.Fail(c, candidate.Id, client.Ip); err != nil {
+			return nil, nil, nil, err
+		}
+
+		return nil, nil, nil, badDataErr.WithErr(err)
+	}
+
+	if err := a.security.Succeed(c, candidate.Id); err != nil {
+		return nil, nil, nil, err
 	}
 
-	tokens, err := a.getTokens(candidate)
+	tokens, challenge, err := a.signIn(c, candidate, client)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
-	return tokens, candidate, nil
+	return tokens, candidate, challenge, nil
 }
 
-func (a *Auth) Refresh(ctx ctx.Context, refresh string) (*entity.Tokens, e.Error) {
+// Issue starts new session of user on client device.
+func (a *Auth) Issue(c ctx.Context, user *entity.User, client *entity.Session) (*entity.Tokens, e.Error) {
+	now := time.Now().UTC()
+
+	session := &entity.Session{
+		Id:         newId(),
+		UserId:     user.Id,
+		TokenId:    newId(),
+		Device:     client.Device,
+		Ip:         client.Ip,
+		CreatedAt:  now,
+		LastUsedAt: now,
+		ExpiresAt:  now.Add(refreshExpires),
+	}
+
+	if err := a.session.Create(c, session); err != nil {
+		return nil, err
+	}
+
+	return a.getTokens(c, user, session)
+}
+
+// Refresh exchanges refresh token for new pair. Every refresh token may be used once:
+// reuse of rotated token means it was leaked, so the whole session is revoked.
+func (a *Auth) Refresh(c ctx.Context, refresh string, client *entity.Session) (*entity.Tokens, e.Error) {
+	if err := a.security.LimitIp(c, types.REFRESH, client.Ip); err != nil {
+		return nil, err
+	}
+
 	claims, err := a.Jwt.ValidateToken(refresh, true)
 	if err != nil {
 		return nil, err
 	}
 
-	user, err := a.user.GetById(ctx, claims.Id)
+	if err := a.security.LimitAccount(c, types.REFRESH, claims.Id); err != nil {
+		return nil, err
+	}
+
+	session, err := a.getSession(c, claims.Id, claims.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := a.user.GetById(c, claims.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	session.TokenId = newId()
+	session.Device = client.Device
+	session.Ip = client.Ip
+	session.LastUsedAt = now
+	session.ExpiresAt = now.Add(refreshExpires)
+
+	if err := a.session.Rotate(c, session, claims.ID); err != nil {
+		if err.GetCode() != e.Conflict {
+			return nil, err
+		}
+
+		if err := a.session.Delete(c, session); err != nil {
+			return nil, err
+		}
+
+		return nil, reuseErr.WithErr(err)
+	}
+
+	return a.getTokens(c, user, session)
+}
+
+// ValidateToken validates access token and checks that its session isn't revoked.
+func (a *Auth) ValidateToken(c ctx.Context, jwtString string) (*Claims, e.Error) {
+	claims, err := a.Jwt.ValidateToken(jwtString, false)
 	if err != nil {
 		return nil, err
 	}
 
-	return a.getTokens(user)
+	if _, err := a.getSession(c, claims.Id, claims.SessionId); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// ValidateServiceToken validates token of internal service.
+func (a *Auth) ValidateServiceToken(c ctx.Context, jwtString string) (*ServiceClaims, e.Error) {
+	return a.Jwt.ValidateServiceToken(jwtString)
+}
+
+func (a *Auth) Logout(c ctx.Context, userId, sessionId string) e.Error {
+	return a.RevokeSession(c, userId, sessionId)
 }
 
-func (a *Auth) ValidateToken(jwtString string, isRefresh bool) (*Claims, e.Error) {
-	return a.Jwt.ValidateToken(jwtString, isRefresh)
+func (a *Auth) GetSessions(c ctx.Context, userId string) ([]*entity.Session, e.Error) {
+	return a.session.GetForUser(c, userId)
 }
 
-func (a *Auth) getTokens(user *entity.User) (*entity.Tokens, e.Error) {
-	access, err := a.Jwt.GenerateToken(user, false)
+func (a *Auth) RevokeSession(c ctx.Context, userId, sessionId string) e.Error {
+	session, err := a.session.Get(c, sessionId)
 	if err != nil {
+		return err
+	}
+
+	if session.UserId != userId {
+		return sessionNotFoundErr
+	}
+
+	return a.session.Delete(c, session)
+}
+
+// RevokeAll logs user out of every device.
+func (a *Auth) RevokeAll(c ctx.Context, userId string) e.Error {
+	if _, err := a.user.GetById(c, userId); err != nil {
+		return err
+	}
+
+	return a.session.DeleteForUser(c, userId)
+}
+
+func (a *Auth) PublicKeys() []*entity.PublicKey {
+	return a.Jwt.PublicKeys()
+}
+
+func (a *Auth) getSession(c ctx.Context, userId, sessionId string) (*entity.Session, e.Error) {
+	if sessionId == "" {
+		return nil, unauthErr
+	}
+
+	session, err := a.session.Get(c, sessionId)
+	if err != nil {
+		if err.GetCode() == e.NotFound {
+			return nil, unauthErr.WithErr(err)
+		}
+
 		return nil, err
 	}
 
-	refresh, err := a.Jwt.GenerateToken(user, true)
+	if session.UserId != userId {
+		return nil, unauthErr
+	}
+
+	return session, nil
+}
+
+func (a *Auth) getTokens(c ctx.Context, user *entity.User, session *entity.Session) (*entity.Tokens, e.Error) {
+	permissions, err := a.perm.GetForRole(c, user.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := a.Jwt.GenerateToken(user, session, permissions, false)
+	if err != nil {
+		return nil, err
+	}
+
+	refresh, err := a.Jwt.GenerateToken(user, session, nil, true)
 	if err != nil {
 		return nil, err
 	}
@@ -85,3 +252,10 @@ func (a *Auth) getTokens(user *entity.User) (*entity.Tokens, e.Error) {
 		Refresh: refresh,
 	}, nil
 }
+
+func newId() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
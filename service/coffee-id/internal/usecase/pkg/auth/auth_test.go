@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/go-playground/assert/v2"
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/nikitaSstepanov/tools/sl"
+)
+
+type rolePermissions map[types.Role][]types.Permission
+
+func (rp rolePermissions) GetForRole(c ctx.Context, role types.Role) ([]types.Permission, e.Error) {
+	return rp[role], nil
+}
+
+func TestTokenPermissions(t *testing.T) {
+	a := New(
+		&Storages{Permission: rolePermissions{
+			types.SUPPORT: {types.BOOKING_READ_ANY, types.BOOKING_VERIFY},
+			types.ADMIN:   {types.BOOKING_READ_ANY, types.LAYOUT_EDIT},
+		}},
+		&UseCases{Jwt: newTestJwt(t, "", "")},
+	)
+
+	cases := []struct {
+		role    types.Role
+		allowed []types.Permission
+		denied  []types.Permission
+	}{
+		{types.USER, nil, []types.Permission{types.BOOKING_READ_ANY, types.LAYOUT_EDIT}},
+		{types.SUPPORT, []types.Permission{types.BOOKING_READ_ANY, types.BOOKING_VERIFY}, []types.Permission{types.LAYOUT_EDIT}},
+		{types.ADMIN, []types.Permission{types.BOOKING_READ_ANY, types.LAYOUT_EDIT}, []types.Permission{types.BOOKING_VERIFY}},
+	}
+
+	for _, tc := range cases {
+		user := &entity.User{Id: "id", Role: tc.role}
+		session := &entity.Session{Id: "sid", TokenId: "jti"}
+
+		tokens, err := a.getTokens(ctx.New(sl.Default()), user, session)
+		if err != nil {
+			t.Fatalf("Can`t issue tokens: %v", err)
+		}
+
+		claims, err := a.Jwt.ValidateToken(tokens.Access, false)
+		if err != nil {
+			t.Fatalf("Can`t validate token: %v", err)
+		}
+
+		for _, permission := range tc.allowed {
+			assert.Equal(t, claims.Can(permission), true)
+		}
+		for _, permission := range tc.denied {
+			assert.Equal(t, claims.Can(permission), false)
+		}
+
+		// Refresh token grants nothing, permissions are read again on refresh.
+		refresh, err := a.Jwt.ValidateToken(tokens.Refresh, true)
+		if err != nil {
+			t.Fatalf("Can`t validate refresh token: %v", err)
+		}
+
+		assert.Equal(t, len(refresh.Permissions), 0)
+	}
+}
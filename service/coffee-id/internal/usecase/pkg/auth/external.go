@@ -0,0 +1,236 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"slices"
+
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+// Providers returns names of configured external identity providers.
+func (a *Auth) Providers() []string {
+	names := make([]string, 0, len(a.providers))
+
+	for name := range a.providers {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// ExternalUrl starts login with external provider and returns url of its login page.
+// If userId isn't empty, provider is linked to this user on callback.
+func (a *Auth) ExternalUrl(c ctx.Context, name, userId string) (string, e.Error) {
+	provider, err := a.provider(name)
+	if err != nil {
+		return "", err
+	}
+
+	state := &entity.ExternalState{
+		State:    randomString(16),
+		Provider: name,
+		Verifier: randomString(32),
+		Nonce:    randomString(16),
+		UserId:   userId,
+	}
+
+	uri, err := provider.AuthUrl(c, state.State, state.Nonce, challenge(state.Verifier))
+	if err != nil {
+		return "", err
+	}
+
+	if err := a.identity.SetState(c, state); err != nil {
+		return "", err
+	}
+
+	return uri, nil
+}
+
+// ExternalCallback finishes login with external provider. Known identity signs its
+// user in. New identity is linked to user who started linking, or to user with the
+// same email if both sides verified it, otherwise new user is created. The second
+// factor is required as on login with password.
+func (a *Auth) ExternalCallback(c ctx.Context, name, code, stateId string, client *entity.Session) (*entity.Tokens, *entity.User, *entity.LoginChallenge, e.Error) {
+	provider, err := a.provider(name)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	state, err := a.identity.TakeState(c, stateId)
+	if err != nil {
+		if err.GetCode() == e.NotFound {
+			return nil, nil, nil, stateErr.WithErr(err)
+		}
+
+		return nil, nil, nil, err
+	}
+
+	if state.Provider != name {
+		return nil, nil, nil, stateErr
+	}
+
+	external, err := provider.Exchange(c, code, state.Verifier, state.Nonce)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	user, err := a.externalUser(c, name, provider, state, external)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	tokens, challenge, err := a.signIn(c, user, client)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return tokens, user, challenge, nil
+}
+
+func (a *Auth) GetIdentities(c ctx.Context, userId string) ([]*entity.Identity, e.Error) {
+	return a.identity.GetForUser(c, userId)
+}
+
+// Unlink removes identity of provider from user. User created by provider keeps
+// at least one identity, because they have no password to sign in with.
+func (a *Auth) Unlink(c ctx.Context, userId, name string) e.Error {
+	user, err := a.user.GetById(c, userId)
+	if err != nil {
+		return err
+	}
+
+	identities, err := a.identity.GetForUser(c, userId)
+	if err != nil {
+		return err
+	}
+
+	index := slices.IndexFunc(identities, func(identity *entity.Identity) bool {
+		return identity.Provider == name
+	})
+	if index == -1 {
+		return identityNotFoundErr
+	}
+
+	if user.OAuth != "" && len(identities) == 1 {
+		return lastIdentityErr
+	}
+
+	return a.identity.Delete(c, name, userId)
+}
+
+func (a *Auth) externalUser(c ctx.Context, name string, provider Provider, state *entity.ExternalState, external *entity.ExternalUser) (*entity.User, e.Error) {
+	identity, err := a.identity.Get(c, name, external.Subject)
+	if err != nil && err.GetCode() != e.NotFound {
+		return nil, err
+	}
+
+	if identity != nil {
+		if state.UserId != "" && state.UserId != identity.UserId {
+			return nil, linkedErr
+		}
+
+		return a.user.GetById(c, identity.UserId)
+	}
+
+	if state.UserId != "" {
+		user, err := a.user.GetById(c, state.UserId)
+		if err != nil {
+			return nil, err
+		}
+
+		return user, a.link(c, name, user, external)
+	}
+
+	if external.Email == "" {
+		return nil, noEmailErr
+	}
+
+	candidate, err := a.user.GetByEmail(c, external.Email)
+	if err != nil && err.GetCode() != e.NotFound {
+		return nil, err
+	}
+
+	if candidate != nil {
+		// Otherwise anyone could take account by registering its email first.
+		if !external.EmailVerified || !candidate.Verified {
+			return nil, emailTakenErr
+		}
+
+		return candidate, a.link(c, name, candidate, external)
+	}
+
+	hash, hashErr := a.coder.Hash(randomString(32))
+	if hashErr != nil {
+		return nil, e.InternalErr.WithErr(hashErr)
+	}
+
+	user := &entity.User{
+		Email:    external.Email,
+		Name:     external.Name,
+		Password: hash,
+		Birthday: external.Birthday,
+		OAuth:    provider.Type(),
+	}
+
+	if err := a.user.Create(c, user); err != nil {
+		return nil, err
+	}
+
+	if external.EmailVerified {
+		user.Verified = true
+
+		if err := a.user.Verify(c, user); err != nil {
+			return nil, err
+		}
+	}
+
+	return user, a.link(c, name, user, external)
+}
+
+func (a *Auth) link(c ctx.Context, name string, user *entity.User, external *entity.ExternalUser) e.Error {
+	identities, err := a.identity.GetForUser(c, user.Id)
+	if err != nil {
+		return err
+	}
+
+	for _, identity := range identities {
+		if identity.Provider == name {
+			return alreadyLinkedErr
+		}
+	}
+
+	return a.identity.Create(c, &entity.Identity{
+		Provider: name,
+		Subject:  external.Subject,
+		UserId:   user.Id,
+		Email:    external.Email,
+	})
+}
+
+func (a *Auth) provider(name string) (Provider, e.Error) {
+	provider, ok := a.providers[name]
+	if !ok {
+		return nil, providerNotFoundErr
+	}
+
+	return provider, nil
+}
+
+func challenge(verifier string) string {
+	hash := sha256.Sum256([]byte(verifier))
+
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+func randomString(size int) string {
+	b := make([]byte, size)
+	rand.Read(b)
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,284 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-playground/assert/v2"
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	"github.com/nikitaSstepanov/coffee-id/internal/usecase/pkg/security"
+	securityStorage "github.com/nikitaSstepanov/coffee-id/internal/usecase/storage/security"
+	"github.com/nikitaSstepanov/coffee-id/internal/usecase/storage/session"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/nikitaSstepanov/tools/sl"
+	"github.com/nikitaSstepanov/tools/utils/coder"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestExternalCallback(t *testing.T) {
+	tests := []struct {
+		TestName    string
+		External    *entity.ExternalUser
+		Existing    *entity.User
+		LinkUserId  string
+		IsError     bool
+		ErrorStatus e.StatusType
+		UserId      string
+	}{
+		{
+			TestName: "New user",
+			External: &entity.ExternalUser{Subject: "1", Email: "new@example.com", EmailVerified: true},
+			IsError:  false,
+		},
+		{
+			TestName: "Link by verified email",
+			External: &entity.ExternalUser{Subject: "2", Email: "user@example.com", EmailVerified: true},
+			Existing: &entity.User{Id: "user", Email: "user@example.com", Verified: true},
+			IsError:  false,
+			UserId:   "user",
+		},
+		{
+			TestName:    "Email isn`t verified by provider",
+			External:    &entity.ExternalUser{Subject: "3", Email: "user@example.com"},
+			Existing:    &entity.User{Id: "user", Email: "user@example.com", Verified: true},
+			IsError:     true,
+			ErrorStatus: e.Conflict,
+		},
+		{
+			TestName:    "Email isn`t verified by user",
+			External:    &entity.ExternalUser{Subject: "4", Email: "user@example.com", EmailVerified: true},
+			Existing:    &entity.User{Id: "user", Email: "user@example.com"},
+			IsError:     true,
+			ErrorStatus: e.Conflict,
+		},
+		{
+			TestName:   "Link to signed in user",
+			External:   &entity.ExternalUser{Subject: "5", Email: "other@example.com"},
+			Existing:   &entity.User{Id: "user", Email: "user@example.com"},
+			LinkUserId: "user",
+			IsError:    false,
+			UserId:     "user",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.TestName, func(t *testing.T) {
+			auth, users, identities := setupExternal(t, tc.External)
+			ctx := ctx.New(sl.Default())
+
+			if tc.Existing != nil {
+				users.users[tc.Existing.Email] = tc.Existing
+			}
+
+			state := login(t, auth, identities, tc.LinkUserId)
+
+			_, user, _, err := auth.ExternalCallback(ctx, "fake", "code", state, &entity.Session{})
+			if err != nil {
+				if tc.IsError {
+					assert.Equal(t, tc.ErrorStatus, err.GetCode())
+				} else {
+					t.Errorf("Test failing: %v", err)
+				}
+				return
+			} else if tc.IsError {
+				t.Fatal("Test failing: expected not nil error")
+			}
+
+			if tc.UserId != "" {
+				assert.Equal(t, user.Id, tc.UserId)
+			}
+
+			identity, err := identities.Get(ctx, "fake", tc.External.Subject)
+			if err != nil {
+				t.Fatalf("Identity wasn`t linked: %v", err)
+			}
+
+			assert.Equal(t, identity.UserId, user.Id)
+		})
+	}
+}
+
+func TestExternalState(t *testing.T) {
+	external := &entity.ExternalUser{Subject: "1", Email: "new@example.com", EmailVerified: true}
+
+	auth, users, identities := setupExternal(t, external)
+	ctx := ctx.New(sl.Default())
+
+	state := login(t, auth, identities, "")
+
+	_, user, _, err := auth.ExternalCallback(ctx, "fake", "code", state, &entity.Session{})
+	if err != nil {
+		t.Fatalf("Callback failed: %v", err)
+	}
+
+	assert.Equal(t, user.OAuth, types.OIDC)
+	assert.Equal(t, users.users["new@example.com"].Verified, true)
+
+	// State is used once.
+	_, _, _, err = auth.ExternalCallback(ctx, "fake", "code", state, &entity.Session{})
+	assert.Equal(t, err.GetCode(), e.BadInput)
+
+	// The only provider of user without password can`t be unlinked.
+	err = auth.Unlink(ctx, user.Id, "fake")
+	assert.Equal(t, err.GetCode(), e.Conflict)
+}
+
+func setupExternal(t *testing.T, external *entity.ExternalUser) (*Auth, *userStorage, *identityStorage) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("Can`t run redis: %v", err)
+	}
+	t.Cleanup(mr.Close)
+
+	rs := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	users := &userStorage{users: make(map[string]*entity.User)}
+	identities := &identityStorage{states: make(map[string]*entity.ExternalState)}
+
+	auth := New(
+		&Storages{
+			User:       users,
+			Permission: &permStorage{},
+			Session:    session.New(*rs),
+			Identity:   identities,
+			TwoFactor:  newTwoFactorStorage(),
+		},
+		&UseCases{
+			Providers: map[string]Provider{"fake": &fakeProvider{user: external}},
+			Security:  security.New(&security.Storages{Security: securityStorage.New(nil, *rs)}),
+			Jwt:       newTestJwt(t, "", ""),
+			Coder:     coder.New(&coder.Config{HashCost: 4}),
+		},
+	)
+
+	return auth, users, identities
+}
+
+// login starts login and returns its state as provider would pass it to callback.
+func login(t *testing.T, auth *Auth, identities *identityStorage, userId string) string {
+	if _, err := auth.ExternalUrl(ctx.New(sl.Default()), "fake", userId); err != nil {
+		t.Fatalf("Can`t start login: %v", err)
+	}
+
+	for state := range identities.states {
+		return state
+	}
+
+	t.Fatal("State wasn`t saved")
+
+	return ""
+}
+
+type fakeProvider struct {
+	user *entity.ExternalUser
+}
+
+func (p *fakeProvider) Type() types.OAuth {
+	return types.OIDC
+}
+
+func (p *fakeProvider) AuthUrl(c ctx.Context, state, nonce, challenge string) (string, e.Error) {
+	return "https://provider.example.com/authorize?state=" + state, nil
+}
+
+func (p *fakeProvider) Exchange(c ctx.Context, code, verifier, nonce string) (*entity.ExternalUser, e.Error) {
+	return p.user, nil
+}
+
+type userStorage struct {
+	users map[string]*entity.User
+}
+
+func (s *userStorage) GetById(c ctx.Context, id string) (*entity.User, e.Error) {
+	for _, user := range s.users {
+		if user.Id == id {
+			return user, nil
+		}
+	}
+
+	return nil, e.New("This user wasn`t found.", e.NotFound)
+}
+
+func (s *userStorage) GetByEmail(c ctx.Context, email string) (*entity.User, e.Error) {
+	if user, ok := s.users[email]; ok {
+		return user, nil
+	}
+
+	return nil, e.New("This user wasn`t found.", e.NotFound)
+}
+
+func (s *userStorage) Create(c ctx.Context, user *entity.User) e.Error {
+	user.Id = "created"
+	user.Role = types.USER
+	s.users[user.Email] = user
+
+	return nil
+}
+
+func (s *userStorage) Verify(c ctx.Context, user *entity.User) e.Error {
+	s.users[user.Email].Verified = user.Verified
+
+	return nil
+}
+
+type permStorage struct{}
+
+func (s *permStorage) GetForRole(c ctx.Context, role types.Role) ([]types.Permission, e.Error) {
+	return nil, nil
+}
+
+type identityStorage struct {
+	identities []*entity.Identity
+	states     map[string]*entity.ExternalState
+}
+
+func (s *identityStorage) Get(c ctx.Context, provider, subject string) (*entity.Identity, e.Error) {
+	for _, identity := range s.identities {
+		if identity.Provider == provider && identity.Subject == subject {
+			return identity, nil
+		}
+	}
+
+	return nil, e.New("This identity wasn`t found.", e.NotFound)
+}
+
+func (s *identityStorage) GetForUser(c ctx.Context, userId string) ([]*entity.Identity, e.Error) {
+	result := make([]*entity.Identity, 0)
+
+	for _, identity := range s.identities {
+		if identity.UserId == userId {
+			result = append(result, identity)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *identityStorage) Create(c ctx.Context, identity *entity.Identity) e.Error {
+	s.identities = append(s.identities, identity)
+
+	return nil
+}
+
+func (s *identityStorage) Delete(c ctx.Context, provider, userId string) e.Error {
+	return nil
+}
+
+func (s *identityStorage) SetState(c ctx.Context, state *entity.ExternalState) e.Error {
+	s.states[state.State] = state
+
+	return nil
+}
+
+func (s *identityStorage) TakeState(c ctx.Context, state string) (*entity.ExternalState, e.Error) {
+	result, ok := s.states[state]
+	if !ok {
+		return nil, e.New("Login request is expired or invalid.", e.NotFound)
+	}
+
+	delete(s.states, state)
+
+	return result, nil
+}
@@ -1,37 +1,62 @@
 package auth
 
 import (
+	"errors"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
 	e "github.com/nikitaSstepanov/tools/error"
 )
 
+var errNoAudience = errors.New("jwt audience is not set")
+
 type Jwt struct {
-	Audience   []string
-	issuer     string
-	accessKey  string
-	refreshKey string
+	Audience        []string
+	serviceAudience string
+	issuer          string
+	keys            *KeySet
 }
 
-func NewJwt(options *JwtOptions) *Jwt {
-	return &Jwt{
-		Audience:   options.Audience,
-		issuer:     options.Issuer,
-		accessKey:  options.AccessKey,
-		refreshKey: options.RefreshKey,
+// NewJwt fails without audience: access tokens would have no aud, and
+// refresh tokens, whose aud is the issuer, would pass as access tokens.
+func NewJwt(options *JwtOptions) (*Jwt, error) {
+	if len(options.Audience) == 0 {
+		return nil, errNoAudience
+	}
+
+	keys, err := NewKeySet(options.KeysDir, options.ActiveKid)
+	if err != nil {
+		return nil, err
 	}
+
+	return &Jwt{
+		Audience:        options.Audience,
+		serviceAudience: options.ServiceAudience,
+		issuer:          options.Issuer,
+		keys:            keys,
+	}, nil
 }
 
+// ValidateToken checks signature, algorithm, issuer, audience and expiration of token.
+// Refresh tokens are issued for Coffee ID itself, so they can't be used as access tokens.
 func (j *Jwt) ValidateToken(jwtString string, isRefresh bool) (*Claims, e.Error) {
-	keyFunc := j.funcAccess
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(j.keys.methods()),
+		jwt.WithIssuer(j.issuer),
+		jwt.WithExpirationRequired(),
+	}
 
 	if isRefresh {
-		keyFunc = j.funcRefresh
+		opts = append(opts, jwt.WithAudience(j.issuer))
+	} else {
+		opts = append(opts, jwt.WithAudience(j.Audience[0]))
 	}
 
-	token, err := jwt.ParseWithClaims(jwtString, &Claims{}, keyFunc)
+	token, err := jwt.ParseWithClaims(jwtString, &Claims{}, j.keys.keyFunc, opts...)
 	if err != nil {
 		return nil, unauthErr.WithErr(err)
 	}
@@ -43,32 +68,88 @@ func (j *Jwt) ValidateToken(jwtString string, isRefresh bool) (*Claims, e.Error)
 	return token.Claims.(*Claims), nil
 }
 
-func (j *Jwt) GenerateToken(user *entity.User, isRefresh bool) (string, e.Error) {
+// GenerateToken signs token of session. Permissions are embedded only into access tokens,
+// refresh tokens are issued with nil permissions and carry id of session's current token.
+func (j *Jwt) GenerateToken(user *entity.User, session *entity.Session, permissions []types.Permission, isRefresh bool) (string, e.Error) {
 	expires := accessExpires
+	audience := j.Audience
+	tokenId := ""
 
 	if isRefresh {
 		expires = refreshExpires
+		audience = []string{j.issuer}
+		tokenId = session.TokenId
 	}
 
 	c := Claims{
 		user.Id,
 		user.Role,
+		permissions,
+		session.Id,
 		jwt.RegisteredClaims{
+			ID:        tokenId,
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expires)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			Issuer:    j.issuer,
-			Audience:  j.Audience,
+			Audience:  audience,
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, c)
+	tokenString, err := j.keys.sign(c)
+	if err != nil {
+		return "", e.InternalErr.WithErr(err)
+	}
 
-	key := j.accessKey
+	return tokenString, nil
+}
 
-	if isRefresh {
-		key = j.refreshKey
+// ServiceToken signs token of internal service with space-delimited scope.
+func (j *Jwt) ServiceToken(clientId, scope string, expires time.Duration) (string, e.Error) {
+	now := time.Now()
+
+	return j.Sign(ServiceClaims{
+		ClientId: clientId,
+		Scope:    scope,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   clientId,
+			Audience:  []string{j.serviceAudience},
+			Issuer:    j.issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(expires)),
+		},
+	})
+}
+
+// ValidateServiceToken checks token of internal service. Tokens of users and OAuth
+// clients have other audiences, so they are rejected.
+func (j *Jwt) ValidateServiceToken(jwtString string) (*ServiceClaims, e.Error) {
+	if j.serviceAudience == "" {
+		return nil, unauthErr
 	}
 
-	tokenString, err := token.SignedString([]byte(key))
+	token, err := jwt.ParseWithClaims(
+		jwtString, &ServiceClaims{}, j.keys.keyFunc,
+		jwt.WithValidMethods(j.keys.methods()),
+		jwt.WithIssuer(j.issuer),
+		jwt.WithAudience(j.serviceAudience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil || !token.Valid {
+		return nil, unauthErr.WithErr(err)
+	}
+
+	claims := token.Claims.(*ServiceClaims)
+
+	if claims.ClientId == "" {
+		return nil, unauthErr
+	}
+
+	return claims, nil
+}
+
+// Sign signs arbitrary claims with active key. It is used for tokens issued to OAuth clients.
+func (j *Jwt) Sign(claims jwt.Claims) (string, e.Error) {
+	tokenString, err := j.keys.sign(claims)
 	if err != nil {
 		return "", e.InternalErr.WithErr(err)
 	}
@@ -76,10 +157,43 @@ func (j *Jwt) GenerateToken(user *entity.User, isRefresh bool) (string, e.Error)
 	return tokenString, nil
 }
 
-func (j *Jwt) funcAccess(token *jwt.Token) (interface{}, error) {
-	return []byte(j.accessKey), nil
+// Parse checks signature, algorithm, issuer and expiration of token and fills claims.
+// Audience is left to caller.
+func (j *Jwt) Parse(jwtString string, claims jwt.Claims) e.Error {
+	token, err := jwt.ParseWithClaims(
+		jwtString, claims, j.keys.keyFunc,
+		jwt.WithValidMethods(j.keys.methods()),
+		jwt.WithIssuer(j.issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil || !token.Valid {
+		return unauthErr.WithErr(err)
+	}
+
+	return nil
+}
+
+func (j *Jwt) Issuer() string {
+	return j.issuer
+}
+
+// Algorithms returns signing algorithms of all keys.
+func (j *Jwt) Algorithms() []string {
+	return j.keys.methods()
+}
+
+// PublicKeys returns keys for JWKS.
+func (j *Jwt) PublicKeys() []*entity.PublicKey {
+	return j.keys.PublicKeys()
+}
+
+// Allows reports whether service was granted scope.
+func (c *ServiceClaims) Allows(scope types.ServiceScope) bool {
+	return slices.Contains(strings.Fields(c.Scope), string(scope))
 }
 
-func (j *Jwt) funcRefresh(token *jwt.Token) (interface{}, error) {
-	return []byte(j.refreshKey), nil
+// Can reports whether role of user had permission when the token was issued,
+// changes of role permissions apply to new tokens only.
+func (c *Claims) Can(permission types.Permission) bool {
+	return slices.Contains(c.Permissions, permission)
 }
@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-playground/assert/v2"
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+)
+
+func TestServiceToken(t *testing.T) {
+	j := newTestJwt(t, "", "")
+
+	token, err := j.ServiceToken("booking", "users.read", time.Minute)
+	if err != nil {
+		t.Fatalf("Can`t sign service token: %v", err)
+	}
+
+	claims, err := j.ValidateServiceToken(token)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, claims.ClientId, "booking")
+	assert.Equal(t, claims.Allows(types.USERS_READ), true)
+	assert.Equal(t, claims.Allows(types.VERIFICATION_READ), false)
+
+	// Service token can`t be used as token of user.
+	_, err = j.ValidateToken(token, false)
+	assert.Equal(t, err.GetCode(), unauthErr.GetCode())
+
+	user := &entity.User{Id: "id", Role: types.ADMIN}
+	session := &entity.Session{Id: "sid"}
+
+	access, err := j.GenerateToken(user, session, nil, false)
+	if err != nil {
+		t.Fatalf("Can`t sign access token: %v", err)
+	}
+
+	// And token of user can`t be used as token of service.
+	_, err = j.ValidateServiceToken(access)
+	assert.Equal(t, err.GetCode(), unauthErr.GetCode())
+
+	expired, err := j.ServiceToken("booking", "users.read", -time.Minute)
+	if err != nil {
+		t.Fatalf("Can`t sign service token: %v", err)
+	}
+
+	_, err = j.ValidateServiceToken(expired)
+	assert.Equal(t, err.GetCode(), unauthErr.GetCode())
+}
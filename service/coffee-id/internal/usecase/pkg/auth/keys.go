@@ -0,0 +1,201 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+)
+
+type signingKey struct {
+	kid     string
+	method  jwt.SigningMethod
+	private crypto.Signer
+	public  crypto.PublicKey
+}
+
+// KeySet holds keys for signing and verifying tokens.
+//
+// Every *.pem file of keys directory is a key, its name without extension is kid.
+// Private keys (PKCS#8 or PKCS#1) may sign tokens, public keys (PKIX) only verify
+// tokens issued before rotation. All keys are published in JWKS, so verifiers
+// accept tokens of previous key until its file is removed.
+type KeySet struct {
+	active *signingKey
+	keys   map[string]*signingKey
+	kids   []string
+}
+
+// NewKeySet loads keys from dir and signs with activeKid, or with the last private
+// key by name if activeKid is empty. Without dir single ephemeral Ed25519 key is
+// generated, which is suitable only for development.
+func NewKeySet(dir, activeKid string) (*KeySet, error) {
+	if dir == "" {
+		return newEphemeralKeySet()
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+
+	slices.Sort(files)
+
+	set := &KeySet{
+		keys: make(map[string]*signingKey, len(files)),
+	}
+
+	for _, file := range files {
+		kid := strings.TrimSuffix(filepath.Base(file), ".pem")
+
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := parseKey(kid, data)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", kid, err)
+		}
+
+		set.keys[kid] = key
+		set.kids = append(set.kids, kid)
+
+		if key.private != nil && activeKid == "" {
+			set.active = key
+		}
+	}
+
+	if activeKid != "" {
+		set.active = set.keys[activeKid]
+	}
+
+	if set.active == nil || set.active.private == nil {
+		return nil, errors.New("no private key to sign tokens")
+	}
+
+	return set, nil
+}
+
+// PublicKeys returns all verification keys.
+func (k *KeySet) PublicKeys() []*entity.PublicKey {
+	keys := make([]*entity.PublicKey, 0, len(k.kids))
+
+	for _, kid := range k.kids {
+		key := k.keys[kid]
+
+		keys = append(keys, &entity.PublicKey{
+			Kid: key.kid,
+			Alg: key.method.Alg(),
+			Key: key.public,
+		})
+	}
+
+	return keys
+}
+
+func (k *KeySet) sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(k.active.method, claims)
+	token.Header["kid"] = k.active.kid
+
+	return token.SignedString(k.active.private)
+}
+
+func (k *KeySet) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	key, ok := k.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown kid %q", kid)
+	}
+
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, fmt.Errorf("unexpected algorithm %s for kid %q", token.Method.Alg(), kid)
+	}
+
+	return key.public, nil
+}
+
+func (k *KeySet) methods() []string {
+	methods := make([]string, 0, 2)
+
+	for _, key := range k.keys {
+		if !slices.Contains(methods, key.method.Alg()) {
+			methods = append(methods, key.method.Alg())
+		}
+	}
+
+	return methods
+}
+
+func newEphemeralKeySet() (*KeySet, error) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &signingKey{
+		kid:     "ephemeral",
+		method:  jwt.SigningMethodEdDSA,
+		private: private,
+		public:  public,
+	}
+
+	return &KeySet{
+		active: key,
+		keys:   map[string]*signingKey{key.kid: key},
+		kids:   []string{key.kid},
+	}, nil
+}
+
+func parseKey(kid string, data []byte) (*signingKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data")
+	}
+
+	var parsed any
+	var err error
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %s", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	key := &signingKey{kid: kid}
+
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		key.method, key.private, key.public = jwt.SigningMethodRS256, k, &k.PublicKey
+	case *rsa.PublicKey:
+		key.method, key.public = jwt.SigningMethodRS256, k
+	case ed25519.PrivateKey:
+		key.method, key.private, key.public = jwt.SigningMethodEdDSA, k, k.Public()
+	case ed25519.PublicKey:
+		key.method, key.public = jwt.SigningMethodEdDSA, k
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", parsed)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-playground/assert/v2"
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+)
+
+func TestKeyRotation(t *testing.T) {
+	dir := t.TempDir()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Can`t generate rsa key: %v", err)
+	}
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("Can`t generate ed25519 key: %v", err)
+	}
+
+	writeKey(t, dir, "2026-01", "PRIVATE KEY", rsaKey)
+	writeKey(t, dir, "2026-02", "PRIVATE KEY", edKey)
+
+	user := &entity.User{Id: "id", Role: types.USER}
+	session := &entity.Session{Id: "sid", TokenId: "jti"}
+
+	old := newTestJwt(t, dir, "2026-01")
+
+	oldToken, err := old.GenerateToken(user, session, nil, false)
+	if err != nil {
+		t.Fatalf("Can`t sign token: %v", err)
+	}
+
+	current := newTestJwt(t, dir, "")
+
+	newToken, err := current.GenerateToken(user, session, nil, false)
+	if err != nil {
+		t.Fatalf("Can`t sign token: %v", err)
+	}
+
+	_, verr := current.ValidateToken(oldToken, false)
+	assert.Equal(t, verr, nil)
+
+	_, verr = current.ValidateToken(newToken, false)
+	assert.Equal(t, verr, nil)
+
+	_, verr = old.ValidateToken(newToken, false)
+	assert.Equal(t, verr, nil)
+
+	assert.Equal(t, len(current.PublicKeys()), 2)
+
+	// After rotation the old key is kept only for verification.
+	os.Remove(filepath.Join(dir, "2026-01.pem"))
+	writeKey(t, dir, "2026-01", "PUBLIC KEY", &rsaKey.PublicKey)
+
+	retired := newTestJwt(t, dir, "")
+
+	_, verr = retired.ValidateToken(oldToken, false)
+	assert.Equal(t, verr, nil)
+
+	_, err = NewKeySet(dir, "2026-01")
+	assert.NotEqual(t, err, nil)
+}
+
+func TestRefreshAudience(t *testing.T) {
+	j := newTestJwt(t, "", "")
+	user := &entity.User{Id: "id", Role: types.ADMIN}
+	session := &entity.Session{Id: "sid", TokenId: "jti"}
+
+	access, err := j.GenerateToken(user, session, []types.Permission{types.LAYOUT_EDIT}, false)
+	if err != nil {
+		t.Fatalf("Can`t sign token: %v", err)
+	}
+
+	refresh, err := j.GenerateToken(user, session, nil, true)
+	if err != nil {
+		t.Fatalf("Can`t sign token: %v", err)
+	}
+
+	claims, verr := j.ValidateToken(access, false)
+	assert.Equal(t, verr, nil)
+	assert.Equal(t, claims.Can(types.LAYOUT_EDIT), true)
+	assert.Equal(t, claims.Can(types.ROLE_MANAGE), false)
+
+	_, verr = j.ValidateToken(access, true)
+	assert.NotEqual(t, verr, nil)
+
+	_, verr = j.ValidateToken(refresh, false)
+	assert.NotEqual(t, verr, nil)
+
+	_, verr = j.ValidateToken(refresh, true)
+	assert.Equal(t, verr, nil)
+}
+
+func TestJwtRequiresAudience(t *testing.T) {
+	_, err := NewJwt(&JwtOptions{Issuer: "coffee-id-backend"})
+	assert.Equal(t, err, errNoAudience)
+}
+
+func newTestJwt(t *testing.T, dir, kid string) *Jwt {
+	j, err := NewJwt(&JwtOptions{
+		Audience:        []string{"coffee-id-frontend"},
+		ServiceAudience: "coffee-services",
+		Issuer:          "coffee-id-backend",
+		KeysDir:         dir,
+		ActiveKid:       kid,
+	})
+	if err != nil {
+		t.Fatalf("Can`t create jwt: %v", err)
+	}
+
+	return j
+}
+
+func writeKey(t *testing.T, dir, kid, blockType string, key any) {
+	var der []byte
+	var err error
+
+	if blockType == "PUBLIC KEY" {
+		der, err = x509.MarshalPKIXPublicKey(key)
+	} else {
+		der, err = x509.MarshalPKCS8PrivateKey(key)
+	}
+	if err != nil {
+		t.Fatalf("Can`t marshal key: %v", err)
+	}
+
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+
+	if err := os.WriteFile(filepath.Join(dir, kid+".pem"), data, 0o600); err != nil {
+		t.Fatalf("Can`t write key: %v", err)
+	}
+}
@@ -0,0 +1,302 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	"github.com/nikitaSstepanov/coffee-id/pkg/totp"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+// VerifyTwoFactor passes challenge of login with TOTP or recovery code and starts
+// session. If user enrolled on login, 2FA is enabled and recovery codes are returned.
+func (a *Auth) VerifyTwoFactor(c ctx.Context, challengeId, code string) (*entity.Tokens, *entity.User, []string, e.Error) {
+	challenge, err := a.twoFactor.GetChallenge(c, challengeId)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	user, err := a.user.GetById(c, challenge.UserId)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	twoFactor, err := a.twoFactor.Get(c, user.Id)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var recovery []string
+
+	if twoFactor.Enabled {
+		err = a.checkCode(c, twoFactor, code)
+	} else {
+		recovery, err = a.enable(c, twoFactor, code)
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if err := a.twoFactor.DelChallenge(c, challenge.Id); err != nil {
+		return nil, nil, nil, err
+	}
+
+	client := &entity.Session{
+		Device: challenge.Device,
+		Ip:     challenge.Ip,
+	}
+
+	tokens, err := a.Issue(c, user, client)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return tokens, user, recovery, nil
+}
+
+// EnrollTwoFactor creates new secret of signed in user. It works after EnableTwoFactor.
+func (a *Auth) EnrollTwoFactor(c ctx.Context, userId string) (*entity.TwoFactorSetup, e.Error) {
+	user, err := a.user.GetById(c, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	twoFactor, err := a.getTwoFactor(c, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if twoFactor != nil && twoFactor.Enabled {
+		return nil, twoFactorEnabledErr
+	}
+
+	return a.setup(c, user)
+}
+
+func (a *Auth) EnableTwoFactor(c ctx.Context, userId, code string) ([]string, e.Error) {
+	twoFactor, err := a.twoFactor.Get(c, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if twoFactor.Enabled {
+		return nil, twoFactorEnabledErr
+	}
+
+	return a.enable(c, twoFactor, code)
+}
+
+// DisableTwoFactor turns 2FA off. Users of roles requiring 2FA can't do it.
+func (a *Auth) DisableTwoFactor(c ctx.Context, userId, code string) e.Error {
+	user, err := a.user.GetById(c, userId)
+	if err != nil {
+		return err
+	}
+
+	if slices.Contains(twoFactorRoles, user.Role) {
+		return twoFactorRequiredErr
+	}
+
+	twoFactor, err := a.enabledTwoFactor(c, userId)
+	if err != nil {
+		return err
+	}
+
+	if err := a.checkCode(c, twoFactor, code); err != nil {
+		return err
+	}
+
+	return a.twoFactor.Delete(c, userId)
+}
+
+// RegenerateRecoveryCodes replaces recovery codes of user, old ones stop working.
+func (a *Auth) RegenerateRecoveryCodes(c ctx.Context, userId, code string) ([]string, e.Error) {
+	twoFactor, err := a.enabledTwoFactor(c, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.checkCode(c, twoFactor, code); err != nil {
+		return nil, err
+	}
+
+	codes, hashes := newRecoveryCodes()
+
+	if err := a.twoFactor.SetRecoveryCodes(c, userId, hashes); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// ResetTwoFactor is used by admins when user lost their device. User is logged out
+// everywhere and enrolls again on next login, if their role requires 2FA.
+func (a *Auth) ResetTwoFactor(c ctx.Context, userId string) e.Error {
+	if _, err := a.user.GetById(c, userId); err != nil {
+		return err
+	}
+
+	if err := a.twoFactor.Delete(c, userId); err != nil {
+		return err
+	}
+
+	return a.session.DeleteForUser(c, userId)
+}
+
+// signIn starts session or returns challenge when user has to pass the second factor.
+func (a *Auth) signIn(c ctx.Context, user *entity.User, client *entity.Session) (*entity.Tokens, *entity.LoginChallenge, e.Error) {
+	twoFactor, err := a.getTwoFactor(c, user.Id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	enabled := twoFactor != nil && twoFactor.Enabled
+
+	if !enabled && !slices.Contains(twoFactorRoles, user.Role) {
+		tokens, err := a.Issue(c, user, client)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return tokens, nil, nil
+	}
+
+	challenge := &entity.LoginChallenge{
+		Id:     newId(),
+		UserId: user.Id,
+		Device: client.Device,
+		Ip:     client.Ip,
+	}
+
+	if !enabled {
+		challenge.Setup, err = a.setup(c, user)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := a.twoFactor.SetChallenge(c, challenge); err != nil {
+		return nil, nil, err
+	}
+
+	return nil, challenge, nil
+}
+
+func (a *Auth) setup(c ctx.Context, user *entity.User) (*entity.TwoFactorSetup, e.Error) {
+	twoFactor := &entity.TwoFactor{
+		UserId: user.Id,
+		Secret: totp.NewSecret(),
+	}
+
+	if err := a.twoFactor.Set(c, twoFactor); err != nil {
+		return nil, err
+	}
+
+	return &entity.TwoFactorSetup{
+		Secret: twoFactor.Secret,
+		Uri:    totp.Uri(totpIssuer, user.Email, twoFactor.Secret),
+	}, nil
+}
+
+// enable checks the first code from authenticator app. Recovery codes can't be
+// used here, user has none yet.
+func (a *Auth) enable(c ctx.Context, twoFactor *entity.TwoFactor, code string) ([]string, e.Error) {
+	step, ok := totp.Validate(twoFactor.Secret, code, time.Now())
+	if !ok {
+		return nil, twoFactorCodeErr
+	}
+
+	codes, hashes := newRecoveryCodes()
+
+	twoFactor.RecoveryCodes = hashes
+	twoFactor.LastStep = step
+
+	if err := a.twoFactor.Enable(c, twoFactor); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// checkCode accepts TOTP code once or any unused recovery code.
+func (a *Auth) checkCode(c ctx.Context, twoFactor *entity.TwoFactor, code string) e.Error {
+	if len(code) == totp.Digits {
+		step, ok := totp.Validate(twoFactor.Secret, code, time.Now())
+		if !ok {
+			return twoFactorCodeErr
+		}
+
+		if err := a.twoFactor.UseStep(c, twoFactor.UserId, step); err != nil {
+			return twoFactorCodeErr.WithErr(err)
+		}
+
+		return nil
+	}
+
+	if err := a.twoFactor.UseRecoveryCode(c, twoFactor.UserId, hashRecoveryCode(code)); err != nil {
+		return twoFactorCodeErr.WithErr(err)
+	}
+
+	return nil
+}
+
+func (a *Auth) getTwoFactor(c ctx.Context, userId string) (*entity.TwoFactor, e.Error) {
+	twoFactor, err := a.twoFactor.Get(c, userId)
+	if err != nil {
+		if err.GetCode() == e.NotFound {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return twoFactor, nil
+}
+
+func (a *Auth) enabledTwoFactor(c ctx.Context, userId string) (*entity.TwoFactor, e.Error) {
+	twoFactor, err := a.getTwoFactor(c, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if twoFactor == nil || !twoFactor.Enabled {
+		return nil, twoFactorDisabledErr
+	}
+
+	return twoFactor, nil
+}
+
+// newRecoveryCodes returns codes shown to user and their hashes to store.
+func newRecoveryCodes() ([]string, []string) {
+	codes := make([]string, 0, recoveryCodesCount)
+	hashes := make([]string, 0, recoveryCodesCount)
+
+	encoding := base32.StdEncoding.WithPadding(base32.NoPadding)
+
+	for range recoveryCodesCount {
+		b := make([]byte, 7)
+		rand.Read(b)
+
+		raw := strings.ToLower(encoding.EncodeToString(b))[:10]
+		code := raw[:5] + "-" + raw[5:]
+
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+
+	return codes, hashes
+}
+
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	hash := sha256.Sum256([]byte(normalized))
+
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,208 @@
+package auth
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-playground/assert/v2"
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	"github.com/nikitaSstepanov/coffee-id/pkg/totp"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/nikitaSstepanov/tools/sl"
+)
+
+func TestTwoFactorLogin(t *testing.T) {
+	auth, users, _ := setupExternal(t, nil)
+	ctx := ctx.New(sl.Default())
+
+	hash, hashErr := auth.coder.Hash("password")
+	if hashErr != nil {
+		t.Fatalf("Can`t hash password: %v", hashErr)
+	}
+
+	users.users["admin@example.com"] = &entity.User{
+		Id:       "admin",
+		Email:    "admin@example.com",
+		Password: hash,
+		Role:     types.ADMIN,
+	}
+
+	login := &entity.User{Email: "admin@example.com", Password: "password"}
+
+	// Admin without 2FA has to enroll before getting tokens.
+	tokens, _, challenge, err := auth.Login(ctx, login, &entity.Session{})
+	assert.Equal(t, err, nil)
+	assert.Equal(t, tokens, nil)
+	assert.NotEqual(t, challenge.Setup, nil)
+
+	code := totpCode(t, challenge.Setup.Secret)
+
+	tokens, _, recovery, err := auth.VerifyTwoFactor(ctx, challenge.Id, code)
+	assert.Equal(t, err, nil)
+	assert.NotEqual(t, tokens, nil)
+	assert.Equal(t, len(recovery), recoveryCodesCount)
+
+	// Challenge can be passed once.
+	_, _, _, err = auth.VerifyTwoFactor(ctx, challenge.Id, code)
+	assert.Equal(t, err.GetCode(), e.NotFound)
+
+	tokens, _, challenge, err = auth.Login(ctx, login, &entity.Session{})
+	assert.Equal(t, err, nil)
+	assert.Equal(t, tokens, nil)
+	assert.Equal(t, challenge.Setup, nil)
+
+	// TOTP code can`t be replayed.
+	_, _, _, err = auth.VerifyTwoFactor(ctx, challenge.Id, code)
+	assert.Equal(t, err.GetCode(), e.Unauthorize)
+
+	tokens, _, _, err = auth.VerifyTwoFactor(ctx, challenge.Id, recovery[0])
+	assert.Equal(t, err, nil)
+	assert.NotEqual(t, tokens, nil)
+
+	_, _, challenge, _ = auth.Login(ctx, login, &entity.Session{})
+
+	// Recovery code can be used once.
+	_, _, _, err = auth.VerifyTwoFactor(ctx, challenge.Id, recovery[0])
+	assert.Equal(t, err.GetCode(), e.Unauthorize)
+
+	err = auth.DisableTwoFactor(ctx, "admin", recovery[1])
+	assert.Equal(t, err.GetCode(), e.Forbidden)
+}
+
+func TestTwoFactorOptional(t *testing.T) {
+	auth, users, _ := setupExternal(t, nil)
+	ctx := ctx.New(sl.Default())
+
+	users.users["user@example.com"] = &entity.User{Id: "user", Email: "user@example.com", Role: types.USER}
+
+	setup, err := auth.EnrollTwoFactor(ctx, "user")
+	assert.Equal(t, err, nil)
+
+	_, err = auth.EnableTwoFactor(ctx, "user", "000000")
+	assert.Equal(t, err.GetCode(), e.Unauthorize)
+
+	recovery, err := auth.EnableTwoFactor(ctx, "user", totpCode(t, setup.Secret))
+	assert.Equal(t, err, nil)
+	assert.Equal(t, len(recovery), recoveryCodesCount)
+
+	_, err = auth.EnrollTwoFactor(ctx, "user")
+	assert.Equal(t, err.GetCode(), e.Conflict)
+
+	err = auth.DisableTwoFactor(ctx, "user", recovery[0])
+	assert.Equal(t, err, nil)
+
+	_, err = auth.RegenerateRecoveryCodes(ctx, "user", recovery[1])
+	assert.Equal(t, err.GetCode(), e.Conflict)
+}
+
+func totpCode(t *testing.T, secret string) string {
+	code, err := totp.Code(secret, totp.Step(time.Now()))
+	if err != nil {
+		t.Fatalf("Can`t generate code: %v", err)
+	}
+
+	return code
+}
+
+type twoFactorStorage struct {
+	twoFactors map[string]*entity.TwoFactor
+	challenges map[string]*entity.LoginChallenge
+}
+
+func newTwoFactorStorage() *twoFactorStorage {
+	return &twoFactorStorage{
+		twoFactors: make(map[string]*entity.TwoFactor),
+		challenges: make(map[string]*entity.LoginChallenge),
+	}
+}
+
+func (s *twoFactorStorage) Get(c ctx.Context, userId string) (*entity.TwoFactor, e.Error) {
+	twoFactor, ok := s.twoFactors[userId]
+	if !ok {
+		return nil, e.New("Two-factor authentication wasn`t found.", e.NotFound)
+	}
+
+	result := *twoFactor
+
+	return &result, nil
+}
+
+func (s *twoFactorStorage) Set(c ctx.Context, twoFactor *entity.TwoFactor) e.Error {
+	s.twoFactors[twoFactor.UserId] = &entity.TwoFactor{UserId: twoFactor.UserId, Secret: twoFactor.Secret}
+
+	return nil
+}
+
+func (s *twoFactorStorage) Enable(c ctx.Context, twoFactor *entity.TwoFactor) e.Error {
+	stored, ok := s.twoFactors[twoFactor.UserId]
+	if !ok || stored.Enabled {
+		return e.New("Two-factor authentication is already enabled.", e.Conflict)
+	}
+
+	stored.Enabled = true
+	stored.RecoveryCodes = twoFactor.RecoveryCodes
+	stored.LastStep = twoFactor.LastStep
+
+	return nil
+}
+
+func (s *twoFactorStorage) SetRecoveryCodes(c ctx.Context, userId string, codes []string) e.Error {
+	s.twoFactors[userId].RecoveryCodes = codes
+
+	return nil
+}
+
+func (s *twoFactorStorage) UseStep(c ctx.Context, userId string, step int64) e.Error {
+	stored := s.twoFactors[userId]
+
+	if stored.LastStep >= step {
+		return e.New("This code was already used.", e.Conflict)
+	}
+
+	stored.LastStep = step
+
+	return nil
+}
+
+func (s *twoFactorStorage) UseRecoveryCode(c ctx.Context, userId, hash string) e.Error {
+	stored := s.twoFactors[userId]
+
+	i := slices.Index(stored.RecoveryCodes, hash)
+	if i == -1 {
+		return e.New("This code was already used.", e.Conflict)
+	}
+
+	stored.RecoveryCodes = slices.Delete(stored.RecoveryCodes, i, i+1)
+
+	return nil
+}
+
+func (s *twoFactorStorage) Delete(c ctx.Context, userId string) e.Error {
+	delete(s.twoFactors, userId)
+
+	return nil
+}
+
+func (s *twoFactorStorage) SetChallenge(c ctx.Context, challenge *entity.LoginChallenge) e.Error {
+	s.challenges[challenge.Id] = challenge
+
+	return nil
+}
+
+func (s *twoFactorStorage) GetChallenge(c ctx.Context, id string) (*entity.LoginChallenge, e.Error) {
+	challenge, ok := s.challenges[id]
+	if !ok {
+		return nil, e.New("Login challenge is expired or invalid.", e.NotFound)
+	}
+
+	return challenge, nil
+}
+
+func (s *twoFactorStorage) DelChallenge(c ctx.Context, id string) e.Error {
+	delete(s.challenges, id)
+
+	return nil
+}
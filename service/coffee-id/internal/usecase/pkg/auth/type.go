@@ -10,27 +10,42 @@ import (
 )
 
 type JwtOptions struct {
-	Audience   []string `yaml:"audience" env:"JWT_AUDIENCE"`
-	Issuer     string   `yaml:"issuer"   env:"JWT_ISSUER"`
-	AccessKey  string   `env:"JWT_ACCESS_SECRET"`
-	RefreshKey string   `env:"JWT_REFRESH_SECRET"`
+	Audience        []string `yaml:"audience"         env:"JWT_AUDIENCE"`
+	ServiceAudience string   `yaml:"service_audience" env:"JWT_SERVICE_AUDIENCE"`
+	Issuer          string   `yaml:"issuer"           env:"JWT_ISSUER"`
+	KeysDir         string   `yaml:"keys_dir"         env:"JWT_KEYS_DIR"`
+	ActiveKid       string   `yaml:"active_kid"       env:"JWT_ACTIVE_KID"`
 }
 
 type Claims struct {
-	Id   string     `json:"id"`
-	Role types.Role `json:"role"`
+	Id          string             `json:"id"`
+	Role        types.Role         `json:"role"`
+	Permissions []types.Permission `json:"permissions,omitempty"`
+	SessionId   string             `json:"sid"`
+	jwt.RegisteredClaims
+}
+
+// ServiceClaims are claims of token issued to internal service by client credentials
+// grant. Their audience is shared by all services and subject is client id.
+type ServiceClaims struct {
+	ClientId string `json:"client_id"`
+	Scope    string `json:"scope"`
 	jwt.RegisteredClaims
 }
 
 type UseCases struct {
-	YandexOAuth YandexOAuth
-	Jwt         *Jwt
-	Coder       *coder.Coder
+	Providers map[string]Provider
+	Security  SecurityUseCase
+	Jwt       *Jwt
+	Coder     *coder.Coder
 }
 
 type Storages struct {
-	User   UserStorage
-	OAuth  OAuthStorage
+	User       UserStorage
+	Permission PermissionStorage
+	Session    SessionStorage
+	Identity   IdentityStorage
+	TwoFactor  TwoFactorStorage
 }
 
 type UserStorage interface {
@@ -40,13 +55,51 @@ type UserStorage interface {
 	Verify(ctx ctx.Context, user *entity.User) e.Error
 }
 
-type YandexOAuth interface {
-	GetUser(c ctx.Context, code string) (*entity.Yandex, e.Error)
+type PermissionStorage interface {
+	GetForRole(c ctx.Context, role types.Role) ([]types.Permission, e.Error)
+}
+
+type SessionStorage interface {
+	Get(c ctx.Context, id string) (*entity.Session, e.Error)
+	GetForUser(c ctx.Context, userId string) ([]*entity.Session, e.Error)
+	Create(c ctx.Context, session *entity.Session) e.Error
+	Rotate(c ctx.Context, session *entity.Session, usedTokenId string) e.Error
+	Delete(c ctx.Context, session *entity.Session) e.Error
+	DeleteForUser(c ctx.Context, userId string) e.Error
+}
+
+type IdentityStorage interface {
+	Get(c ctx.Context, provider, subject string) (*entity.Identity, e.Error)
+	GetForUser(c ctx.Context, userId string) ([]*entity.Identity, e.Error)
+	Create(c ctx.Context, identity *entity.Identity) e.Error
+	Delete(c ctx.Context, provider, userId string) e.Error
+	SetState(c ctx.Context, state *entity.ExternalState) e.Error
+	TakeState(c ctx.Context, state string) (*entity.ExternalState, e.Error)
+}
+
+type TwoFactorStorage interface {
+	Get(c ctx.Context, userId string) (*entity.TwoFactor, e.Error)
+	Set(c ctx.Context, twoFactor *entity.TwoFactor) e.Error
+	Enable(c ctx.Context, twoFactor *entity.TwoFactor) e.Error
+	SetRecoveryCodes(c ctx.Context, userId string, codes []string) e.Error
+	UseStep(c ctx.Context, userId string, step int64) e.Error
+	UseRecoveryCode(c ctx.Context, userId, hash string) e.Error
+	Delete(c ctx.Context, userId string) e.Error
+	SetChallenge(c ctx.Context, challenge *entity.LoginChallenge) e.Error
+	GetChallenge(c ctx.Context, id string) (*entity.LoginChallenge, e.Error)
+	DelChallenge(c ctx.Context, id string) e.Error
+}
+
+type SecurityUseCase interface {
+	LimitIp(c ctx.Context, action types.Action, ip string) e.Error
+	LimitAccount(c ctx.Context, action types.Action, account string) e.Error
+	Check(c ctx.Context, userId string) e.Error
+	Fail(c ctx.Context, userId, ip string) e.Error
+	Succeed(c ctx.Context, userId string) e.Error
 }
 
-type OAuthStorage interface {
-	SetSession(c ctx.Context, key string, session *entity.OAuthSession) e.Error
-	GetSession(c ctx.Context, key string) (*entity.OAuthSession, e.Error)
-	SetCode(c ctx.Context, key string, code *entity.OAuthCode) e.Error
-	GetCode(c ctx.Context, key string) (*entity.OAuthCode, e.Error)
+type Provider interface {
+	Type() types.OAuth
+	AuthUrl(c ctx.Context, state, nonce, challenge string) (string, e.Error)
+	Exchange(c ctx.Context, code, verifier, nonce string) (*entity.ExternalUser, e.Error)
 }
@@ -3,16 +3,45 @@ package auth
 import (
 	"time"
 
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
 	e "github.com/nikitaSstepanov/tools/error"
 )
 
 const (
 	refreshExpires = 72 * time.Hour
-	accessExpires  = 72 * time.Hour
+	// Access tokens are verified by services without Coffee ID, so they live
+	// only until revoked session would be noticed on refresh.
+	accessExpires = 15 * time.Minute
 )
 
 var (
-	badDataErr  = e.New("Incorrect email or password", e.Unauthorize)
-	unauthErr   = e.New("Token is invalid", e.Unauthorize)
-	notFoundErr = e.New("This Coffee ID user wasn`t found.", e.NotFound)
+	badDataErr         = e.New("Incorrect email or password", e.Unauthorize)
+	unauthErr          = e.New("Token is invalid", e.Unauthorize)
+	notFoundErr        = e.New("This Coffee ID user wasn`t found.", e.NotFound)
+	sessionNotFoundErr = e.New("This session wasn`t found.", e.NotFound)
+	reuseErr           = e.New("Refresh token was already used, session is revoked.", e.Unauthorize)
+
+	providerNotFoundErr = e.New("This identity provider wasn`t found.", e.NotFound)
+	identityNotFoundErr = e.New("This provider isn`t linked to account.", e.NotFound)
+	stateErr            = e.New("Login request is expired or invalid.", e.BadInput)
+	noEmailErr          = e.New("Identity provider didn`t share email.", e.BadInput)
+	emailTakenErr       = e.New("User with this email already exists. Sign in and link provider to account.", e.Conflict)
+	linkedErr           = e.New("This identity is linked to another account.", e.Conflict)
+	alreadyLinkedErr    = e.New("Another identity of this provider is already linked to account.", e.Conflict)
+	lastIdentityErr     = e.New("Account has no password, so the last provider can`t be unlinked.", e.Conflict)
+
+	twoFactorCodeErr     = e.New("Code is incorrect.", e.Unauthorize)
+	twoFactorEnabledErr  = e.New("Two-factor authentication is already enabled.", e.Conflict)
+	twoFactorDisabledErr = e.New("Two-factor authentication isn`t enabled.", e.Conflict)
+	twoFactorRequiredErr = e.New("Two-factor authentication is required for your role.", e.Forbidden)
+)
+
+const (
+	totpIssuer         = "Coffee ID"
+	recoveryCodesCount = 10
+)
+
+var (
+	// Users of these roles can`t get tokens without the second factor.
+	twoFactorRoles = []types.Role{types.ADMIN, types.SUPER_ADMIN}
 )
@@ -0,0 +1,365 @@
+package oauth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/nikitaSstepanov/tools/utils/coder"
+)
+
+// OAuth makes Coffee ID an OpenID Connect provider. Users sign in to clients with
+// authorization code flow with PKCE (S256), internal services get tokens by client
+// credentials grant.
+type OAuth struct {
+	client   ClientStorage
+	oauth    OAuthStorage
+	user     UserStorage
+	services map[string]Service
+	jwt      Signer
+	coder    *coder.Coder
+}
+
+func New(store *Storages, uc *UseCases) *OAuth {
+	return &OAuth{
+		client:   store.Client,
+		oauth:    store.OAuth,
+		user:     store.User,
+		services: uc.Services,
+		jwt:      uc.Jwt,
+		coder:    uc.Coder,
+	}
+}
+
+// NewServices checks configs of internal services and returns them by client id.
+func NewServices(configs []Service) (map[string]Service, error) {
+	services := make(map[string]Service, len(configs))
+
+	for _, service := range configs {
+		if service.ClientId == "" {
+			return nil, fmt.Errorf("service client id is required")
+		}
+
+		if _, ok := services[service.ClientId]; ok {
+			return nil, fmt.Errorf("service %s is configured twice", service.ClientId)
+		}
+
+		for _, scope := range service.Scopes {
+			if !scope.IsValid() {
+				return nil, fmt.Errorf("service %s has unknown scope %s", service.ClientId, scope)
+			}
+		}
+
+		service.Secret = os.ExpandEnv(service.Secret)
+
+		// Service without secret can't authenticate, so it is disabled.
+		if service.Secret == "" {
+			continue
+		}
+
+		services[service.ClientId] = service
+	}
+
+	return services, nil
+}
+
+func (o *OAuth) GetClients(c ctx.Context) ([]*entity.Client, e.Error) {
+	return o.client.GetAll(c)
+}
+
+func (o *OAuth) GetClient(c ctx.Context, id string) (*entity.Client, e.Error) {
+	return o.client.GetById(c, id)
+}
+
+// CreateClient registers client and returns its secret. Only hash of secret is
+// stored, so it can't be shown again.
+func (o *OAuth) CreateClient(c ctx.Context, client *entity.Client) (string, e.Error) {
+	for _, field := range client.Fields {
+		if !field.IsValid() {
+			return "", fieldErr
+		}
+	}
+
+	secret := randomString(32)
+
+	hash, err := o.coder.Hash(secret)
+	if err != nil {
+		return "", e.InternalErr.WithErr(err)
+	}
+
+	client.ClientId = randomString(16)
+	client.Secret = hash
+
+	if err := o.client.Create(c, client); err != nil {
+		return "", err
+	}
+
+	return secret, nil
+}
+
+func (o *OAuth) DeleteClient(c ctx.Context, id string) e.Error {
+	if _, err := o.client.GetById(c, id); err != nil {
+		return err
+	}
+
+	return o.client.Delete(c, id)
+}
+
+// Authorize checks authorization request of signed in user and saves it until
+// user consents. Client gets only fields it was registered with.
+func (o *OAuth) Authorize(c ctx.Context, request *entity.OAuthSession) (*entity.Client, e.Error) {
+	client, err := o.client.GetByClientId(c, request.ClientId)
+	if err != nil {
+		if err.GetCode() == e.NotFound {
+			return nil, unknownClientErr.WithErr(err)
+		}
+
+		return nil, err
+	}
+
+	if client.RedirectUri != request.RedirectUri {
+		return nil, redirectErr
+	}
+
+	for _, field := range request.Fields {
+		if !field.IsValid() || !slices.Contains(client.Fields, field) {
+			return nil, scopeErr
+		}
+	}
+
+	request.Id = randomString(16)
+
+	if err := o.oauth.SetSession(c, request); err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// Consent finishes authorization request and returns url to redirect user to.
+// Code is added only if user approved request.
+func (o *OAuth) Consent(c ctx.Context, userId, id string, approve bool) (string, e.Error) {
+	session, err := o.oauth.GetSession(c, id)
+	if err != nil {
+		return "", err
+	}
+
+	if session.UserId != userId {
+		return "", requestErr
+	}
+
+	if err := o.oauth.DelSession(c, id); err != nil {
+		return "", err
+	}
+
+	query := url.Values{}
+
+	if session.State != "" {
+		query.Set("state", session.State)
+	}
+
+	if !approve {
+		query.Set("error", "access_denied")
+		return withQuery(session.RedirectUri, query), nil
+	}
+
+	code := &entity.OAuthCode{
+		Code:        randomString(32),
+		UserId:      session.UserId,
+		ClientId:    session.ClientId,
+		RedirectUri: session.RedirectUri,
+		Fields:      session.Fields,
+		Nonce:       session.Nonce,
+		Challenge:   session.Challenge,
+	}
+
+	if err := o.oauth.SetCode(c, code); err != nil {
+		return "", err
+	}
+
+	query.Set("code", code.Code)
+
+	return withQuery(session.RedirectUri, query), nil
+}
+
+// Exchange authenticates client and exchanges authorization code for access and ID tokens.
+func (o *OAuth) Exchange(c ctx.Context, exchange *entity.OAuthExchange) (*entity.OAuthTokens, e.Error) {
+	client, err := o.client.GetByClientId(c, exchange.ClientId)
+	if err != nil {
+		if err.GetCode() == e.NotFound {
+			return nil, invalidClientErr.WithErr(err)
+		}
+
+		return nil, err
+	}
+
+	if err := o.coder.CompareHash(client.Secret, exchange.Secret); err != nil {
+		return nil, invalidClientErr.WithErr(err)
+	}
+
+	code, err := o.oauth.TakeCode(c, exchange.Code)
+	if err != nil {
+		if err.GetCode() == e.NotFound {
+			return nil, invalidGrantErr.WithErr(err)
+		}
+
+		return nil, err
+	}
+
+	if code.ClientId != client.ClientId || code.RedirectUri != exchange.RedirectUri {
+		return nil, invalidGrantErr
+	}
+
+	if !verifyChallenge(code.Challenge, exchange.Verifier) {
+		return nil, invalidGrantErr
+	}
+
+	user, err := o.user.GetById(c, code.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	access, err := o.jwt.Sign(AccessClaims{
+		ClientId: client.ClientId,
+		Scope:    types.Scope(code.Fields),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        randomString(16),
+			Subject:   user.Id,
+			Audience:  []string{client.ClientId},
+			Issuer:    o.jwt.Issuer(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(accessExpires)),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	idToken, err := o.jwt.Sign(IdClaims{
+		Nonce: code.Nonce,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   user.Id,
+			Audience:  []string{client.ClientId},
+			Issuer:    o.jwt.Issuer(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(idExpires)),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.OAuthTokens{
+		Access:  access,
+		IdToken: idToken,
+		Expires: accessExpires,
+		Scope:   types.Scope(code.Fields),
+	}, nil
+}
+
+// ClientCredentials authenticates internal service and issues token for calls to
+// other services. Service gets requested scopes or all of its scopes.
+func (o *OAuth) ClientCredentials(c ctx.Context, clientId, secret, scope string) (*entity.OAuthTokens, e.Error) {
+	service, ok := o.services[clientId]
+	if !ok || subtle.ConstantTimeCompare([]byte(service.Secret), []byte(secret)) != 1 {
+		return nil, invalidClientErr
+	}
+
+	granted := make([]string, 0, len(service.Scopes))
+
+	for _, scope := range service.Scopes {
+		granted = append(granted, string(scope))
+	}
+
+	requested := strings.Fields(scope)
+
+	for _, s := range requested {
+		if !slices.Contains(granted, s) {
+			return nil, serviceScopeErr
+		}
+	}
+
+	if len(requested) != 0 {
+		granted = requested
+	}
+
+	access, err := o.jwt.ServiceToken(clientId, strings.Join(granted, " "), accessExpires)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.OAuthTokens{
+		Access:  access,
+		Expires: accessExpires,
+		Scope:   strings.Join(granted, " "),
+	}, nil
+}
+
+// UserInfo returns owner of client's access token and fields the client may read.
+func (o *OAuth) UserInfo(c ctx.Context, token string) (*entity.User, []types.Field, e.Error) {
+	var claims AccessClaims
+
+	if err := o.jwt.Parse(token, &claims); err != nil {
+		return nil, nil, invalidTokenErr.WithErr(err)
+	}
+
+	// Tokens of Coffee ID itself and ID tokens have no client_id.
+	if claims.ClientId == "" || !slices.Contains(claims.Audience, claims.ClientId) {
+		return nil, nil, invalidTokenErr
+	}
+
+	user, err := o.user.GetById(c, claims.Subject)
+	if err != nil {
+		if err.GetCode() == e.NotFound {
+			return nil, nil, invalidTokenErr.WithErr(err)
+		}
+
+		return nil, nil, err
+	}
+
+	return user, types.ParseScope(claims.Scope), nil
+}
+
+func (o *OAuth) Issuer() string {
+	return o.jwt.Issuer()
+}
+
+func (o *OAuth) Algorithms() []string {
+	return o.jwt.Algorithms()
+}
+
+func verifyChallenge(challenge, verifier string) bool {
+	hash := sha256.Sum256([]byte(verifier))
+	expected := base64.RawURLEncoding.EncodeToString(hash[:])
+
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(challenge)) == 1
+}
+
+func withQuery(uri string, query url.Values) string {
+	if strings.Contains(uri, "?") {
+		return uri + "&" + query.Encode()
+	}
+
+	return uri + "?" + query.Encode()
+}
+
+func randomString(size int) string {
+	b := make([]byte, size)
+	rand.Read(b)
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
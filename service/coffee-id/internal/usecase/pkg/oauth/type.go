@@ -0,0 +1,73 @@
+package oauth
+
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/nikitaSstepanov/tools/utils/coder"
+)
+
+// AccessClaims are claims of access token issued to client. Unlike tokens of
+// Coffee ID itself, their audience is client and they carry granted scope.
+type AccessClaims struct {
+	ClientId string `json:"client_id"`
+	Scope    string `json:"scope"`
+	jwt.RegisteredClaims
+}
+
+type IdClaims struct {
+	Nonce string `json:"nonce,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// Service is internal client, which gets tokens by client credentials grant to call
+// other services. Secret may reference environment variable, e.g. "${BOOKING_CLIENT_SECRET}".
+type Service struct {
+	ClientId string               `yaml:"client_id"`
+	Secret   string               `yaml:"client_secret"`
+	Scopes   []types.ServiceScope `yaml:"scopes"`
+}
+
+type UseCases struct {
+	Services map[string]Service
+	Jwt      Signer
+	Coder    *coder.Coder
+}
+
+type Storages struct {
+	Client ClientStorage
+	OAuth  OAuthStorage
+	User   UserStorage
+}
+
+type Signer interface {
+	Sign(claims jwt.Claims) (string, e.Error)
+	ServiceToken(clientId, scope string, expires time.Duration) (string, e.Error)
+	Parse(jwtString string, claims jwt.Claims) e.Error
+	Issuer() string
+	Algorithms() []string
+}
+
+type ClientStorage interface {
+	GetById(c ctx.Context, id string) (*entity.Client, e.Error)
+	GetByClientId(c ctx.Context, clientId string) (*entity.Client, e.Error)
+	GetAll(c ctx.Context) ([]*entity.Client, e.Error)
+	Create(c ctx.Context, client *entity.Client) e.Error
+	Delete(c ctx.Context, id string) e.Error
+}
+
+type OAuthStorage interface {
+	GetSession(c ctx.Context, id string) (*entity.OAuthSession, e.Error)
+	SetSession(c ctx.Context, session *entity.OAuthSession) e.Error
+	DelSession(c ctx.Context, id string) e.Error
+	SetCode(c ctx.Context, code *entity.OAuthCode) e.Error
+	TakeCode(c ctx.Context, code string) (*entity.OAuthCode, e.Error)
+}
+
+type UserStorage interface {
+	GetById(c ctx.Context, id string) (*entity.User, e.Error)
+}
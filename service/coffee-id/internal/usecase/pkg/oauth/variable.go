@@ -0,0 +1,24 @@
+package oauth
+
+import (
+	"time"
+
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+const (
+	accessExpires = 15 * time.Minute
+	idExpires     = time.Hour
+)
+
+var (
+	unknownClientErr = e.New("Unknown client.", e.BadInput)
+	redirectErr      = e.New("Redirect uri doesn`t match registered one.", e.BadInput)
+	scopeErr         = e.New("Requested scope isn`t allowed for this client.", e.BadInput)
+	fieldErr         = e.New("Unknown account field.", e.BadInput)
+	requestErr       = e.New("This authorization request wasn`t found.", e.NotFound)
+	invalidClientErr = e.New("Client authentication failed.", e.Unauthorize)
+	invalidGrantErr  = e.New("Authorization code is invalid.", e.BadInput)
+	invalidTokenErr  = e.New("Token is invalid", e.Unauthorize)
+	serviceScopeErr  = e.New("Requested scope isn`t granted to this service.", e.BadInput)
+)
@@ -0,0 +1,58 @@
+package role
+
+import (
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+type Role struct {
+	permission PermissionStorage
+}
+
+func New(store *Storages) *Role {
+	return &Role{
+		permission: store.Permission,
+	}
+}
+
+func (r *Role) GetAll(c ctx.Context) ([]*entity.RolePermissions, e.Error) {
+	return r.permission.GetAll(c)
+}
+
+func (r *Role) Get(c ctx.Context, role types.Role) (*entity.RolePermissions, e.Error) {
+	if !role.IsValid() {
+		return nil, roleErr
+	}
+
+	permissions, err := r.permission.GetForRole(c, role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.RolePermissions{
+		Role:        role,
+		Permissions: permissions,
+	}, nil
+}
+
+// SetPermissions replaces permissions of role. Already issued tokens keep
+// old permissions until they are refreshed.
+func (r *Role) SetPermissions(c ctx.Context, role *entity.RolePermissions) (*entity.RolePermissions, e.Error) {
+	if !role.Role.IsValid() {
+		return nil, roleErr
+	}
+
+	for _, permission := range role.Permissions {
+		if !permission.IsValid() {
+			return nil, permissionErr.WithMessage("Unknown permission: " + string(permission))
+		}
+	}
+
+	if err := r.permission.SetForRole(c, role); err != nil {
+		return nil, err
+	}
+
+	return r.Get(c, role.Role)
+}
@@ -0,0 +1,55 @@
+package role
+
+import (
+	"testing"
+
+	"github.com/go-playground/assert/v2"
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/nikitaSstepanov/tools/sl"
+)
+
+type permissions map[types.Role][]types.Permission
+
+func (p permissions) GetForRole(c ctx.Context, role types.Role) ([]types.Permission, e.Error) {
+	return p[role], nil
+}
+
+func (p permissions) GetAll(c ctx.Context) ([]*entity.RolePermissions, e.Error) {
+	return nil, nil
+}
+
+func (p permissions) SetForRole(c ctx.Context, role *entity.RolePermissions) e.Error {
+	p[role.Role] = role.Permissions
+	return nil
+}
+
+func TestSetPermissions(t *testing.T) {
+	c := ctx.New(sl.Default())
+	store := permissions{types.SUPPORT: {types.BOOKING_READ_ANY}}
+	r := New(&Storages{Permission: store})
+
+	got, err := r.SetPermissions(c, &entity.RolePermissions{
+		Role:        types.SUPPORT,
+		Permissions: []types.Permission{types.BOOKING_READ_ANY, types.ORDER_FULFIL},
+	})
+	assert.Equal(t, err, nil)
+	assert.Equal(t, got.Permissions, []types.Permission{types.BOOKING_READ_ANY, types.ORDER_FULFIL})
+
+	_, err = r.SetPermissions(c, &entity.RolePermissions{
+		Role:        types.SUPPORT,
+		Permissions: []types.Permission{"booking.delete.all"},
+	})
+	assert.Equal(t, err.GetCode(), permissionErr.GetCode())
+
+	// Rejected change keeps permissions of role.
+	assert.Equal(t, store[types.SUPPORT], []types.Permission{types.BOOKING_READ_ANY, types.ORDER_FULFIL})
+
+	_, err = r.SetPermissions(c, &entity.RolePermissions{Role: "OWNER"})
+	assert.Equal(t, err.GetCode(), roleErr.GetCode())
+
+	_, err = r.Get(c, "OWNER")
+	assert.Equal(t, err.GetCode(), roleErr.GetCode())
+}
@@ -0,0 +1,18 @@
+package role
+
+import (
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+type Storages struct {
+	Permission PermissionStorage
+}
+
+type PermissionStorage interface {
+	GetForRole(c ctx.Context, role types.Role) ([]types.Permission, e.Error)
+	GetAll(c ctx.Context) ([]*entity.RolePermissions, e.Error)
+	SetForRole(c ctx.Context, role *entity.RolePermissions) e.Error
+}
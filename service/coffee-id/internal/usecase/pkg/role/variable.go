@@ -0,0 +1,10 @@
+package role
+
+import (
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+var (
+	roleErr       = e.New("This role doesn`t exist.", e.NotFound)
+	permissionErr = e.New("Unknown permission.", e.BadInput)
+)
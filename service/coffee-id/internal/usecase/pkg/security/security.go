@@ -0,0 +1,128 @@
+package security
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/nikitaSstepanov/tools/sl"
+)
+
+type Security struct {
+	security SecurityStorage
+}
+
+func New(store *Storages) *Security {
+	return &Security{
+		security: store.Security,
+	}
+}
+
+// LimitIp limits rate of action from one ip.
+func (s *Security) LimitIp(c ctx.Context, action types.Action, ip string) e.Error {
+	if ip == "" {
+		return nil
+	}
+
+	limit := ipLimits[action]
+
+	return s.security.Hit(c, action, "ip:"+ip, limit.count, limit.window)
+}
+
+// LimitAccount limits rate of action with one account from all ips. Account is
+// email or id of user, it doesn't have to exist.
+func (s *Security) LimitAccount(c ctx.Context, action types.Action, account string) e.Error {
+	limit := accountLimits[action]
+
+	return s.security.Hit(c, action, "account:"+strings.ToLower(account), limit.count, limit.window)
+}
+
+// Check rejects login of user who is locked or has to wait after failed logins.
+func (s *Security) Check(c ctx.Context, userId string) e.Error {
+	lock, err := s.security.GetLock(c, userId)
+	if err != nil {
+		return err
+	}
+
+	if lock > 0 {
+		return lockedErr.WithMessage(retryMessage(lockedErr, lock))
+	}
+
+	delay, err := s.security.GetDelay(c, userId)
+	if err != nil {
+		return err
+	}
+
+	if delay > 0 {
+		return delayErr.WithMessage(retryMessage(delayErr, delay))
+	}
+
+	return nil
+}
+
+// Fail counts failed login. After few failures every next login is delayed twice
+// as long as previous one, then user is locked and lockout is recorded.
+func (s *Security) Fail(c ctx.Context, userId, ip string) e.Error {
+	failures, err := s.security.Fail(c, userId, failuresWindow)
+	if err != nil {
+		return err
+	}
+
+	if failures >= lockoutAfter {
+		event := &entity.SecurityEvent{
+			UserId:    userId,
+			Type:      types.LOCKOUT,
+			Ip:        ip,
+			ExpiresAt: time.Now().Add(lockoutDuration),
+		}
+
+		if err := s.security.Lock(c, event); err != nil {
+			return err
+		}
+
+		c.Logger().Warn("Account is locked after failed logins.", sl.StringAttr("user_id", userId))
+
+		return nil
+	}
+
+	if failures >= delayAfter {
+		return s.security.SetDelay(c, userId, progressiveDelay(failures))
+	}
+
+	return nil
+}
+
+// Succeed forgets failed logins of user.
+func (s *Security) Succeed(c ctx.Context, userId string) e.Error {
+	return s.security.Reset(c, userId)
+}
+
+func (s *Security) GetEvents(c ctx.Context, userId string, page, size int) ([]*entity.SecurityEvent, e.Error) {
+	return s.security.GetEvents(c, userId, size, page*size)
+}
+
+func (s *Security) GetLockouts(c ctx.Context) ([]*entity.SecurityEvent, e.Error) {
+	return s.security.GetLockouts(c)
+}
+
+// Unlock clears lockout of user before it expires.
+func (s *Security) Unlock(c ctx.Context, userId, adminId string) e.Error {
+	return s.security.Unlock(c, userId, adminId)
+}
+
+func progressiveDelay(failures int64) time.Duration {
+	delay := baseDelay * time.Duration(math.Pow(2, float64(failures-delayAfter)))
+
+	return min(delay, maxDelay)
+}
+
+func retryMessage(err e.Error, wait time.Duration) string {
+	seconds := int(math.Ceil(wait.Seconds()))
+
+	return fmt.Sprintf("%s Retry after %d seconds.", err.GetMessage(), seconds)
+}
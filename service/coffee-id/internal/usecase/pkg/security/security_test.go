@@ -0,0 +1,132 @@
+package security
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-playground/assert/v2"
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/nikitaSstepanov/tools/sl"
+)
+
+func TestFail(t *testing.T) {
+	store := &securityStorage{
+		failures: make(map[string]int64),
+		delays:   make(map[string]time.Duration),
+		locks:    make(map[string]time.Duration),
+	}
+
+	security := New(&Storages{Security: store})
+	ctx := ctx.New(sl.Default())
+
+	for range delayAfter - 1 {
+		if err := security.Fail(ctx, "user", "127.0.0.1"); err != nil {
+			t.Fatalf("Test failing: %v", err)
+		}
+	}
+
+	assert.Equal(t, security.Check(ctx, "user"), nil)
+
+	for range lockoutAfter - delayAfter {
+		if err := security.Fail(ctx, "user", "127.0.0.1"); err != nil {
+			t.Fatalf("Test failing: %v", err)
+		}
+
+		err := security.Check(ctx, "user")
+		assert.Equal(t, err.GetCode(), types.TooManyRequests)
+	}
+
+	assert.Equal(t, store.delays["user"], maxDelay)
+
+	if err := security.Fail(ctx, "user", "127.0.0.1"); err != nil {
+		t.Fatalf("Test failing: %v", err)
+	}
+
+	assert.Equal(t, len(store.events), 1)
+	assert.Equal(t, store.events[0].Type, types.LOCKOUT)
+
+	err := security.Check(ctx, "user")
+	assert.Equal(t, err.GetCode(), types.TooManyRequests)
+
+	if err := security.Unlock(ctx, "user", "admin"); err != nil {
+		t.Fatalf("Test failing: %v", err)
+	}
+
+	assert.Equal(t, security.Check(ctx, "user"), nil)
+
+	err = security.Unlock(ctx, "user", "admin")
+	assert.Equal(t, err.GetCode(), e.NotFound)
+}
+
+func TestProgressiveDelay(t *testing.T) {
+	assert.Equal(t, progressiveDelay(delayAfter), baseDelay)
+	assert.Equal(t, progressiveDelay(delayAfter+1), 2*baseDelay)
+	assert.Equal(t, progressiveDelay(delayAfter+2), 4*baseDelay)
+	assert.Equal(t, progressiveDelay(lockoutAfter), maxDelay)
+}
+
+type securityStorage struct {
+	failures map[string]int64
+	delays   map[string]time.Duration
+	locks    map[string]time.Duration
+	events   []*entity.SecurityEvent
+}
+
+func (s *securityStorage) Hit(c ctx.Context, action types.Action, key string, limit int64, window time.Duration) e.Error {
+	return nil
+}
+
+func (s *securityStorage) Fail(c ctx.Context, userId string, window time.Duration) (int64, e.Error) {
+	s.failures[userId]++
+
+	return s.failures[userId], nil
+}
+
+func (s *securityStorage) SetDelay(c ctx.Context, userId string, delay time.Duration) e.Error {
+	s.delays[userId] = delay
+
+	return nil
+}
+
+func (s *securityStorage) GetDelay(c ctx.Context, userId string) (time.Duration, e.Error) {
+	return s.delays[userId], nil
+}
+
+func (s *securityStorage) Reset(c ctx.Context, userId string) e.Error {
+	delete(s.failures, userId)
+	delete(s.delays, userId)
+
+	return nil
+}
+
+func (s *securityStorage) Lock(c ctx.Context, event *entity.SecurityEvent) e.Error {
+	s.events = append(s.events, event)
+	s.locks[event.UserId] = time.Until(event.ExpiresAt)
+
+	return s.Reset(c, event.UserId)
+}
+
+func (s *securityStorage) GetLock(c ctx.Context, userId string) (time.Duration, e.Error) {
+	return s.locks[userId], nil
+}
+
+func (s *securityStorage) Unlock(c ctx.Context, userId, adminId string) e.Error {
+	if _, ok := s.locks[userId]; !ok {
+		return e.New("This account isn`t locked.", e.NotFound)
+	}
+
+	delete(s.locks, userId)
+
+	return s.Reset(c, userId)
+}
+
+func (s *securityStorage) GetEvents(c ctx.Context, userId string, limit, offset int) ([]*entity.SecurityEvent, e.Error) {
+	return s.events, nil
+}
+
+func (s *securityStorage) GetLockouts(c ctx.Context) ([]*entity.SecurityEvent, e.Error) {
+	return s.events, nil
+}
@@ -0,0 +1,32 @@
+package security
+
+import (
+	"time"
+
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+type Storages struct {
+	Security SecurityStorage
+}
+
+type SecurityStorage interface {
+	Hit(c ctx.Context, action types.Action, key string, limit int64, window time.Duration) e.Error
+	Fail(c ctx.Context, userId string, window time.Duration) (int64, e.Error)
+	SetDelay(c ctx.Context, userId string, delay time.Duration) e.Error
+	GetDelay(c ctx.Context, userId string) (time.Duration, e.Error)
+	Reset(c ctx.Context, userId string) e.Error
+	Lock(c ctx.Context, event *entity.SecurityEvent) e.Error
+	GetLock(c ctx.Context, userId string) (time.Duration, e.Error)
+	Unlock(c ctx.Context, userId, adminId string) e.Error
+	GetEvents(c ctx.Context, userId string, limit, offset int) ([]*entity.SecurityEvent, e.Error)
+	GetLockouts(c ctx.Context) ([]*entity.SecurityEvent, e.Error)
+}
+
+type limit struct {
+	count  int64
+	window time.Duration
+}
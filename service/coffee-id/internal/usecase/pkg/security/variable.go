@@ -0,0 +1,36 @@
+package security
+
+import (
+	"time"
+
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+const (
+	failuresWindow  = time.Hour
+	delayAfter      = 3
+	baseDelay       = time.Second
+	maxDelay        = time.Minute
+	lockoutAfter    = 10
+	lockoutDuration = 15 * time.Minute
+)
+
+var (
+	ipLimits = map[types.Action]limit{
+		types.LOGIN:   {count: 20, window: time.Minute},
+		types.SIGNUP:  {count: 5, window: time.Hour},
+		types.REFRESH: {count: 60, window: time.Minute},
+	}
+
+	accountLimits = map[types.Action]limit{
+		types.LOGIN:   {count: 10, window: time.Minute},
+		types.SIGNUP:  {count: 3, window: time.Hour},
+		types.REFRESH: {count: 20, window: time.Minute},
+	}
+)
+
+var (
+	delayErr  = e.New("Too many failed logins, try again later.", types.TooManyRequests)
+	lockedErr = e.New("Account is temporarily locked after too many failed logins.", types.TooManyRequests)
+)
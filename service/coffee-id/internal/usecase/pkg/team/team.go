@@ -0,0 +1,108 @@
+package team
+
+import (
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+type Team struct {
+	team TeamStorage
+	user UserStorage
+}
+
+func New(store *Storages) *Team {
+	return &Team{
+		team: store.Team,
+		user: store.User,
+	}
+}
+
+func (t *Team) Get(c ctx.Context, id string) (*entity.Team, e.Error) {
+	return t.team.GetById(c, id)
+}
+
+func (t *Team) GetAll(c ctx.Context) ([]*entity.Team, e.Error) {
+	return t.team.GetAll(c)
+}
+
+func (t *Team) GetForUser(c ctx.Context, userId string) ([]*entity.Team, e.Error) {
+	if _, err := t.user.GetById(c, userId); err != nil {
+		return nil, err
+	}
+
+	return t.team.GetForUser(c, userId)
+}
+
+func (t *Team) Create(c ctx.Context, team *entity.Team) e.Error {
+	if err := t.checkName(c, team); err != nil {
+		return err
+	}
+
+	return t.team.Create(c, team)
+}
+
+func (t *Team) Update(c ctx.Context, team *entity.Team) (*entity.Team, e.Error) {
+	if _, err := t.team.GetById(c, team.Id); err != nil {
+		return nil, err
+	}
+
+	if err := t.checkName(c, team); err != nil {
+		return nil, err
+	}
+
+	if err := t.team.Update(c, team); err != nil {
+		return nil, err
+	}
+
+	return t.team.GetById(c, team.Id)
+}
+
+func (t *Team) Delete(c ctx.Context, id string) e.Error {
+	if _, err := t.team.GetById(c, id); err != nil {
+		return err
+	}
+
+	return t.team.Delete(c, id)
+}
+
+func (t *Team) AddMember(c ctx.Context, teamId, userId string) (*entity.Team, e.Error) {
+	if _, err := t.team.GetById(c, teamId); err != nil {
+		return nil, err
+	}
+
+	if _, err := t.user.GetById(c, userId); err != nil {
+		return nil, err
+	}
+
+	if err := t.team.AddMember(c, teamId, userId); err != nil {
+		return nil, err
+	}
+
+	return t.team.GetById(c, teamId)
+}
+
+func (t *Team) RemoveMember(c ctx.Context, teamId, userId string) (*entity.Team, e.Error) {
+	if _, err := t.team.GetById(c, teamId); err != nil {
+		return nil, err
+	}
+
+	if err := t.team.RemoveMember(c, teamId, userId); err != nil {
+		return nil, err
+	}
+
+	return t.team.GetById(c, teamId)
+}
+
+func (t *Team) checkName(c ctx.Context, team *entity.Team) e.Error {
+	candidate, err := t.team.GetByName(c, team.Name)
+	if err != nil && err.GetCode() != e.NotFound {
+		return err
+	}
+
+	if candidate != nil && candidate.Id != team.Id {
+		return conflictErr
+	}
+
+	return nil
+}
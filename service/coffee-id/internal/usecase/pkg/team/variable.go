@@ -0,0 +1,9 @@
+package team
+
+import (
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+var (
+	conflictErr = e.New("Team with this name already exist", e.Conflict)
+)
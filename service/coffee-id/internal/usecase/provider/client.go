@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+// oauthClient implements parts of OAuth 2.0 code flow shared by providers.
+type oauthClient struct {
+	cfg  Config
+	http *http.Client
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	IdToken     string `json:"id_token"`
+	TokenType   string `json:"token_type"`
+	Error       string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (o *oauthClient) authUrl(endpoint, state, challenge string, extra url.Values) string {
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {o.cfg.ClientId},
+		"redirect_uri":          {o.cfg.RedirectUri},
+		"state":                 {state},
+		"code_challenge":        {challenge},
+		"code_challenge_method": {"S256"},
+	}
+
+	if len(o.cfg.Scopes) != 0 {
+		query.Set("scope", strings.Join(o.cfg.Scopes, " "))
+	}
+
+	for key, values := range extra {
+		query[key] = values
+	}
+
+	separator := "?"
+	if strings.Contains(endpoint, "?") {
+		separator = "&"
+	}
+
+	return endpoint + separator + query.Encode()
+}
+
+func (o *oauthClient) exchange(c ctx.Context, endpoint, code, verifier string) (*tokenResponse, e.Error) {
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {o.cfg.RedirectUri},
+		"code_verifier": {verifier},
+		"client_id":     {o.cfg.ClientId},
+		"client_secret": {o.cfg.ClientSecret},
+	}
+
+	req, err := http.NewRequestWithContext(c, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, e.InternalErr.WithErr(err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	res, err := o.http.Do(req)
+	if err != nil {
+		return nil, providerErr.WithErr(err)
+	}
+	defer res.Body.Close()
+
+	var tokens tokenResponse
+
+	if err := json.NewDecoder(res.Body).Decode(&tokens); err != nil {
+		return nil, providerErr.WithErr(err)
+	}
+
+	if res.StatusCode != http.StatusOK || tokens.AccessToken == "" {
+		if tokens.Error == "invalid_grant" {
+			return nil, codeErr
+		}
+
+		return nil, providerErr
+	}
+
+	return &tokens, nil
+}
+
+func (o *oauthClient) getJson(c ctx.Context, endpoint, authorization string, v any) e.Error {
+	req, err := http.NewRequestWithContext(c, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return e.InternalErr.WithErr(err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	res, err := o.http.Do(req)
+	if err != nil {
+		return providerErr.WithErr(err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return providerErr
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		return providerErr.WithErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+// Oidc is any OpenID Connect provider. ID token is taken straight from token
+// endpoint over TLS, so its signature isn't checked (OpenID Connect Core 3.1.3.7).
+type Oidc struct {
+	client    *oauthClient
+	mu        sync.Mutex
+	discovery *discovery
+}
+
+type discovery struct {
+	Issuer      string `json:"issuer"`
+	AuthUrl     string `json:"authorization_endpoint"`
+	TokenUrl    string `json:"token_endpoint"`
+	UserInfoUrl string `json:"userinfo_endpoint"`
+}
+
+type oidcClaims struct {
+	Nonce         string `json:"nonce"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Name          string `json:"name"`
+	jwt.RegisteredClaims
+}
+
+type oidcInfo struct {
+	Subject       string `json:"sub"`
+	Email         string `json:"email"`
+	EmailVerified *bool  `json:"email_verified"`
+	Name          string `json:"name"`
+}
+
+func newOidc(client *oauthClient) *Oidc {
+	if len(client.cfg.Scopes) == 0 {
+		client.cfg.Scopes = []string{"openid", "email", "profile"}
+	}
+
+	return &Oidc{
+		client: client,
+	}
+}
+
+func (o *Oidc) Name() string {
+	return o.client.cfg.Name
+}
+
+func (o *Oidc) Type() types.OAuth {
+	return types.OIDC
+}
+
+func (o *Oidc) AuthUrl(c ctx.Context, state, nonce, challenge string) (string, e.Error) {
+	endpoints, err := o.endpoints(c)
+	if err != nil {
+		return "", err
+	}
+
+	extra := map[string][]string{
+		"nonce": {nonce},
+	}
+
+	return o.client.authUrl(endpoints.AuthUrl, state, challenge, extra), nil
+}
+
+func (o *Oidc) Exchange(c ctx.Context, code, verifier, nonce string) (*entity.ExternalUser, e.Error) {
+	endpoints, err := o.endpoints(c)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := o.client.exchange(c, endpoints.TokenUrl, code, verifier)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims oidcClaims
+
+	if _, _, err := jwt.NewParser().ParseUnverified(tokens.IdToken, &claims); err != nil {
+		return nil, idTokenErr.WithErr(err)
+	}
+
+	validator := jwt.NewValidator(
+		jwt.WithIssuer(endpoints.Issuer),
+		jwt.WithAudience(o.client.cfg.ClientId),
+		jwt.WithExpirationRequired(),
+	)
+
+	if err := validator.Validate(claims); err != nil {
+		return nil, idTokenErr.WithErr(err)
+	}
+
+	if claims.Subject == "" || claims.Nonce != nonce {
+		return nil, idTokenErr
+	}
+
+	user := &entity.ExternalUser{
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		Name:          claims.Name,
+	}
+
+	if endpoints.UserInfoUrl == "" {
+		return user, nil
+	}
+
+	var info oidcInfo
+
+	if err := o.client.getJson(c, endpoints.UserInfoUrl, "Bearer "+tokens.AccessToken, &info); err != nil {
+		return nil, err
+	}
+
+	if info.Subject != user.Subject {
+		return nil, idTokenErr
+	}
+
+	if info.Email != "" {
+		user.Email = info.Email
+		user.EmailVerified = info.EmailVerified != nil && *info.EmailVerified
+	}
+
+	if info.Name != "" {
+		user.Name = info.Name
+	}
+
+	return user, nil
+}
+
+// endpoints discovers provider once. Endpoints from config take precedence.
+func (o *Oidc) endpoints(c ctx.Context) (*discovery, e.Error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.discovery != nil {
+		return o.discovery, nil
+	}
+
+	cfg := o.client.cfg
+
+	var result discovery
+
+	if cfg.AuthUrl == "" || cfg.TokenUrl == "" {
+		uri := strings.TrimSuffix(cfg.Issuer, "/") + "/.well-known/openid-configuration"
+
+		if err := o.client.getJson(c, uri, "", &result); err != nil {
+			return nil, err
+		}
+
+		if result.Issuer != cfg.Issuer {
+			return nil, providerErr
+		}
+	}
+
+	result.Issuer = cfg.Issuer
+
+	if cfg.AuthUrl != "" {
+		result.AuthUrl = cfg.AuthUrl
+	}
+
+	if cfg.TokenUrl != "" {
+		result.TokenUrl = cfg.TokenUrl
+	}
+
+	if cfg.UserInfoUrl != "" {
+		result.UserInfoUrl = cfg.UserInfoUrl
+	}
+
+	if result.AuthUrl == "" || result.TokenUrl == "" {
+		return nil, providerErr
+	}
+
+	o.discovery = &result
+
+	return o.discovery, nil
+}
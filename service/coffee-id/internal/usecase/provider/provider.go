@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+// Provider is external identity provider users may sign in with.
+type Provider interface {
+	Name() string
+	Type() types.OAuth
+	// AuthUrl returns url of provider's login page. Code is returned to redirect uri
+	// of provider with state, PKCE challenge and nonce are checked on exchange.
+	AuthUrl(c ctx.Context, state, nonce, challenge string) (string, e.Error)
+	// Exchange exchanges code for profile of user.
+	Exchange(c ctx.Context, code, verifier, nonce string) (*entity.ExternalUser, e.Error)
+}
+
+// Config describes one provider. Type is "yandex" or "oidc". Endpoints of OIDC
+// provider are discovered from issuer, endpoints of both types may be overridden.
+// Client id and secret may reference environment variables, e.g. "${YANDEX_CLIENT_SECRET}".
+type Config struct {
+	Name         string        `yaml:"name"`
+	Type         string        `yaml:"type"`
+	ClientId     string        `yaml:"client_id"`
+	ClientSecret string        `yaml:"client_secret"`
+	RedirectUri  string        `yaml:"redirect_uri"`
+	Issuer       string        `yaml:"issuer"`
+	Scopes       []string      `yaml:"scopes"`
+	AuthUrl      string        `yaml:"auth_url"`
+	TokenUrl     string        `yaml:"token_url"`
+	UserInfoUrl  string        `yaml:"userinfo_url"`
+	Timeout      time.Duration `yaml:"timeout"`
+}
+
+// New creates providers by their names.
+func New(configs []Config) (map[string]Provider, error) {
+	providers := make(map[string]Provider, len(configs))
+
+	for _, cfg := range configs {
+		if cfg.Name == "" {
+			return nil, fmt.Errorf("provider name is required")
+		}
+
+		if _, ok := providers[cfg.Name]; ok {
+			return nil, fmt.Errorf("provider %s is configured twice", cfg.Name)
+		}
+
+		cfg.ClientId = os.ExpandEnv(cfg.ClientId)
+		cfg.ClientSecret = os.ExpandEnv(cfg.ClientSecret)
+
+		if cfg.Timeout == 0 {
+			cfg.Timeout = defaultTimeout
+		}
+
+		client := &oauthClient{
+			cfg:  cfg,
+			http: &http.Client{Timeout: cfg.Timeout},
+		}
+
+		switch cfg.Type {
+		case yandexType:
+			providers[cfg.Name] = newYandex(client)
+		case oidcType:
+			if cfg.Issuer == "" {
+				return nil, fmt.Errorf("issuer of provider %s is required", cfg.Name)
+			}
+
+			providers[cfg.Name] = newOidc(client)
+		default:
+			return nil, fmt.Errorf("unknown type %q of provider %s", cfg.Type, cfg.Name)
+		}
+	}
+
+	return providers, nil
+}
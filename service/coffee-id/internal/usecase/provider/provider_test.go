@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-playground/assert/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/nikitaSstepanov/tools/sl"
+)
+
+const (
+	testClientId = "coffee-id"
+	testSecret   = "secret"
+	testCode     = "code"
+	testVerifier = "verifier"
+	testSubject  = "42"
+)
+
+func TestOidc(t *testing.T) {
+	fake := newFakeProvider(t)
+
+	providers, err := New([]Config{{
+		Name:         "fake",
+		Type:         oidcType,
+		ClientId:     testClientId,
+		ClientSecret: testSecret,
+		RedirectUri:  "https://id.example.com/callback",
+		Issuer:       fake.server.URL,
+	}})
+	if err != nil {
+		t.Fatalf("Can`t create provider: %v", err)
+	}
+
+	provider := providers["fake"]
+	ctx := ctx.New(sl.Default())
+
+	uri, cerr := provider.AuthUrl(ctx, "state", "nonce", "challenge")
+	if cerr != nil {
+		t.Fatalf("Can`t get auth url: %v", cerr)
+	}
+
+	parsed, _ := url.Parse(uri)
+	assert.Equal(t, parsed.Path, "/authorize")
+	assert.Equal(t, parsed.Query().Get("client_id"), testClientId)
+	assert.Equal(t, parsed.Query().Get("nonce"), "nonce")
+	assert.Equal(t, parsed.Query().Get("code_challenge"), "challenge")
+	assert.Equal(t, parsed.Query().Get("scope"), "openid email profile")
+
+	tests := []struct {
+		TestName    string
+		Code        string
+		Nonce       string
+		IsError     bool
+		ErrorStatus e.StatusType
+	}{
+		{
+			TestName: "Success",
+			Code:     testCode,
+			Nonce:    "nonce",
+			IsError:  false,
+		},
+		{
+			TestName:    "Wrong code",
+			Code:        "wrong",
+			Nonce:       "nonce",
+			IsError:     true,
+			ErrorStatus: e.BadInput,
+		},
+		{
+			TestName:    "Wrong nonce",
+			Code:        testCode,
+			Nonce:       "other",
+			IsError:     true,
+			ErrorStatus: e.BadInput,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.TestName, func(t *testing.T) {
+			fake.nonce = "nonce"
+
+			user, err := provider.Exchange(ctx, tc.Code, testVerifier, tc.Nonce)
+			if err != nil {
+				if tc.IsError {
+					assert.Equal(t, tc.ErrorStatus, err.GetCode())
+				} else {
+					t.Errorf("Test failing: %v", err)
+				}
+				return
+			} else if tc.IsError {
+				t.Fatal("Test failing: expected not nil error")
+			}
+
+			assert.Equal(t, user.Subject, testSubject)
+			assert.Equal(t, user.Email, "user@example.com")
+			assert.Equal(t, user.EmailVerified, true)
+			assert.Equal(t, user.Name, "User")
+		})
+	}
+}
+
+func TestYandex(t *testing.T) {
+	fake := newFakeProvider(t)
+
+	providers, err := New([]Config{{
+		Name:         "yandex",
+		Type:         yandexType,
+		ClientId:     testClientId,
+		ClientSecret: testSecret,
+		AuthUrl:      fake.server.URL + "/authorize",
+		TokenUrl:     fake.server.URL + "/token",
+		UserInfoUrl:  fake.server.URL + "/info",
+	}})
+	if err != nil {
+		t.Fatalf("Can`t create provider: %v", err)
+	}
+
+	user, cerr := providers["yandex"].Exchange(ctx.New(sl.Default()), testCode, testVerifier, "")
+	if cerr != nil {
+		t.Fatalf("Exchange failed: %v", cerr)
+	}
+
+	assert.Equal(t, user.Subject, testSubject)
+	assert.Equal(t, user.Email, "user@yandex.ru")
+	assert.Equal(t, user.EmailVerified, true)
+	assert.Equal(t, user.Name, "Yandex User")
+	assert.Equal(t, user.Birthday.Format(time.DateOnly), "2000-01-02")
+}
+
+func TestNew(t *testing.T) {
+	configs := [][]Config{
+		{{Name: "fake", Type: "saml"}},
+		{{Name: "fake", Type: oidcType}},
+		{{Name: "fake", Type: yandexType}, {Name: "fake", Type: yandexType}},
+	}
+
+	for _, cfg := range configs {
+		if _, err := New(cfg); err == nil {
+			t.Errorf("Test failing: expected not nil error for %v", cfg)
+		}
+	}
+}
+
+// fakeProvider is OpenID Connect provider and Yandex ID served locally.
+type fakeProvider struct {
+	server *httptest.Server
+	nonce  string
+}
+
+func newFakeProvider(t *testing.T) *fakeProvider {
+	fake := &fakeProvider{}
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJson(w, http.StatusOK, map[string]string{
+			"issuer":                 fake.server.URL,
+			"authorization_endpoint": fake.server.URL + "/authorize",
+			"token_endpoint":         fake.server.URL + "/token",
+			"userinfo_endpoint":      fake.server.URL + "/userinfo",
+		})
+	})
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+
+		if r.Form.Get("client_id") != testClientId || r.Form.Get("client_secret") != testSecret {
+			writeJson(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+			return
+		}
+
+		if r.Form.Get("code") != testCode || r.Form.Get("code_verifier") != testVerifier {
+			writeJson(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+			return
+		}
+
+		idToken, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"iss":   fake.server.URL,
+			"aud":   testClientId,
+			"sub":   testSubject,
+			"exp":   time.Now().Add(time.Minute).Unix(),
+			"nonce": fake.nonce,
+		}).SignedString([]byte("key"))
+
+		writeJson(w, http.StatusOK, map[string]string{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"id_token":     idToken,
+		})
+	})
+
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer access" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		writeJson(w, http.StatusOK, map[string]any{
+			"sub":            testSubject,
+			"email":          "user@example.com",
+			"email_verified": true,
+			"name":           "User",
+		})
+	})
+
+	mux.HandleFunc("/info", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "OAuth access" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		writeJson(w, http.StatusOK, map[string]string{
+			"id":            testSubject,
+			"default_email": "user@yandex.ru",
+			"real_name":     "Yandex User",
+			"birthday":      "2000-01-02",
+		})
+	})
+
+	fake.server = httptest.NewServer(mux)
+	t.Cleanup(fake.server.Close)
+
+	return fake
+}
+
+func writeJson(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
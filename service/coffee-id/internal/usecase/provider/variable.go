@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"time"
+
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+const (
+	yandexType     = "yandex"
+	oidcType       = "oidc"
+	defaultTimeout = 5 * time.Second
+
+	yandexAuthUrl     = "https://oauth.yandex.ru/authorize"
+	yandexTokenUrl    = "https://oauth.yandex.ru/token"
+	yandexUserInfoUrl = "https://login.yandex.ru/info?format=json"
+)
+
+var (
+	providerErr = e.New("External provider is unavailable.", e.Internal)
+	codeErr     = e.New("External provider rejected authorization code.", e.BadInput)
+	idTokenErr  = e.New("External provider returned invalid ID token.", e.BadInput)
+)
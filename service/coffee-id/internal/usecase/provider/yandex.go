@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+// Yandex is Yandex ID. It isn't OpenID Connect provider, so profile is read from
+// its own info endpoint. Emails of Yandex accounts are confirmed.
+type Yandex struct {
+	client *oauthClient
+}
+
+type yandexInfo struct {
+	Id           string `json:"id"`
+	DefaultEmail string `json:"default_email"`
+	RealName     string `json:"real_name"`
+	DisplayName  string `json:"display_name"`
+	Birthday     string `json:"birthday"`
+}
+
+func newYandex(client *oauthClient) *Yandex {
+	if client.cfg.AuthUrl == "" {
+		client.cfg.AuthUrl = yandexAuthUrl
+	}
+
+	if client.cfg.TokenUrl == "" {
+		client.cfg.TokenUrl = yandexTokenUrl
+	}
+
+	if client.cfg.UserInfoUrl == "" {
+		client.cfg.UserInfoUrl = yandexUserInfoUrl
+	}
+
+	return &Yandex{
+		client: client,
+	}
+}
+
+func (y *Yandex) Name() string {
+	return y.client.cfg.Name
+}
+
+func (y *Yandex) Type() types.OAuth {
+	return types.YANDEX
+}
+
+func (y *Yandex) AuthUrl(c ctx.Context, state, nonce, challenge string) (string, e.Error) {
+	return y.client.authUrl(y.client.cfg.AuthUrl, state, challenge, nil), nil
+}
+
+func (y *Yandex) Exchange(c ctx.Context, code, verifier, nonce string) (*entity.ExternalUser, e.Error) {
+	tokens, err := y.client.exchange(c, y.client.cfg.TokenUrl, code, verifier)
+	if err != nil {
+		return nil, err
+	}
+
+	var info yandexInfo
+
+	if err := y.client.getJson(c, y.client.cfg.UserInfoUrl, "OAuth "+tokens.AccessToken, &info); err != nil {
+		return nil, err
+	}
+
+	if info.Id == "" {
+		return nil, providerErr
+	}
+
+	user := &entity.ExternalUser{
+		Subject:       info.Id,
+		Email:         info.DefaultEmail,
+		EmailVerified: info.DefaultEmail != "",
+		Name:          info.RealName,
+	}
+
+	if user.Name == "" {
+		user.Name = info.DisplayName
+	}
+
+	if birthday, err := time.Parse(time.DateOnly, info.Birthday); err == nil {
+		user.Birthday = birthday
+	}
+
+	return user, nil
+}
@@ -2,9 +2,11 @@ package activation_code
 
 import (
 	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
 	rs "github.com/nikitaSstepanov/tools/client/redis"
 	"github.com/nikitaSstepanov/tools/ctx"
 	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/redis/go-redis/v9"
 )
 
 type Code struct {
@@ -17,10 +19,10 @@ func New(redis rs.Client) *Code {
 	}
 }
 
-func (c *Code) Get(ctx ctx.Context, userId uint64) (*entity.ActivationCode, e.Error) {
+func (c *Code) Get(ctx ctx.Context, codeType types.CodeType, userId string) (*entity.ActivationCode, e.Error) {
 	var result entity.ActivationCode
 
-	err := c.redis.Get(ctx, redisKey(userId)).Scan(&result)
+	err := c.redis.Get(ctx, redisKey(codeType, userId)).Scan(&result)
 	if err != nil {
 		if err == rs.Nil {
 			return nil, notFoundErr.
@@ -36,8 +38,25 @@ func (c *Code) Get(ctx ctx.Context, userId uint64) (*entity.ActivationCode, e.Er
 	return &result, nil
 }
 
+// Set replaces previous code of the same type, so only the last sent code works.
 func (c *Code) Set(ctx ctx.Context, code *entity.ActivationCode) e.Error {
-	err := c.redis.Set(ctx, redisKey(code.UserId), code, redisExpires).Err()
+	_, err := c.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, redisKey(code.Type, code.UserId), code, codeExpires[code.Type])
+		pipe.Del(ctx, attemptsKey(code.Type, code.UserId))
+
+		return nil
+	})
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return nil
+}
+
+func (c *Code) Del(ctx ctx.Context, codeType types.CodeType, userId string) e.Error {
+	err := c.redis.Del(ctx, redisKey(codeType, userId), attemptsKey(codeType, userId)).Err()
 	if err != nil {
 		return e.InternalErr.
 			WithErr(err).
@@ -47,13 +66,71 @@ func (c *Code) Set(ctx ctx.Context, code *entity.ActivationCode) e.Error {
 	return nil
 }
 
-func (c *Code) Del(ctx ctx.Context, userId uint64) e.Error {
-	err := c.redis.Del(ctx, redisKey(userId)).Err()
+// Attempt counts check of code. Code is deleted after too many wrong attempts,
+// so it can't be guessed.
+func (c *Code) Attempt(ctx ctx.Context, codeType types.CodeType, userId string) e.Error {
+	key := attemptsKey(codeType, userId)
+
+	attempts, err := c.redis.Incr(ctx, key).Result()
 	if err != nil {
 		return e.InternalErr.
 			WithErr(err).
 			WithCtx(ctx)
 	}
 
+	if attempts == 1 {
+		if err := c.redis.Expire(ctx, key, codeExpires[codeType]).Err(); err != nil {
+			return e.InternalErr.
+				WithErr(err).
+				WithCtx(ctx)
+		}
+	}
+
+	if attempts > maxAttempts {
+		if err := c.Del(ctx, codeType, userId); err != nil {
+			return err
+		}
+
+		return attemptsErr.WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// Throttle limits how often codes are sent to user: one per cooldown and
+// limited number per hour.
+func (c *Code) Throttle(ctx ctx.Context, codeType types.CodeType, userId string) e.Error {
+	ok, err := c.redis.SetNX(ctx, cooldownKey(codeType, userId), 1, sendCooldown).Result()
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	if !ok {
+		return throttleErr.WithCtx(ctx)
+	}
+
+	key := sendsKey(codeType, userId)
+
+	sends, err := c.redis.Incr(ctx, key).Result()
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	if sends == 1 {
+		if err := c.redis.Expire(ctx, key, sendsWindow).Err(); err != nil {
+			return e.InternalErr.
+				WithErr(err).
+				WithCtx(ctx)
+		}
+	}
+
+	if sends > maxSends {
+		return throttleErr.WithCtx(ctx)
+	}
+
 	return nil
 }
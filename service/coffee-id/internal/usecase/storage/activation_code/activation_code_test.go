@@ -8,6 +8,7 @@ import (
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/go-playground/assert/v2"
 	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
 	"github.com/nikitaSstepanov/tools/ctx"
 	e "github.com/nikitaSstepanov/tools/error"
 	"github.com/nikitaSstepanov/tools/sl"
@@ -24,7 +25,8 @@ func TestGet(t *testing.T) {
 
 	code := &entity.ActivationCode{
 		Code:   gofakeit.DigitN(6),
-		UserId: gofakeit.Uint64(),
+		UserId: gofakeit.UUID(),
+		Type:   types.VERIFICATION,
 	}
 
 	err = repo.Set(ctx, code)
@@ -35,7 +37,7 @@ func TestGet(t *testing.T) {
 	tests := []struct {
 		TestName    string
 		Code        string
-		UserId      uint64
+		UserId      string
 		IsError     bool
 		ErrorStatus e.StatusType
 	}{
@@ -47,7 +49,7 @@ func TestGet(t *testing.T) {
 		},
 		{
 			TestName:    "Code not found",
-			UserId:      code.UserId + 1,
+			UserId:      gofakeit.UUID(),
 			IsError:     true,
 			ErrorStatus: e.NotFound,
 		},
@@ -57,7 +59,7 @@ func TestGet(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.TestName, func(t *testing.T) {
-			code, err := repo.Get(ctx, tc.UserId)
+			code, err := repo.Get(ctx, types.VERIFICATION, tc.UserId)
 			if err != nil {
 				if tc.IsError {
 					assert.Equal(t, tc.ErrorStatus, err.GetCode())
@@ -86,14 +88,14 @@ func TestSet(t *testing.T) {
 	tests := []struct {
 		TestName    string
 		Code        string
-		UserId      uint64
+		UserId      string
 		IsError     bool
 		ErrorStatus e.StatusType
 	}{
 		{
 			TestName: "Success",
 			Code:     gofakeit.DigitN(6),
-			UserId:   gofakeit.Uint64(),
+			UserId:   gofakeit.UUID(),
 			IsError:  false,
 		},
 
@@ -105,6 +107,7 @@ func TestSet(t *testing.T) {
 			code := &entity.ActivationCode{
 				Code:   tc.Code,
 				UserId: tc.UserId,
+				Type:   types.VERIFICATION,
 			}
 
 			err := repo.Set(ctx, code)
@@ -118,7 +121,7 @@ func TestSet(t *testing.T) {
 				if tc.IsError {
 					t.Error("Test failing: expected not nil error")
 				} else {
-					code, err := repo.Get(ctx, tc.UserId)
+					code, err := repo.Get(ctx, types.VERIFICATION, tc.UserId)
 					if err != nil {
 						t.Errorf("Test failing: %v", err)
 					}
@@ -141,14 +144,14 @@ func TestDel(t *testing.T) {
 	tests := []struct {
 		TestName    string
 		Code        string
-		UserId      uint64
+		UserId      string
 		IsError     bool
 		ErrorStatus e.StatusType
 	}{
 		{
 			TestName: "Success",
 			Code:     gofakeit.DigitN(6),
-			UserId:   gofakeit.Uint64(),
+			UserId:   gofakeit.UUID(),
 			IsError:  false,
 		},
 
@@ -160,6 +163,7 @@ func TestDel(t *testing.T) {
 			code := &entity.ActivationCode{
 				Code:   tc.Code,
 				UserId: tc.UserId,
+				Type:   types.VERIFICATION,
 			}
 
 			err := repo.Set(ctx, code)
@@ -167,7 +171,7 @@ func TestDel(t *testing.T) {
 				t.Errorf("Test failing: %v", err)
 			}
 
-			err = repo.Del(ctx, tc.UserId)
+			err = repo.Del(ctx, types.VERIFICATION, tc.UserId)
 			if err != nil {
 				if tc.IsError {
 					assert.Equal(t, tc.ErrorStatus, err.GetCode())
@@ -178,7 +182,7 @@ func TestDel(t *testing.T) {
 				if tc.IsError {
 					t.Error("Test failing: expected not nil error")
 				} else {
-					_, err := repo.Get(ctx, tc.UserId)
+					_, err := repo.Get(ctx, types.VERIFICATION, tc.UserId)
 					if err == nil || err.GetCode() != e.NotFound {
 						t.Errorf("Test failing: %v", err)
 					}
@@ -188,10 +192,63 @@ func TestDel(t *testing.T) {
 	}
 }
 
+func TestAttempt(t *testing.T) {
+	repo, err := setupRepo()
+	if err != nil {
+		t.Errorf("Can`t setup code repo: %v", err)
+	}
+
+	ctx := ctx.New(sl.Default())
+
+	code := &entity.ActivationCode{
+		Code:   gofakeit.DigitN(6),
+		UserId: gofakeit.UUID(),
+		Type:   types.PASSWORD_RESET,
+	}
+
+	if err := repo.Set(ctx, code); err != nil {
+		t.Errorf("Can`t set code for tests: %v", err)
+	}
+
+	for range maxAttempts {
+		if err := repo.Attempt(ctx, code.Type, code.UserId); err != nil {
+			t.Errorf("Test failing: %v", err)
+		}
+	}
+
+	err = repo.Attempt(ctx, code.Type, code.UserId)
+	assert.Equal(t, err.GetCode(), types.TooManyRequests)
+
+	_, err = repo.Get(ctx, code.Type, code.UserId)
+	assert.Equal(t, err.GetCode(), e.NotFound)
+}
+
+func TestThrottle(t *testing.T) {
+	repo, err := setupRepo()
+	if err != nil {
+		t.Errorf("Can`t setup code repo: %v", err)
+	}
+
+	ctx := ctx.New(sl.Default())
+	userId := gofakeit.UUID()
+
+	if err := repo.Throttle(ctx, types.VERIFICATION, userId); err != nil {
+		t.Errorf("Test failing: %v", err)
+	}
+
+	err = repo.Throttle(ctx, types.VERIFICATION, userId)
+	assert.Equal(t, err.GetCode(), types.TooManyRequests)
+
+	// Codes of other type are limited separately.
+	if err := repo.Throttle(ctx, types.PASSWORD_RESET, userId); err != nil {
+		t.Errorf("Test failing: %v", err)
+	}
+}
+
 func TestRedisKey(t *testing.T) {
-	userId := gofakeit.Uint64()
-	key := redisKey(userId)
-	excpected := fmt.Sprintf("codes:%d", userId)
+	userId := gofakeit.UUID()
+	key := redisKey(types.VERIFICATION, userId)
+	excpected := fmt.Sprintf("codes:VERIFICATION:%s", userId)
 
 	assert.Equal(t, excpected, key)
 }
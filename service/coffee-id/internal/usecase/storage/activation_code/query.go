@@ -1,7 +1,23 @@
 package activation_code
 
-import "fmt"
+import (
+	"fmt"
 
-func redisKey(userId uint64) string {
-	return fmt.Sprintf("codes:%d", userId)
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+)
+
+func redisKey(codeType types.CodeType, userId string) string {
+	return fmt.Sprintf("codes:%s:%s", codeType, userId)
+}
+
+func attemptsKey(codeType types.CodeType, userId string) string {
+	return fmt.Sprintf("code_attempts:%s:%s", codeType, userId)
+}
+
+func cooldownKey(codeType types.CodeType, userId string) string {
+	return fmt.Sprintf("code_cooldowns:%s:%s", codeType, userId)
+}
+
+func sendsKey(codeType types.CodeType, userId string) string {
+	return fmt.Sprintf("code_sends:%s:%s", codeType, userId)
 }
@@ -3,13 +3,26 @@ package activation_code
 import (
 	"time"
 
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
 	e "github.com/nikitaSstepanov/tools/error"
 )
 
 const (
-	redisExpires = 5 * time.Minute
+	maxAttempts  = 5
+	maxSends     = 5
+	sendCooldown = time.Minute
+	sendsWindow  = time.Hour
+)
+
+var (
+	codeExpires = map[types.CodeType]time.Duration{
+		types.VERIFICATION:   30 * time.Minute,
+		types.PASSWORD_RESET: 15 * time.Minute,
+	}
 )
 
 var (
 	notFoundErr = e.New("This code wasn`t found.", e.NotFound)
+	attemptsErr = e.New("Too many wrong attempts, request new code.", types.TooManyRequests)
+	throttleErr = e.New("Code was sent recently, try again later.", types.TooManyRequests)
 )
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	"github.com/nikitaSstepanov/tools/client/pg"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/nikitaSstepanov/tools/sl"
+)
+
+type Client struct {
+	postgres pg.Client
+}
+
+func New(postgres pg.Client) *Client {
+	return &Client{
+		postgres,
+	}
+}
+
+func (c *Client) GetById(ctx ctx.Context, id string) (*entity.Client, e.Error) {
+	return c.getOne(ctx, idQuery(), id)
+}
+
+func (c *Client) GetByClientId(ctx ctx.Context, clientId string) (*entity.Client, e.Error) {
+	return c.getOne(ctx, clientIdQuery(), clientId)
+}
+
+func (c *Client) GetAll(ctx ctx.Context) ([]*entity.Client, e.Error) {
+	rows, err := c.postgres.Query(ctx, allQuery())
+	if err != nil {
+		return nil, e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+	defer rows.Close()
+
+	clients := make([]*entity.Client, 0)
+
+	for rows.Next() {
+		var client entity.Client
+
+		if err := client.Scan(rows); err != nil {
+			return nil, e.InternalErr.
+				WithErr(err).
+				WithCtx(ctx)
+		}
+
+		clients = append(clients, &client)
+	}
+
+	return clients, nil
+}
+
+func (c *Client) Create(ctx ctx.Context, client *entity.Client) e.Error {
+	log := ctx.Logger()
+
+	tx, err := c.postgres.Begin(ctx)
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	fields := make([]string, 0, len(client.Fields))
+	for _, field := range client.Fields {
+		fields = append(fields, string(field))
+	}
+
+	row := tx.QueryRow(
+		ctx, createQuery(),
+		client.Name, client.ClientId, client.Secret, client.RedirectUri, fields, client.UserId,
+	)
+
+	if err := row.Scan(&client.Id, &client.CreatedAt); err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		if err := tx.Rollback(ctx); err != nil {
+			log.Warn("transaction failed to rollback", sl.ErrAttr(err))
+		}
+
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return nil
+}
+
+func (c *Client) Delete(ctx ctx.Context, id string) e.Error {
+	if _, err := c.postgres.Exec(ctx, deleteQuery(), id); err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return nil
+}
+
+func (c *Client) getOne(ctx ctx.Context, query string, args ...any) (*entity.Client, e.Error) {
+	var client entity.Client
+
+	row := c.postgres.QueryRow(ctx, query, args...)
+
+	if err := client.Scan(row); err != nil {
+		if err == pg.ErrNoRows {
+			return nil, notFoundErr.
+				WithErr(err).
+				WithCtx(ctx)
+		}
+
+		return nil, e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return &client, nil
+}
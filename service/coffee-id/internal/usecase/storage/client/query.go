@@ -0,0 +1,51 @@
+package client
+
+import "fmt"
+
+func idQuery() string {
+	return fmt.Sprintf(
+		`
+			SELECT * FROM %s 
+			WHERE id = $1;
+		`, clientsTable,
+	)
+}
+
+func clientIdQuery() string {
+	return fmt.Sprintf(
+		`
+			SELECT * FROM %s 
+			WHERE client_id = $1;
+		`, clientsTable,
+	)
+}
+
+func allQuery() string {
+	return fmt.Sprintf(
+		`
+			SELECT * FROM %s 
+			ORDER BY created_at;
+		`, clientsTable,
+	)
+}
+
+func createQuery() string {
+	return fmt.Sprintf(
+		`
+			INSERT INTO %s 
+				(name, client_id, secret, redirect_uri, fields, user_id) 
+			VALUES 
+				($1, $2, $3, $4, $5::account_field[], NULLIF($6, '')::uuid) 
+			RETURNING id, created_at;
+		`, clientsTable,
+	)
+}
+
+func deleteQuery() string {
+	return fmt.Sprintf(
+		`
+			DELETE FROM %s 
+			WHERE id = $1;
+		`, clientsTable,
+	)
+}
@@ -0,0 +1,123 @@
+package identity
+
+import (
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	"github.com/nikitaSstepanov/tools/client/pg"
+	rs "github.com/nikitaSstepanov/tools/client/redis"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+type Identity struct {
+	postgres pg.Client
+	redis    rs.Client
+}
+
+func New(postgres pg.Client, redis rs.Client) *Identity {
+	return &Identity{
+		postgres,
+		redis,
+	}
+}
+
+func (i *Identity) Get(ctx ctx.Context, provider, subject string) (*entity.Identity, e.Error) {
+	var identity entity.Identity
+
+	row := i.postgres.QueryRow(ctx, subjectQuery(), provider, subject)
+
+	if err := identity.Scan(row); err != nil {
+		if err == pg.ErrNoRows {
+			return nil, notFoundErr.
+				WithErr(err).
+				WithCtx(ctx)
+		}
+
+		return nil, e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return &identity, nil
+}
+
+func (i *Identity) GetForUser(ctx ctx.Context, userId string) ([]*entity.Identity, e.Error) {
+	rows, err := i.postgres.Query(ctx, userQuery(), userId)
+	if err != nil {
+		return nil, e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+	defer rows.Close()
+
+	identities := make([]*entity.Identity, 0)
+
+	for rows.Next() {
+		var identity entity.Identity
+
+		if err := identity.Scan(rows); err != nil {
+			return nil, e.InternalErr.
+				WithErr(err).
+				WithCtx(ctx)
+		}
+
+		identities = append(identities, &identity)
+	}
+
+	return identities, nil
+}
+
+func (i *Identity) Create(ctx ctx.Context, identity *entity.Identity) e.Error {
+	row := i.postgres.QueryRow(
+		ctx, createQuery(),
+		identity.Provider, identity.Subject, identity.UserId, identity.Email,
+	)
+
+	if err := row.Scan(&identity.CreatedAt); err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return nil
+}
+
+func (i *Identity) Delete(ctx ctx.Context, provider, userId string) e.Error {
+	if _, err := i.postgres.Exec(ctx, deleteQuery(), provider, userId); err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return nil
+}
+
+func (i *Identity) SetState(ctx ctx.Context, state *entity.ExternalState) e.Error {
+	err := i.redis.Set(ctx, stateKey(state.State), state, stateExpires).Err()
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// TakeState returns state and removes it, so callback can't be replayed.
+func (i *Identity) TakeState(ctx ctx.Context, state string) (*entity.ExternalState, e.Error) {
+	var result entity.ExternalState
+
+	err := i.redis.GetDel(ctx, stateKey(state)).Scan(&result)
+	if err != nil {
+		if err == rs.Nil {
+			return nil, stateNotFoundErr.
+				WithErr(err).
+				WithCtx(ctx)
+		}
+
+		return nil, e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,47 @@
+package identity
+
+import "fmt"
+
+func subjectQuery() string {
+	return fmt.Sprintf(
+		`
+			SELECT * FROM %s 
+			WHERE provider = $1 AND subject = $2;
+		`, identitiesTable,
+	)
+}
+
+func userQuery() string {
+	return fmt.Sprintf(
+		`
+			SELECT * FROM %s 
+			WHERE user_id = $1 
+			ORDER BY created_at;
+		`, identitiesTable,
+	)
+}
+
+func createQuery() string {
+	return fmt.Sprintf(
+		`
+			INSERT INTO %s 
+				(provider, subject, user_id, email) 
+			VALUES 
+				($1, $2, $3, NULLIF($4, '')) 
+			RETURNING created_at;
+		`, identitiesTable,
+	)
+}
+
+func deleteQuery() string {
+	return fmt.Sprintf(
+		`
+			DELETE FROM %s 
+			WHERE provider = $1 AND user_id = $2;
+		`, identitiesTable,
+	)
+}
+
+func stateKey(state string) string {
+	return fmt.Sprintf("external_states:%s", state)
+}
@@ -0,0 +1,17 @@
+package identity
+
+import (
+	"time"
+
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+const (
+	identitiesTable = "identities"
+	stateExpires    = 10 * time.Minute
+)
+
+var (
+	notFoundErr      = e.New("This identity wasn`t found.", e.NotFound)
+	stateNotFoundErr = e.New("Login request is expired or invalid.", e.NotFound)
+)
@@ -0,0 +1,90 @@
+package oauth
+
+import (
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	rs "github.com/nikitaSstepanov/tools/client/redis"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+type OAuth struct {
+	redis rs.Client
+}
+
+func New(redis rs.Client) *OAuth {
+	return &OAuth{
+		redis,
+	}
+}
+
+func (o *OAuth) GetSession(ctx ctx.Context, id string) (*entity.OAuthSession, e.Error) {
+	var session entity.OAuthSession
+
+	err := o.redis.Get(ctx, sessionKey(id)).Scan(&session)
+	if err != nil {
+		if err == rs.Nil {
+			return nil, sessionNotFoundErr.
+				WithErr(err).
+				WithCtx(ctx)
+		}
+
+		return nil, e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return &session, nil
+}
+
+func (o *OAuth) SetSession(ctx ctx.Context, session *entity.OAuthSession) e.Error {
+	err := o.redis.Set(ctx, sessionKey(session.Id), session, sessionExpires).Err()
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return nil
+}
+
+func (o *OAuth) DelSession(ctx ctx.Context, id string) e.Error {
+	err := o.redis.Del(ctx, sessionKey(id)).Err()
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return nil
+}
+
+func (o *OAuth) SetCode(ctx ctx.Context, code *entity.OAuthCode) e.Error {
+	err := o.redis.Set(ctx, codeKey(code.Code), code, codeExpires).Err()
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// TakeCode returns code and removes it atomically, so code can be exchanged only once.
+func (o *OAuth) TakeCode(ctx ctx.Context, code string) (*entity.OAuthCode, e.Error) {
+	var result entity.OAuthCode
+
+	err := o.redis.GetDel(ctx, codeKey(code)).Scan(&result)
+	if err != nil {
+		if err == rs.Nil {
+			return nil, codeNotFoundErr.
+				WithErr(err).
+				WithCtx(ctx)
+		}
+
+		return nil, e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return &result, nil
+}
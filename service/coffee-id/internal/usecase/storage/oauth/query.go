@@ -0,0 +1,11 @@
+package oauth
+
+import "fmt"
+
+func sessionKey(id string) string {
+	return fmt.Sprintf("oauth_sessions:%s", id)
+}
+
+func codeKey(code string) string {
+	return fmt.Sprintf("oauth_codes:%s", code)
+}
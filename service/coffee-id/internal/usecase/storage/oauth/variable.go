@@ -0,0 +1,17 @@
+package oauth
+
+import (
+	"time"
+
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+const (
+	sessionExpires = 10 * time.Minute
+	codeExpires    = time.Minute
+)
+
+var (
+	sessionNotFoundErr = e.New("This authorization request wasn`t found.", e.NotFound)
+	codeNotFoundErr    = e.New("Authorization code is invalid or expired.", e.NotFound)
+)
@@ -0,0 +1,132 @@
+package permission
+
+import (
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	"github.com/nikitaSstepanov/tools/client/pg"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/nikitaSstepanov/tools/sl"
+)
+
+type Permission struct {
+	postgres pg.Client
+}
+
+func New(postgres pg.Client) *Permission {
+	return &Permission{
+		postgres,
+	}
+}
+
+func (p *Permission) GetForRole(ctx ctx.Context, role types.Role) ([]types.Permission, e.Error) {
+	rows, err := p.postgres.Query(ctx, roleQuery(), role)
+	if err != nil {
+		return nil, e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+	defer rows.Close()
+
+	permissions := make([]types.Permission, 0)
+
+	for rows.Next() {
+		var permission types.Permission
+
+		if err := rows.Scan(&permission); err != nil {
+			return nil, e.InternalErr.
+				WithErr(err).
+				WithCtx(ctx)
+		}
+
+		permissions = append(permissions, permission)
+	}
+
+	return permissions, nil
+}
+
+func (p *Permission) GetAll(ctx ctx.Context) ([]*entity.RolePermissions, e.Error) {
+	rows, err := p.postgres.Query(ctx, allQuery())
+	if err != nil {
+		return nil, e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+	defer rows.Close()
+
+	byRole := make(map[types.Role][]types.Permission)
+
+	for rows.Next() {
+		var role types.Role
+		var permission types.Permission
+
+		if err := rows.Scan(&role, &permission); err != nil {
+			return nil, e.InternalErr.
+				WithErr(err).
+				WithCtx(ctx)
+		}
+
+		byRole[role] = append(byRole[role], permission)
+	}
+
+	roles := make([]*entity.RolePermissions, 0, len(types.Roles))
+
+	for _, role := range types.Roles {
+		permissions := byRole[role]
+		if permissions == nil {
+			permissions = make([]types.Permission, 0)
+		}
+
+		roles = append(roles, &entity.RolePermissions{
+			Role:        role,
+			Permissions: permissions,
+		})
+	}
+
+	return roles, nil
+}
+
+func (p *Permission) SetForRole(ctx ctx.Context, role *entity.RolePermissions) e.Error {
+	log := ctx.Logger()
+
+	tx, err := p.postgres.Begin(ctx)
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	rollback := func() {
+		if err := tx.Rollback(ctx); err != nil {
+			log.Warn("transaction failed to rollback", sl.ErrAttr(err))
+		}
+	}
+
+	if _, err := tx.Exec(ctx, deleteQuery(), role.Role); err != nil {
+		rollback()
+
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	for _, permission := range role.Permissions {
+		if _, err := tx.Exec(ctx, insertQuery(), role.Role, permission); err != nil {
+			rollback()
+
+			return e.InternalErr.
+				WithErr(err).
+				WithCtx(ctx)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		rollback()
+
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return nil
+}
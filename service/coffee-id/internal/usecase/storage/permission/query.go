@@ -0,0 +1,43 @@
+package permission
+
+import "fmt"
+
+func roleQuery() string {
+	return fmt.Sprintf(
+		`
+			SELECT permission FROM %s 
+			WHERE role = $1 
+			ORDER BY permission;
+		`, permissionsTable,
+	)
+}
+
+func allQuery() string {
+	return fmt.Sprintf(
+		`
+			SELECT role, permission FROM %s 
+			ORDER BY role, permission;
+		`, permissionsTable,
+	)
+}
+
+func deleteQuery() string {
+	return fmt.Sprintf(
+		`
+			DELETE FROM %s 
+			WHERE role = $1;
+		`, permissionsTable,
+	)
+}
+
+func insertQuery() string {
+	return fmt.Sprintf(
+		`
+			INSERT INTO %s 
+				(role, permission) 
+			VALUES 
+				($1, $2) 
+			ON CONFLICT DO NOTHING;
+		`, permissionsTable,
+	)
+}
@@ -0,0 +1,5 @@
+package permission
+
+const (
+	permissionsTable = "role_permissions"
+)
@@ -0,0 +1,67 @@
+package security
+
+import (
+	"fmt"
+
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+)
+
+func createQuery() string {
+	return fmt.Sprintf(
+		`
+			INSERT INTO %s 
+				(user_id, type, ip, expires_at) 
+			VALUES 
+				($1, $2, $3, $4) 
+			RETURNING id, created_at;
+		`, eventsTable,
+	)
+}
+
+func userQuery() string {
+	return fmt.Sprintf(
+		`
+			SELECT * FROM %s 
+			WHERE user_id = $1 
+			ORDER BY created_at DESC 
+			LIMIT $2 OFFSET $3;
+		`, eventsTable,
+	)
+}
+
+func activeQuery() string {
+	return fmt.Sprintf(
+		`
+			SELECT * FROM %s 
+			WHERE type = $1 AND cleared_at IS NULL AND expires_at > now() 
+			ORDER BY created_at DESC;
+		`, eventsTable,
+	)
+}
+
+func clearQuery() string {
+	return fmt.Sprintf(
+		`
+			UPDATE %s 
+			SET cleared_at = now(), cleared_by = NULLIF($3, '')::uuid 
+			WHERE user_id = $1 AND type = $2 
+				AND cleared_at IS NULL AND expires_at > now();
+		`, eventsTable,
+	)
+}
+
+func rateKey(action types.Action, key string) string {
+	return fmt.Sprintf("rate_limits:%s:%s", action, key)
+}
+
+func failuresKey(userId string) string {
+	return fmt.Sprintf("login_failures:%s", userId)
+}
+
+func delayKey(userId string) string {
+	return fmt.Sprintf("login_delays:%s", userId)
+}
+
+func lockKey(userId string) string {
+	return fmt.Sprintf("lockouts:%s", userId)
+}
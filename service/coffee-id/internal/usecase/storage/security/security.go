@@ -0,0 +1,220 @@
+package security
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"time"
+
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	"github.com/nikitaSstepanov/tools/client/pg"
+	rs "github.com/nikitaSstepanov/tools/client/redis"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/redis/go-redis/v9"
+)
+
+type Security struct {
+	postgres pg.Client
+	redis    rs.Client
+}
+
+func New(postgres pg.Client, redis rs.Client) *Security {
+	return &Security{
+		postgres,
+		redis,
+	}
+}
+
+// Hit counts request in sliding window. Rejected requests aren't counted, so
+// client is let in again as soon as old requests leave the window.
+func (s *Security) Hit(ctx ctx.Context, action types.Action, key string, limit int64, window time.Duration) e.Error {
+	now := time.Now()
+	redisKey := rateKey(action, key)
+	member := fmt.Sprintf("%d-%d", now.UnixMicro(), rand.Int64())
+
+	var count *redis.IntCmd
+
+	_, err := s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRemRangeByScore(ctx, redisKey, "-inf", strconv.FormatInt(now.Add(-window).UnixMicro(), 10))
+		pipe.ZAdd(ctx, redisKey, redis.Z{Score: float64(now.UnixMicro()), Member: member})
+		count = pipe.ZCard(ctx, redisKey)
+		pipe.PExpire(ctx, redisKey, window)
+
+		return nil
+	})
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	if count.Val() > limit {
+		if err := s.redis.ZRem(ctx, redisKey, member).Err(); err != nil {
+			return e.InternalErr.
+				WithErr(err).
+				WithCtx(ctx)
+		}
+
+		return limitErr.WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// Fail counts failed login of user and returns number of failures in window.
+func (s *Security) Fail(ctx ctx.Context, userId string, window time.Duration) (int64, e.Error) {
+	key := failuresKey(userId)
+
+	failures, err := s.redis.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	if failures == 1 {
+		if err := s.redis.Expire(ctx, key, window).Err(); err != nil {
+			return 0, e.InternalErr.
+				WithErr(err).
+				WithCtx(ctx)
+		}
+	}
+
+	return failures, nil
+}
+
+func (s *Security) SetDelay(ctx ctx.Context, userId string, delay time.Duration) e.Error {
+	if err := s.redis.Set(ctx, delayKey(userId), 1, delay).Err(); err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// GetDelay returns how long user has to wait before the next login.
+func (s *Security) GetDelay(ctx ctx.Context, userId string) (time.Duration, e.Error) {
+	return s.ttl(ctx, delayKey(userId))
+}
+
+// Reset forgets failed logins of user.
+func (s *Security) Reset(ctx ctx.Context, userId string) e.Error {
+	if err := s.redis.Del(ctx, failuresKey(userId), delayKey(userId)).Err(); err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// Lock records lockout event and locks user until it expires.
+func (s *Security) Lock(ctx ctx.Context, event *entity.SecurityEvent) e.Error {
+	row := s.postgres.QueryRow(
+		ctx, createQuery(),
+		event.UserId, event.Type, event.Ip, event.ExpiresAt,
+	)
+
+	if err := row.Scan(&event.Id, &event.CreatedAt); err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	_, err := s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, lockKey(event.UserId), event.Id, time.Until(event.ExpiresAt))
+		pipe.Del(ctx, failuresKey(event.UserId), delayKey(event.UserId))
+
+		return nil
+	})
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// GetLock returns how long user stays locked.
+func (s *Security) GetLock(ctx ctx.Context, userId string) (time.Duration, e.Error) {
+	return s.ttl(ctx, lockKey(userId))
+}
+
+// Unlock removes lockout of user before it expires and marks its events
+// as cleared by admin.
+func (s *Security) Unlock(ctx ctx.Context, userId, adminId string) e.Error {
+	deleted, err := s.redis.Del(ctx, lockKey(userId), failuresKey(userId), delayKey(userId)).Result()
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	tag, err := s.postgres.Exec(ctx, clearQuery(), userId, types.LOCKOUT, adminId)
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	if deleted == 0 && tag.RowsAffected() == 0 {
+		return notLockedErr.WithCtx(ctx)
+	}
+
+	return nil
+}
+
+func (s *Security) GetEvents(ctx ctx.Context, userId string, limit, offset int) ([]*entity.SecurityEvent, e.Error) {
+	return s.query(ctx, userQuery(), userId, limit, offset)
+}
+
+// GetLockouts returns lockouts which are neither expired nor cleared.
+func (s *Security) GetLockouts(ctx ctx.Context) ([]*entity.SecurityEvent, e.Error) {
+	return s.query(ctx, activeQuery(), types.LOCKOUT)
+}
+
+func (s *Security) query(ctx ctx.Context, query string, args ...any) ([]*entity.SecurityEvent, e.Error) {
+	rows, err := s.postgres.Query(ctx, query, args...)
+	if err != nil {
+		return nil, e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+	defer rows.Close()
+
+	events := make([]*entity.SecurityEvent, 0)
+
+	for rows.Next() {
+		var event entity.SecurityEvent
+
+		if err := event.Scan(rows); err != nil {
+			return nil, e.InternalErr.
+				WithErr(err).
+				WithCtx(ctx)
+		}
+
+		events = append(events, &event)
+	}
+
+	return events, nil
+}
+
+func (s *Security) ttl(ctx ctx.Context, key string) (time.Duration, e.Error) {
+	ttl, err := s.redis.PTTL(ctx, key).Result()
+	if err != nil {
+		return 0, e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	// Missing key has negative ttl.
+	if ttl < 0 {
+		return 0, nil
+	}
+
+	return ttl, nil
+}
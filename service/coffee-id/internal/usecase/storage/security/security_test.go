@@ -0,0 +1,109 @@
+package security
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/go-playground/assert/v2"
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/nikitaSstepanov/tools/sl"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestHit(t *testing.T) {
+	repo, _, err := setupRepo()
+	if err != nil {
+		t.Fatalf("Can`t setup security repo: %v", err)
+	}
+
+	ctx := ctx.New(sl.Default())
+	ip := gofakeit.IPv4Address()
+
+	for range 3 {
+		if err := repo.Hit(ctx, types.LOGIN, ip, 3, time.Second); err != nil {
+			t.Fatalf("Test failing: %v", err)
+		}
+	}
+
+	err = repo.Hit(ctx, types.LOGIN, ip, 3, time.Second)
+	assert.Equal(t, err.GetCode(), types.TooManyRequests)
+
+	// Other actions are limited separately.
+	if err := repo.Hit(ctx, types.SIGNUP, ip, 3, time.Second); err != nil {
+		t.Errorf("Test failing: %v", err)
+	}
+
+	// Window slides, so requests are allowed again after it passes.
+	time.Sleep(time.Second)
+
+	if err := repo.Hit(ctx, types.LOGIN, ip, 3, time.Second); err != nil {
+		t.Errorf("Test failing: %v", err)
+	}
+}
+
+func TestFail(t *testing.T) {
+	repo, server, err := setupRepo()
+	if err != nil {
+		t.Fatalf("Can`t setup security repo: %v", err)
+	}
+
+	ctx := ctx.New(sl.Default())
+	userId := gofakeit.UUID()
+
+	for i := range 3 {
+		failures, err := repo.Fail(ctx, userId, time.Hour)
+		if err != nil {
+			t.Fatalf("Test failing: %v", err)
+		}
+
+		assert.Equal(t, failures, int64(i+1))
+	}
+
+	if err := repo.SetDelay(ctx, userId, time.Minute); err != nil {
+		t.Fatalf("Test failing: %v", err)
+	}
+
+	delay, err := repo.GetDelay(ctx, userId)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, delay, time.Minute)
+
+	server.FastForward(time.Minute)
+
+	delay, err = repo.GetDelay(ctx, userId)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, delay, time.Duration(0))
+
+	if err := repo.Reset(ctx, userId); err != nil {
+		t.Fatalf("Test failing: %v", err)
+	}
+
+	failures, err := repo.Fail(ctx, userId, time.Hour)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, failures, int64(1))
+}
+
+func TestRateKey(t *testing.T) {
+	ip := gofakeit.IPv4Address()
+	key := rateKey(types.LOGIN, "ip:"+ip)
+	excpected := fmt.Sprintf("rate_limits:LOGIN:ip:%s", ip)
+
+	assert.Equal(t, excpected, key)
+}
+
+func setupRepo() (*Security, *miniredis.Miniredis, e.Error) {
+	server, err := miniredis.Run()
+	if err != nil {
+		return nil, nil, e.E(err)
+	}
+
+	rs := redis.NewClient(&redis.Options{
+		Addr: server.Addr(),
+	})
+
+	return New(nil, *rs), server, nil
+}
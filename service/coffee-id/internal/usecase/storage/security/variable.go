@@ -0,0 +1,15 @@
+package security
+
+import (
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+const (
+	eventsTable = "security_events"
+)
+
+var (
+	limitErr     = e.New("Too many requests, try again later.", types.TooManyRequests)
+	notLockedErr = e.New("This account isn`t locked.", e.NotFound)
+)
@@ -0,0 +1,11 @@
+package session
+
+import "fmt"
+
+func redisKey(id string) string {
+	return fmt.Sprintf("sessions:%s", id)
+}
+
+func userKey(userId string) string {
+	return fmt.Sprintf("user_sessions:%s", userId)
+}
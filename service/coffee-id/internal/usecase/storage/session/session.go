@@ -0,0 +1,181 @@
+package session
+
+import (
+	"time"
+
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	rs "github.com/nikitaSstepanov/tools/client/redis"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/redis/go-redis/v9"
+)
+
+type Session struct {
+	redis rs.Client
+}
+
+func New(redis rs.Client) *Session {
+	return &Session{
+		redis,
+	}
+}
+
+func (s *Session) Get(ctx ctx.Context, id string) (*entity.Session, e.Error) {
+	var session entity.Session
+
+	err := s.redis.Get(ctx, redisKey(id)).Scan(&session)
+	if err != nil {
+		if err == rs.Nil {
+			return nil, notFoundErr.
+				WithErr(err).
+				WithCtx(ctx)
+		}
+
+		return nil, e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return &session, nil
+}
+
+// GetForUser returns alive sessions of user and forgets expired ones.
+func (s *Session) GetForUser(ctx ctx.Context, userId string) ([]*entity.Session, e.Error) {
+	ids, err := s.redis.SMembers(ctx, userKey(userId)).Result()
+	if err != nil {
+		return nil, e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	sessions := make([]*entity.Session, 0, len(ids))
+
+	for _, id := range ids {
+		session, err := s.Get(ctx, id)
+		if err != nil {
+			if err.GetCode() != e.NotFound {
+				return nil, err
+			}
+
+			if err := s.redis.SRem(ctx, userKey(userId), id).Err(); err != nil {
+				return nil, e.InternalErr.
+					WithErr(err).
+					WithCtx(ctx)
+			}
+
+			continue
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+func (s *Session) Create(ctx ctx.Context, session *entity.Session) e.Error {
+	ttl := time.Until(session.ExpiresAt)
+
+	_, err := s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, redisKey(session.Id), session, ttl)
+		pipe.SAdd(ctx, userKey(session.UserId), session.Id)
+		pipe.Expire(ctx, userKey(session.UserId), ttl)
+
+		return nil
+	})
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// Rotate saves session only if its current refresh token is still usedTokenId.
+// Otherwise the token was already exchanged and rotatedErr is returned.
+func (s *Session) Rotate(ctx ctx.Context, session *entity.Session, usedTokenId string) e.Error {
+	key := redisKey(session.Id)
+	ttl := time.Until(session.ExpiresAt)
+
+	var result e.Error
+
+	err := s.redis.Watch(ctx, func(tx *redis.Tx) error {
+		var current entity.Session
+
+		if err := tx.Get(ctx, key).Scan(&current); err != nil {
+			if err == rs.Nil {
+				result = notFoundErr.WithErr(err).WithCtx(ctx)
+				return nil
+			}
+
+			return err
+		}
+
+		if current.TokenId != usedTokenId {
+			result = rotatedErr.WithCtx(ctx)
+			return nil
+		}
+
+		_, err := tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, key, session, ttl)
+			pipe.Expire(ctx, userKey(session.UserId), ttl)
+
+			return nil
+		})
+
+		return err
+	}, key)
+	if err != nil {
+		// Concurrent exchange of the same token.
+		if err == redis.TxFailedErr {
+			return rotatedErr.
+				WithErr(err).
+				WithCtx(ctx)
+		}
+
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return result
+}
+
+func (s *Session) Delete(ctx ctx.Context, session *entity.Session) e.Error {
+	_, err := s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, redisKey(session.Id))
+		pipe.SRem(ctx, userKey(session.UserId), session.Id)
+
+		return nil
+	})
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return nil
+}
+
+func (s *Session) DeleteForUser(ctx ctx.Context, userId string) e.Error {
+	ids, err := s.redis.SMembers(ctx, userKey(userId)).Result()
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	keys := make([]string, 0, len(ids)+1)
+	for _, id := range ids {
+		keys = append(keys, redisKey(id))
+	}
+	keys = append(keys, userKey(userId))
+
+	if err := s.redis.Del(ctx, keys...).Err(); err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return nil
+}
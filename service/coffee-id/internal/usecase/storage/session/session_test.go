@@ -0,0 +1,125 @@
+package session
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-playground/assert/v2"
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/nikitaSstepanov/tools/sl"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestRotate(t *testing.T) {
+	repo, err := setupRepo()
+	if err != nil {
+		t.Errorf("Can`t setup session repo: %v", err)
+	}
+
+	ctx := ctx.New(sl.Default())
+
+	session := &entity.Session{
+		Id:        "session",
+		UserId:    "user",
+		TokenId:   "first",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	if err := repo.Create(ctx, session); err != nil {
+		t.Errorf("Can`t create session for tests: %v", err)
+	}
+
+	session.TokenId = "second"
+
+	tests := []struct {
+		TestName    string
+		UsedTokenId string
+		IsError     bool
+		ErrorStatus e.StatusType
+	}{
+		{
+			TestName:    "Success",
+			UsedTokenId: "first",
+			IsError:     false,
+		},
+		{
+			TestName:    "Token reuse",
+			UsedTokenId: "first",
+			IsError:     true,
+			ErrorStatus: e.Conflict,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.TestName, func(t *testing.T) {
+			err := repo.Rotate(ctx, session, tc.UsedTokenId)
+			if err != nil {
+				if tc.IsError {
+					assert.Equal(t, tc.ErrorStatus, err.GetCode())
+				} else {
+					t.Errorf("Test failing: %v", err)
+				}
+			} else if tc.IsError {
+				t.Error("Test failing: expected not nil error")
+			}
+		})
+	}
+
+	saved, err := repo.Get(ctx, session.Id)
+	if err != nil {
+		t.Errorf("Can`t get session: %v", err)
+	}
+
+	assert.Equal(t, saved.TokenId, "second")
+}
+
+func TestDeleteForUser(t *testing.T) {
+	repo, err := setupRepo()
+	if err != nil {
+		t.Errorf("Can`t setup session repo: %v", err)
+	}
+
+	ctx := ctx.New(sl.Default())
+
+	for _, id := range []string{"first", "second"} {
+		session := &entity.Session{
+			Id:        id,
+			UserId:    "user",
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		if err := repo.Create(ctx, session); err != nil {
+			t.Errorf("Can`t create session for tests: %v", err)
+		}
+	}
+
+	sessions, err := repo.GetForUser(ctx, "user")
+	if err != nil {
+		t.Errorf("Can`t get sessions: %v", err)
+	}
+
+	assert.Equal(t, len(sessions), 2)
+
+	if err := repo.DeleteForUser(ctx, "user"); err != nil {
+		t.Errorf("Can`t delete sessions: %v", err)
+	}
+
+	_, err = repo.Get(ctx, "first")
+	assert.Equal(t, err.GetCode(), e.NotFound)
+}
+
+func setupRepo() (*Session, e.Error) {
+	server, err := miniredis.Run()
+	if err != nil {
+		return nil, e.E(err)
+	}
+
+	rs := redis.NewClient(&redis.Options{
+		Addr: server.Addr(),
+	})
+
+	return New(*rs), nil
+}
@@ -0,0 +1,10 @@
+package session
+
+import (
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+var (
+	notFoundErr = e.New("This session wasn`t found.", e.NotFound)
+	rotatedErr  = e.New("Refresh token was already used.", e.Conflict)
+)
@@ -1,9 +1,19 @@
 package storage
 
 import (
+	"context"
+
 	code "github.com/nikitaSstepanov/coffee-id/internal/usecase/storage/activation_code"
+	"github.com/nikitaSstepanov/coffee-id/internal/usecase/storage/client"
+	"github.com/nikitaSstepanov/coffee-id/internal/usecase/storage/identity"
+	"github.com/nikitaSstepanov/coffee-id/internal/usecase/storage/oauth"
+	"github.com/nikitaSstepanov/coffee-id/internal/usecase/storage/permission"
+	"github.com/nikitaSstepanov/coffee-id/internal/usecase/storage/security"
+	"github.com/nikitaSstepanov/coffee-id/internal/usecase/storage/session"
+	"github.com/nikitaSstepanov/coffee-id/internal/usecase/storage/team"
+	"github.com/nikitaSstepanov/coffee-id/internal/usecase/storage/two_factor"
 	"github.com/nikitaSstepanov/coffee-id/internal/usecase/storage/user"
-	"github.com/nikitaSstepanov/coffee-id/internal/usecase/storage/yandex"
+	"github.com/nikitaSstepanov/coffee-id/pkg/metrics"
 	"github.com/nikitaSstepanov/tools"
 	"github.com/nikitaSstepanov/tools/client/pg"
 	rs "github.com/nikitaSstepanov/tools/client/redis"
@@ -13,23 +23,41 @@ import (
 )
 
 type Storage struct {
-	Users  *user.User
-	Codes  *code.Code
-	Yandex *yandex.Yandex
-	pg     pg.Client
-	rs     rs.Client
+	Users     *user.User
+	Codes     *code.Code
+	Identity  *identity.Identity
+	TwoFactor *two_factor.TwoFactor
+	Security  *security.Security
+	Teams     *team.Team
+	Perms     *permission.Permission
+	Sessions  *session.Session
+	Clients   *client.Client
+	OAuth     *oauth.OAuth
+	pg        pg.Client
+	rs        rs.Client
 }
 
 func New(c ctx.Context) *Storage {
 	postgres := connectPg(c)
 	redis := connectRs(c)
 
+	// Redis client is copied to every storage, so it must be instrumented before.
+	metrics.RegisterPgx(postgres.ToPgx())
+	metrics.RegisterRedis(&redis)
+
 	return &Storage{
-		Users:  user.New(postgres, redis),
-		Codes:  code.New(redis),
-		Yandex: yandex.New(postgres, redis),
-		pg:     postgres,
-		rs:     redis,
+		Users:     user.New(postgres, redis),
+		Codes:     code.New(redis),
+		Identity:  identity.New(postgres, redis),
+		TwoFactor: two_factor.New(postgres, redis),
+		Security:  security.New(postgres, redis),
+		Teams:     team.New(postgres),
+		Perms:     permission.New(postgres),
+		Sessions:  session.New(redis),
+		Clients:   client.New(postgres),
+		OAuth:     oauth.New(redis),
+		pg:        postgres,
+		rs:        redis,
 	}
 }
 
@@ -38,6 +66,14 @@ func (s *Storage) Close() e.Error {
 	return e.E(s.rs.Close())
 }
 
+func (s *Storage) PingPostgres(ctx context.Context) error {
+	return s.pg.ToPgx().Ping(ctx)
+}
+
+func (s *Storage) PingRedis(ctx context.Context) error {
+	return s.rs.Ping(ctx).Err()
+}
+
 func connectPg(c ctx.Context) pg.Client {
 	log := c.Logger()
 
@@ -0,0 +1,102 @@
+package team
+
+import "fmt"
+
+func idQuery() string {
+	return fmt.Sprintf(
+		`
+			SELECT * FROM %s 
+			WHERE id = $1;
+		`, teamsTable,
+	)
+}
+
+func nameQuery() string {
+	return fmt.Sprintf(
+		`
+			SELECT * FROM %s 
+			WHERE name = $1;
+		`, teamsTable,
+	)
+}
+
+func allQuery() string {
+	return fmt.Sprintf(
+		`
+			SELECT * FROM %s 
+			ORDER BY name;
+		`, teamsTable,
+	)
+}
+
+func userQuery() string {
+	return fmt.Sprintf(
+		`
+			SELECT t.* FROM %s t 
+			JOIN %s m ON m.team_id = t.id 
+			WHERE m.user_id = $1 
+			ORDER BY t.name;
+		`, teamsTable, membersTable,
+	)
+}
+
+func membersQuery() string {
+	return fmt.Sprintf(
+		`
+			SELECT user_id FROM %s 
+			WHERE team_id = $1;
+		`, membersTable,
+	)
+}
+
+func createQuery() string {
+	return fmt.Sprintf(
+		`
+			INSERT INTO %s 
+				(name) 
+			VALUES 
+				($1) 
+			RETURNING id, created_at;
+		`, teamsTable,
+	)
+}
+
+func updateQuery() string {
+	return fmt.Sprintf(
+		`
+			UPDATE %s 
+			SET name = $1 
+			WHERE id = $2;
+		`, teamsTable,
+	)
+}
+
+func deleteQuery() string {
+	return fmt.Sprintf(
+		`
+			DELETE FROM %s 
+			WHERE id = $1;
+		`, teamsTable,
+	)
+}
+
+func addMemberQuery() string {
+	return fmt.Sprintf(
+		`
+			INSERT INTO %s 
+				(team_id, user_id) 
+			VALUES 
+				($1, $2) 
+			ON CONFLICT DO NOTHING;
+		`, membersTable,
+	)
+}
+
+func removeMemberQuery() string {
+	return fmt.Sprintf(
+		`
+			DELETE FROM %s 
+			WHERE team_id = $1 AND user_id = $2;
+		`, membersTable,
+	)
+}
@@ -0,0 +1,204 @@
+package team
+
+import (
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	"github.com/nikitaSstepanov/tools/client/pg"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+	"github.com/nikitaSstepanov/tools/sl"
+)
+
+type Team struct {
+	postgres pg.Client
+}
+
+func New(postgres pg.Client) *Team {
+	return &Team{
+		postgres,
+	}
+}
+
+func (t *Team) GetById(ctx ctx.Context, id string) (*entity.Team, e.Error) {
+	var team entity.Team
+
+	row := t.postgres.QueryRow(ctx, idQuery(), id)
+
+	if err := team.Scan(row); err != nil {
+		if err == pg.ErrNoRows {
+			return nil, notFoundErr.
+				WithErr(err).
+				WithCtx(ctx)
+		}
+
+		return nil, e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	members, err := t.GetMembers(ctx, team.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	team.Members = members
+
+	return &team, nil
+}
+
+func (t *Team) GetByName(ctx ctx.Context, name string) (*entity.Team, e.Error) {
+	var team entity.Team
+
+	row := t.postgres.QueryRow(ctx, nameQuery(), name)
+
+	if err := team.Scan(row); err != nil {
+		if err == pg.ErrNoRows {
+			return nil, notFoundErr.
+				WithErr(err).
+				WithCtx(ctx)
+		}
+
+		return nil, e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return &team, nil
+}
+
+func (t *Team) GetAll(ctx ctx.Context) ([]*entity.Team, e.Error) {
+	return t.getMany(ctx, allQuery())
+}
+
+func (t *Team) GetForUser(ctx ctx.Context, userId string) ([]*entity.Team, e.Error) {
+	return t.getMany(ctx, userQuery(), userId)
+}
+
+func (t *Team) GetMembers(ctx ctx.Context, teamId string) ([]string, e.Error) {
+	rows, err := t.postgres.Query(ctx, membersQuery(), teamId)
+	if err != nil {
+		return nil, e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+	defer rows.Close()
+
+	members := make([]string, 0)
+
+	for rows.Next() {
+		var userId string
+
+		if err := rows.Scan(&userId); err != nil {
+			return nil, e.InternalErr.
+				WithErr(err).
+				WithCtx(ctx)
+		}
+
+		members = append(members, userId)
+	}
+
+	return members, nil
+}
+
+func (t *Team) Create(ctx ctx.Context, team *entity.Team) e.Error {
+	log := ctx.Logger()
+
+	tx, err := t.postgres.Begin(ctx)
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	row := tx.QueryRow(ctx, createQuery(), team.Name)
+
+	if err := row.Scan(&team.Id, &team.CreatedAt); err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		if err := tx.Rollback(ctx); err != nil {
+			log.Warn("transaction failed to rollback", sl.ErrAttr(err))
+		}
+
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	team.Members = make([]string, 0)
+
+	return nil
+}
+
+func (t *Team) Update(ctx ctx.Context, team *entity.Team) e.Error {
+	return t.exec(ctx, updateQuery(), team.Name, team.Id)
+}
+
+func (t *Team) Delete(ctx ctx.Context, id string) e.Error {
+	return t.exec(ctx, deleteQuery(), id)
+}
+
+func (t *Team) AddMember(ctx ctx.Context, teamId, userId string) e.Error {
+	return t.exec(ctx, addMemberQuery(), teamId, userId)
+}
+
+func (t *Team) RemoveMember(ctx ctx.Context, teamId, userId string) e.Error {
+	return t.exec(ctx, removeMemberQuery(), teamId, userId)
+}
+
+func (t *Team) getMany(ctx ctx.Context, query string, args ...any) ([]*entity.Team, e.Error) {
+	rows, err := t.postgres.Query(ctx, query, args...)
+	if err != nil {
+		return nil, e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+	defer rows.Close()
+
+	teams := make([]*entity.Team, 0)
+
+	for rows.Next() {
+		var team entity.Team
+
+		if err := team.Scan(rows); err != nil {
+			return nil, e.InternalErr.
+				WithErr(err).
+				WithCtx(ctx)
+		}
+
+		teams = append(teams, &team)
+	}
+
+	return teams, nil
+}
+
+func (t *Team) exec(ctx ctx.Context, query string, args ...any) e.Error {
+	log := ctx.Logger()
+
+	tx, err := t.postgres.Begin(ctx)
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		if err := tx.Rollback(ctx); err != nil {
+			log.Warn("transaction failed to rollback", sl.ErrAttr(err))
+		}
+
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return nil
+}
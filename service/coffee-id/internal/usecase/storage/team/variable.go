@@ -0,0 +1,14 @@
+package team
+
+import (
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+const (
+	teamsTable   = "teams"
+	membersTable = "team_members"
+)
+
+var (
+	notFoundErr = e.New("This team wasn`t found.", e.NotFound)
+)
@@ -0,0 +1,84 @@
+package two_factor
+
+import "fmt"
+
+func userQuery() string {
+	return fmt.Sprintf(
+		`
+			SELECT * FROM %s 
+			WHERE user_id = $1;
+		`, twoFactorTable,
+	)
+}
+
+// setQuery starts enrollment again, so previous secret stops working.
+func setQuery() string {
+	return fmt.Sprintf(
+		`
+			INSERT INTO %s 
+				(user_id, secret) 
+			VALUES 
+				($1, $2) 
+			ON CONFLICT (user_id) DO UPDATE 
+			SET secret = EXCLUDED.secret, enabled = false, 
+				recovery_codes = '{}', last_step = 0, created_at = now();
+		`, twoFactorTable,
+	)
+}
+
+func enableQuery() string {
+	return fmt.Sprintf(
+		`
+			UPDATE %s 
+			SET enabled = true, recovery_codes = $2, last_step = $3 
+			WHERE user_id = $1 AND NOT enabled;
+		`, twoFactorTable,
+	)
+}
+
+func recoveryCodesQuery() string {
+	return fmt.Sprintf(
+		`
+			UPDATE %s 
+			SET recovery_codes = $2 
+			WHERE user_id = $1 AND enabled;
+		`, twoFactorTable,
+	)
+}
+
+func stepQuery() string {
+	return fmt.Sprintf(
+		`
+			UPDATE %s 
+			SET last_step = $2 
+			WHERE user_id = $1 AND last_step < $2;
+		`, twoFactorTable,
+	)
+}
+
+func useRecoveryCodeQuery() string {
+	return fmt.Sprintf(
+		`
+			UPDATE %s 
+			SET recovery_codes = array_remove(recovery_codes, $2) 
+			WHERE user_id = $1 AND $2 = ANY(recovery_codes);
+		`, twoFactorTable,
+	)
+}
+
+func deleteQuery() string {
+	return fmt.Sprintf(
+		`
+			DELETE FROM %s 
+			WHERE user_id = $1;
+		`, twoFactorTable,
+	)
+}
+
+func challengeKey(id string) string {
+	return fmt.Sprintf("login_challenges:%s", id)
+}
+
+func attemptsKey(id string) string {
+	return fmt.Sprintf("login_challenge_attempts:%s", id)
+}
@@ -0,0 +1,195 @@
+package two_factor
+
+import (
+	"github.com/nikitaSstepanov/coffee-id/internal/entity"
+	"github.com/nikitaSstepanov/tools/client/pg"
+	rs "github.com/nikitaSstepanov/tools/client/redis"
+	"github.com/nikitaSstepanov/tools/ctx"
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+type TwoFactor struct {
+	postgres pg.Client
+	redis    rs.Client
+}
+
+func New(postgres pg.Client, redis rs.Client) *TwoFactor {
+	return &TwoFactor{
+		postgres,
+		redis,
+	}
+}
+
+func (t *TwoFactor) Get(ctx ctx.Context, userId string) (*entity.TwoFactor, e.Error) {
+	var twoFactor entity.TwoFactor
+
+	row := t.postgres.QueryRow(ctx, userQuery(), userId)
+
+	if err := twoFactor.Scan(row); err != nil {
+		if err == pg.ErrNoRows {
+			return nil, notFoundErr.
+				WithErr(err).
+				WithCtx(ctx)
+		}
+
+		return nil, e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return &twoFactor, nil
+}
+
+// Set saves new secret of user. It isn't enabled until Enable is called.
+func (t *TwoFactor) Set(ctx ctx.Context, twoFactor *entity.TwoFactor) e.Error {
+	if _, err := t.postgres.Exec(ctx, setQuery(), twoFactor.UserId, twoFactor.Secret); err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return nil
+}
+
+func (t *TwoFactor) Enable(ctx ctx.Context, twoFactor *entity.TwoFactor) e.Error {
+	tag, err := t.postgres.Exec(
+		ctx, enableQuery(),
+		twoFactor.UserId, twoFactor.RecoveryCodes, twoFactor.LastStep,
+	)
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return notFoundErr.WithCtx(ctx)
+	}
+
+	return nil
+}
+
+func (t *TwoFactor) SetRecoveryCodes(ctx ctx.Context, userId string, codes []string) e.Error {
+	tag, err := t.postgres.Exec(ctx, recoveryCodesQuery(), userId, codes)
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return notFoundErr.WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// UseStep marks TOTP step as used. Codes of this and previous steps are rejected then.
+func (t *TwoFactor) UseStep(ctx ctx.Context, userId string, step int64) e.Error {
+	tag, err := t.postgres.Exec(ctx, stepQuery(), userId, step)
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return usedErr.WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// UseRecoveryCode removes hash of recovery code, so it can be used once.
+func (t *TwoFactor) UseRecoveryCode(ctx ctx.Context, userId, hash string) e.Error {
+	tag, err := t.postgres.Exec(ctx, useRecoveryCodeQuery(), userId, hash)
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return usedErr.WithCtx(ctx)
+	}
+
+	return nil
+}
+
+func (t *TwoFactor) Delete(ctx ctx.Context, userId string) e.Error {
+	if _, err := t.postgres.Exec(ctx, deleteQuery(), userId); err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return nil
+}
+
+func (t *TwoFactor) SetChallenge(ctx ctx.Context, challenge *entity.LoginChallenge) e.Error {
+	err := t.redis.Set(ctx, challengeKey(challenge.Id), challenge, challengeExpires).Err()
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return nil
+}
+
+// GetChallenge returns challenge and counts attempt to pass it. Challenge is
+// deleted after too many attempts, so codes can't be guessed.
+func (t *TwoFactor) GetChallenge(ctx ctx.Context, id string) (*entity.LoginChallenge, e.Error) {
+	var result entity.LoginChallenge
+
+	err := t.redis.Get(ctx, challengeKey(id)).Scan(&result)
+	if err != nil {
+		if err == rs.Nil {
+			return nil, challengeNotFoundErr.
+				WithErr(err).
+				WithCtx(ctx)
+		}
+
+		return nil, e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	key := attemptsKey(id)
+
+	attempts, err := t.redis.Incr(ctx, key).Result()
+	if err != nil {
+		return nil, e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	if attempts == 1 {
+		if err := t.redis.Expire(ctx, key, challengeExpires).Err(); err != nil {
+			return nil, e.InternalErr.
+				WithErr(err).
+				WithCtx(ctx)
+		}
+	}
+
+	if attempts > maxAttempts {
+		if err := t.DelChallenge(ctx, id); err != nil {
+			return nil, err
+		}
+
+		return nil, attemptsErr.WithCtx(ctx)
+	}
+
+	return &result, nil
+}
+
+func (t *TwoFactor) DelChallenge(ctx ctx.Context, id string) e.Error {
+	err := t.redis.Del(ctx, challengeKey(id), attemptsKey(id)).Err()
+	if err != nil {
+		return e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+
+	return nil
+}
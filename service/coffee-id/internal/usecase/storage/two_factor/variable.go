@@ -0,0 +1,21 @@
+package two_factor
+
+import (
+	"time"
+
+	types "github.com/nikitaSstepanov/coffee-id/internal/entity/type"
+	e "github.com/nikitaSstepanov/tools/error"
+)
+
+const (
+	twoFactorTable   = "two_factor"
+	challengeExpires = 5 * time.Minute
+	maxAttempts      = 5
+)
+
+var (
+	notFoundErr          = e.New("Two-factor authentication isn`t set up.", e.NotFound)
+	challengeNotFoundErr = e.New("Login request is expired or invalid.", e.NotFound)
+	usedErr              = e.New("This code was already used.", e.Conflict)
+	attemptsErr          = e.New("Too many wrong codes, log in again.", types.TooManyRequests)
+)
@@ -18,6 +18,15 @@ func idQuery(id string) string {
 	)
 }
 
+func idsQuery(ids []string) string {
+	return fmt.Sprintf(
+		`
+			SELECT * FROM %s 
+			WHERE id IN ('%s');
+		`, usersTable, strings.Join(ids, "', '"),
+	)
+}
+
 func emailQuery(email string) string {
 	return fmt.Sprintf(
 		`
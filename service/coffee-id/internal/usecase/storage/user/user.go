@@ -125,6 +125,37 @@ func (u *User) GetById(ctx ctx.Context, id string) (*entity.User, e.Error) {
 	return &user, nil
 }
 
+// GetByIds returns found users, unknown ids are skipped.
+func (u *User) GetByIds(ctx ctx.Context, ids []string) ([]*entity.User, e.Error) {
+	users := make([]*entity.User, 0, len(ids))
+
+	if len(ids) == 0 {
+		return users, nil
+	}
+
+	rows, err := u.postgres.Query(ctx, idsQuery(ids))
+	if err != nil {
+		return nil, e.InternalErr.
+			WithErr(err).
+			WithCtx(ctx)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var user entity.User
+
+		if err := user.Scan(rows); err != nil {
+			return nil, e.InternalErr.
+				WithErr(err).
+				WithCtx(ctx)
+		}
+
+		users = append(users, &user)
+	}
+
+	return users, nil
+}
+
 func (u *User) GetByEmail(ctx ctx.Context, email string) (*entity.User, e.Error) {
 	var user entity.User
 
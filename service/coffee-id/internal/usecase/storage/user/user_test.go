@@ -125,6 +125,20 @@ func TestIdQuery(t *testing.T) {
 	assert.Equal(t, expected, query)
 }
 
+func TestIdsQuery(t *testing.T) {
+	first, second := gofakeit.UUID(), gofakeit.UUID()
+	query := idsQuery([]string{first, second})
+
+	expected := fmt.Sprintf(
+		`
+			SELECT * FROM %s 
+			WHERE id IN ('%s', '%s');
+		`, usersTable, first, second,
+	)
+
+	assert.Equal(t, expected, query)
+}
+
 func TestEmailQuery(t *testing.T) {
 	email := gofakeit.Email()
 	query := emailQuery(email)
@@ -1,53 +1,137 @@
 package usecase
 
 import (
+	"fmt"
+
 	"github.com/nikitaSstepanov/coffee-id/internal/usecase/admin"
+	"github.com/nikitaSstepanov/coffee-id/internal/usecase/mail"
 	"github.com/nikitaSstepanov/coffee-id/internal/usecase/pkg/account"
 	"github.com/nikitaSstepanov/coffee-id/internal/usecase/pkg/auth"
+	"github.com/nikitaSstepanov/coffee-id/internal/usecase/pkg/oauth"
+	"github.com/nikitaSstepanov/coffee-id/internal/usecase/pkg/role"
+	"github.com/nikitaSstepanov/coffee-id/internal/usecase/pkg/security"
+	"github.com/nikitaSstepanov/coffee-id/internal/usecase/pkg/team"
+	"github.com/nikitaSstepanov/coffee-id/internal/usecase/provider"
 	"github.com/nikitaSstepanov/coffee-id/internal/usecase/storage"
 	"github.com/nikitaSstepanov/tools"
 	"github.com/nikitaSstepanov/tools/httper"
 )
 
 type UseCase struct {
-	Account *account.Account
-	Auth    *auth.Auth
+	Account  *account.Account
+	Auth     *auth.Auth
+	Team     *team.Team
+	Role     *role.Role
+	OAuth    *oauth.OAuth
+	Security *security.Security
 }
 
 type Config struct {
-	Jwt   auth.JwtOptions  `yaml:"jwt"`
-	Admin httper.ClientCfg `yaml:"admin"`
+	Jwt       auth.JwtOptions   `yaml:"jwt"`
+	Admin     httper.ClientCfg  `yaml:"admin"`
+	Providers []provider.Config `yaml:"providers"`
+	Services  []oauth.Service   `yaml:"services"`
+	Mail      mail.Config       `yaml:"mail"`
 }
 
 func New(storage *storage.Storage, cfg *Config) *UseCase {
-	jwtAuth := auth.NewJwt(&cfg.Jwt)
-	adm := admin.New(&cfg.Admin)
+	jwtAuth, err := auth.NewJwt(&cfg.Jwt)
+	if err != nil {
+		panic(fmt.Sprintf("Can`t init JWT: %s", err))
+	}
+
+	providers, err := provider.New(cfg.Providers)
+	if err != nil {
+		panic(fmt.Sprintf("Can`t configure identity providers: %s", err))
+	}
+
+	services, err := oauth.NewServices(cfg.Services)
+	if err != nil {
+		panic(fmt.Sprintf("Can`t configure services: %s", err))
+	}
+
+	mailer, err := mail.New(&cfg.Mail)
+	if err != nil {
+		panic(fmt.Sprintf("Can`t configure mail: %s", err))
+	}
+
+	adm := admin.New(&cfg.Admin, jwtAuth)
 	coder := tools.Coder()
 
+	external := make(map[string]auth.Provider, len(providers))
+
+	for name, provider := range providers {
+		external[name] = provider
+	}
+
+	security := security.New(
+		&security.Storages{
+			Security: storage.Security,
+		},
+	)
+
+	auth := auth.New(
+		&auth.Storages{
+			User:       storage.Users,
+			Permission: storage.Perms,
+			Session:    storage.Sessions,
+			Identity:   storage.Identity,
+			TwoFactor:  storage.TwoFactor,
+		},
+		&auth.UseCases{
+			Providers: external,
+			Security:  security,
+			Jwt:       jwtAuth,
+			Coder:     coder,
+		},
+	)
+
 	account := account.New(
 		&account.Storages{
 			User: storage.Users,
 			Code: storage.Codes,
 		},
 		&account.UseCases{
-			Admin: adm,
-			Jwt:   jwtAuth,
-			Coder: coder,
+			Admin:    adm,
+			Session:  auth,
+			Security: security,
+			Mail:     mailer,
+			Coder:    coder,
 		},
 	)
 
-	auth := auth.New(
-		&auth.Storages{
+	role := role.New(
+		&role.Storages{
+			Permission: storage.Perms,
+		},
+	)
+
+	team := team.New(
+		&team.Storages{
+			Team: storage.Teams,
 			User: storage.Users,
 		},
-		&auth.UseCases{
-			Jwt:   jwtAuth,
-			Coder: coder,
+	)
+
+	oauth := oauth.New(
+		&oauth.Storages{
+			Client: storage.Clients,
+			OAuth:  storage.OAuth,
+			User:   storage.Users,
+		},
+		&oauth.UseCases{
+			Services: services,
+			Jwt:      jwtAuth,
+			Coder:    coder,
 		},
 	)
 
 	return &UseCase{
-		Account: account,
-		Auth:    auth,
+		Account:  account,
+		Auth:     auth,
+		Team:     team,
+		Role:     role,
+		OAuth:    oauth,
+		Security: security,
 	}
 }
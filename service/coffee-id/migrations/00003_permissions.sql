@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS role_permissions (
+    role       role        NOT NULL,
+    permission VARCHAR(64) NOT NULL,
+    PRIMARY KEY (role, permission)
+);
+
+INSERT INTO role_permissions (role, permission)
+VALUES
+('SUPPORT', 'booking.read.any'),
+('SUPPORT', 'booking.verify'),
+('SUPPORT', 'order.read.any'),
+('SUPPORT', 'order.fulfil'),
+('SUPPORT', 'account.read.any'),
+('ADMIN', 'booking.read.any'),
+('ADMIN', 'booking.write.any'),
+('ADMIN', 'booking.verify'),
+('ADMIN', 'order.read.any'),
+('ADMIN', 'order.write.any'),
+('ADMIN', 'order.fulfil'),
+('ADMIN', 'layout.edit'),
+('ADMIN', 'quota.manage'),
+('ADMIN', 'stats.read'),
+('ADMIN', 'account.read.any'),
+('ADMIN', 'account.write.any'),
+('ADMIN', 'role.assign'),
+('ADMIN', 'team.manage'),
+('SUPER_ADMIN', 'booking.read.any'),
+('SUPER_ADMIN', 'booking.write.any'),
+('SUPER_ADMIN', 'booking.verify'),
+('SUPER_ADMIN', 'order.read.any'),
+('SUPER_ADMIN', 'order.write.any'),
+('SUPER_ADMIN', 'order.fulfil'),
+('SUPER_ADMIN', 'layout.edit'),
+('SUPER_ADMIN', 'quota.manage'),
+('SUPER_ADMIN', 'stats.read'),
+('SUPER_ADMIN', 'account.read.any'),
+('SUPER_ADMIN', 'account.write.any'),
+('SUPER_ADMIN', 'role.assign'),
+('SUPER_ADMIN', 'role.manage'),
+('SUPER_ADMIN', 'team.manage') ON CONFLICT DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS role_permissions;
+-- +goose StatementEnd
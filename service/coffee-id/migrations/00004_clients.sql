@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS clients (
+    id           UUID DEFAULT gen_random_uuid() PRIMARY KEY,
+    name         VARCHAR(255) NOT NULL,
+    client_id    VARCHAR(64) UNIQUE NOT NULL,
+    secret       VARCHAR(255) NOT NULL,
+    redirect_uri VARCHAR(2048) NOT NULL,
+    fields       account_field[] NOT NULL DEFAULT '{}',
+    user_id      UUID REFERENCES users (id) ON DELETE SET NULL,
+    created_at   TIMESTAMP NOT NULL DEFAULT now()
+);
+
+INSERT INTO role_permissions (role, permission)
+VALUES
+('ADMIN', 'client.manage'),
+('SUPER_ADMIN', 'client.manage') ON CONFLICT DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM role_permissions WHERE permission = 'client.manage';
+
+DROP TABLE IF EXISTS clients;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TYPE oauth ADD VALUE IF NOT EXISTS 'OIDC';
+
+CREATE TABLE IF NOT EXISTS identities (
+    provider   VARCHAR(64)  NOT NULL,
+    subject    VARCHAR(255) NOT NULL,
+    user_id    UUID         NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    email      VARCHAR(255),
+    created_at TIMESTAMP    NOT NULL DEFAULT now(),
+    PRIMARY KEY (provider, subject),
+    UNIQUE (provider, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS identities_user_id_idx ON identities (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Values can't be removed from enum, so OIDC stays in oauth type.
+DROP TABLE IF EXISTS identities;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS two_factor (
+    user_id        UUID         PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    secret         VARCHAR(64)  NOT NULL,
+    enabled        BOOLEAN      NOT NULL DEFAULT false,
+    recovery_codes VARCHAR(64)[] NOT NULL DEFAULT '{}',
+    last_step      BIGINT       NOT NULL DEFAULT 0,
+    created_at     TIMESTAMP    NOT NULL DEFAULT now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS two_factor;
+-- +goose StatementEnd
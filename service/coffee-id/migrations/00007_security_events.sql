@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS security_events (
+    id         UUID        DEFAULT gen_random_uuid() PRIMARY KEY,
+    user_id    UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    type       VARCHAR(32) NOT NULL,
+    ip         VARCHAR(64) NOT NULL DEFAULT '',
+    created_at TIMESTAMP   NOT NULL DEFAULT now(),
+    expires_at TIMESTAMP   NOT NULL,
+    cleared_at TIMESTAMP,
+    cleared_by UUID        REFERENCES users (id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS security_events_user_id_idx ON security_events (user_id, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS security_events;
+-- +goose StatementEnd
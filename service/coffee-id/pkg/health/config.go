@@ -0,0 +1,14 @@
+package health
+
+import "time"
+
+type Config struct {
+	Timeout    time.Duration `yaml:"timeout" env:"HEALTH_TIMEOUT" env-default:"2s"`
+	Downstream []Downstream  `yaml:"downstream"`
+}
+
+// Downstream is a service whose liveness endpoint is reported by readiness.
+type Downstream struct {
+	Name string `yaml:"name"`
+	Url  string `yaml:"url"`
+}
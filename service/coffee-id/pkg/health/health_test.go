@@ -0,0 +1,48 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestChecker(t *testing.T) {
+	admin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer admin.Close()
+
+	c := New(&Config{
+		Timeout:    time.Second,
+		Downstream: []Downstream{{Name: "admin", Url: admin.URL}},
+	})
+	c.Add("postgres", func(ctx context.Context) error { return nil })
+
+	res := c.Run(context.Background())
+	if res.Status != StatusDegraded {
+		t.Errorf("status with failing downstream = %q, want %q", res.Status, StatusDegraded)
+	}
+
+	if got := res.Checks["admin"].Error; got != "unexpected status 503" {
+		t.Errorf("admin error = %q", got)
+	}
+
+	c.Add("redis", func(ctx context.Context) error { return errors.New("connection refused") })
+
+	w := httptest.NewRecorder()
+	c.Ready(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("ready code with failing redis = %d, want 503", w.Code)
+	}
+
+	c.Drain()
+
+	w = httptest.NewRecorder()
+	c.Live(w, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if w.Code != http.StatusOK {
+		t.Errorf("live code while draining = %d, want 200", w.Code)
+	}
+}
@@ -0,0 +1,38 @@
+package health
+
+import "runtime/debug"
+
+// Version and Commit are passed to the dockerfile as VERSION and COMMIT
+// build args.
+var (
+	Version = "dev"
+	Commit  = ""
+)
+
+type BuildInfo struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit,omitempty"`
+	GoVersion string `json:"go_version"`
+}
+
+// Build returns version info of the running binary. Commit falls back to
+// the VCS revision stamped by the go tool when it isn't set explicitly.
+func Build() BuildInfo {
+	info := BuildInfo{Version: Version, Commit: Commit}
+
+	build, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+
+	info.GoVersion = build.GoVersion
+	if info.Commit == "" {
+		for _, setting := range build.Settings {
+			if setting.Key == "vcs.revision" {
+				info.Commit = setting.Value
+			}
+		}
+	}
+
+	return info
+}
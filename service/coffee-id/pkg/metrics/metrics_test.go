@@ -0,0 +1,32 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestGin(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(Gin())
+	router.GET("/account/:id", func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	for _, path := range []string{"/account/1", "/account/2", "/unknown"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	if got := testutil.ToFloat64(requestsTotal.WithLabelValues("/account/:id", http.MethodGet, "204")); got != 2 {
+		t.Errorf("requests of route = %v, want 2", got)
+	}
+
+	if got := testutil.ToFloat64(requestsTotal.WithLabelValues("unmatched", http.MethodGet, "404")); got != 1 {
+		t.Errorf("unmatched requests = %v, want 1", got)
+	}
+}
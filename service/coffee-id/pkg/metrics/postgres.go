@@ -0,0 +1,51 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// RegisterPgx exports connection pool statistics of postgres.
+func RegisterPgx(pool *pgxpool.Pool) {
+	gauge := func(name, help string, value func(*pgxpool.Stat) float64) prometheus.Collector {
+		return prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "db_pool",
+			Name:      name,
+			Help:      help,
+		}, func() float64 { return value(pool.Stat()) })
+	}
+
+	counter := func(name, help string, value func(*pgxpool.Stat) float64) prometheus.Collector {
+		return prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "db_pool",
+			Name:      name,
+			Help:      help,
+		}, func() float64 { return value(pool.Stat()) })
+	}
+
+	registry.MustRegister(
+		gauge("max_conns", "Maximum size of the pool.", func(s *pgxpool.Stat) float64 {
+			return float64(s.MaxConns())
+		}),
+		gauge("total_conns", "Number of connections in the pool.", func(s *pgxpool.Stat) float64 {
+			return float64(s.TotalConns())
+		}),
+		gauge("acquired_conns", "Number of connections in use.", func(s *pgxpool.Stat) float64 {
+			return float64(s.AcquiredConns())
+		}),
+		gauge("idle_conns", "Number of idle connections.", func(s *pgxpool.Stat) float64 {
+			return float64(s.IdleConns())
+		}),
+		counter("acquires_total", "Number of connection acquires.", func(s *pgxpool.Stat) float64 {
+			return float64(s.AcquireCount())
+		}),
+		counter("empty_acquires_total", "Number of acquires that waited for a connection.", func(s *pgxpool.Stat) float64 {
+			return float64(s.EmptyAcquireCount())
+		}),
+		counter("acquire_duration_seconds_total", "Total time spent acquiring connections.", func(s *pgxpool.Stat) float64 {
+			return s.AcquireDuration().Seconds()
+		}),
+	)
+}
@@ -0,0 +1,108 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	redisCommands = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "redis",
+		Name:      "commands_total",
+		Help:      "Number of executed redis commands.",
+	}, []string{"command", "status"})
+
+	redisDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "redis",
+		Name:      "command_duration_seconds",
+		Help:      "Duration of redis commands.",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+	}, []string{"command"})
+)
+
+// RegisterRedis instruments commands of the client and exports its
+// connection pool statistics.
+func RegisterRedis(client *redis.Client) {
+	client.AddHook(redisHook{})
+
+	gauge := func(name, help string, value func(*redis.PoolStats) float64) prometheus.Collector {
+		return prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "redis_pool",
+			Name:      name,
+			Help:      help,
+		}, func() float64 { return value(client.PoolStats()) })
+	}
+
+	counter := func(name, help string, value func(*redis.PoolStats) float64) prometheus.Collector {
+		return prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "redis_pool",
+			Name:      name,
+			Help:      help,
+		}, func() float64 { return value(client.PoolStats()) })
+	}
+
+	registry.MustRegister(
+		gauge("total_conns", "Number of connections in the pool.", func(s *redis.PoolStats) float64 {
+			return float64(s.TotalConns)
+		}),
+		gauge("idle_conns", "Number of idle connections.", func(s *redis.PoolStats) float64 {
+			return float64(s.IdleConns)
+		}),
+		counter("hits_total", "Number of times free connection was found in the pool.", func(s *redis.PoolStats) float64 {
+			return float64(s.Hits)
+		}),
+		counter("misses_total", "Number of times free connection was not found in the pool.", func(s *redis.PoolStats) float64 {
+			return float64(s.Misses)
+		}),
+		counter("timeouts_total", "Number of times a wait timeout occurred.", func(s *redis.PoolStats) float64 {
+			return float64(s.Timeouts)
+		}),
+	)
+}
+
+type redisHook struct{}
+
+func (redisHook) DialHook(next redis.DialHook) redis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return next(ctx, network, addr)
+	}
+}
+
+func (redisHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		start := time.Now()
+		err := next(ctx, cmd)
+		observeRedis(cmd.Name(), err, time.Since(start))
+
+		return err
+	}
+}
+
+func (redisHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		start := time.Now()
+		err := next(ctx, cmds)
+		observeRedis("pipeline", err, time.Since(start))
+
+		return err
+	}
+}
+
+func observeRedis(command string, err error, elapsed time.Duration) {
+	status := "ok"
+	if err != nil && !errors.Is(err, redis.Nil) {
+		status = "error"
+	}
+
+	redisCommands.WithLabelValues(command, status).Inc()
+	redisDuration.WithLabelValues(command).Observe(elapsed.Seconds())
+}
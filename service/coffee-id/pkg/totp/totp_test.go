@@ -0,0 +1,56 @@
+package totp
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-playground/assert/v2"
+)
+
+// Vectors of RFC 6238 for SHA1, truncated to 6 digits.
+func TestCode(t *testing.T) {
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+	tests := []struct {
+		Time int64
+		Code string
+	}{
+		{Time: 59, Code: "287082"},
+		{Time: 1111111109, Code: "081804"},
+		{Time: 1234567890, Code: "005924"},
+		{Time: 20000000000, Code: "353130"},
+	}
+
+	for _, tc := range tests {
+		code, err := Code(secret, Step(time.Unix(tc.Time, 0)))
+		if err != nil {
+			t.Fatalf("Can`t generate code: %v", err)
+		}
+
+		assert.Equal(t, code, tc.Code)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret := NewSecret()
+	now := time.Now()
+
+	previous, _ := Code(secret, Step(now)-1)
+	old, _ := Code(secret, Step(now)-3)
+
+	step, ok := Validate(secret, previous, now)
+	assert.Equal(t, ok, true)
+	assert.Equal(t, step, Step(now)-1)
+
+	_, ok = Validate(secret, old, now)
+	assert.Equal(t, ok, false)
+}
+
+func TestUri(t *testing.T) {
+	uri := Uri("Coffee ID", "user@coffee.id", "SECRET")
+
+	assert.Equal(t, strings.HasPrefix(uri, "otpauth://totp/Coffee%20ID:user@coffee.id?"), true)
+	assert.Equal(t, strings.Contains(uri, "secret=SECRET"), true)
+}
@@ -0,0 +1,40 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestOutgoing(t *testing.T) {
+	if _, err := Init(context.Background(), &Config{Exporter: ExporterNone}); err != nil {
+		t.Fatalf("Can`t init tracing: %v", err)
+	}
+
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	req, err := http.NewRequest(http.MethodPost, "http://admin:80/api/v1/verification/42", nil)
+	if err != nil {
+		t.Fatalf("Can`t create request: %v", err)
+	}
+
+	End(Outgoing(context.Background(), req), http.StatusBadGateway, nil)
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("ended spans = %d, want 1", len(spans))
+	}
+
+	if got := spans[0].Name(); got != "POST admin:80" {
+		t.Errorf("span name = %q, want %q", got, "POST admin:80")
+	}
+
+	if req.Header.Get("traceparent") == "" {
+		t.Errorf("traceparent is not propagated")
+	}
+}
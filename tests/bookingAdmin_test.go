@@ -71,6 +71,8 @@ func TestListAllBookingsAdmin(t *testing.T) {
 			Expect().
 			Status(http.StatusOK).
 			JSON().
+			Object().
+			Value("items").
 			Array().
 			NotEmpty().
 			Value(0).
@@ -157,6 +157,8 @@ func TestListAllBookings(t *testing.T) {
 			Expect().
 			Status(http.StatusOK).
 			JSON().
+			Object().
+			Value("items").
 			Array().
 			NotEmpty().
 			Value(0).
@@ -166,6 +168,40 @@ func TestListAllBookings(t *testing.T) {
 			ContainsKey("entity")
 	})
 
+	t.Run("List All Bookings - Pagination", func(t *testing.T) {
+		page := e.GET("/booking/bookings").
+			WithHeader("Authorization", "Bearer "+adminToken).
+			WithQuery("limit", 1).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object()
+
+		page.Value("items").Array().Length().IsEqual(1)
+		cursor := page.Value("next_cursor").String().NotEmpty().Raw()
+
+		e.GET("/booking/bookings").
+			WithHeader("Authorization", "Bearer "+adminToken).
+			WithQuery("limit", 1).
+			WithQuery("cursor", cursor).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			Value("items").
+			Array().
+			Length().
+			IsEqual(1)
+	})
+
+	t.Run("List All Bookings - Invalid Cursor", func(t *testing.T) {
+		e.GET("/booking/bookings").
+			WithHeader("Authorization", "Bearer "+adminToken).
+			WithQuery("cursor", "invalid").
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
 	t.Run("List All Bookings - Unauthorized", func(t *testing.T) {
 		e.GET("/booking/bookings").
 			Expect().
@@ -238,6 +274,8 @@ func TestListMyBookings(t *testing.T) {
 			Expect().
 			Status(http.StatusOK).
 			JSON().
+			Object().
+			Value("items").
 			Array().
 			NotEmpty().
 			Value(0).
@@ -400,6 +438,85 @@ func TestUpdateBooking(t *testing.T) {
 
 	})
 
+	t.Run("Update Booking - Precondition Failed", func(t *testing.T) {
+		updateData := map[string]interface{}{
+			"time_from": 1672506000,
+			"time_to":   1672509600,
+		}
+
+		etag := e.GET("/booking/bookings/{bookingId}", booking["id"].(string)).
+			WithHeader("Authorization", "Bearer "+token).
+			Expect().
+			Status(http.StatusOK).
+			Header("ETag").
+			NotEmpty().
+			Raw()
+
+		e.PATCH("/booking/bookings/{bookingId}", booking["id"].(string)).
+			WithHeader("Authorization", "Bearer "+token).
+			WithHeader("If-Match", etag).
+			WithJSON(updateData).
+			Expect().
+			Status(http.StatusOK).
+			Header("ETag").
+			NotEqual(etag)
+
+		// Изменение по устаревшей версии отклоняется
+		e.PATCH("/booking/bookings/{bookingId}", booking["id"].(string)).
+			WithHeader("Authorization", "Bearer "+token).
+			WithHeader("If-Match", etag).
+			WithJSON(updateData).
+			Expect().
+			Status(http.StatusPreconditionFailed)
+	})
+
+	t.Run("Extend Booking - Not Active", func(t *testing.T) {
+		e.POST("/booking/bookings/{bookingId}/extend", booking["id"].(string)).
+			WithHeader("Authorization", "Bearer "+token).
+			WithJSON(map[string]interface{}{
+				"slots": 2,
+			}).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
+	t.Run("Release Booking - Not Active", func(t *testing.T) {
+		e.POST("/booking/bookings/{bookingId}/release", booking["id"].(string)).
+			WithHeader("Authorization", "Bearer "+token).
+			Expect().
+			Status(http.StatusBadRequest)
+
+		e.GET("/booking/bookings/{bookingId}/history", booking["id"].(string)).
+			WithHeader("Authorization", "Bearer "+token).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Array().
+			IsEmpty()
+	})
+
+	t.Run("Transfer Booking - Finished", func(t *testing.T) {
+		recipient, _ := createUser(e)
+
+		e.POST("/booking/bookings/{bookingId}/transfers", booking["id"].(string)).
+			WithHeader("Authorization", "Bearer "+token).
+			WithJSON(map[string]interface{}{
+				"email": recipient["email"],
+			}).
+			Expect().
+			Status(http.StatusBadRequest)
+
+		e.GET("/booking/transfers").
+			WithHeader("Authorization", "Bearer "+token).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			Value("outgoing").
+			Array().
+			IsEmpty()
+	})
+
 	t.Run("Update Booking - Invalid Data", func(t *testing.T) {
 		invalidUpdateData := map[string]interface{}{
 			"time_from": "invalid-time", // Некорректное время
@@ -224,6 +224,60 @@ func TestSaveFloorLayout(t *testing.T) {
 			Status(http.StatusOK)
 	})
 
+	t.Run("Save Floor Layout - Precondition Failed", func(t *testing.T) {
+		createFloor(e, floorData)
+
+		// Очистка данных после теста
+		t.Cleanup(func() {
+			deleteFloor(e, floorData["id"].(string))
+		})
+
+		etag := e.GET("/admin/layout/floors/{id}", floorData["id"].(string)).
+			WithHeader("Authorization", "Bearer "+adminToken).
+			Expect().
+			Status(http.StatusOK).
+			Header("ETag").
+			NotEmpty().
+			Raw()
+
+		renamed := map[string]interface{}{}
+		for key, value := range floorData["entities"].([]map[string]interface{})[0] {
+			renamed[key] = value
+		}
+		renamed["title"] = "Renamed Room"
+
+		// Другой администратор переименовывает комнату
+		e.POST("/admin/layout/floors").
+			WithHeader("Authorization", "Bearer "+adminToken).
+			WithHeader("If-Match", etag).
+			WithJSON(map[string]interface{}{
+				"id":       floorData["id"],
+				"name":     floorData["name"],
+				"entities": []map[string]interface{}{renamed},
+			}).
+			Expect().
+			Status(http.StatusOK).
+			Header("ETag").
+			NotEqual(etag)
+
+		// Сохранение устаревшего макета перезаписало бы переименование
+		conflicts := e.POST("/admin/layout/floors").
+			WithHeader("Authorization", "Bearer "+adminToken).
+			WithHeader("If-Match", etag).
+			WithJSON(floorData).
+			Expect().
+			Status(http.StatusPreconditionFailed).
+			JSON().
+			Object().
+			Value("entities").
+			Array()
+
+		conflicts.Length().IsEqual(1)
+		conflicts.Value(0).Object().
+			HasValue("entity_id", renamed["id"]).
+			HasValue("reason", "MODIFIED")
+	})
+
 	t.Run("Save Floor Layout - Invalid Data", func(t *testing.T) {
 		invalidFloorData := map[string]interface{}{
 			"id":   "invalid-id",
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/gavv/httpexpect/v2"
+	"github.com/google/uuid"
 )
 
 // Тест для создания заказа
@@ -98,6 +99,39 @@ func TestCreateOrder(t *testing.T) {
 			Status(http.StatusBadRequest)
 	})
 
+	t.Run("Create Order - Idempotency Key", func(t *testing.T) {
+		key := uuid.New().String()
+		coffee := map[string]interface{}{
+			"thing": "coffee",
+		}
+
+		create := func(data map[string]interface{}) *httpexpect.Response {
+			return e.POST("/booking/bookings/{bookingId}/orders", bookingID).
+				WithHeader("Authorization", "Bearer "+token).
+				WithHeader("Idempotency-Key", key).
+				WithJSON(data).
+				Expect()
+		}
+
+		first := create(coffee).Status(http.StatusOK).JSON().Object()
+		orderID := first.Value("id").String().Raw()
+
+		t.Cleanup(func() {
+			deleteOrder(e, token, bookingID, orderID)
+		})
+
+		// Повторный запрос возвращает тот же заказ, а не создает новый
+		create(coffee).
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			HasValue("id", orderID)
+
+		// Тот же ключ с другим телом запроса
+		create(orderData).
+			Status(http.StatusUnprocessableEntity)
+	})
+
 	t.Run("Create Order - Unauthorized", func(t *testing.T) {
 		e.POST("/booking/bookings/{bookingId}/orders", bookingID).
 			WithJSON(orderData).